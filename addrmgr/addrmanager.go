@@ -779,59 +779,88 @@ func (a *AddrManager) GetAddress() *KnownAddress {
 
 	// Use a 50% chance for choosing between tried and new table entries.
 	if a.nTried > 0 && (a.nNew == 0 || a.rand.Intn(2) == 0) {
-		// Tried entry.
-		large := 1 << 30
-		factor := 1.0
-		for {
-			// pick a random bucket.
-			bucket := a.rand.Intn(len(a.addrTried))
-			if a.addrTried[bucket].Len() == 0 {
-				continue
-			}
+		return a.getTriedAddress()
+	}
+	return a.getNewAddress()
+}
 
-			// Pick a random entry in the list
-			e := a.addrTried[bucket].Front()
-			for i :=
-				a.rand.Int63n(int64(a.addrTried[bucket].Len())); i > 0; i-- {
-				e = e.Next()
-			}
-			ka := e.Value.(*KnownAddress)
-			randval := a.rand.Intn(large)
-			if float64(randval) < (factor * ka.chance() * float64(large)) {
-				log.Tracef("Selected %v from tried bucket",
-					NetAddressKey(ka.na))
-				return ka
-			}
-			factor *= 1.2
+// GetNewTableAddress returns a single address from the new table, which holds
+// the addresses that were never successfully connected to, or nil when it is
+// empty.  It is used to pick addresses to test with feeler connections before
+// they are moved to the tried table.
+func (a *AddrManager) GetNewTableAddress() *KnownAddress {
+	// Protect concurrent access.
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if a.nNew == 0 {
+		return nil
+	}
+	return a.getNewAddress()
+}
+
+// getTriedAddress picks a random address from the tried table with preference
+// given to ones that have not been used recently.
+//
+// This function MUST be called with the address manager lock held and with at
+// least one address in the tried table.
+func (a *AddrManager) getTriedAddress() *KnownAddress {
+	large := 1 << 30
+	factor := 1.0
+	for {
+		// pick a random bucket.
+		bucket := a.rand.Intn(len(a.addrTried))
+		if a.addrTried[bucket].Len() == 0 {
+			continue
 		}
-	} else {
-		// new node.
-		// XXX use a closure/function to avoid repeating this.
-		large := 1 << 30
-		factor := 1.0
-		for {
-			// Pick a random bucket.
-			bucket := a.rand.Intn(len(a.addrNew))
-			if len(a.addrNew[bucket]) == 0 {
-				continue
-			}
-			// Then, a random entry in it.
-			var ka *KnownAddress
-			nth := a.rand.Intn(len(a.addrNew[bucket]))
-			for _, value := range a.addrNew[bucket] {
-				if nth == 0 {
-					ka = value
-				}
-				nth--
-			}
-			randval := a.rand.Intn(large)
-			if float64(randval) < (factor * ka.chance() * float64(large)) {
-				log.Tracef("Selected %v from new bucket",
-					NetAddressKey(ka.na))
-				return ka
+
+		// Pick a random entry in the list
+		e := a.addrTried[bucket].Front()
+		for i :=
+			a.rand.Int63n(int64(a.addrTried[bucket].Len())); i > 0; i-- {
+			e = e.Next()
+		}
+		ka := e.Value.(*KnownAddress)
+		randval := a.rand.Intn(large)
+		if float64(randval) < (factor * ka.chance() * float64(large)) {
+			log.Tracef("Selected %v from tried bucket",
+				NetAddressKey(ka.na))
+			return ka
+		}
+		factor *= 1.2
+	}
+}
+
+// getNewAddress picks a random address from the new table with preference
+// given to ones that have not been used recently.
+//
+// This function MUST be called with the address manager lock held and with at
+// least one address in the new table.
+func (a *AddrManager) getNewAddress() *KnownAddress {
+	large := 1 << 30
+	factor := 1.0
+	for {
+		// Pick a random bucket.
+		bucket := a.rand.Intn(len(a.addrNew))
+		if len(a.addrNew[bucket]) == 0 {
+			continue
+		}
+		// Then, a random entry in it.
+		var ka *KnownAddress
+		nth := a.rand.Intn(len(a.addrNew[bucket]))
+		for _, value := range a.addrNew[bucket] {
+			if nth == 0 {
+				ka = value
 			}
-			factor *= 1.2
+			nth--
+		}
+		randval := a.rand.Intn(large)
+		if float64(randval) < (factor * ka.chance() * float64(large)) {
+			log.Tracef("Selected %v from new bucket",
+				NetAddressKey(ka.na))
+			return ka
 		}
+		factor *= 1.2
 	}
 }
 
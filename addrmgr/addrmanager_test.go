@@ -353,6 +353,33 @@ func TestGetAddress(t *testing.T) {
 	}
 }
 
+// TestGetNewTableAddress ensures that only addresses which were never
+// successfully connected to are returned.
+func TestGetNewTableAddress(t *testing.T) {
+	n := addrmgr.New("testgetnewtableaddress", lookupFunc)
+	if rv := n.GetNewTableAddress(); rv != nil {
+		t.Errorf("GetNewTableAddress failed: got: %v want: %v\n", rv, nil)
+	}
+
+	err := n.AddAddressByIP(someIP + ":8333")
+	if err != nil {
+		t.Fatalf("Adding address failed: %v", err)
+	}
+	ka := n.GetNewTableAddress()
+	if ka == nil {
+		t.Fatalf("Did not get an address where there is one in the new table")
+	}
+	if ka.NetAddress().IP.String() != someIP {
+		t.Errorf("Wrong IP: got %v, want %v", ka.NetAddress().IP.String(), someIP)
+	}
+
+	// Once marked as good, the address moves to the tried table.
+	n.Good(ka.NetAddress())
+	if rv := n.GetNewTableAddress(); rv != nil {
+		t.Errorf("GetNewTableAddress failed: got: %v want: %v\n", rv, nil)
+	}
+}
+
 func TestGetBestLocalAddress(t *testing.T) {
 	localAddrs := []wire.NetAddress{
 		{IP: net.ParseIP("192.168.0.100")},
@@ -0,0 +1,92 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkt-cash/pktd/connmgr"
+)
+
+// anchorsFilename is the name of the file the addresses of the block relay only
+// peers are saved to in the data directory.
+const anchorsFilename = "anchors.json"
+
+// anchorsPath returns the path of the file the anchors are saved to.
+func anchorsPath() string {
+	return filepath.Join(cfg.DataDir, anchorsFilename)
+}
+
+// loadAnchors returns the addresses of the block relay only peers saved when
+// the server last stopped.  The file is removed so that a node which can't
+// connect to its anchors doesn't try them again after every restart.
+func loadAnchors() []string {
+	path := anchorsPath()
+	f, errr := os.Open(path)
+	if os.IsNotExist(errr) {
+		return nil
+	}
+	if errr != nil {
+		srvrLog.Warnf("Unable to open anchors file: %v", errr)
+		return nil
+	}
+	var anchors []string
+	decodeErr := jsoniter.NewDecoder(f).Decode(&anchors)
+	f.Close()
+	if errr := os.Remove(path); errr != nil {
+		srvrLog.Warnf("Unable to remove anchors file: %v", errr)
+	}
+	if decodeErr != nil {
+		srvrLog.Warnf("Unable to decode anchors file: %v", decodeErr)
+		return nil
+	}
+	if len(anchors) > defaultTargetBlockRelayOnly {
+		anchors = anchors[:defaultTargetBlockRelayOnly]
+	}
+	srvrLog.Debugf("Loaded %d anchors", len(anchors))
+	return anchors
+}
+
+// popAnchor returns the address of a block relay only peer of the previous run
+// to reconnect to, or an empty string when there are none left.
+func (s *server) popAnchor() string {
+	s.anchorsMtx.Lock()
+	defer s.anchorsMtx.Unlock()
+	if len(s.anchors) == 0 {
+		return ""
+	}
+	anchor := s.anchors[0]
+	s.anchors = s.anchors[1:]
+	return anchor
+}
+
+// saveAnchors saves the addresses of the connected block relay only peers so
+// that they are reconnected to after a restart.  It is invoked from the
+// peerHandler goroutine.
+func (s *server) saveAnchors(state *peerState) {
+	var anchors []string
+	for _, sp := range state.outboundPeers {
+		if sp.Connected() && sp.connClass() == connmgr.ConnBlockRelayOnly {
+			anchors = append(anchors, sp.Addr())
+		}
+	}
+	if len(anchors) == 0 {
+		return
+	}
+
+	f, errr := os.Create(anchorsPath())
+	if errr != nil {
+		srvrLog.Errorf("Unable to create anchors file: %v", errr)
+		return
+	}
+	defer f.Close()
+	if errr := jsoniter.NewEncoder(f).Encode(anchors); errr != nil {
+		srvrLog.Errorf("Unable to save anchors: %v", errr)
+		return
+	}
+	srvrLog.Debugf("Saved %d anchors", len(anchors))
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/database"
+)
+
+// banListBucketName is the name of the db bucket used to house the bans.
+var banListBucketName = []byte("banlist")
+
+// banValueSize is the size of a serialized ban excluding its reason, the
+// expiry and the creation times as unix timestamps.
+const banValueSize = 8 + 8
+
+// banEntry is a banned IP address or subnet.
+type banEntry struct {
+	ipNet   *net.IPNet
+	created time.Time
+	until   time.Time
+	reason  string
+}
+
+// banList maintains the banned IP addresses and subnets.  Each change is
+// written to the chain database so that bans survive a restart.
+//
+// The ban list is not safe for concurrent access, it is owned by the
+// peerHandler goroutine.
+type banList struct {
+	db   database.DB
+	bans map[string]*banEntry
+}
+
+// serializeBan returns the value of a ban in the database.
+//
+//	Field           Type              Size
+//	banned until    int64             8 bytes
+//	created         int64             8 bytes
+//	reason          string            variable
+func serializeBan(ban *banEntry) []byte {
+	serialized := make([]byte, banValueSize+len(ban.reason))
+	binary.LittleEndian.PutUint64(serialized, uint64(ban.until.Unix()))
+	binary.LittleEndian.PutUint64(serialized[8:], uint64(ban.created.Unix()))
+	copy(serialized[banValueSize:], ban.reason)
+	return serialized
+}
+
+// deserializeBan decodes a ban from its key and value in the database.
+func deserializeBan(key, serialized []byte) (*banEntry, er.R) {
+	ipNet, err := parseSubnet(string(key))
+	if err != nil {
+		return nil, err
+	}
+	if len(serialized) < banValueSize {
+		return nil, er.Errorf("unexpected end of data for ban of %s",
+			ipNet)
+	}
+	return &banEntry{
+		ipNet:   ipNet,
+		until:   time.Unix(int64(binary.LittleEndian.Uint64(serialized)), 0),
+		created: time.Unix(int64(binary.LittleEndian.Uint64(serialized[8:])), 0),
+		reason:  string(serialized[banValueSize:]),
+	}, nil
+}
+
+// parseSubnet parses an IP address or a subnet in CIDR notation.  A single
+// address is treated as a subnet containing only that address.
+func parseSubnet(subnet string) (*net.IPNet, er.R) {
+	if strings.Contains(subnet, "/") {
+		_, ipNet, errr := net.ParseCIDR(subnet)
+		if errr != nil {
+			return nil, er.E(errr)
+		}
+		return ipNet, nil
+	}
+	ip := net.ParseIP(subnet)
+	if ip == nil {
+		return nil, er.Errorf("invalid IP address or subnet: %s", subnet)
+	}
+	return singleIPNet(ip), nil
+}
+
+// singleIPNet returns the subnet containing only the provided address.
+func singleIPNet(ip net.IP) *net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+// loadBanList loads the bans from the database, creating the bucket which
+// houses them if needed.  Bans which have expired are dropped.
+func loadBanList(db database.DB) (*banList, er.R) {
+	bl := &banList{
+		db:   db,
+		bans: make(map[string]*banEntry),
+	}
+	now := time.Now()
+	err := db.Update(func(dbTx database.Tx) er.R {
+		bucket, err := dbTx.Metadata().CreateBucketIfNotExists(banListBucketName)
+		if err != nil {
+			return err
+		}
+		var expired [][]byte
+		err = bucket.ForEach(func(k, v []byte) er.R {
+			ban, err := deserializeBan(k, v)
+			if err != nil {
+				return err
+			}
+			if !now.Before(ban.until) {
+				expired = append(expired, k)
+				return nil
+			}
+			bl.bans[ban.ipNet.String()] = ban
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bl, nil
+}
+
+// ban bans the provided subnet until the provided time, replacing any
+// existing ban of the same subnet.
+func (bl *banList) ban(ipNet *net.IPNet, until time.Time, reason string) er.R {
+	ban := &banEntry{
+		ipNet:   ipNet,
+		created: time.Now(),
+		until:   until,
+		reason:  reason,
+	}
+	key := ipNet.String()
+	err := bl.db.Update(func(dbTx database.Tx) er.R {
+		bucket := dbTx.Metadata().Bucket(banListBucketName)
+		return bucket.Put([]byte(key), serializeBan(ban))
+	})
+	if err != nil {
+		return err
+	}
+	bl.bans[key] = ban
+	return nil
+}
+
+// unban lifts the ban of the provided subnet.  It returns whether the subnet
+// was banned.
+func (bl *banList) unban(ipNet *net.IPNet) (bool, er.R) {
+	key := ipNet.String()
+	if _, ok := bl.bans[key]; !ok {
+		return false, nil
+	}
+	err := bl.db.Update(func(dbTx database.Tx) er.R {
+		return dbTx.Metadata().Bucket(banListBucketName).Delete([]byte(key))
+	})
+	if err != nil {
+		return false, err
+	}
+	delete(bl.bans, key)
+	return true, nil
+}
+
+// clear lifts all bans.
+func (bl *banList) clear() er.R {
+	err := bl.db.Update(func(dbTx database.Tx) er.R {
+		meta := dbTx.Metadata()
+		if err := meta.DeleteBucket(banListBucketName); err != nil {
+			return err
+		}
+		_, err := meta.CreateBucket(banListBucketName)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	bl.bans = make(map[string]*banEntry)
+	return nil
+}
+
+// isBanned returns whether the provided subnet itself is banned.
+func (bl *banList) isBanned(ipNet *net.IPNet) bool {
+	ban, ok := bl.bans[ipNet.String()]
+	return ok && time.Now().Before(ban.until)
+}
+
+// lookup returns the ban, among those which have not yet expired, covering
+// the provided address or nil when it is not banned.  When several bans
+// cover it, the one lasting the longest is returned.
+func (bl *banList) lookup(ip net.IP) *banEntry {
+	var found *banEntry
+	for _, ban := range bl.entries() {
+		if ban.ipNet.Contains(ip) &&
+			(found == nil || ban.until.After(found.until)) {
+			found = ban
+		}
+	}
+	return found
+}
+
+// entries returns the bans which have not yet expired, sorted by subnet.
+// Expired bans are removed from the list.
+func (bl *banList) entries() []*banEntry {
+	now := time.Now()
+	bans := make([]*banEntry, 0, len(bl.bans))
+	for _, ban := range bl.bans {
+		if !now.Before(ban.until) {
+			srvrLog.Infof("Ban for %s has expired", ban.ipNet)
+			if _, err := bl.unban(ban.ipNet); err != nil {
+				srvrLog.Warnf("Unable to remove expired ban of %s: %v",
+					ban.ipNet, err)
+			}
+			continue
+		}
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].ipNet.String() < bans[j].ipNet.String()
+	})
+	return bans
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/database/ffldb"
+	"github.com/pkt-cash/pktd/wire/protocol"
+)
+
+// TestParseSubnet ensures that both single addresses and CIDR subnets are
+// accepted and that single addresses are normalized.
+func TestParseSubnet(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"1.2.3.4", "1.2.3.4/32"},
+		{"::ffff:1.2.3.4", "1.2.3.4/32"},
+		{"10.1.2.3/8", "10.0.0.0/8"},
+		{"2001:db8::1", "2001:db8::1/128"},
+		{"2001:db8::/32", "2001:db8::/32"},
+	}
+	for _, test := range tests {
+		ipNet, err := parseSubnet(test.in)
+		if err != nil {
+			t.Errorf("parseSubnet(%s): %v", test.in, err)
+			continue
+		}
+		if ipNet.String() != test.want {
+			t.Errorf("parseSubnet(%s): got %s want %s", test.in, ipNet,
+				test.want)
+		}
+	}
+	for _, bad := range []string{"", "host.example", "1.2.3.4/33"} {
+		if _, err := parseSubnet(bad); err == nil {
+			t.Errorf("parseSubnet(%q): expected failure", bad)
+		}
+	}
+}
+
+// TestBanList ensures that bans cover their whole subnet, survive reloading
+// the database and are dropped once expired.
+func TestBanList(t *testing.T) {
+	db, err := ffldb.OpenDB(filepath.Join(t.TempDir(), "db"),
+		protocol.MainNet, true)
+	if err != nil {
+		t.Fatalf("OpenDB: %v", err)
+	}
+	defer db.Close()
+
+	bl, err := loadBanList(db)
+	if err != nil {
+		t.Fatalf("loadBanList: %v", err)
+	}
+	subnet, _ := parseSubnet("10.0.0.0/8")
+	single, _ := parseSubnet("192.168.1.1")
+	expired, _ := parseSubnet("172.16.0.1")
+	now := time.Now()
+	if err := bl.ban(subnet, now.Add(time.Hour), "manually added"); err != nil {
+		t.Fatalf("ban: %v", err)
+	}
+	if err := bl.ban(single, now.Add(time.Hour), "ban score exceeded: getdata"); err != nil {
+		t.Fatalf("ban: %v", err)
+	}
+	if err := bl.ban(expired, now.Add(-time.Second), "manually added"); err != nil {
+		t.Fatalf("ban: %v", err)
+	}
+
+	if ban := bl.lookup(net.ParseIP("10.20.30.40")); ban == nil ||
+		ban.ipNet.String() != "10.0.0.0/8" {
+		t.Errorf("lookup: 10.20.30.40 is not banned by its subnet")
+	}
+	if ban := bl.lookup(net.ParseIP("11.0.0.1")); ban != nil {
+		t.Errorf("lookup: 11.0.0.1 is banned by %s", ban.ipNet)
+	}
+	if ban := bl.lookup(net.ParseIP("172.16.0.1")); ban != nil {
+		t.Errorf("lookup: expired ban of 172.16.0.1 is still active")
+	}
+
+	// Reloading restores the bans along with their reasons.
+	bl, err = loadBanList(db)
+	if err != nil {
+		t.Fatalf("loadBanList: %v", err)
+	}
+	entries := bl.entries()
+	if len(entries) != 2 {
+		t.Fatalf("entries: got %d bans want 2", len(entries))
+	}
+	if entries[1].ipNet.String() != "192.168.1.1/32" ||
+		entries[1].reason != "ban score exceeded: getdata" ||
+		entries[1].until.Unix() != now.Add(time.Hour).Unix() {
+		t.Errorf("entries: got %+v", entries[1])
+	}
+
+	unbanned, err := bl.unban(single)
+	if err != nil || !unbanned {
+		t.Fatalf("unban: got %v, %v", unbanned, err)
+	}
+	if unbanned, _ := bl.unban(single); unbanned {
+		t.Errorf("unban: unbanned a subnet which is not banned")
+	}
+	if err := bl.clear(); err != nil {
+		t.Fatalf("clear: %v", err)
+	}
+	bl, err = loadBanList(db)
+	if err != nil {
+		t.Fatalf("loadBanList: %v", err)
+	}
+	if len(bl.entries()) != 0 {
+		t.Errorf("entries: bans remain after clear")
+	}
+}
+
+// TestBanExpiry ensures that setban ban times are interpreted as durations,
+// timestamps or the configured default.
+func TestBanExpiry(t *testing.T) {
+	defer func(saved *config) { cfg = saved }(cfg)
+	cfg = &config{BanDuration: time.Hour}
+	now := time.Unix(1600000000, 0)
+	if got := banExpiry(now, 0, true); !got.Equal(now.Add(time.Hour)) {
+		t.Errorf("banExpiry(0): got %v", got)
+	}
+	if got := banExpiry(now, 60, false); !got.Equal(now.Add(time.Minute)) {
+		t.Errorf("banExpiry(60): got %v", got)
+	}
+	if got := banExpiry(now, 1700000000, true); got.Unix() != 1700000000 {
+		t.Errorf("banExpiry(absolute): got %v", got)
+	}
+}
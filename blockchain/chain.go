@@ -16,6 +16,7 @@ import (
 	"github.com/pkt-cash/pktd/wire/constants"
 	"github.com/pkt-cash/pktd/wire/ruleerror"
 
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/announce"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/chaincfg"
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
@@ -107,6 +108,7 @@ type BlockChain struct {
 	sigCache            *txscript.SigCache
 	indexManager        IndexManager
 	hashCache           *txscript.HashCache
+	pcValidator         *pcProofValidator
 
 	// The following fields are calculated based upon the provided chain
 	// parameters.  They are also set when the instance is created and
@@ -1790,6 +1792,22 @@ type Config struct {
 	// This field can be nil if the caller is not interested in using a
 	// signature cache.
 	HashCache *txscript.HashCache
+
+	// PcProofWorkers is the number of goroutines validating the
+	// PacketCrypt proofs of blocks queued with QueuePcProofValidation
+	// ahead of them being processed.  When it is zero, one goroutine is
+	// run per CPU.  The goroutines exit when Interrupt is closed, so none
+	// are run when it is nil and the proofs are validated when the blocks
+	// are processed instead.
+	PcProofWorkers int
+
+	// AnnCache defines a cache of valid PacketCrypt announcements to use
+	// when validating PacketCrypt proofs, announcements which have already
+	// been checked, such as by pool operators, are not checked again.
+	//
+	// This field can be nil if the caller is not interested in using an
+	// announcement cache.
+	AnnCache *announce.Cache
 }
 
 // New returns a BlockChain instance using the provided configuration details.
@@ -1840,6 +1858,8 @@ func New(config *Config) (*BlockChain, er.R) {
 		blocksPerRetarget:   int32(targetTimespan / targetTimePerBlock),
 		index:               newBlockIndex(config.DB, params),
 		hashCache:           config.HashCache,
+		pcValidator:         newPcProofValidator(config.PcProofWorkers,
+			config.AnnCache, config.Interrupt),
 		bestChain:           newChainView(nil),
 		orphans:             make(map[chainhash.Hash]*orphanBlock),
 		prevOrphans:         make(map[chainhash.Hash][]*orphanBlock),
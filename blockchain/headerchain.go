@@ -0,0 +1,86 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"fmt"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/globalcfg"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/ruleerror"
+)
+
+// HeaderChain checks the proof of work of block headers which extend the block
+// index, so that headers past the latest checkpoint can be trusted before
+// their blocks are downloaded.  Only the headers which are needed to compute
+// the difficulty of the next header are kept in memory.
+//
+// A HeaderChain is NOT safe for concurrent access.
+type HeaderChain struct {
+	chain *BlockChain
+
+	// tip is the latest header, it is nil until the first header is added.
+	// The nodes are the headers which are not in the block index, oldest
+	// first, the parent of the oldest one is dropped once there are more
+	// than a difficulty retarget interval of them.
+	tip   *blockNode
+	nodes []*blockNode
+}
+
+// NewHeaderChain returns a HeaderChain whose first header must extend a block
+// which is in the block index.
+func (b *BlockChain) NewHeaderChain() *HeaderChain {
+	return &HeaderChain{chain: b}
+}
+
+// Add checks the header and makes it the tip of the header chain.  The header
+// must extend the tip.  Headers past the latest checkpoint must also specify
+// the difficulty required by the retarget rules and, unless the proof of work
+// is in the PacketCrypt proof of the block, hash to a value below it.
+func (hc *HeaderChain) Add(header *wire.BlockHeader) er.R {
+	b := hc.chain
+	prevNode := hc.tip
+	if prevNode == nil {
+		prevNode = b.index.LookupNode(&header.PrevBlock)
+	}
+	if prevNode == nil || prevNode.hash != header.PrevBlock {
+		str := fmt.Sprintf("previous block %s is not the tip of the "+
+			"header chain", header.PrevBlock)
+		return ruleerror.ErrPrevBlockNotBest.New(str, nil)
+	}
+
+	checkpoint := b.LatestCheckpoint()
+	if checkpoint == nil || prevNode.height >= checkpoint.Height {
+		flags := BFNone
+		if globalcfg.GetProofOfWorkAlgorithm() == globalcfg.PowPacketCrypt {
+			flags |= BFNoPoWCheck
+		}
+		err := checkProofOfWork(header, b.chainParams.PowLimit, flags)
+		if err != nil {
+			return err
+		}
+		expectedDifficulty, err := b.calcNextRequiredDifficulty(prevNode,
+			header.Timestamp)
+		if err != nil {
+			return err
+		}
+		if header.Bits != expectedDifficulty {
+			str := fmt.Sprintf("block difficulty of %d is not the "+
+				"expected value of %d", header.Bits,
+				expectedDifficulty)
+			return ruleerror.ErrUnexpectedDifficulty.New(str, nil)
+		}
+	}
+
+	node := newBlockNode(header, prevNode)
+	hc.tip = node
+	hc.nodes = append(hc.nodes, node)
+	if len(hc.nodes) > int(b.blocksPerRetarget) {
+		hc.nodes = hc.nodes[1:]
+		hc.nodes[0].parent = nil
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/chaincfg/genesis"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/ruleerror"
+)
+
+// makeTestHeader returns a header which extends prev with the provided bits.
+// Its hash meets the target when solve is set, otherwise it is above it.
+func makeTestHeader(prev *wire.BlockHeader, bits uint32, solve bool) *wire.BlockHeader {
+	header := &wire.BlockHeader{
+		Version:   1,
+		PrevBlock: prev.BlockHash(),
+		Timestamp: prev.Timestamp.Add(time.Second),
+		Bits:      bits,
+	}
+	target := CompactToBig(bits)
+	for {
+		hash := header.BlockHash()
+		if (HashToBig(&hash).Cmp(target) <= 0) == solve {
+			return header
+		}
+		header.Nonce++
+	}
+}
+
+func TestHeaderChain(t *testing.T) {
+	// Retarget every 4 blocks, the headers are a second apart so each
+	// retarget makes the difficulty as hard as allowed.
+	params := chaincfg.RegressionNetParams
+	params.TargetTimespan = 4 * params.TargetTimePerBlock
+	params.ReduceMinDifficulty = false
+	b := newFakeChain(&params)
+	hc := b.NewHeaderChain()
+
+	prev := genesis.Block(params.GenesisHash).Header
+	unknown := makeTestHeader(&prev, params.PowLimitBits, true)
+	unknown.PrevBlock = chainhash.Hash{0x01}
+	if err := hc.Add(unknown); !ruleerror.ErrPrevBlockNotBest.Is(err) {
+		t.Fatalf("header extending an unknown block: got %v, want "+
+			"ErrPrevBlockNotBest", err)
+	}
+
+	for height := 1; height <= 9; height++ {
+		var bits uint32
+		if hc.tip == nil {
+			bits = params.PowLimitBits
+		} else {
+			var err er.R
+			bits, err = b.calcNextRequiredDifficulty(hc.tip,
+				prev.Timestamp.Add(time.Second))
+			if err != nil {
+				t.Fatalf("height %d: unable to calculate "+
+					"difficulty: %v", height, err)
+			}
+		}
+		if height%4 == 0 {
+			if bits == params.PowLimitBits {
+				t.Fatalf("height %d: difficulty was not "+
+					"retargeted", height)
+			}
+			wrongBits := makeTestHeader(&prev, params.PowLimitBits, true)
+			err := hc.Add(wrongBits)
+			if !ruleerror.ErrUnexpectedDifficulty.Is(err) {
+				t.Fatalf("height %d: header with the wrong "+
+					"difficulty: got %v, want "+
+					"ErrUnexpectedDifficulty", height, err)
+			}
+			highHash := makeTestHeader(&prev, bits, false)
+			if err := hc.Add(highHash); !ruleerror.ErrHighHash.Is(err) {
+				t.Fatalf("height %d: header with a high hash: "+
+					"got %v, want ErrHighHash", height, err)
+			}
+		}
+		header := makeTestHeader(&prev, bits, true)
+		if err := hc.Add(header); err != nil {
+			t.Fatalf("height %d: valid header was rejected: %v",
+				height, err)
+		}
+		if hc.tip.height != int32(height) {
+			t.Fatalf("tip is at height %d, want %d", hc.tip.height,
+				height)
+		}
+		prev = *header
+	}
+
+	// Only the headers of one retarget interval are kept.
+	if len(hc.nodes) != 4 || hc.nodes[0].parent != nil {
+		t.Fatalf("%d headers are kept, want 4", len(hc.nodes))
+	}
+
+	stale := makeTestHeader(&prev, params.PowLimitBits, true)
+	stale.PrevBlock = hc.nodes[0].hash
+	if err := hc.Add(stale); !ruleerror.ErrPrevBlockNotBest.Is(err) {
+		t.Fatalf("header not extending the tip: got %v, want "+
+			"ErrPrevBlockNotBest", err)
+	}
+
+	// The checkpoints vouch for the headers up to the latest one.
+	b.checkpoints = []chaincfg.Checkpoint{{Height: 2}}
+	hc = b.NewHeaderChain()
+	prev = genesis.Block(params.GenesisHash).Header
+	for height := 1; height <= 2; height++ {
+		header := makeTestHeader(&prev, params.PowLimitBits-1, false)
+		if err := hc.Add(header); err != nil {
+			t.Fatalf("height %d: header before the checkpoint was "+
+				"rejected: %v", height, err)
+		}
+		prev = *header
+	}
+	header := makeTestHeader(&prev, params.PowLimitBits, false)
+	if err := hc.Add(header); !ruleerror.ErrHighHash.Is(err) {
+		t.Fatalf("header after the checkpoint: got %v, want "+
+			"ErrHighHash", err)
+	}
+}
@@ -0,0 +1,638 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/database"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// addrIndexVersion is the current version of the address index.  Version 1
+// only mapped addresses to transactions, version 2 added the per-address
+// deltas and unspent outputs.
+const addrIndexVersion = 2
+
+var (
+	// addrIndexVersionKey is the key in the address index bucket which
+	// holds the version of the index.  Version 1 indexes do not have it.
+	addrIndexVersionKey = []byte("version")
+
+	// addrIndexUpgradeKey is the key in the address index bucket which
+	// holds the hash and height of the last block whose deltas have been
+	// added while upgrading a version 1 index.
+	addrIndexUpgradeKey = []byte("upgradetip")
+
+	// addrDeltasBucketName is the name of the nested bucket which holds
+	// the deltas of each address.
+	addrDeltasBucketName = []byte("deltas")
+
+	// addrUtxosBucketName is the name of the nested bucket which holds the
+	// unspent outputs of each address.
+	addrUtxosBucketName = []byte("utxos")
+
+	// ErrAddrIndexNotUpgraded is returned when querying the deltas of an
+	// address index which has not been upgraded to hold them.
+	ErrAddrIndexNotUpgraded = Err.CodeWithDetail("ErrAddrIndexNotUpgraded",
+		"the address index does not hold the deltas of addresses, restart "+
+			"to upgrade it")
+)
+
+// keyOrder is the byte order of numeric fields in the keys of the deltas
+// and unspent outputs.  It is big endian so that keys sort by height and
+// position in the block.
+var keyOrder = binary.BigEndian
+
+// -----------------------------------------------------------------------------
+// Version 2 of the address index adds two nested buckets to the address index
+// bucket.  Only outputs whose script pays to exactly one address are
+// included since the balance of a bare multisig output cannot be attributed
+// to any one of its keys.
+//
+// The deltas bucket holds every change to the balance of an address, that is
+// every output which pays to it and every input which spends such an output.
+//
+// The serialized key format is:
+//
+//   <addr key><block height><tx index><spending><index>
+//
+//   Field           Type      Size
+//   addr key        [21]byte  21 bytes
+//   block height    uint32    4 bytes (big endian)
+//   tx index        uint32    4 bytes (big endian)
+//   spending        uint8     1 byte
+//   index           uint32    4 bytes (big endian)
+//   -----
+//   Total: 34 bytes
+//
+// The serialized value format is:
+//
+//   <tx hash><amount>[<prev tx hash><prev index>]
+//
+//   Field           Type             Size
+//   tx hash         chainhash.Hash   32 bytes
+//   amount          int64            8 bytes
+//   prev tx hash    chainhash.Hash   32 bytes (spending only)
+//   prev index      uint32           4 bytes (spending only)
+//
+// The utxos bucket holds the unspent outputs which pay to each address.
+//
+// The serialized key format is:
+//
+//   <addr key><tx hash><index>
+//
+//   Field           Type             Size
+//   addr key        [21]byte         21 bytes
+//   tx hash         chainhash.Hash   32 bytes
+//   index           uint32           4 bytes (big endian)
+//   -----
+//   Total: 57 bytes
+//
+// The serialized value format is:
+//
+//   <amount><block height><pkscript>
+//
+//   Field           Type      Size
+//   amount          int64     8 bytes
+//   block height    uint32    4 bytes
+//   pkscript        []byte    variable
+// -----------------------------------------------------------------------------
+
+const (
+	// deltaKeySize is the size of a key in the deltas bucket.
+	deltaKeySize = addrKeySize + 4 + 4 + 1 + 4
+
+	// utxoKeySize is the size of a key in the utxos bucket.
+	utxoKeySize = addrKeySize + chainhash.HashSize + 4
+)
+
+// AddrDelta is a change to the balance of an address, made either by an
+// output which pays to it or by an input which spends such an output.
+type AddrDelta struct {
+	// TxHash is the hash of the transaction which made the change.
+	TxHash chainhash.Hash
+
+	// Index is the index of the output, or of the input when spending.
+	Index uint32
+
+	// Spending is true if the change is an input which spends from the
+	// address.
+	Spending bool
+
+	// Amount is the change to the balance, it is negative when spending.
+	Amount int64
+
+	// PrevOut is the output which is spent, it is only set when spending.
+	PrevOut wire.OutPoint
+
+	// Height is the height of the block holding the transaction, or -1
+	// if the transaction is unconfirmed.
+	Height int32
+
+	// BlockIndex is the position of the transaction in its block.
+	BlockIndex uint32
+}
+
+// AddrUtxo is an unspent output which pays to an address.
+type AddrUtxo struct {
+	OutPoint wire.OutPoint
+	Amount   int64
+	Height   int32
+	PkScript []byte
+}
+
+// addrDeltaEntry is a delta along with the address it applies to and the
+// information needed to update the unspent outputs of the address.
+type addrDeltaEntry struct {
+	addrKey  [addrKeySize]byte
+	delta    AddrDelta
+	pkScript []byte
+
+	// prevHeight is the height of the block holding the spent output.
+	prevHeight int32
+}
+
+// singleAddrKey returns the address key of a script which pays to exactly
+// one supported address.
+func (idx *AddrIndex) singleAddrKey(pkScript []byte) ([addrKeySize]byte, bool) {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript,
+		idx.chainParams)
+	if err != nil || len(addrs) != 1 {
+		return [addrKeySize]byte{}, false
+	}
+	addrKey, err := addrToKey(addrs[0])
+	return addrKey, err == nil
+}
+
+// txDeltas appends the deltas made by one transaction to entries.  The
+// spent outputs must be given in the order of the inputs, they are nil for
+// a coinbase.
+func (idx *AddrIndex) txDeltas(entries []addrDeltaEntry, tx *btcutil.Tx,
+	stxos []blockchain.SpentTxOut, height int32, blockIndex uint32) []addrDeltaEntry {
+
+	msgTx := tx.MsgTx()
+	for i, stxo := range stxos {
+		addrKey, ok := idx.singleAddrKey(stxo.PkScript)
+		if !ok {
+			continue
+		}
+		entries = append(entries, addrDeltaEntry{
+			addrKey: addrKey,
+			delta: AddrDelta{
+				TxHash:     *tx.Hash(),
+				Index:      uint32(i),
+				Spending:   true,
+				Amount:     -stxo.Amount,
+				PrevOut:    msgTx.TxIn[i].PreviousOutPoint,
+				Height:     height,
+				BlockIndex: blockIndex,
+			},
+			pkScript:   stxo.PkScript,
+			prevHeight: stxo.Height,
+		})
+	}
+	for i, txOut := range msgTx.TxOut {
+		addrKey, ok := idx.singleAddrKey(txOut.PkScript)
+		if !ok {
+			continue
+		}
+		entries = append(entries, addrDeltaEntry{
+			addrKey: addrKey,
+			delta: AddrDelta{
+				TxHash:     *tx.Hash(),
+				Index:      uint32(i),
+				Amount:     txOut.Value,
+				Height:     height,
+				BlockIndex: blockIndex,
+			},
+			pkScript: txOut.PkScript,
+		})
+	}
+	return entries
+}
+
+// blockDeltas returns the deltas made by all of the transactions in the
+// block, in the order in which they apply.
+func (idx *AddrIndex) blockDeltas(block *btcutil.Block,
+	stxos []blockchain.SpentTxOut) []addrDeltaEntry {
+
+	var entries []addrDeltaEntry
+	stxoIndex := 0
+	for txIdx, tx := range block.Transactions() {
+		var txStxos []blockchain.SpentTxOut
+		if txIdx != 0 {
+			numIn := len(tx.MsgTx().TxIn)
+			txStxos = stxos[stxoIndex : stxoIndex+numIn]
+			stxoIndex += numIn
+		}
+		entries = idx.txDeltas(entries, tx, txStxos, block.Height(),
+			uint32(txIdx))
+	}
+	return entries
+}
+
+// deltaKey returns the key of a delta in the deltas bucket.
+func deltaKey(addrKey [addrKeySize]byte, d *AddrDelta) []byte {
+	key := make([]byte, deltaKeySize)
+	copy(key, addrKey[:])
+	keyOrder.PutUint32(key[addrKeySize:], uint32(d.Height))
+	keyOrder.PutUint32(key[addrKeySize+4:], d.BlockIndex)
+	if d.Spending {
+		key[addrKeySize+8] = 1
+	}
+	keyOrder.PutUint32(key[addrKeySize+9:], d.Index)
+	return key
+}
+
+// serializeDelta returns the value of a delta in the deltas bucket.
+func serializeDelta(d *AddrDelta) []byte {
+	size := chainhash.HashSize + 8
+	if d.Spending {
+		size += chainhash.HashSize + 4
+	}
+	serialized := make([]byte, size)
+	copy(serialized, d.TxHash[:])
+	byteOrder.PutUint64(serialized[chainhash.HashSize:], uint64(d.Amount))
+	if d.Spending {
+		offset := chainhash.HashSize + 8
+		copy(serialized[offset:], d.PrevOut.Hash[:])
+		byteOrder.PutUint32(serialized[offset+chainhash.HashSize:],
+			d.PrevOut.Index)
+	}
+	return serialized
+}
+
+// deserializeDelta decodes a delta from its key and value in the deltas
+// bucket.
+func deserializeDelta(key, serialized []byte) (*AddrDelta, er.R) {
+	if len(key) != deltaKeySize || len(serialized) < chainhash.HashSize+8 {
+		return nil, errDeserialize("unexpected end of data for address " +
+			"delta")
+	}
+	d := &AddrDelta{
+		Height:     int32(keyOrder.Uint32(key[addrKeySize:])),
+		BlockIndex: keyOrder.Uint32(key[addrKeySize+4:]),
+		Spending:   key[addrKeySize+8] != 0,
+		Index:      keyOrder.Uint32(key[addrKeySize+9:]),
+		Amount:     int64(byteOrder.Uint64(serialized[chainhash.HashSize:])),
+	}
+	copy(d.TxHash[:], serialized)
+	if d.Spending {
+		offset := chainhash.HashSize + 8
+		if len(serialized) < offset+chainhash.HashSize+4 {
+			return nil, errDeserialize("unexpected end of data for " +
+				"address delta previous output")
+		}
+		copy(d.PrevOut.Hash[:], serialized[offset:])
+		d.PrevOut.Index = byteOrder.Uint32(serialized[offset+chainhash.HashSize:])
+	}
+	return d, nil
+}
+
+// utxoKey returns the key of an unspent output in the utxos bucket.
+func utxoKey(addrKey [addrKeySize]byte, op *wire.OutPoint) []byte {
+	key := make([]byte, utxoKeySize)
+	copy(key, addrKey[:])
+	copy(key[addrKeySize:], op.Hash[:])
+	keyOrder.PutUint32(key[addrKeySize+chainhash.HashSize:], op.Index)
+	return key
+}
+
+// serializeUtxo returns the value of an unspent output in the utxos bucket.
+func serializeUtxo(amount int64, height int32, pkScript []byte) []byte {
+	serialized := make([]byte, 12+len(pkScript))
+	byteOrder.PutUint64(serialized, uint64(amount))
+	byteOrder.PutUint32(serialized[8:], uint32(height))
+	copy(serialized[12:], pkScript)
+	return serialized
+}
+
+// deserializeUtxo decodes an unspent output from its key and value in the
+// utxos bucket.
+func deserializeUtxo(key, serialized []byte) (*AddrUtxo, er.R) {
+	if len(key) != utxoKeySize || len(serialized) < 12 {
+		return nil, errDeserialize("unexpected end of data for address " +
+			"utxo")
+	}
+	u := &AddrUtxo{
+		Amount:   int64(byteOrder.Uint64(serialized)),
+		Height:   int32(byteOrder.Uint32(serialized[8:])),
+		PkScript: append([]byte(nil), serialized[12:]...),
+	}
+	copy(u.OutPoint.Hash[:], key[addrKeySize:])
+	u.OutPoint.Index = keyOrder.Uint32(key[addrKeySize+chainhash.HashSize:])
+	return u, nil
+}
+
+// dbConnectDeltas adds the deltas of a block to the index and updates the
+// unspent outputs of the addresses.
+func dbConnectDeltas(deltas, utxos database.Bucket, entries []addrDeltaEntry) er.R {
+	for i := range entries {
+		e := &entries[i]
+		err := deltas.Put(deltaKey(e.addrKey, &e.delta),
+			serializeDelta(&e.delta))
+		if err != nil {
+			return err
+		}
+		if e.delta.Spending {
+			err = utxos.Delete(utxoKey(e.addrKey, &e.delta.PrevOut))
+		} else {
+			op := wire.OutPoint{Hash: e.delta.TxHash, Index: e.delta.Index}
+			err = utxos.Put(utxoKey(e.addrKey, &op), serializeUtxo(
+				e.delta.Amount, e.delta.Height, e.pkScript))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dbDisconnectDeltas removes the deltas of a block from the index and
+// restores the unspent outputs of the addresses.  The entries are undone in
+// reverse order so that outputs spent in the same block are handled.
+func dbDisconnectDeltas(deltas, utxos database.Bucket, entries []addrDeltaEntry) er.R {
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := &entries[i]
+		if err := deltas.Delete(deltaKey(e.addrKey, &e.delta)); err != nil {
+			return err
+		}
+		var err er.R
+		if e.delta.Spending {
+			err = utxos.Put(utxoKey(e.addrKey, &e.delta.PrevOut),
+				serializeUtxo(-e.delta.Amount, e.prevHeight, e.pkScript))
+		} else {
+			op := wire.OutPoint{Hash: e.delta.TxHash, Index: e.delta.Index}
+			err = utxos.Delete(utxoKey(e.addrKey, &op))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deltaBuckets returns the deltas and utxos buckets if the index has been
+// upgraded to hold them.
+func deltaBuckets(addrIdxBucket database.Bucket) (database.Bucket, database.Bucket, bool) {
+	version := addrIdxBucket.Get(addrIndexVersionKey)
+	if len(version) != 4 || byteOrder.Uint32(version) < addrIndexVersion {
+		return nil, nil, false
+	}
+	deltas := addrIdxBucket.Bucket(addrDeltasBucketName)
+	utxos := addrIdxBucket.Bucket(addrUtxosBucketName)
+	return deltas, utxos, deltas != nil && utxos != nil
+}
+
+// createDeltaBuckets creates the buckets which were added in version 2 of
+// the index.
+func createDeltaBuckets(addrIdxBucket database.Bucket) er.R {
+	if _, err := addrIdxBucket.CreateBucketIfNotExists(addrDeltasBucketName); err != nil {
+		return err
+	}
+	_, err := addrIdxBucket.CreateBucketIfNotExists(addrUtxosBucketName)
+	return err
+}
+
+// putAddrIndexVersion marks the index as holding the current version.
+func putAddrIndexVersion(addrIdxBucket database.Bucket) er.R {
+	var version [4]byte
+	byteOrder.PutUint32(version[:], addrIndexVersion)
+	return addrIdxBucket.Put(addrIndexVersionKey, version[:])
+}
+
+// Upgrade brings a version 1 address index, which only maps addresses to
+// transactions, up to the current version by adding the deltas of every
+// block up to the tip of the index.  The existing entries are kept, so this
+// is much quicker than dropping and rebuilding the index.  The upgrade
+// resumes where it stopped if it is interrupted.
+//
+// This is part of the Upgrader interface.
+func (idx *AddrIndex) Upgrade(chain *blockchain.BlockChain, interrupt <-chan struct{}) er.R {
+	var upgraded bool
+	var tipHeight, startHeight int32
+	err := idx.db.Update(func(dbTx database.Tx) er.R {
+		bucket := dbTx.Metadata().Bucket(addrIndexKey)
+		if _, _, ok := deltaBuckets(bucket); ok {
+			upgraded = true
+			return nil
+		}
+		var err er.R
+		_, tipHeight, err = dbFetchIndexerTip(dbTx, addrIndexKey)
+		if err != nil {
+			return err
+		}
+
+		// Start over if an earlier upgrade stopped on a block which
+		// has since been reorganized out of the main chain.
+		startHeight = 0
+		if progress := bucket.Get(addrIndexUpgradeKey); len(progress) == chainhash.HashSize+4 {
+			hash, err := chainhash.NewHash(progress[:chainhash.HashSize])
+			if err != nil {
+				return err
+			}
+			height := int32(byteOrder.Uint32(progress[chainhash.HashSize:]))
+			if chain.MainChainHasBlock(hash) && height <= tipHeight {
+				startHeight = height + 1
+			}
+		}
+		if startHeight == 0 {
+			for _, name := range [][]byte{addrDeltasBucketName, addrUtxosBucketName} {
+				if bucket.Bucket(name) == nil {
+					continue
+				}
+				if err := bucket.DeleteBucket(name); err != nil {
+					return err
+				}
+			}
+		}
+		return createDeltaBuckets(bucket)
+	})
+	if err != nil || upgraded {
+		return err
+	}
+
+	log.Infof("Upgrading %s to version %d, adding the deltas of %d blocks",
+		addrIndexName, addrIndexVersion, tipHeight-startHeight+1)
+	progressLogger := newBlockProgressLogger("Upgraded", log)
+	for height := startHeight; height <= tipHeight; height++ {
+		if interruptRequested(interrupt) {
+			return errInterruptRequested.Default()
+		}
+		block, err := chain.BlockByHeight(height)
+		if err != nil {
+			return err
+		}
+		var stxos []blockchain.SpentTxOut
+		if height > 0 {
+			stxos, err = chain.FetchSpendJournal(block)
+			if err != nil {
+				return err
+			}
+		}
+		entries := idx.blockDeltas(block, stxos)
+		err = idx.db.Update(func(dbTx database.Tx) er.R {
+			bucket := dbTx.Metadata().Bucket(addrIndexKey)
+			deltas := bucket.Bucket(addrDeltasBucketName)
+			utxos := bucket.Bucket(addrUtxosBucketName)
+			if err := dbConnectDeltas(deltas, utxos, entries); err != nil {
+				return err
+			}
+			progress := make([]byte, chainhash.HashSize+4)
+			copy(progress, block.Hash()[:])
+			byteOrder.PutUint32(progress[chainhash.HashSize:], uint32(height))
+			return bucket.Put(addrIndexUpgradeKey, progress)
+		})
+		if err != nil {
+			return err
+		}
+		progressLogger.LogBlockHeight(block)
+	}
+
+	return idx.db.Update(func(dbTx database.Tx) er.R {
+		bucket := dbTx.Metadata().Bucket(addrIndexKey)
+		if err := bucket.Delete(addrIndexUpgradeKey); err != nil {
+			return err
+		}
+		return putAddrIndexVersion(bucket)
+	})
+}
+
+// IsSupportedAddress returns whether the address index can index the
+// address.
+func IsSupportedAddress(addr btcutil.Address) bool {
+	_, err := addrToKey(addr)
+	return err == nil
+}
+
+// DeltasForAddress returns the changes to the balance of an address made by
+// the blocks from startHeight to endHeight inclusive, ordered by height and
+// position in the block.
+//
+// This function is safe for concurrent access.
+func (idx *AddrIndex) DeltasForAddress(addr btcutil.Address, startHeight, endHeight int32) ([]AddrDelta, er.R) {
+	addrKey, err := addrToKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []AddrDelta
+	err = idx.db.View(func(dbTx database.Tx) er.R {
+		deltas, _, ok := deltaBuckets(dbTx.Metadata().Bucket(addrIndexKey))
+		if !ok {
+			return ErrAddrIndexNotUpgraded.Default()
+		}
+		seek := make([]byte, addrKeySize+4)
+		copy(seek, addrKey[:])
+		keyOrder.PutUint32(seek[addrKeySize:], uint32(startHeight))
+		cursor := deltas.Cursor()
+		for ok := cursor.Seek(seek); ok; ok = cursor.Next() {
+			key := cursor.Key()
+			if !bytes.HasPrefix(key, addrKey[:]) {
+				break
+			}
+			d, err := deserializeDelta(key, cursor.Value())
+			if err != nil {
+				return err
+			}
+			if d.Height > endHeight {
+				break
+			}
+			result = append(result, *d)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// UtxosForAddress returns the unspent outputs which pay to an address.
+//
+// This function is safe for concurrent access.
+func (idx *AddrIndex) UtxosForAddress(addr btcutil.Address) ([]AddrUtxo, er.R) {
+	addrKey, err := addrToKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []AddrUtxo
+	err = idx.db.View(func(dbTx database.Tx) er.R {
+		_, utxos, ok := deltaBuckets(dbTx.Metadata().Bucket(addrIndexKey))
+		if !ok {
+			return ErrAddrIndexNotUpgraded.Default()
+		}
+		cursor := utxos.Cursor()
+		for ok := cursor.Seek(addrKey[:]); ok; ok = cursor.Next() {
+			key := cursor.Key()
+			if !bytes.HasPrefix(key, addrKey[:]) {
+				break
+			}
+			u, err := deserializeUtxo(key, cursor.Value())
+			if err != nil {
+				return err
+			}
+			result = append(result, *u)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// BalanceForAddress returns the balance of an address along with the total
+// it has ever received, not counting unconfirmed transactions.
+//
+// This function is safe for concurrent access.
+func (idx *AddrIndex) BalanceForAddress(addr btcutil.Address) (int64, int64, er.R) {
+	utxos, err := idx.UtxosForAddress(addr)
+	if err != nil {
+		return 0, 0, err
+	}
+	deltas, err := idx.DeltasForAddress(addr, 0, int32(^uint32(0)>>1))
+	if err != nil {
+		return 0, 0, err
+	}
+	var balance, received int64
+	for _, u := range utxos {
+		balance += u.Amount
+	}
+	for _, d := range deltas {
+		if d.Amount > 0 {
+			received += d.Amount
+		}
+	}
+	return balance, received, nil
+}
+
+// UnconfirmedDeltasForAddress returns the changes to the balance of an
+// address made by the transactions in the unconfirmed (memory-only) address
+// index.  Unsupported address types are ignored and will result in no
+// results.
+//
+// This function is safe for concurrent access.
+func (idx *AddrIndex) UnconfirmedDeltasForAddress(addr btcutil.Address) []AddrDelta {
+	addrKey, err := addrToKey(addr)
+	if err != nil {
+		return nil
+	}
+
+	idx.unconfirmedLock.RLock()
+	defer idx.unconfirmedLock.RUnlock()
+
+	var result []AddrDelta
+	for hash := range idx.txnsByAddr[addrKey] {
+		for _, e := range idx.deltasByTx[hash] {
+			if e.addrKey == addrKey {
+				result = append(result, e.delta)
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/database"
+	"github.com/pkt-cash/pktd/database/ffldb"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+	"github.com/pkt-cash/pktd/wire/protocol"
+)
+
+// testAddr returns a pay-to-pubkey-hash address and its script.
+func testAddr(t *testing.T, b byte) (btcutil.Address, []byte) {
+	hash := make([]byte, 20)
+	hash[0] = b
+	addr, err := btcutil.NewAddressPubKeyHash(hash, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("NewAddressPubKeyHash: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %v", err)
+	}
+	return addr, pkScript
+}
+
+// testCoinbase returns a coinbase transaction paying to the script.
+func testCoinbase(height byte, value int64, pkScript []byte) *wire.MsgTx {
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: constants.MaxPrevOutIndex},
+		SignatureScript:  []byte{0x01, height},
+	})
+	tx.AddTxOut(wire.NewTxOut(value, pkScript))
+	return tx
+}
+
+// TestAddrDeltas ensures that connecting and disconnecting blocks maintains
+// the deltas and unspent outputs of each address.
+func TestAddrDeltas(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "db")
+	db, err := ffldb.OpenDB(dbPath, protocol.MainNet, true)
+	if err != nil {
+		t.Fatalf("OpenDB: %v", err)
+	}
+	defer db.Close()
+
+	addrA, scriptA := testAddr(t, 1)
+	addrB, scriptB := testAddr(t, 2)
+	idx := NewAddrIndex(db, &chaincfg.MainNetParams)
+
+	// Block 1 pays 50 to A, block 2 spends it paying 30 to B and 20 back to
+	// A, followed by a transaction in the same block spending B's output.
+	cb1 := testCoinbase(1, 50, scriptA)
+	block1 := btcutil.NewBlock(&wire.MsgBlock{Transactions: []*wire.MsgTx{cb1}})
+	block1.SetHeight(1)
+
+	tx1 := wire.NewMsgTx(1)
+	tx1.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Hash: cb1.TxHash()}})
+	tx1.AddTxOut(wire.NewTxOut(30, scriptB))
+	tx1.AddTxOut(wire.NewTxOut(20, scriptA))
+	tx2 := wire.NewMsgTx(1)
+	tx2.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Hash: tx1.TxHash()}})
+	tx2.AddTxOut(wire.NewTxOut(25, scriptA))
+	block2 := btcutil.NewBlock(&wire.MsgBlock{Transactions: []*wire.MsgTx{
+		testCoinbase(2, 50, scriptB), tx1, tx2}})
+	block2.SetHeight(2)
+	stxos2 := []blockchain.SpentTxOut{
+		{Amount: 50, PkScript: scriptA, Height: 1, IsCoinBase: true},
+		{Amount: 30, PkScript: scriptB, Height: 2},
+	}
+
+	update := func(f func(deltas, utxos database.Bucket) er.R) {
+		err := db.Update(func(dbTx database.Tx) er.R {
+			deltas, utxos, ok := deltaBuckets(dbTx.Metadata().Bucket(addrIndexKey))
+			if !ok {
+				t.Fatalf("deltaBuckets: index not created at version %d",
+					addrIndexVersion)
+			}
+			return f(deltas, utxos)
+		})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+	}
+	err = db.Update(func(dbTx database.Tx) er.R {
+		return idx.Create(dbTx)
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	update(func(deltas, utxos database.Bucket) er.R {
+		err := dbConnectDeltas(deltas, utxos, idx.blockDeltas(block1, nil))
+		if err != nil {
+			return err
+		}
+		return dbConnectDeltas(deltas, utxos, idx.blockDeltas(block2, stxos2))
+	})
+
+	checkBalance := func(addr btcutil.Address, wantBalance, wantReceived int64) {
+		t.Helper()
+		balance, received, err := idx.BalanceForAddress(addr)
+		if err != nil {
+			t.Fatalf("BalanceForAddress: %v", err)
+		}
+		if balance != wantBalance || received != wantReceived {
+			t.Errorf("BalanceForAddress(%s): got %d/%d want %d/%d", addr,
+				balance, received, wantBalance, wantReceived)
+		}
+	}
+	checkBalance(addrA, 45, 95)
+	checkBalance(addrB, 50, 80)
+
+	deltas, err := idx.DeltasForAddress(addrA, 0, 10)
+	if err != nil {
+		t.Fatalf("DeltasForAddress: %v", err)
+	}
+	// Within a transaction the outputs sort before the inputs.
+	wantAmounts := []int64{50, 20, -50, 25}
+	if len(deltas) != len(wantAmounts) {
+		t.Fatalf("DeltasForAddress: got %d deltas want %d", len(deltas),
+			len(wantAmounts))
+	}
+	for i, d := range deltas {
+		if d.Amount != wantAmounts[i] {
+			t.Errorf("DeltasForAddress: delta %d amount %d want %d", i,
+				d.Amount, wantAmounts[i])
+		}
+	}
+	if !deltas[2].Spending || deltas[2].PrevOut.Hash != cb1.TxHash() {
+		t.Errorf("DeltasForAddress: spend does not reference the coinbase")
+	}
+	deltas, err = idx.DeltasForAddress(addrA, 2, 2)
+	if err != nil {
+		t.Fatalf("DeltasForAddress: %v", err)
+	}
+	if len(deltas) != 3 {
+		t.Errorf("DeltasForAddress(2, 2): got %d deltas want 3", len(deltas))
+	}
+
+	// Disconnecting block 2 restores the output of block 1.
+	update(func(deltas, utxos database.Bucket) er.R {
+		return dbDisconnectDeltas(deltas, utxos, idx.blockDeltas(block2, stxos2))
+	})
+	checkBalance(addrA, 50, 50)
+	checkBalance(addrB, 0, 0)
+	utxos, err := idx.UtxosForAddress(addrA)
+	if err != nil {
+		t.Fatalf("UtxosForAddress: %v", err)
+	}
+	if len(utxos) != 1 || utxos[0].Height != 1 ||
+		utxos[0].OutPoint.Hash != cb1.TxHash() {
+		t.Errorf("UtxosForAddress: got %+v", utxos)
+	}
+}
+
+// TestDeltaSerialization ensures that deltas and unspent outputs survive a
+// round trip through their serialized forms.
+func TestDeltaSerialization(t *testing.T) {
+	var addrKey [addrKeySize]byte
+	addrKey[0] = addrKeyTypeScriptHash
+	d := AddrDelta{
+		Index:      3,
+		Spending:   true,
+		Amount:     -1234,
+		PrevOut:    wire.OutPoint{Index: 7},
+		Height:     100,
+		BlockIndex: 5,
+	}
+	d.TxHash[0] = 1
+	d.PrevOut.Hash[0] = 2
+	got, err := deserializeDelta(deltaKey(addrKey, &d), serializeDelta(&d))
+	if err != nil {
+		t.Fatalf("deserializeDelta: %v", err)
+	}
+	if *got != d {
+		t.Errorf("deserializeDelta: got %+v want %+v", *got, d)
+	}
+	if _, err := deserializeDelta(deltaKey(addrKey, &d),
+		serializeDelta(&d)[:40]); !isDeserializeErr(err) {
+		t.Errorf("deserializeDelta: got %v want a deserialize error", err)
+	}
+
+	op := wire.OutPoint{Index: 9}
+	op.Hash[31] = 4
+	u, err := deserializeUtxo(utxoKey(addrKey, &op),
+		serializeUtxo(5000, 42, []byte{0x51}))
+	if err != nil {
+		t.Fatalf("deserializeUtxo: %v", err)
+	}
+	if u.OutPoint != op || u.Amount != 5000 || u.Height != 42 ||
+		len(u.PkScript) != 1 || u.PkScript[0] != 0x51 {
+		t.Errorf("deserializeUtxo: got %+v", u)
+	}
+}
@@ -599,6 +599,11 @@ type AddrIndex struct {
 	unconfirmedLock sync.RWMutex
 	txnsByAddr      map[[addrKeySize]byte]map[chainhash.Hash]*btcutil.Tx
 	addrsByTx       map[chainhash.Hash]map[[addrKeySize]byte]struct{}
+
+	// The deltasByTx field holds the changes to the balances of addresses
+	// made by each unconfirmed transaction.  It is also protected by the
+	// unconfirmedLock field.
+	deltasByTx map[chainhash.Hash][]addrDeltaEntry
 }
 
 // Ensure the AddrIndex type implements the Indexer interface.
@@ -607,6 +612,9 @@ var _ Indexer = (*AddrIndex)(nil)
 // Ensure the AddrIndex type implements the NeedsInputser interface.
 var _ NeedsInputser = (*AddrIndex)(nil)
 
+// Ensure the AddrIndex type implements the Upgrader interface.
+var _ Upgrader = (*AddrIndex)(nil)
+
 // NeedsInputs signals that the index requires the referenced inputs in order
 // to properly create the index.
 //
@@ -640,12 +648,18 @@ func (idx *AddrIndex) Name() string {
 
 // Create is invoked when the indexer manager determines the index needs
 // to be created for the first time.  It creates the bucket for the address
-// index.
+// index along with the nested buckets for the deltas and unspent outputs.
 //
 // This is part of the Indexer interface.
 func (idx *AddrIndex) Create(dbTx database.Tx) er.R {
-	_, err := dbTx.Metadata().CreateBucket(addrIndexKey)
-	return err
+	bucket, err := dbTx.Metadata().CreateBucket(addrIndexKey)
+	if err != nil {
+		return err
+	}
+	if err := createDeltaBuckets(bucket); err != nil {
+		return err
+	}
+	return putAddrIndexVersion(bucket)
 }
 
 // writeIndexData represents the address index data to be written for one block.
@@ -754,7 +768,13 @@ func (idx *AddrIndex) ConnectBlock(dbTx database.Tx, block *btcutil.Block,
 		}
 	}
 
-	return nil
+	// Add the deltas of the block unless the index has yet to be
+	// upgraded, in which case the upgrade will add them.
+	deltas, utxos, ok := deltaBuckets(addrIdxBucket)
+	if !ok {
+		return nil
+	}
+	return dbConnectDeltas(deltas, utxos, idx.blockDeltas(block, stxos))
 }
 
 // DisconnectBlock is invoked by the index manager when a block has been
@@ -777,7 +797,12 @@ func (idx *AddrIndex) DisconnectBlock(dbTx database.Tx, block *btcutil.Block,
 		}
 	}
 
-	return nil
+	// Remove the deltas of the block if the index holds them.
+	deltas, utxos, ok := deltaBuckets(bucket)
+	if !ok {
+		return nil
+	}
+	return dbDisconnectDeltas(deltas, utxos, idx.blockDeltas(block, stxos))
 }
 
 // TxRegionsForAddress returns a slice of block regions which identify each
@@ -871,21 +896,36 @@ func (idx *AddrIndex) AddUnconfirmedTx(tx *btcutil.Tx, utxoView *blockchain.Utxo
 	// The existence checks are elided since this is only called after the
 	// transaction has already been validated and thus all inputs are
 	// already known to exist.
+	stxos := make([]blockchain.SpentTxOut, 0, len(tx.MsgTx().TxIn))
 	for _, txIn := range tx.MsgTx().TxIn {
 		entry := utxoView.LookupEntry(txIn.PreviousOutPoint)
 		if entry == nil {
 			// Ignore missing entries.  This should never happen
 			// in practice since the function comments specifically
 			// call out all inputs must be available.
+			stxos = append(stxos, blockchain.SpentTxOut{})
 			continue
 		}
 		idx.indexUnconfirmedAddresses(entry.PkScript(), tx)
+		stxos = append(stxos, blockchain.SpentTxOut{
+			Amount:   entry.Amount(),
+			PkScript: entry.PkScript(),
+			Height:   entry.BlockHeight(),
+		})
 	}
 
 	// Index addresses of all created outputs.
 	for _, txOut := range tx.MsgTx().TxOut {
 		idx.indexUnconfirmedAddresses(txOut.PkScript, tx)
 	}
+
+	// Record the changes to the balances of the addresses.
+	deltas := idx.txDeltas(nil, tx, stxos, -1, 0)
+	if len(deltas) > 0 {
+		idx.unconfirmedLock.Lock()
+		idx.deltasByTx[*tx.Hash()] = deltas
+		idx.unconfirmedLock.Unlock()
+	}
 }
 
 // RemoveUnconfirmedTx removes the passed transaction from the unconfirmed
@@ -908,6 +948,7 @@ func (idx *AddrIndex) RemoveUnconfirmedTx(hash *chainhash.Hash) {
 
 	// Remove the entry from the transaction to address lookup map as well.
 	delete(idx.addrsByTx, *hash)
+	delete(idx.deltasByTx, *hash)
 }
 
 // UnconfirmedTxnsForAddress returns all transactions currently in the
@@ -952,6 +993,7 @@ func NewAddrIndex(db database.DB, chainParams *chaincfg.Params) *AddrIndex {
 		chainParams: chainParams,
 		txnsByAddr:  make(map[[addrKeySize]byte]map[chainhash.Hash]*btcutil.Tx),
 		addrsByTx:   make(map[chainhash.Hash]map[[addrKeySize]byte]struct{}),
+		deltasByTx:  make(map[chainhash.Hash][]addrDeltaEntry),
 	}
 }
 
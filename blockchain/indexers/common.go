@@ -39,6 +39,14 @@ type NeedsInputser interface {
 	NeedsInputs() bool
 }
 
+// Upgrader provides a generic interface for an indexer whose stored format
+// has changed and which can upgrade an existing index in place rather than
+// being dropped and rebuilt.  It is invoked after the index has been rolled
+// back to the main chain and before it is caught up to the best chain tip.
+type Upgrader interface {
+	Upgrade(chain *blockchain.BlockChain, interrupt <-chan struct{}) er.R
+}
+
 // Indexer provides a generic interface for an indexer that is managed by an
 // index manager such as the Manager type provided by this package.
 type Indexer interface {
@@ -354,6 +354,16 @@ func (m *Manager) Init(chain *blockchain.BlockChain, interrupt <-chan struct{})
 		}
 	}
 
+	// Upgrade the indexes whose stored format has changed since they were
+	// created.
+	for _, indexer := range m.enabledIndexes {
+		if upgrader, ok := indexer.(Upgrader); ok {
+			if err := upgrader.Upgrade(chain, interrupt); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Fetch the current tip heights for each index along with tracking the
 	// lowest one so the catchup code only needs to start at the earliest
 	// block and is able to skip connecting the block for the indexes that
@@ -0,0 +1,245 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/database"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+const (
+	// spentIndexName is the human-readable name for the index.
+	spentIndexName = "spent output index"
+
+	// spentKeySize is the size of a key in the spent index, the hash and
+	// index of the spent output.
+	spentKeySize = chainhash.HashSize + 4
+
+	// spentValueSize is the size of a value in the spent index.
+	spentValueSize = chainhash.HashSize + 4 + 4 + 8
+)
+
+var (
+	// spentIndexKey is the key of the spent output index and the db
+	// bucket used to house it.
+	spentIndexKey = []byte("spentbyoutpointidx")
+)
+
+// -----------------------------------------------------------------------------
+// The spent output index consists of an entry for every output spent in the
+// main chain, which identifies the input spending it.
+//
+// The serialized format for keys and values in the bucket is:
+//   <prev hash><prev index> = <tx hash><input index><height><amount>
+//
+//   Field           Type              Size
+//   prev hash       chainhash.Hash    32 bytes
+//   prev index      uint32            4 bytes
+//   -----
+//   Total: 36 bytes
+//
+//   Field           Type              Size
+//   tx hash         chainhash.Hash    32 bytes
+//   input index     uint32            4 bytes
+//   height          uint32            4 bytes
+//   amount          int64             8 bytes
+//   -----
+//   Total: 48 bytes
+// -----------------------------------------------------------------------------
+
+// SpentInfo identifies the input which spends an output.
+type SpentInfo struct {
+	// TxHash is the hash of the spending transaction.
+	TxHash chainhash.Hash
+
+	// Index is the index of the spending input.
+	Index uint32
+
+	// Height is the height of the block holding the spending transaction.
+	Height int32
+
+	// Amount is the value of the spent output.
+	Amount int64
+}
+
+// spentKey returns the key of a spent output in the index.
+func spentKey(op *wire.OutPoint) []byte {
+	key := make([]byte, spentKeySize)
+	copy(key, op.Hash[:])
+	byteOrder.PutUint32(key[chainhash.HashSize:], op.Index)
+	return key
+}
+
+// serializeSpentInfo returns the value of a spent output in the index.
+func serializeSpentInfo(info *SpentInfo) []byte {
+	serialized := make([]byte, spentValueSize)
+	copy(serialized, info.TxHash[:])
+	offset := chainhash.HashSize
+	byteOrder.PutUint32(serialized[offset:], info.Index)
+	byteOrder.PutUint32(serialized[offset+4:], uint32(info.Height))
+	byteOrder.PutUint64(serialized[offset+8:], uint64(info.Amount))
+	return serialized
+}
+
+// deserializeSpentInfo decodes the value of a spent output in the index.
+func deserializeSpentInfo(serialized []byte) (*SpentInfo, er.R) {
+	if len(serialized) < spentValueSize {
+		return nil, errDeserialize("unexpected end of data for spent " +
+			"output entry")
+	}
+	info := &SpentInfo{}
+	copy(info.TxHash[:], serialized)
+	offset := chainhash.HashSize
+	info.Index = byteOrder.Uint32(serialized[offset:])
+	info.Height = int32(byteOrder.Uint32(serialized[offset+4:]))
+	info.Amount = int64(byteOrder.Uint64(serialized[offset+8:]))
+	return info, nil
+}
+
+// SpentIndex implements a spent output index.  That is to say, it supports
+// querying which transaction input spends a given output of the main chain.
+type SpentIndex struct {
+	db database.DB
+}
+
+// Ensure the SpentIndex type implements the Indexer interface.
+var _ Indexer = (*SpentIndex)(nil)
+
+// Ensure the SpentIndex type implements the NeedsInputser interface.
+var _ NeedsInputser = (*SpentIndex)(nil)
+
+// NeedsInputs signals that the index requires the referenced inputs in order
+// to record the value of each spent output.
+//
+// This implements the NeedsInputser interface.
+func (idx *SpentIndex) NeedsInputs() bool {
+	return true
+}
+
+// Init is only provided to satisfy the Indexer interface as there is nothing to
+// initialize for this index.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) Init() er.R {
+	// Nothing to do.
+	return nil
+}
+
+// Key returns the database key to use for the index as a byte slice.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) Key() []byte {
+	return spentIndexKey
+}
+
+// Name returns the human-readable name of the index.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) Name() string {
+	return spentIndexName
+}
+
+// Create is invoked when the indexer manager determines the index needs
+// to be created for the first time.  It creates the bucket for the spent
+// output index.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) Create(dbTx database.Tx) er.R {
+	_, err := dbTx.Metadata().CreateBucket(spentIndexKey)
+	return err
+}
+
+// ConnectBlock is invoked by the index manager when a new block has been
+// connected to the main chain.  This indexer adds an entry for every output
+// spent by the block.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) ConnectBlock(dbTx database.Tx, block *btcutil.Block,
+	stxos []blockchain.SpentTxOut) er.R {
+
+	bucket := dbTx.Metadata().Bucket(spentIndexKey)
+	stxoIndex := 0
+	for _, tx := range block.Transactions()[1:] {
+		for i, txIn := range tx.MsgTx().TxIn {
+			if stxoIndex >= len(stxos) {
+				return er.New("spend journal is shorter than the " +
+					"inputs of block " + block.Hash().String())
+			}
+			info := SpentInfo{
+				TxHash: *tx.Hash(),
+				Index:  uint32(i),
+				Height: block.Height(),
+				Amount: stxos[stxoIndex].Amount,
+			}
+			stxoIndex++
+			err := bucket.Put(spentKey(&txIn.PreviousOutPoint),
+				serializeSpentInfo(&info))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DisconnectBlock is invoked by the index manager when a block has been
+// disconnected from the main chain.  This indexer removes the entry for every
+// output spent by the block.
+//
+// This is part of the Indexer interface.
+func (idx *SpentIndex) DisconnectBlock(dbTx database.Tx, block *btcutil.Block,
+	stxos []blockchain.SpentTxOut) er.R {
+
+	bucket := dbTx.Metadata().Bucket(spentIndexKey)
+	for _, tx := range block.Transactions()[1:] {
+		for _, txIn := range tx.MsgTx().TxIn {
+			err := bucket.Delete(spentKey(&txIn.PreviousOutPoint))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SpentInfo returns the input which spends the provided output in the main
+// chain.  When the output is unspent, or unknown, nil will be returned for
+// both the entry and the error.
+//
+// This function is safe for concurrent access.
+func (idx *SpentIndex) SpentInfo(op *wire.OutPoint) (*SpentInfo, er.R) {
+	var info *SpentInfo
+	err := idx.db.View(func(dbTx database.Tx) er.R {
+		serialized := dbTx.Metadata().Bucket(spentIndexKey).Get(spentKey(op))
+		if serialized == nil {
+			return nil
+		}
+		var err er.R
+		info, err = deserializeSpentInfo(serialized)
+		return err
+	})
+	return info, err
+}
+
+// NewSpentIndex returns a new instance of an indexer that is used to create a
+// mapping of every spent output in the blockchain to the input which spends
+// it.
+//
+// It implements the Indexer interface which plugs into the IndexManager that in
+// turn is used by the blockchain package.  This allows the index to be
+// seamlessly maintained along with the chain.
+func NewSpentIndex(db database.DB) *SpentIndex {
+	return &SpentIndex{db: db}
+}
+
+// DropSpentIndex drops the spent output index from the provided database if
+// it exists.
+func DropSpentIndex(db database.DB, interrupt <-chan struct{}) er.R {
+	return dropIndex(db, spentIndexKey, spentIndexName, interrupt)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package indexers
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/database"
+	"github.com/pkt-cash/pktd/database/ffldb"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/protocol"
+)
+
+// TestSpentIndex ensures that the spent output index records the input
+// spending each output and forgets it when the block is disconnected.
+func TestSpentIndex(t *testing.T) {
+	db, err := ffldb.OpenDB(filepath.Join(t.TempDir(), "db"),
+		protocol.MainNet, true)
+	if err != nil {
+		t.Fatalf("OpenDB: %v", err)
+	}
+	defer db.Close()
+	idx := NewSpentIndex(db)
+
+	_, pkScript := testAddr(t, 1)
+	cb := testCoinbase(1, 50, pkScript)
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 3}})
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Hash: cb.TxHash()}})
+	tx.AddTxOut(wire.NewTxOut(60, pkScript))
+	block := btcutil.NewBlock(&wire.MsgBlock{Transactions: []*wire.MsgTx{
+		testCoinbase(2, 50, pkScript), tx}})
+	block.SetHeight(2)
+	stxos := []blockchain.SpentTxOut{
+		{Amount: 10, PkScript: pkScript, Height: 1},
+		{Amount: 50, PkScript: pkScript, Height: 1, IsCoinBase: true},
+	}
+
+	err = db.Update(func(dbTx database.Tx) er.R {
+		if err := idx.Create(dbTx); err != nil {
+			return err
+		}
+		return idx.ConnectBlock(dbTx, block, stxos)
+	})
+	if err != nil {
+		t.Fatalf("ConnectBlock: %v", err)
+	}
+
+	spent := wire.OutPoint{Hash: cb.TxHash()}
+	info, err := idx.SpentInfo(&spent)
+	if err != nil {
+		t.Fatalf("SpentInfo: %v", err)
+	}
+	want := SpentInfo{TxHash: tx.TxHash(), Index: 1, Height: 2, Amount: 50}
+	if info == nil || *info != want {
+		t.Fatalf("SpentInfo: got %+v want %+v", info, want)
+	}
+	unspent := wire.OutPoint{Hash: tx.TxHash()}
+	if info, err := idx.SpentInfo(&unspent); err != nil || info != nil {
+		t.Errorf("SpentInfo(unspent): got %+v, %v want nil", info, err)
+	}
+
+	err = db.Update(func(dbTx database.Tx) er.R {
+		return idx.DisconnectBlock(dbTx, block, stxos)
+	})
+	if err != nil {
+		t.Fatalf("DisconnectBlock: %v", err)
+	}
+	if info, err := idx.SpentInfo(&spent); err != nil || info != nil {
+		t.Errorf("SpentInfo after disconnect: got %+v, %v want nil", info, err)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package announce
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// cacheKey identifies the validation of an announcement against the hash of
+// its parent block.  The PacketCrypt version is part of the key since it
+// affects which announcements are valid.
+type cacheKey struct {
+	annHash         chainhash.Hash
+	parentBlockHash chainhash.Hash
+	version         int
+}
+
+// cacheEntry is the work hash of a valid announcement.
+type cacheEntry struct {
+	key      cacheKey
+	workHash chainhash.Hash
+}
+
+// Cache implements a cache of valid announcements with a least recently used
+// eviction policy.  Pool operators check announcements which later show up in
+// blocks, so the cache saves checking them again when validating the blocks.
+// Only valid announcements are added to the cache.
+type Cache struct {
+	mtx        sync.Mutex
+	entries    map[cacheKey]*list.Element
+	lru        *list.List
+	maxEntries uint
+}
+
+// NewCache returns a new announcement cache holding at most maxEntries
+// announcements.  The least recently used entry is evicted to make room for
+// new entries.
+func NewCache(maxEntries uint) *Cache {
+	return &Cache{
+		entries:    make(map[cacheKey]*list.Element),
+		lru:        list.New(),
+		maxEntries: maxEntries,
+	}
+}
+
+// lookup returns the work hash of the announcement if it is known to be valid.
+func (c *Cache) lookup(key *cacheKey) (*chainhash.Hash, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	el, ok := c.entries[*key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	workHash := el.Value.(*cacheEntry).workHash
+	return &workHash, true
+}
+
+// add adds a valid announcement to the cache, evicting the least recently used
+// entry if the cache is full.
+func (c *Cache) add(key *cacheKey, workHash *chainhash.Hash) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.maxEntries == 0 {
+		return
+	}
+	if el, ok := c.entries[*key]; ok {
+		c.lru.MoveToFront(el)
+		return
+	}
+	if uint(len(c.entries)) >= c.maxEntries {
+		oldest := c.lru.Back()
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+		c.lru.Remove(oldest)
+	}
+	c.entries[*key] = c.lru.PushFront(&cacheEntry{key: *key, workHash: *workHash})
+}
+
+// Len returns the number of announcements in the cache.
+func (c *Cache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return len(c.entries)
+}
+
+// CheckAnn checks an announcement like the CheckAnn function, returning the
+// work hash from the cache when the announcement has already been found to
+// be valid.  A nil cache checks the announcement every time.
+//
+// This function is safe for concurrent access.
+func (c *Cache) CheckAnn(pcAnn *wire.PacketCryptAnn, parentBlockHash *chainhash.Hash,
+	packetCryptVersion int) (*chainhash.Hash, er.R) {
+
+	if c == nil {
+		return CheckAnn(pcAnn, parentBlockHash, packetCryptVersion)
+	}
+	key := cacheKey{
+		annHash:         pcAnn.Hash(),
+		parentBlockHash: *parentBlockHash,
+		version:         packetCryptVersion,
+	}
+	if workHash, ok := c.lookup(&key); ok {
+		return workHash, nil
+	}
+	workHash, err := CheckAnn(pcAnn, parentBlockHash, packetCryptVersion)
+	if err != nil {
+		return nil, err
+	}
+	c.add(&key, workHash)
+	return workHash, nil
+}
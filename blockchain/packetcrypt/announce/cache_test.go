@@ -0,0 +1,71 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package announce
+
+import (
+	"testing"
+
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// TestCacheEviction ensures that the least recently used announcement is
+// evicted once the cache is full.
+func TestCacheEviction(t *testing.T) {
+	c := NewCache(2)
+	keys := make([]cacheKey, 3)
+	for i := range keys {
+		keys[i] = cacheKey{
+			annHash:         chainhash.Hash{byte(i)},
+			parentBlockHash: chainhash.Hash{0xff},
+			version:         1,
+		}
+		workHash := chainhash.Hash{byte(i), 1}
+		c.add(&keys[i], &workHash)
+		if i == 1 {
+			// Use the first entry so the second one is the least
+			// recently used.
+			if _, ok := c.lookup(&keys[0]); !ok {
+				t.Fatalf("lookup: first entry missing")
+			}
+		}
+	}
+	if c.Len() != 2 {
+		t.Fatalf("Len: got %d want 2", c.Len())
+	}
+	if _, ok := c.lookup(&keys[1]); ok {
+		t.Errorf("lookup: least recently used entry was not evicted")
+	}
+	workHash, ok := c.lookup(&keys[2])
+	if !ok || *workHash != (chainhash.Hash{2, 1}) {
+		t.Errorf("lookup: got %v, %v", workHash, ok)
+	}
+
+	// A different parent block hash is a different entry.
+	other := keys[0]
+	other.parentBlockHash = chainhash.Hash{0xfe}
+	if _, ok := c.lookup(&other); ok {
+		t.Errorf("lookup: found entry for another parent block")
+	}
+}
+
+// TestCacheInvalidAnn ensures that invalid announcements are not cached.
+func TestCacheInvalidAnn(t *testing.T) {
+	c := NewCache(10)
+	var ann wire.PacketCryptAnn
+	var parent chainhash.Hash
+	for i := 0; i < 2; i++ {
+		if _, err := c.CheckAnn(&ann, &parent, 1); err == nil {
+			t.Fatalf("CheckAnn: invalid announcement accepted")
+		}
+	}
+	if c.Len() != 0 {
+		t.Errorf("Len: got %d want 0", c.Len())
+	}
+	var nilCache *Cache
+	if _, err := nilCache.CheckAnn(&ann, &parent, 1); err == nil {
+		t.Errorf("CheckAnn: invalid announcement accepted without cache")
+	}
+}
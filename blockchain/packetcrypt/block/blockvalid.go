@@ -86,6 +86,7 @@ func isPcHashOk(
 // to be a block in case that shareTarget is non-zero.
 // If there is enough work to make a valid block, this function will always accept the share
 // even if shareTarget is not met!
+// Announcements found in annCache, which may be nil, are not checked again.
 func ValidatePcProof(
 	pcp *wire.PacketCryptProof,
 	blockHeight int32,
@@ -95,6 +96,7 @@ func ValidatePcProof(
 	blockHashes []*chainhash.Hash,
 	contentProofs [][]byte,
 	packetCryptVersion int,
+	annCache *announce.Cache,
 ) (bool, er.R) {
 	// Check cb magic
 	if cb.Magic() != wire.PcCoinbaseCommitMagic ||
@@ -113,7 +115,7 @@ func ValidatePcProof(
 	var annHashes [4][32]byte
 	for i := 0; i < 4; i++ {
 		ann := &pcp.Announcements[i]
-		if _, err := announce.CheckAnn(ann, blockHashes[i], packetCryptVersion); err != nil {
+		if _, err := annCache.CheckAnn(ann, blockHashes[i], packetCryptVersion); err != nil {
 			return false, err
 		}
 		effectiveAnnTarget := uint32(0xffffffff)
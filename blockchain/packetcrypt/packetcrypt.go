@@ -72,7 +72,8 @@ func contentProofIdx2(mb *wire.MsgBlock) uint32 {
 	return binary.LittleEndian.Uint32(buf) ^ mb.Pcp.Nonce
 }
 
-func ValidatePcBlock(mb *wire.MsgBlock, height int32, shareTarget uint32, annParentHashes []*chainhash.Hash) (bool, er.R) {
+func ValidatePcBlock(mb *wire.MsgBlock, height int32, shareTarget uint32, annParentHashes []*chainhash.Hash,
+	annCache *announce.Cache) (bool, er.R) {
 	if len(annParentHashes) != 4 {
 		return false, er.New("wrong number of annParentHashes")
 	}
@@ -125,7 +126,7 @@ func ValidatePcBlock(mb *wire.MsgBlock, height int32, shareTarget uint32, annPar
 		return false, er.New("missing packetcrypt commitment")
 	}
 	return block.ValidatePcProof(
-		mb.Pcp, height, &mb.Header, cbc, shareTarget, annParentHashes, contentProofs, mb.Pcp.Version)
+		mb.Pcp, height, &mb.Header, cbc, shareTarget, annParentHashes, contentProofs, mb.Pcp.Version, annCache)
 }
 
 var pcCoinbasePrefix = [...]byte{0x6a, 0x30, 0x09, 0xf9, 0x11, 0x02}
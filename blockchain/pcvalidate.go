@@ -0,0 +1,244 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/announce"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/chaincfg/globalcfg"
+)
+
+// maxPendingPcProofs is the maximum number of blocks whose PacketCrypt proofs
+// may be queued or cached ahead of the blocks being processed.
+const maxPendingPcProofs = 2048
+
+// pcProofJob is the validation of the PacketCrypt proof of a block against the
+// hashes of the parent blocks of its announcements.
+type pcProofJob struct {
+	block           *btcutil.Block
+	height          int32
+	annParentHashes []*chainhash.Hash
+
+	// claimed is set by whoever runs the validation, either a worker or
+	// the chain when it needs the result before a worker got to it.  The
+	// done channel is closed once err holds the result.
+	claimed int32
+	done    chan struct{}
+	err     er.R
+}
+
+// pcProofValidator validates the PacketCrypt proofs of blocks which are
+// waiting to be processed using a pool of workers, so that checking the
+// announcements, which is the most expensive part of validating a block
+// during the initial block download, is done in parallel.  The results are
+// cached by block hash until the chain processes the block, all checks which
+// depend on the chain state remain sequential.
+type pcProofValidator struct {
+	annCache *announce.Cache
+	validate func(block *btcutil.Block, height int32, annParentHashes []*chainhash.Hash) er.R
+	jobChan  chan *pcProofJob
+
+	mtx  sync.Mutex
+	jobs map[chainhash.Hash]*pcProofJob
+}
+
+// validatePcProof validates the PacketCrypt proof of a block.
+func (v *pcProofValidator) validatePcProof(block *btcutil.Block, height int32,
+	annParentHashes []*chainhash.Hash) er.R {
+
+	_, err := packetcrypt.ValidatePcBlock(block.MsgBlock(), height, 0,
+		annParentHashes, v.annCache)
+	return err
+}
+
+// newPcProofValidator returns a validator running the provided number of
+// workers, or one per CPU when it is not positive, until quit is closed.
+// Announcements found in annCache, which may be nil, are not checked again.
+//
+// No workers are run when quit is nil since nothing could stop them, nothing
+// is queued then and every proof is validated when its block is processed.
+func newPcProofValidator(workers int, annCache *announce.Cache,
+	quit <-chan struct{}) *pcProofValidator {
+
+	v := &pcProofValidator{
+		annCache: annCache,
+		jobs:     make(map[chainhash.Hash]*pcProofJob),
+	}
+	v.validate = v.validatePcProof
+	if quit == nil {
+		return v
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	v.jobChan = make(chan *pcProofJob, maxPendingPcProofs)
+	for i := 0; i < workers; i++ {
+		go v.worker(quit)
+	}
+	return v
+}
+
+// worker runs queued validations until quit is closed.  It must be run as a
+// goroutine.
+func (v *pcProofValidator) worker(quit <-chan struct{}) {
+	for {
+		select {
+		case job := <-v.jobChan:
+			v.run(job)
+		case <-quit:
+			return
+		}
+	}
+}
+
+// run validates the proof of a queued job, unless the job has been pruned or
+// taken by the chain since it was queued.
+func (v *pcProofValidator) run(job *pcProofJob) {
+	v.mtx.Lock()
+	queued := v.jobs[*job.block.Hash()] == job
+	v.mtx.Unlock()
+	if queued {
+		v.claim(job)
+	}
+}
+
+// claim validates the proof of the job unless it has already been claimed.
+func (v *pcProofValidator) claim(job *pcProofJob) {
+	if !atomic.CompareAndSwapInt32(&job.claimed, 0, 1) {
+		return
+	}
+	job.err = v.validate(job.block, job.height, job.annParentHashes)
+	close(job.done)
+}
+
+// queue queues the validation of a proof, it is dropped when too many are
+// pending or there are no workers.  Jobs of blocks at or below the prune
+// height, which are not going to be processed anymore, are dropped to make
+// room.
+func (v *pcProofValidator) queue(job *pcProofJob, pruneHeight int32) {
+	if v.jobChan == nil {
+		return
+	}
+	hash := *job.block.Hash()
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if _, ok := v.jobs[hash]; ok {
+		return
+	}
+	if len(v.jobs) >= maxPendingPcProofs {
+		for h, j := range v.jobs {
+			if j.height <= pruneHeight {
+				delete(v.jobs, h)
+			}
+		}
+		if len(v.jobs) >= maxPendingPcProofs {
+			return
+		}
+	}
+	select {
+	case v.jobChan <- job:
+		v.jobs[hash] = job
+	default:
+	}
+}
+
+// result returns the result of validating the proof of the block, waiting for
+// a queued validation against the same announcement parent hashes or
+// validating it right away when there is none.
+func (v *pcProofValidator) result(block *btcutil.Block, height int32,
+	annParentHashes []*chainhash.Hash) er.R {
+
+	v.mtx.Lock()
+	job := v.jobs[*block.Hash()]
+	delete(v.jobs, *block.Hash())
+	v.mtx.Unlock()
+
+	if job == nil || job.height != height ||
+		!sameHashes(job.annParentHashes, annParentHashes) {
+
+		return v.validate(block, height, annParentHashes)
+	}
+	v.claim(job)
+	<-job.done
+	return job.err
+}
+
+// sameHashes returns whether both lists contain the same hashes.
+func sameHashes(a, b []*chainhash.Hash) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].IsEqual(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// QueuePcProofValidation queues the validation of the PacketCrypt proof of a
+// block which is going to be processed later, typically a block downloaded
+// ahead of its parent during the initial block download.  The hash of the
+// block at a height of the chain the block belongs to is obtained with
+// blockHash, which returns nil when it is unknown and is only called before
+// this function returns.  Nothing is queued for blocks which don't need their
+// proof validated or whose proof can't be validated yet.
+//
+// The result is used when the block is processed, provided the hashes of the
+// announcement parent blocks turn out to be the same.
+//
+// This function is safe for concurrent access.
+func (b *BlockChain) QueuePcProofValidation(block *btcutil.Block,
+	blockHash func(height int32) *chainhash.Hash) {
+
+	pcp := block.MsgBlock().Pcp
+	if globalcfg.GetProofOfWorkAlgorithm() != globalcfg.PowPacketCrypt ||
+		pcp == nil {
+
+		return
+	}
+	coinbase, err := block.Tx(0)
+	if err != nil {
+		return
+	}
+	height, err := ExtractCoinbaseHeight(coinbase)
+	if err != nil {
+		return
+	}
+	if checkpoint := b.LatestCheckpoint(); checkpoint != nil &&
+		checkpoint.Height >= height {
+
+		return
+	}
+	if !globalcfg.IsPacketCryptAllowedVersion(pcp.Version, height) {
+		return
+	}
+
+	hashes := make([]*chainhash.Hash, len(pcp.Announcements))
+	for i := range pcp.Announcements {
+		ph := pcp.Announcements[i].GetParentBlockHeight()
+		if ph > 0x7fffffff {
+			return
+		}
+		if hashes[i] = blockHash(int32(ph)); hashes[i] == nil {
+			return
+		}
+	}
+
+	b.pcValidator.queue(&pcProofJob{
+		block:           block,
+		height:          height,
+		annParentHashes: hashes,
+		done:            make(chan struct{}),
+	}, b.BestSnapshot().Height)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// pcProofTestBlocks returns blocks with distinct hashes along with the
+// announcement parent hashes to validate them against.
+func pcProofTestBlocks(n int) ([]*btcutil.Block, [][]*chainhash.Hash) {
+	blocks := make([]*btcutil.Block, n)
+	hashes := make([][]*chainhash.Hash, n)
+	for i := 0; i < n; i++ {
+		mb := wire.NewMsgBlock(&wire.BlockHeader{Nonce: uint32(i)})
+		blocks[i] = btcutil.NewBlock(mb)
+		hashes[i] = make([]*chainhash.Hash, 4)
+		for j := range hashes[i] {
+			hashes[i][j] = &chainhash.Hash{byte(i), byte(j)}
+		}
+	}
+	return blocks, hashes
+}
+
+// TestPcProofValidator ensures that queued validations are used when the
+// announcement parent hashes match and that proofs are validated again
+// otherwise.
+func TestPcProofValidator(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+	v := newPcProofValidator(2, nil, quit)
+	var calls int32
+	errBad := er.New("bad proof")
+	v.validate = func(block *btcutil.Block, height int32, annParentHashes []*chainhash.Hash) er.R {
+		atomic.AddInt32(&calls, 1)
+		if block.MsgBlock().Header.Nonce == 1 {
+			return errBad
+		}
+		return nil
+	}
+
+	blocks, hashes := pcProofTestBlocks(3)
+	for i := 0; i < 2; i++ {
+		v.queue(&pcProofJob{
+			block:           blocks[i],
+			height:          int32(i),
+			annParentHashes: hashes[i],
+			done:            make(chan struct{}),
+		}, -1)
+	}
+	if err := v.result(blocks[0], 0, hashes[0]); err != nil {
+		t.Errorf("result: unexpected error %v", err)
+	}
+	if err := v.result(blocks[1], 1, hashes[1]); err == nil ||
+		err.Message() != errBad.Message() {
+
+		t.Errorf("result: got %v want %v", err, errBad)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("validate: got %d calls want 2", n)
+	}
+
+	// A queued proof is not used when the hashes differ, and results are
+	// only used once.
+	v.queue(&pcProofJob{
+		block:           blocks[2],
+		height:          2,
+		annParentHashes: hashes[2],
+		done:            make(chan struct{}),
+	}, -1)
+	if err := v.result(blocks[2], 2, hashes[0]); err != nil {
+		t.Errorf("result: unexpected error %v", err)
+	}
+	if err := v.result(blocks[1], 1, hashes[1]); err == nil ||
+		err.Message() != errBad.Message() {
+
+		t.Errorf("result: got %v want %v", err, errBad)
+	}
+	v.mtx.Lock()
+	pending := len(v.jobs)
+	v.mtx.Unlock()
+	if pending != 0 {
+		t.Errorf("jobs: %d results remain cached", pending)
+	}
+}
+
+// TestPcProofValidatorNoQuit ensures that nothing is queued when the
+// validator runs no workers, and that proofs are validated when requested.
+func TestPcProofValidatorNoQuit(t *testing.T) {
+	v := newPcProofValidator(2, nil, nil)
+	var calls int32
+	v.validate = func(block *btcutil.Block, height int32, annParentHashes []*chainhash.Hash) er.R {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	}
+
+	blocks, hashes := pcProofTestBlocks(1)
+	v.queue(&pcProofJob{
+		block:           blocks[0],
+		height:          0,
+		annParentHashes: hashes[0],
+		done:            make(chan struct{}),
+	}, -1)
+	if pending := len(v.jobs); pending != 0 {
+		t.Errorf("jobs: %d validations queued without workers", pending)
+	}
+	if err := v.result(blocks[0], 0, hashes[0]); err != nil {
+		t.Errorf("result: unexpected error %v", err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("validate: got %d calls want 1", n)
+	}
+}
+
+// TestPcProofValidatorPrune ensures that the validations of blocks at or below
+// the prune height are dropped to make room when the queue is full, and that
+// the workers skip them.
+func TestPcProofValidatorPrune(t *testing.T) {
+	const numPruned = 10
+	v := newPcProofValidator(0, nil, nil)
+	v.jobChan = make(chan *pcProofJob, maxPendingPcProofs+1)
+	var calls int32
+	v.validate = func(block *btcutil.Block, height int32, annParentHashes []*chainhash.Hash) er.R {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	}
+
+	blocks, hashes := pcProofTestBlocks(maxPendingPcProofs + 1)
+	for i, block := range blocks {
+		v.queue(&pcProofJob{
+			block:           block,
+			height:          int32(i),
+			annParentHashes: hashes[i],
+			done:            make(chan struct{}),
+		}, numPruned-1)
+	}
+	if pending := len(v.jobs); pending != maxPendingPcProofs+1-numPruned {
+		t.Fatalf("jobs: got %d pending want %d", pending,
+			maxPendingPcProofs+1-numPruned)
+	}
+	for i := 0; i < numPruned; i++ {
+		if v.jobs[*blocks[i].Hash()] != nil {
+			t.Fatalf("jobs: job at height %d was not pruned", i)
+		}
+	}
+
+	for len(v.jobChan) > 0 {
+		v.run(<-v.jobChan)
+	}
+	if n := atomic.LoadInt32(&calls); n != maxPendingPcProofs+1-numPruned {
+		t.Errorf("validate: got %d calls want %d", n,
+			maxPendingPcProofs+1-numPruned)
+	}
+}
+
+// BenchmarkPcProofValidation compares validating PacketCrypt proofs while
+// processing each block to validating them with the worker pool, as done for
+// blocks downloaded ahead of being processed.  Only the announcements are
+// checked, as they make up most of the cost of validating a proof.
+func BenchmarkPcProofValidation(b *testing.B) {
+	const numBlocks = 64
+	blocks, hashes := pcProofTestBlocks(numBlocks)
+	validate := func(block *btcutil.Block, height int32, annParentHashes []*chainhash.Hash) er.R {
+		for i, hash := range annParentHashes {
+			var ann wire.PacketCryptAnn
+			ann.Header[8] = byte(i)
+			packetcrypt.ValidatePcAnn(&ann, hash, 1)
+		}
+		return nil
+	}
+
+	b.Run("sequential", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for i, block := range blocks {
+				validate(block, int32(i), hashes[i])
+			}
+		}
+	})
+	b.Run("parallel", func(b *testing.B) {
+		quit := make(chan struct{})
+		defer close(quit)
+		v := newPcProofValidator(0, nil, quit)
+		v.validate = validate
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			for i, block := range blocks {
+				v.queue(&pcProofJob{
+					block:           block,
+					height:          int32(i),
+					annParentHashes: hashes[i],
+					done:            make(chan struct{}),
+				}, -1)
+			}
+			for i, block := range blocks {
+				v.result(block, int32(i), hashes[i])
+			}
+		}
+	})
+}
@@ -189,6 +189,44 @@ func newTxValidator(utxoView *UtxoViewpoint, flags txscript.ScriptFlags,
 	}
 }
 
+// taprootActive returns true if the script flags enforce the taproot
+// soft-fork package.
+func taprootActive(flags txscript.ScriptFlags) bool {
+	return flags&txscript.ScriptVerifyTaproot == txscript.ScriptVerifyTaproot
+}
+
+// taprootSigHashes returns the sighash midstate of a transaction including
+// the BIP0341 sighashes, which commit to every output spent by the
+// transaction.  They are computed from the utxo view and added to the
+// HashCache, if it is present, unless it already contains them.
+func taprootSigHashes(tx *btcutil.Tx, utxoView *UtxoViewpoint,
+	hashCache *txscript.HashCache) *txscript.TxSigHashes {
+
+	if hashCache != nil {
+		sigHashes, found := hashCache.GetSigHashes(tx.Hash())
+		if found && sigHashes.Taproot != nil {
+			return sigHashes
+		}
+	}
+
+	prevOuts := make([]*wire.TxOut, len(tx.MsgTx().TxIn))
+	for i, txIn := range tx.MsgTx().TxIn {
+		// A missing input leaves the taproot sighashes out, which
+		// is reported by the validator.
+		entry := utxoView.LookupEntry(txIn.PreviousOutPoint)
+		if entry == nil {
+			continue
+		}
+		prevOuts[i] = wire.NewTxOut(entry.Amount(), entry.PkScript())
+	}
+	if hashCache == nil {
+		return txscript.NewTxSigHashesWithPrevOuts(tx.MsgTx(), prevOuts)
+	}
+	hashCache.AddTaprootSigHashes(tx.MsgTx(), prevOuts)
+	sigHashes, _ := hashCache.GetSigHashes(tx.Hash())
+	return sigHashes
+}
+
 // ValidateTransactionScripts validates the scripts for the passed transaction
 // using multiple goroutines.
 func ValidateTransactionScripts(tx *btcutil.Tx, utxoView *UtxoViewpoint,
@@ -215,6 +253,9 @@ func ValidateTransactionScripts(tx *btcutil.Tx, utxoView *UtxoViewpoint,
 		// are only computed once.
 		cachedHashes, _ = hashCache.GetSigHashes(tx.Hash())
 	}
+	if taprootActive(flags) && tx.MsgTx().HasWitness() {
+		cachedHashes = taprootSigHashes(tx, utxoView, hashCache)
+	}
 
 	// Collect all of the transaction inputs and required information for
 	// validation.
@@ -277,6 +318,9 @@ func checkBlockScripts(block *btcutil.Block, utxoView *UtxoViewpoint,
 				cachedHashes = txscript.NewTxSigHashes(tx.MsgTx())
 			}
 		}
+		if taprootActive(scriptFlags) && tx.HasWitness() {
+			cachedHashes = taprootSigHashes(tx, utxoView, hashCache)
+		}
 
 		for txInIdx, txIn := range tx.MsgTx().TxIn {
 			// Skip coinbases.
@@ -19,7 +19,6 @@ import (
 	"github.com/pkt-cash/pktd/txscript/params"
 	"github.com/pkt-cash/pktd/wire/ruleerror"
 
-	"github.com/pkt-cash/pktd/blockchain/packetcrypt"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/chaincfg"
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
@@ -421,7 +420,7 @@ func (b *BlockChain) pcCheckProofOfWork(block *btcutil.Block) (int32, er.R) {
 		}
 		hashes[i] = hash
 	}
-	if _, err := packetcrypt.ValidatePcBlock(block.MsgBlock(), height, 0, hashes); err != nil {
+	if err := b.pcValidator.result(block, height, hashes); err != nil {
 		str := fmt.Sprintf("Error validating PacketCrypt proof [%v]", err)
 		return height, ruleerror.ErrBadPow.New(str, nil)
 	}
@@ -1165,6 +1164,14 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *btcutil.Block,
 	}
 	enforceSegWit := segwitState == ThresholdActive
 
+	// Query for the Version Bits state for the taproot soft-fork
+	// deployment, which enables witness version 1 spends.
+	taprootState, err := b.deploymentState(node.parent, chaincfg.DeploymentTaproot)
+	if err != nil {
+		return nil, err
+	}
+	enforceTaproot := enforceSegWit && taprootState == ThresholdActive
+
 	// The number of signature operations must be less than the maximum
 	// allowed per block.  Note that the preliminary sanity checks on a
 	// block also include a check similar to this one, but this check
@@ -1358,6 +1365,12 @@ func (b *BlockChain) checkConnectBlock(node *blockNode, block *btcutil.Block,
 		scriptFlags |= txscript.ScriptStrictMultiSig
 	}
 
+	// Enforce the taproot soft-fork package once the soft-fork has
+	// shifted into the "active" version bits state.
+	if enforceTaproot {
+		scriptFlags |= txscript.ScriptVerifyTaproot
+	}
+
 	// Now that the inexpensive checks are done and have passed, verify the
 	// transactions are actually allowed to spend the coins by running the
 	// expensive ECDSA signature check scripts.  Doing this last helps
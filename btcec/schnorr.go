@@ -29,7 +29,7 @@ type SchnorrPublicKey struct{ x *big.Int }
 
 // Serialize returns x(P) in a 32 byte slice.
 func (p *SchnorrPublicKey) Serialize() []byte {
-	return p.x.Bytes()
+	return paddedAppend(schnorrPublicKeyLen, nil, p.x.Bytes())
 }
 
 // ParseSchnorrPubKey parses a public key, verifies it is valid, and returns the schnorr key.
@@ -144,8 +144,8 @@ func schnorrSign(privKey, msg []byte, a []byte) (sig [64]byte, err error) {
 	// Get a deterministic nonce k.
 	{
 		m := make([]byte, 96)
-		copy(m[:32], t.Bytes())
-		copy(m[32:64], Px.Bytes())
+		copy(m[:32], paddedAppend(32, nil, t.Bytes()))
+		copy(m[32:64], paddedAppend(32, nil, Px.Bytes()))
 		copy(m[64:], msg)
 
 		// rand = sha256(BIP0340/nonce || (t || P || m))
@@ -171,8 +171,8 @@ func schnorrSign(privKey, msg []byte, a []byte) (sig [64]byte, err error) {
 	// e = int(hashBIP0340/challenge(R || P || m)) mod n
 	{
 		m := make([]byte, 96)
-		copy(m[:32], Rx.Bytes())
-		copy(m[32:64], Px.Bytes())
+		copy(m[:32], paddedAppend(32, nil, Rx.Bytes()))
+		copy(m[32:64], paddedAppend(32, nil, Px.Bytes()))
 		copy(m[64:], msg)
 		e.SetBytes(taggedHash(BIP340Challenge, m))
 		e.Mod(e, n)
@@ -183,12 +183,12 @@ func schnorrSign(privKey, msg []byte, a []byte) (sig [64]byte, err error) {
 	s.Add(k, s)
 	s.Mod(s, n)
 
-	// Signature is (x(R), s).
-	copy(sig[:32], Rx.Bytes())
-	copy(sig[32:], s.Bytes())
+	// Signature is (x(R), s), both are padded to 32 bytes.
+	copy(sig[:32], paddedAppend(32, nil, Rx.Bytes()))
+	copy(sig[32:], paddedAppend(32, nil, s.Bytes()))
 
 	// Verify signature before returning.
-	if verify, err := schnorrVerify(msg, Px.Bytes(), sig[:]); !verify || err != nil {
+	if verify, err := schnorrVerify(msg, paddedAppend(32, nil, Px.Bytes()), sig[:]); !verify || err != nil {
 		return sig, errors.New("cannot create signature")
 	}
 
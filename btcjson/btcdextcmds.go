@@ -111,6 +111,31 @@ func NewGetHeadersCmd(blockLocators []string, hashStop string) *GetHeadersCmd {
 	}
 }
 
+// DebugScriptCmd defines the debugscript JSON-RPC command.
+type DebugScriptCmd struct {
+	HexTx    string
+	Input    uint32
+	PkScript *string
+	Amount   *float64
+	Text     *bool `jsonrpcdefault:"false"`
+}
+
+// NewDebugScriptCmd returns a new instance which can be used to issue a
+// debugscript JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewDebugScriptCmd(hexTx string, input uint32, pkScript *string,
+	amount *float64, text *bool) *DebugScriptCmd {
+	return &DebugScriptCmd{
+		HexTx:    hexTx,
+		Input:    input,
+		PkScript: pkScript,
+		Amount:   amount,
+		Text:     text,
+	}
+}
+
 // VersionCmd defines the version JSON-RPC command.
 //
 // NOTE: This is a btcsuite extension ported from
@@ -129,6 +154,7 @@ func init() {
 	flags := UsageFlag(0)
 
 	MustRegisterCmd("debuglevel", (*DebugLevelCmd)(nil), flags)
+	MustRegisterCmd("debugscript", (*DebugScriptCmd)(nil), flags)
 	MustRegisterCmd("node", (*NodeCmd)(nil), flags)
 	MustRegisterCmd("generate", (*GenerateCmd)(nil), flags)
 	MustRegisterCmd("getbestblock", (*GetBestBlockCmd)(nil), flags)
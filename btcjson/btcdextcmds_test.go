@@ -44,6 +44,39 @@ func TestBtcdExtCmds(t *testing.T) {
 				LevelSpec: "trace",
 			},
 		},
+		{
+			name: "debugscript",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("debugscript", "0100", 1)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewDebugScriptCmd("0100", 1, nil, nil, nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"debugscript","params":["0100",1],"id":1}`,
+			unmarshaled: &btcjson.DebugScriptCmd{
+				HexTx: "0100",
+				Input: 1,
+				Text:  btcjson.Bool(false),
+			},
+		},
+		{
+			name: "debugscript optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("debugscript", "0100", 0, "51", 0.5, true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewDebugScriptCmd("0100", 0, btcjson.String("51"),
+					btcjson.Float64(0.5), btcjson.Bool(true))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"debugscript","params":["0100",0,"51",0.5,true],"id":1}`,
+			unmarshaled: &btcjson.DebugScriptCmd{
+				HexTx:    "0100",
+				Input:    0,
+				PkScript: btcjson.String("51"),
+				Amount:   btcjson.Float64(0.5),
+				Text:     btcjson.Bool(true),
+			},
+		},
 		{
 			name: "node",
 			newCmd: func() (interface{}, er.R) {
@@ -18,3 +18,31 @@ type VersionResult struct {
 	Prerelease    string `json:"prerelease"`
 	BuildMetadata string `json:"buildmetadata"`
 }
+
+// DebugScriptStep models one executed opcode in the reply to the debugscript
+// command.
+type DebugScriptStep struct {
+	Step      int      `json:"step"`
+	PC        string   `json:"pc"`
+	Opcode    string   `json:"opcode"`
+	Executed  bool     `json:"executed"`
+	Stack     []string `json:"stack"`
+	AltStack  []string `json:"altstack"`
+	Branch    []string `json:"branch"`
+	Error     string   `json:"error,omitempty"`
+	ErrorCode string   `json:"errorcode,omitempty"`
+}
+
+// DebugScriptResult models the data returned by the debugscript command.
+type DebugScriptResult struct {
+	TxID      string            `json:"txid"`
+	Input     uint32            `json:"input"`
+	PkScript  string            `json:"pkscript"`
+	Type      string            `json:"type"`
+	Svalue    string            `json:"svalue"`
+	Witness   bool              `json:"witness"`
+	Steps     []DebugScriptStep `json:"steps"`
+	Valid     bool              `json:"valid"`
+	Error     string            `json:"error,omitempty"`
+	ErrorCode string            `json:"errorcode,omitempty"`
+}
@@ -41,6 +41,17 @@ func TestChainSvrCmds(t *testing.T) {
 			marshaled:   `{"jsonrpc":"1.0","method":"addnode","params":["127.0.0.1","remove"],"id":1}`,
 			unmarshaled: &btcjson.AddNodeCmd{Addr: "127.0.0.1", SubCmd: btcjson.ANRemove},
 		},
+		{
+			name: "clearbanned",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("clearbanned")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewClearBannedCmd()
+			},
+			marshaled:   `{"jsonrpc":"1.0","method":"clearbanned","params":[],"id":1}`,
+			unmarshaled: &btcjson.ClearBannedCmd{},
+		},
 		{
 			name: "createrawtransaction",
 			newCmd: func() (interface{}, er.R) {
@@ -103,6 +114,33 @@ func TestChainSvrCmds(t *testing.T) {
 			marshaled:   `{"jsonrpc":"1.0","method":"decodescript","params":["00"],"id":1}`,
 			unmarshaled: &btcjson.DecodeScriptCmd{HexScript: "00"},
 		},
+		{
+			name: "disconnectnode",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("disconnectnode", "127.0.0.1:64764")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewDisconnectNodeCmd(btcjson.String("127.0.0.1:64764"), nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"disconnectnode","params":["127.0.0.1:64764"],"id":1}`,
+			unmarshaled: &btcjson.DisconnectNodeCmd{
+				Address: btcjson.String("127.0.0.1:64764"),
+			},
+		},
+		{
+			name: "disconnectnode nodeid",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("disconnectnode", "", 3)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewDisconnectNodeCmd(btcjson.String(""), btcjson.Int32(3))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"disconnectnode","params":["",3],"id":1}`,
+			unmarshaled: &btcjson.DisconnectNodeCmd{
+				Address: btcjson.String(""),
+				NodeID:  btcjson.Int32(3),
+			},
+		},
 		{
 			name: "getaddednodeinfo",
 			newCmd: func() (interface{}, er.R) {
@@ -128,6 +166,48 @@ func TestChainSvrCmds(t *testing.T) {
 				Node: btcjson.String("127.0.0.1"),
 			},
 		},
+		{
+			name: "getaddressbalance",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getaddressbalance", []string{"1Address", "2Address"})
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressBalanceCmd([]string{"1Address", "2Address"})
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getaddressbalance","params":[["1Address","2Address"]],"id":1}`,
+			unmarshaled: &btcjson.GetAddressBalanceCmd{
+				Addresses: []string{"1Address", "2Address"},
+			},
+		},
+		{
+			name: "getaddressdeltas",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getaddressdeltas", []string{"1Address"}, 10, 20)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressDeltasCmd([]string{"1Address"},
+					btcjson.Int32(10), btcjson.Int32(20))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getaddressdeltas","params":[["1Address"],10,20],"id":1}`,
+			unmarshaled: &btcjson.GetAddressDeltasCmd{
+				Addresses: []string{"1Address"},
+				Start:     btcjson.Int32(10),
+				End:       btcjson.Int32(20),
+			},
+		},
+		{
+			name: "getaddressutxos",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getaddressutxos", []string{"1Address"})
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetAddressUtxosCmd([]string{"1Address"})
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getaddressutxos","params":[["1Address"]],"id":1}`,
+			unmarshaled: &btcjson.GetAddressUtxosCmd{
+				Addresses: []string{"1Address"},
+			},
+		},
 		{
 			name: "getbestblockhash",
 			newCmd: func() (interface{}, er.R) {
@@ -238,6 +318,21 @@ func TestChainSvrCmds(t *testing.T) {
 				Verbose: btcjson.Bool(true),
 			},
 		},
+		{
+			name: "getblockstats",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getblockstats", "1000", []string{"avgfee", "txs"})
+			},
+			staticCmd: func() interface{} {
+				stats := []string{"avgfee", "txs"}
+				return btcjson.NewGetBlockStatsCmd("1000", &stats)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getblockstats","params":["1000",["avgfee","txs"]],"id":1}`,
+			unmarshaled: &btcjson.GetBlockStatsCmd{
+				HashOrHeight: "1000",
+				Stats:        &[]string{"avgfee", "txs"},
+			},
+		},
 		{
 			name: "getblocktemplate",
 			newCmd: func() (interface{}, er.R) {
@@ -353,6 +448,19 @@ func TestChainSvrCmds(t *testing.T) {
 				FilterType: wire.GCSFilterRegular,
 			},
 		},
+		{
+			name: "getchaintxstats",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getchaintxstats", 100)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetChainTxStatsCmd(btcjson.Int32(100), nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getchaintxstats","params":[100],"id":1}`,
+			unmarshaled: &btcjson.GetChainTxStatsCmd{
+				NBlocks: btcjson.Int32(100),
+			},
+		},
 		{
 			name: "getchaintips",
 			newCmd: func() (interface{}, er.R) {
@@ -583,6 +691,20 @@ func TestChainSvrCmds(t *testing.T) {
 				Verbose: btcjson.Bool(true),
 			},
 		},
+		{
+			name: "getspentinfo",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getspentinfo", "123", 1)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetSpentInfoCmd("123", 1)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getspentinfo","params":["123",1],"id":1}`,
+			unmarshaled: &btcjson.GetSpentInfoCmd{
+				Txid:  "123",
+				Index: 1,
+			},
+		},
 		{
 			name: "gettxout",
 			newCmd: func() (interface{}, er.R) {
@@ -719,6 +841,44 @@ func TestChainSvrCmds(t *testing.T) {
 				BlockHash: "123",
 			},
 		},
+		{
+			name: "checkpcanns",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("checkpcanns", []string{"00", "01"}, 2)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewCheckPcAnnsCmd([]string{"00", "01"}, btcjson.Int(2))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"checkpcanns","params":[["00","01"],2],"id":1}`,
+			unmarshaled: &btcjson.CheckPcAnnsCmd{
+				AnnHexes:  []string{"00", "01"},
+				PcVersion: btcjson.Int(2),
+			},
+		},
+		{
+			name: "getpcannpool",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("getpcannpool", 10)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewGetPcAnnPoolCmd(btcjson.Int(10))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"getpcannpool","params":[10],"id":1}`,
+			unmarshaled: &btcjson.GetPcAnnPoolCmd{
+				Count: btcjson.Int(10),
+			},
+		},
+		{
+			name: "listbanned",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("listbanned")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewListBannedCmd()
+			},
+			marshaled:   `{"jsonrpc":"1.0","method":"listbanned","params":[],"id":1}`,
+			unmarshaled: &btcjson.ListBannedCmd{},
+		},
 		{
 			name: "ping",
 			newCmd: func() (interface{}, er.R) {
@@ -743,6 +903,20 @@ func TestChainSvrCmds(t *testing.T) {
 				BlockHash: "0123",
 			},
 		},
+		{
+			name: "prioritisetransaction",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("prioritisetransaction", "0123", 0, 10000)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewPrioritiseTransactionCmd("0123", 0, 10000)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"prioritisetransaction","params":["0123",0,10000],"id":1}`,
+			unmarshaled: &btcjson.PrioritiseTransactionCmd{
+				Txid:     "0123",
+				FeeDelta: 10000,
+			},
+		},
 		{
 			name: "reconsiderblock",
 			newCmd: func() (interface{}, er.R) {
@@ -756,6 +930,17 @@ func TestChainSvrCmds(t *testing.T) {
 				BlockHash: "123",
 			},
 		},
+		{
+			name: "savemempool",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("savemempool")
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSaveMempoolCmd()
+			},
+			marshaled:   `{"jsonrpc":"1.0","method":"savemempool","params":[],"id":1}`,
+			unmarshaled: &btcjson.SaveMempoolCmd{},
+		},
 		{
 			name: "searchrawtransactions",
 			newCmd: func() (interface{}, er.R) {
@@ -923,6 +1108,39 @@ func TestChainSvrCmds(t *testing.T) {
 				AllowHighFees: btcjson.Bool(false),
 			},
 		},
+		{
+			name: "setban",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("setban", "10.0.0.0/8", btcjson.SBAdd)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetBanCmd("10.0.0.0/8", btcjson.SBAdd, nil, nil)
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"setban","params":["10.0.0.0/8","add"],"id":1}`,
+			unmarshaled: &btcjson.SetBanCmd{
+				Subnet:   "10.0.0.0/8",
+				SubCmd:   btcjson.SBAdd,
+				BanTime:  btcjson.Int64(0),
+				Absolute: btcjson.Bool(false),
+			},
+		},
+		{
+			name: "setban optional",
+			newCmd: func() (interface{}, er.R) {
+				return btcjson.NewCmd("setban", "1.2.3.4", btcjson.SBAdd, 1700000000, true)
+			},
+			staticCmd: func() interface{} {
+				return btcjson.NewSetBanCmd("1.2.3.4", btcjson.SBAdd,
+					btcjson.Int64(1700000000), btcjson.Bool(true))
+			},
+			marshaled: `{"jsonrpc":"1.0","method":"setban","params":["1.2.3.4","add",1700000000,true],"id":1}`,
+			unmarshaled: &btcjson.SetBanCmd{
+				Subnet:   "1.2.3.4",
+				SubCmd:   btcjson.SBAdd,
+				BanTime:  btcjson.Int64(1700000000),
+				Absolute: btcjson.Bool(true),
+			},
+		},
 		{
 			name: "setgenerate",
 			newCmd: func() (interface{}, er.R) {
@@ -85,6 +85,56 @@ type DecodeScriptResult struct {
 	Vote      *Vote    `json:"vote,omitempty"`
 }
 
+// GetAddressBalanceResult models the data returned from the
+// getaddressbalance command.  Amounts are in atomic units.
+type GetAddressBalanceResult struct {
+	Balance  int64 `json:"balance"`
+	Received int64 `json:"received"`
+}
+
+// AddressDeltaResult models a change to the balance of an address as
+// returned by the getaddressdeltas command.  The index is that of the input
+// for spends, which have a negative amount.
+type AddressDeltaResult struct {
+	Address    string `json:"address"`
+	TxID       string `json:"txid"`
+	Index      uint32 `json:"index"`
+	Satoshis   int64  `json:"satoshis"`
+	Height     int32  `json:"height"`
+	BlockIndex uint32 `json:"blockindex"`
+}
+
+// AddressMempoolResult models a change to the balance of an address made by
+// an unconfirmed transaction as returned by the getaddressmempool command.
+// The previous output is only set for spends.
+type AddressMempoolResult struct {
+	Address  string  `json:"address"`
+	TxID     string  `json:"txid"`
+	Index    uint32  `json:"index"`
+	Satoshis int64   `json:"satoshis"`
+	PrevTxID string  `json:"prevtxid,omitempty"`
+	PrevOut  *uint32 `json:"prevout,omitempty"`
+}
+
+// AddressUtxoResult models an unspent output as returned by the
+// getaddressutxos command.
+type AddressUtxoResult struct {
+	Address     string `json:"address"`
+	TxID        string `json:"txid"`
+	OutputIndex uint32 `json:"outputIndex"`
+	Script      string `json:"script"`
+	Satoshis    int64  `json:"satoshis"`
+	Height      int32  `json:"height"`
+}
+
+// GetSpentInfoResult models the data returned from the getspentinfo command.
+type GetSpentInfoResult struct {
+	TxID     string `json:"txid"`
+	Index    uint32 `json:"index"`
+	Height   int32  `json:"height"`
+	Satoshis int64  `json:"satoshis"`
+}
+
 // GetAddedNodeInfoResultAddr models the data of the addresses portion of the
 // getaddednodeinfo command.
 type GetAddedNodeInfoResultAddr struct {
@@ -119,6 +169,55 @@ type Bip9SoftForkDescription struct {
 	Since     int32  `json:"since"`
 }
 
+// GetBlockStatsResult models the data returned from the getblockstats
+// command.  Amounts are in atomic units and fee rates are in atomic units
+// per virtual byte.
+type GetBlockStatsResult struct {
+	AvgFee               int64   `json:"avgfee"`
+	AvgFeeRate           int64   `json:"avgfeerate"`
+	AvgTxSize            int64   `json:"avgtxsize"`
+	BlockHash            string  `json:"blockhash"`
+	FeeRatePercentiles   []int64 `json:"feerate_percentiles"`
+	Height               int64   `json:"height"`
+	Ins                  int64   `json:"ins"`
+	MaxFee               int64   `json:"maxfee"`
+	MaxFeeRate           int64   `json:"maxfeerate"`
+	MaxTxSize            int64   `json:"maxtxsize"`
+	MedianFee            int64   `json:"medianfee"`
+	MedianTxSize         int64   `json:"mediantxsize"`
+	MinFee               int64   `json:"minfee"`
+	MinFeeRate           int64   `json:"minfeerate"`
+	MinTxSize            int64   `json:"mintxsize"`
+	Outs                 int64   `json:"outs"`
+	Subsidy              int64   `json:"subsidy"`
+	SegWitTotalSize      int64   `json:"swtotal_size"`
+	SegWitTotalWeight    int64   `json:"swtotal_weight"`
+	SegWitTxs            int64   `json:"swtxs"`
+	Time                 int64   `json:"time"`
+	TotalOut             int64   `json:"total_out"`
+	TotalSize            int64   `json:"total_size"`
+	TotalWeight          int64   `json:"total_weight"`
+	TotalFee             int64   `json:"totalfee"`
+	Txs                  int64   `json:"txs"`
+	UTXOIncrease         int64   `json:"utxo_increase"`
+	NetworkStewardPayout int64   `json:"networkstewardpayout"`
+	PcAnnCount           uint64  `json:"pcanncount"`
+	PcProofSize          int64   `json:"pcproofsize"`
+}
+
+// GetChainTxStatsResult models the data returned from the getchaintxstats
+// command.
+type GetChainTxStatsResult struct {
+	Time                   int64   `json:"time"`
+	TxCount                *int64  `json:"txcount,omitempty"`
+	WindowFinalBlockHash   string  `json:"window_final_block_hash"`
+	WindowFinalBlockHeight int32   `json:"window_final_block_height"`
+	WindowBlockCount       int32   `json:"window_block_count"`
+	WindowTxCount          int64   `json:"window_tx_count"`
+	WindowInterval         int64   `json:"window_interval"`
+	TxRate                 float64 `json:"txrate"`
+}
+
 // GetBlockChainInfoResult models the data returned from the getblockchaininfo
 // command.
 type GetBlockChainInfoResult struct {
@@ -199,8 +298,11 @@ type GetBlockTemplateResult struct {
 // GetMempoolInfoResult models the data returned from the getmempoolinfo
 // command.
 type GetMempoolInfoResult struct {
-	Size  int64 `json:"size"`
-	Bytes int64 `json:"bytes"`
+	Size          int64   `json:"size"`
+	Bytes         int64   `json:"bytes"`
+	MaxMempool    int64   `json:"maxmempool"`
+	MempoolMinFee float64 `json:"mempoolminfee"`
+	MinRelayTxFee float64 `json:"minrelaytxfee"`
 }
 
 // GetNetworkStewardResult models the data returned from the getnetworksteward command.
@@ -210,6 +312,15 @@ type GetNetworkStewardResult struct {
 	TotalPossible int64  `json:"totalpossible"`
 }
 
+// ListBannedResult models the data returned for each entry of the listbanned
+// command.
+type ListBannedResult struct {
+	Address     string `json:"address"`
+	BannedUntil int64  `json:"banned_until"`
+	BanCreated  int64  `json:"ban_created"`
+	BanReason   string `json:"ban_reason"`
+}
+
 // GetPeerInfoResult models the data returned from the getpeerinfo command.
 type GetPeerInfoResult struct {
 	ID             int32   `json:"id"`
@@ -233,6 +344,7 @@ type GetPeerInfoResult struct {
 	BanScore       int32   `json:"banscore"`
 	FeeFilter      int64   `json:"feefilter"`
 	SyncNode       bool    `json:"syncnode"`
+	ConnectionType string  `json:"connectiontype"`
 }
 
 type GetNetworkInfoNetworks struct {
@@ -272,6 +384,7 @@ type GetRawMempoolVerboseResult struct {
 	Size             int32    `json:"size"`
 	Vsize            int32    `json:"vsize"`
 	Fee              float64  `json:"fee"`
+	ModifiedFee      float64  `json:"modifiedfee"`
 	Time             int64    `json:"time"`
 	Height           int64    `json:"height"`
 	StartingPriority float64  `json:"startingpriority"`
@@ -465,6 +578,7 @@ type Vout struct {
 	N          uint32  `json:"n"`
 	Address    string  `json:"address"`
 	Vote       *Vote   `json:"vote,omitempty"`
+	SpentTxID  string  `json:"spentTxId,omitempty"`
 }
 
 // GetMiningInfoResult models the data from the getmininginfo command.
@@ -528,8 +642,11 @@ type TxRawDecodeResult struct {
 // ValidateAddressChainResult models the data returned by the chain server
 // validateaddress command.
 type ValidateAddressChainResult struct {
-	IsValid bool   `json:"isvalid"`
-	Address string `json:"address,omitempty"`
+	IsValid        bool   `json:"isvalid"`
+	Address        string `json:"address,omitempty"`
+	IsWitness      bool   `json:"iswitness,omitempty"`
+	WitnessVersion *int32 `json:"witness_version,omitempty"`
+	WitnessProgram string `json:"witness_program,omitempty"`
 }
 
 // EstimateSmartFeeResult models the data returned buy the chain server
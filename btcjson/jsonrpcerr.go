@@ -37,7 +37,10 @@ var (
 // Peer-to-peer client errors.
 var (
 	ErrRPCClientInInitialDownload = Err.CodeWithNumber("ErrRPCClientInInitialDownload", -10)
+	ErrRPCClientNodeAlreadyAdded  = Err.CodeWithNumber("ErrRPCClientNodeAlreadyAdded", -23)
 	ErrRPCClientNodeNotAdded      = Err.CodeWithNumber("ErrRPCClientNodeNotAdded", -24)
+	ErrRPCClientNodeNotConnected  = Err.CodeWithNumber("ErrRPCClientNodeNotConnected", -29)
+	ErrRPCClientInvalidIPOrSubnet = Err.CodeWithNumber("ErrRPCClientInvalidIPOrSubnet", -30)
 )
 
 // Wallet JSON errors
@@ -19,6 +19,19 @@ type AddP2shScriptCmd struct {
 	Segwit bool
 }
 
+// AnalyzeMiniscriptCmd defines the analyzeminiscript JSON-RPC command.
+type AnalyzeMiniscriptCmd struct {
+	Miniscript string
+}
+
+// NewAnalyzeMiniscriptCmd returns a new instance which can be used to issue
+// an analyzeminiscript JSON-RPC command.
+func NewAnalyzeMiniscriptCmd(miniscript string) *AnalyzeMiniscriptCmd {
+	return &AnalyzeMiniscriptCmd{
+		Miniscript: miniscript,
+	}
+}
+
 // AddWitnessAddressCmd defines the addwitnessaddress JSON-RPC command.
 type AddWitnessAddressCmd struct {
 	Address string
@@ -68,6 +81,23 @@ type GetAddressBalancesCmd struct {
 
 type GetWalletSeedCmd struct{}
 
+// SplitWalletSeedCmd defines the splitwalletseed JSON-RPC command.
+type SplitWalletSeedCmd struct {
+	Threshold int
+	Count     int
+	Language  *string `jsonrpcdefault:"\"english\""`
+}
+
+// NewSplitWalletSeedCmd returns a new instance which can be used to issue a
+// splitwalletseed JSON-RPC command.
+func NewSplitWalletSeedCmd(threshold, count int, language *string) *SplitWalletSeedCmd {
+	return &SplitWalletSeedCmd{
+		Threshold: threshold,
+		Count:     count,
+		Language:  language,
+	}
+}
+
 type GetSecretCmd struct {
 	Name string
 }
@@ -81,6 +111,24 @@ type ResyncCmd struct {
 
 type StopResyncCmd struct{}
 
+// FoldAddressCmd defines the foldaddress JSON-RPC command.
+type FoldAddressCmd struct {
+	Address string
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// NewFoldAddressCmd returns a new instance which can be used to issue a
+// foldaddress JSON-RPC command.
+func NewFoldAddressCmd(address string, minConf *int) *FoldAddressCmd {
+	return &FoldAddressCmd{
+		Address: address,
+		MinConf: minConf,
+	}
+}
+
+// StopFoldCmd defines the stopfold JSON-RPC command.
+type StopFoldCmd struct{}
+
 // GetBalanceCmd defines the getbalance JSON-RPC command.
 type GetBalanceCmd struct {
 	MinConf *int `jsonrpcdefault:"1"`
@@ -129,6 +177,51 @@ func NewGetTransactionCmd(txHash string, includeWatchOnly *bool) *GetTransaction
 	}
 }
 
+// ImportDescriptorsRequest is a single descriptor to be imported by the
+// importdescriptors JSON-RPC command.
+type ImportDescriptorsRequest struct {
+	// Desc is the descriptor, with or without a checksum.
+	Desc string `json:"desc"`
+
+	// Range is [start,end] of the indexes to import for a ranged
+	// descriptor, it defaults to [0,999].
+	Range *[]uint32 `json:"range,omitempty"`
+
+	// FromHeight is the earliest block height where the scripts may have
+	// been used, if Rescan is true then the chain is rescanned from here.
+	FromHeight *int32 `json:"fromheight,omitempty"`
+
+	// Rescan causes the wallet to search the chain for transactions
+	// involving the imported scripts.
+	Rescan *bool `json:"rescan,omitempty"`
+}
+
+// ImportDescriptorsCmd defines the importdescriptors JSON-RPC command.
+type ImportDescriptorsCmd struct {
+	Requests []ImportDescriptorsRequest
+}
+
+// NewImportDescriptorsCmd returns a new instance which can be used to issue
+// an importdescriptors JSON-RPC command.
+func NewImportDescriptorsCmd(requests []ImportDescriptorsRequest) *ImportDescriptorsCmd {
+	return &ImportDescriptorsCmd{
+		Requests: requests,
+	}
+}
+
+// ImportMiniscriptCmd defines the importminiscript JSON-RPC command.
+type ImportMiniscriptCmd struct {
+	Miniscript string
+}
+
+// NewImportMiniscriptCmd returns a new instance which can be used to issue
+// an importminiscript JSON-RPC command.
+func NewImportMiniscriptCmd(miniscript string) *ImportMiniscriptCmd {
+	return &ImportMiniscriptCmd{
+		Miniscript: miniscript,
+	}
+}
+
 // ImportPrivKeyCmd defines the importprivkey JSON-RPC command.
 type ImportPrivKeyCmd struct {
 	PrivKey string
@@ -149,6 +242,15 @@ func NewImportPrivKeyCmd(privKey string, label *string, rescan *bool) *ImportPri
 	}
 }
 
+// ListDescriptorsCmd defines the listdescriptors JSON-RPC command.
+type ListDescriptorsCmd struct{}
+
+// NewListDescriptorsCmd returns a new instance which can be used to issue a
+// listdescriptors JSON-RPC command.
+func NewListDescriptorsCmd() *ListDescriptorsCmd {
+	return &ListDescriptorsCmd{}
+}
+
 // ListLockUnspentCmd defines the listlockunspent JSON-RPC command.
 type ListLockUnspentCmd struct{}
 
@@ -280,6 +382,9 @@ type CreateTransactionCmd struct {
 	Vote           *bool
 	MaxInputs      *int
 	AutoLock       *string
+	Inputs         *[]TransactionInput
+	CoinSelection  *string
+	AvoidMixing    *bool
 }
 
 // SendManyCmd defines the sendmany JSON-RPC command.
@@ -289,6 +394,9 @@ type SendManyCmd struct {
 	MinConf       *int `jsonrpcdefault:"1"`
 	Comment       *string
 	MaxInputs     *int
+	Inputs        *[]TransactionInput
+	CoinSelection *string
+	AvoidMixing   *bool
 }
 
 // NewSendManyCmd returns a new instance which can be used to issue a sendmany
@@ -440,11 +548,14 @@ func init() {
 	MustRegisterCmd("addmultisigaddress", (*AddMultisigAddressCmd)(nil), flags)
 	MustRegisterCmd("addp2shscript", (*AddP2shScriptCmd)(nil), flags)
 	MustRegisterCmd("addwitnessaddress", (*AddWitnessAddressCmd)(nil), flags)
+	MustRegisterCmd("analyzeminiscript", (*AnalyzeMiniscriptCmd)(nil), flags)
 	MustRegisterCmd("createmultisig", (*CreateMultisigCmd)(nil), flags)
 	MustRegisterCmd("createtransaction", (*CreateTransactionCmd)(nil), flags)
 	MustRegisterCmd("getaddressbalances", (*GetAddressBalancesCmd)(nil), flags)
 	MustRegisterCmd("resync", (*ResyncCmd)(nil), flags)
 	MustRegisterCmd("stopresync", (*StopResyncCmd)(nil), flags)
+	MustRegisterCmd("foldaddress", (*FoldAddressCmd)(nil), flags)
+	MustRegisterCmd("stopfold", (*StopFoldCmd)(nil), flags)
 	MustRegisterCmd("dumpprivkey", (*DumpPrivKeyCmd)(nil), flags)
 	MustRegisterCmd("getbalance", (*GetBalanceCmd)(nil), flags)
 	MustRegisterCmd("getnetworkstewardvote", (*GetNetworkStewardVoteCmd)(nil), flags)
@@ -453,7 +564,11 @@ func init() {
 	MustRegisterCmd("gettransaction", (*GetTransactionCmd)(nil), flags)
 	MustRegisterCmd("getwalletseed", (*GetWalletSeedCmd)(nil), flags)
 	MustRegisterCmd("getsecret", (*GetSecretCmd)(nil), flags)
+	MustRegisterCmd("splitwalletseed", (*SplitWalletSeedCmd)(nil), flags)
+	MustRegisterCmd("importdescriptors", (*ImportDescriptorsCmd)(nil), flags)
+	MustRegisterCmd("importminiscript", (*ImportMiniscriptCmd)(nil), flags)
 	MustRegisterCmd("importprivkey", (*ImportPrivKeyCmd)(nil), flags)
+	MustRegisterCmd("listdescriptors", (*ListDescriptorsCmd)(nil), flags)
 	MustRegisterCmd("listlockunspent", (*ListLockUnspentCmd)(nil), flags)
 	MustRegisterCmd("listreceivedbyaddress", (*ListReceivedByAddressCmd)(nil), flags)
 	MustRegisterCmd("listsinceblock", (*ListSinceBlockCmd)(nil), flags)
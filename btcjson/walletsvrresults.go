@@ -90,6 +90,15 @@ type WalletStats struct {
 	MaintenanceLastBlockVisited int
 	TimeOfLastMaintenance       time.Time
 
+	// If we're currently folding an address, see foldaddress
+	FoldInProgress   bool
+	FoldName         string
+	FoldAddress      string
+	FoldStatus       string
+	FoldTransactions int
+	FoldInputs       int64
+	FoldAmount       float64
+
 	// If we're currently in a resync
 	Syncing              bool
 	SyncStarted          *time.Time
@@ -208,6 +217,10 @@ type ValidateAddressWalletResult struct {
 	Hex          string   `json:"hex,omitempty"`
 	Script       string   `json:"script,omitempty"`
 	SigsRequired int32    `json:"sigsrequired,omitempty"`
+
+	IsWitness      bool   `json:"iswitness,omitempty"`
+	WitnessVersion *int32 `json:"witness_version,omitempty"`
+	WitnessProgram string `json:"witness_program,omitempty"`
 }
 
 // GetBestBlockResult models the data from the getbestblock command.
@@ -243,6 +256,51 @@ type GetAddressBalancesResult struct {
 	OutputCount int32 `json:"outputcount"`
 }
 
+// MiniscriptResult models the data from the analyzeminiscript and
+// importminiscript commands.
+type MiniscriptResult struct {
+	Miniscript          string `json:"miniscript"`
+	Address             string `json:"address"`
+	WitnessScript       string `json:"witnessscript"`
+	Type                string `json:"type"`
+	Sane                bool   `json:"sane"`
+	InsaneReason        string `json:"insanereason,omitempty"`
+	NonMalleable        bool   `json:"nonmalleable"`
+	NeedsSignature      bool   `json:"needssignature"`
+	TimelockMix         bool   `json:"timelockmix"`
+	ScriptSize          int    `json:"scriptsize"`
+	OpCount             int    `json:"opcount"`
+	MaxSatisfactionSize int    `json:"maxsatisfactionsize"`
+	MaxStackItems       int    `json:"maxstackitems"`
+}
+
+// ImportDescriptorsResult models the result of importing one descriptor
+// with the importdescriptors command.
+type ImportDescriptorsResult struct {
+	Success   bool     `json:"success"`
+	Addresses []string `json:"addresses,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// DescriptorInfo models a single descriptor in the listdescriptors result.
+type DescriptorInfo struct {
+	Desc      string   `json:"desc"`
+	Timestamp int64    `json:"timestamp"`
+	Range     []uint32 `json:"range,omitempty"`
+}
+
+// ListDescriptorsResult models the data from the listdescriptors command.
+type ListDescriptorsResult struct {
+	Descriptors []DescriptorInfo `json:"descriptors"`
+}
+
+// SplitWalletSeedResult models the data from the splitwalletseed command.
+type SplitWalletSeedResult struct {
+	GroupID   string   `json:"groupid"`
+	Threshold int      `json:"threshold"`
+	Shares    []string `json:"shares"`
+}
+
 type MaintenanceStats struct {
 	// Burned           int
 	// Orphaned         int
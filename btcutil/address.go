@@ -27,7 +27,7 @@ import (
 type UnsupportedWitnessVerError byte
 
 func (e UnsupportedWitnessVerError) Error() string {
-	return "unsupported witness version: " + string(e)
+	return "unsupported witness version: " + strconv.Itoa(int(e))
 }
 
 // UnsupportedWitnessProgLenError describes an error where a segwit address
@@ -79,11 +79,17 @@ func encodeSegWitAddress(hrp string, witnessVersion byte, witnessProgram []byte)
 	}
 
 	// Concatenate the witness version and program, and encode the resulting
-	// bytes using bech32 encoding.
+	// bytes using bech32 encoding for version 0 and bech32m for version 1
+	// and higher, see BIP 350.
 	combined := make([]byte, len(converted)+1)
 	combined[0] = witnessVersion
 	copy(combined[1:], converted)
-	bech, err := bech32.Encode(hrp, combined)
+	var bech string
+	if witnessVersion == 0 {
+		bech, err = bech32.Encode(hrp, combined)
+	} else {
+		bech, err = bech32.EncodeM(hrp, combined)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -101,6 +107,19 @@ func encodeSegWitAddress(hrp string, witnessVersion byte, witnessProgram []byte)
 	return bech, nil
 }
 
+// WitnessAddress is an Address which pays to a segwit witness program, it is
+// implemented by AddressWitnessPubKeyHash, AddressWitnessScriptHash,
+// AddressTaproot and AddressSegWit.
+type WitnessAddress interface {
+	Address
+
+	// WitnessVersion returns the witness version of the address.
+	WitnessVersion() byte
+
+	// WitnessProgram returns the witness program of the address.
+	WitnessProgram() []byte
+}
+
 // Address is an interface type for any type of destination a transaction
 // output may spend to.  This includes pay-to-pubkey (P2PK), pay-to-pubkey-hash
 // (P2PKH), and pay-to-script-hash (P2SH).  Address is designed to be generic
@@ -165,15 +184,18 @@ func DecodeAddress(addr string, defaultNet *chaincfg.Params) (Address, er.R) {
 				return nil, err
 			}
 
-			// We currently only support P2WPKH and P2WSH, which is
-			// witness version 0.
-			if witnessVer != 0 {
-				return nil, er.E(UnsupportedWitnessVerError(witnessVer))
-			}
-
 			// The HRP is everything before the found '1'.
 			hrp := prefix[:len(prefix)-1]
 
+			// Version 0 programs are P2WPKH or P2WSH and a version 1
+			// program of 32 bytes is P2TR, all other programs are
+			// for future soft forks.
+			if witnessVer == 1 && len(witnessProg) == 32 {
+				return newAddressTaproot(hrp, witnessProg)
+			} else if witnessVer != 0 {
+				return newAddressSegWit(hrp, witnessVer, witnessProg)
+			}
+
 			switch len(witnessProg) {
 			case 20:
 				return newAddressWitnessPubKeyHash(hrp, witnessProg)
@@ -226,8 +248,8 @@ func DecodeAddress(addr string, defaultNet *chaincfg.Params) (Address, er.R) {
 // decodeSegWitAddress parses a bech32 encoded segwit address string and
 // returns the witness version and witness program byte representation.
 func decodeSegWitAddress(address string) (byte, []byte, er.R) {
-	// Decode the bech32 encoded address.
-	_, data, err := bech32.Decode(address)
+	// Decode the bech32 or bech32m encoded address.
+	_, data, bechVersion, err := bech32.DecodeGeneric(address)
 	if err != nil {
 		return 0, nil, err
 	}
@@ -263,6 +285,16 @@ func decodeSegWitAddress(address string) (byte, []byte, er.R) {
 			"version 0: %v", len(regrouped))
 	}
 
+	// Version 0 addresses MUST use bech32 and all other versions MUST use
+	// bech32m, see BIP 350.
+	if version == 0 && bechVersion != bech32.Version0 {
+		return 0, nil, er.Errorf("witness version 0 address must " +
+			"use bech32 encoding")
+	} else if version != 0 && bechVersion != bech32.VersionM {
+		return 0, nil, er.Errorf("witness version %d address must "+
+			"use bech32m encoding", version)
+	}
+
 	return version, regrouped, nil
 }
 
@@ -687,6 +719,169 @@ func (a *AddressWitnessScriptHash) WitnessProgram() []byte {
 	return a.witnessProgram[:]
 }
 
+// AddressTaproot is an Address for a pay-to-taproot (P2TR) output, the
+// witness program is the 32 byte x-only output key.  See BIP 341 and BIP 350
+// for further details regarding taproot outputs and their address encoding.
+type AddressTaproot struct {
+	hrp            string
+	witnessProgram [32]byte
+}
+
+// NewAddressTaproot returns a new AddressTaproot.
+func NewAddressTaproot(witnessProg []byte, net *chaincfg.Params) (*AddressTaproot, er.R) {
+	return newAddressTaproot(net.Bech32HRPSegwit, witnessProg)
+}
+
+// newAddressTaproot is an internal helper function to create an
+// AddressTaproot with a known human-readable part, rather than looking it up
+// through its parameters.
+func newAddressTaproot(hrp string, witnessProg []byte) (*AddressTaproot, er.R) {
+	if len(witnessProg) != 32 {
+		return nil, er.New("witness program must be 32 " +
+			"bytes for p2tr")
+	}
+
+	addr := &AddressTaproot{
+		hrp: strings.ToLower(hrp),
+	}
+
+	copy(addr.witnessProgram[:], witnessProg)
+
+	return addr, nil
+}
+
+// EncodeAddress returns the bech32m string encoding of an AddressTaproot.
+// Part of the Address interface.
+func (a *AddressTaproot) EncodeAddress() string {
+	str, err := encodeSegWitAddress(a.hrp, a.WitnessVersion(),
+		a.witnessProgram[:])
+	if err != nil {
+		return ""
+	}
+	return str
+}
+
+// ScriptAddress returns the witness program for this address.
+// Part of the Address interface.
+func (a *AddressTaproot) ScriptAddress() []byte {
+	return a.witnessProgram[:]
+}
+
+// IsForNet returns whether or not the AddressTaproot is associated with the
+// passed bitcoin network.
+// Part of the Address interface.
+func (a *AddressTaproot) IsForNet(net *chaincfg.Params) bool {
+	return a.hrp == net.Bech32HRPSegwit
+}
+
+// String returns a human-readable string for the AddressTaproot.
+// This is equivalent to calling EncodeAddress, but is provided so the type
+// can be used as a fmt.Stringer.
+// Part of the Address interface.
+func (a *AddressTaproot) String() string {
+	return a.EncodeAddress()
+}
+
+// Hrp returns the human-readable part of the bech32m encoded AddressTaproot.
+func (a *AddressTaproot) Hrp() string {
+	return a.hrp
+}
+
+// WitnessVersion returns the witness version of the AddressTaproot, which is
+// always 1.
+func (a *AddressTaproot) WitnessVersion() byte {
+	return 0x01
+}
+
+// WitnessProgram returns the witness program of the AddressTaproot.
+func (a *AddressTaproot) WitnessProgram() []byte {
+	return a.witnessProgram[:]
+}
+
+// AddressSegWit is an Address for a witness program of version 1 to 16 which
+// is not otherwise known, such programs are reserved for future soft forks.
+// These addresses are bech32m encoded, see BIP 350.
+type AddressSegWit struct {
+	hrp            string
+	witnessVersion byte
+	witnessProgram []byte
+}
+
+// NewAddressSegWit returns a new AddressSegWit.  Version 0 programs must use
+// AddressWitnessPubKeyHash or AddressWitnessScriptHash instead.
+func NewAddressSegWit(witnessVersion byte, witnessProg []byte,
+	net *chaincfg.Params) (*AddressSegWit, er.R) {
+
+	return newAddressSegWit(net.Bech32HRPSegwit, witnessVersion, witnessProg)
+}
+
+// newAddressSegWit is an internal helper function to create an AddressSegWit
+// with a known human-readable part, rather than looking it up through its
+// parameters.
+func newAddressSegWit(hrp string, witnessVersion byte,
+	witnessProg []byte) (*AddressSegWit, er.R) {
+
+	if witnessVersion < 1 || witnessVersion > 16 {
+		return nil, er.E(UnsupportedWitnessVerError(witnessVersion))
+	}
+	if len(witnessProg) < 2 || len(witnessProg) > 40 {
+		return nil, er.E(UnsupportedWitnessProgLenError(len(witnessProg)))
+	}
+
+	return &AddressSegWit{
+		hrp:            strings.ToLower(hrp),
+		witnessVersion: witnessVersion,
+		witnessProgram: util.CloneBytes(witnessProg),
+	}, nil
+}
+
+// EncodeAddress returns the bech32m string encoding of an AddressSegWit.
+// Part of the Address interface.
+func (a *AddressSegWit) EncodeAddress() string {
+	str, err := encodeSegWitAddress(a.hrp, a.witnessVersion,
+		a.witnessProgram)
+	if err != nil {
+		return ""
+	}
+	return str
+}
+
+// ScriptAddress returns the witness program for this address.
+// Part of the Address interface.
+func (a *AddressSegWit) ScriptAddress() []byte {
+	return a.witnessProgram
+}
+
+// IsForNet returns whether or not the AddressSegWit is associated with the
+// passed bitcoin network.
+// Part of the Address interface.
+func (a *AddressSegWit) IsForNet(net *chaincfg.Params) bool {
+	return a.hrp == net.Bech32HRPSegwit
+}
+
+// String returns a human-readable string for the AddressSegWit.
+// This is equivalent to calling EncodeAddress, but is provided so the type
+// can be used as a fmt.Stringer.
+// Part of the Address interface.
+func (a *AddressSegWit) String() string {
+	return a.EncodeAddress()
+}
+
+// Hrp returns the human-readable part of the bech32m encoded AddressSegWit.
+func (a *AddressSegWit) Hrp() string {
+	return a.hrp
+}
+
+// WitnessVersion returns the witness version of the AddressSegWit.
+func (a *AddressSegWit) WitnessVersion() byte {
+	return a.witnessVersion
+}
+
+// WitnessProgram returns the witness program of the AddressSegWit.
+func (a *AddressSegWit) WitnessProgram() []byte {
+	return a.witnessProgram
+}
+
 // AddressNonStandard is an Address representation of a script of any type.
 // It it textually represented as "script:" followed by a base64 representation
 // of the pkScript itself.
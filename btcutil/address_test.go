@@ -676,21 +676,125 @@ func TestAddresses(t *testing.T) {
 			},
 			net: &chaincfg.TestNet3Params,
 		},
-		// Unsupported witness versions (version 0 only supported at this point)
 		{
-			name:  "segwit mainnet witness v1",
+			name:    "segwit mainnet p2tr v1",
+			addr:    "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
+			encoded: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
+			valid:   true,
+			result: btcutil.TstAddressTaproot(
+				[32]byte{
+					0x79, 0xbe, 0x66, 0x7e, 0xf9, 0xdc, 0xbb, 0xac,
+					0x55, 0xa0, 0x62, 0x95, 0xce, 0x87, 0x0b, 0x07,
+					0x02, 0x9b, 0xfc, 0xdb, 0x2d, 0xce, 0x28, 0xd9,
+					0x59, 0xf2, 0x81, 0x5b, 0x16, 0xf8, 0x17, 0x98,
+				},
+				chaincfg.MainNetParams.Bech32HRPSegwit),
+			f: func() (btcutil.Address, er.R) {
+				outputKey := []byte{
+					0x79, 0xbe, 0x66, 0x7e, 0xf9, 0xdc, 0xbb, 0xac,
+					0x55, 0xa0, 0x62, 0x95, 0xce, 0x87, 0x0b, 0x07,
+					0x02, 0x9b, 0xfc, 0xdb, 0x2d, 0xce, 0x28, 0xd9,
+					0x59, 0xf2, 0x81, 0x5b, 0x16, 0xf8, 0x17, 0x98,
+				}
+				return btcutil.NewAddressTaproot(outputKey, &chaincfg.MainNetParams)
+			},
+			net: &chaincfg.MainNetParams,
+		},
+		{
+			name:  "segwit mainnet p2tr v1 with bech32 checksum",
+			addr:  "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd",
+			valid: false,
+			net:   &chaincfg.MainNetParams,
+		},
+		{
+			name:  "segwit mainnet p2wpkh v0 with bech32m checksum",
+			addr:  "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh",
+			valid: false,
+			net:   &chaincfg.MainNetParams,
+		},
+		// Witness versions which are reserved for future soft forks, they
+		// are bech32m encoded.
+		{
+			name:    "segwit mainnet witness v1 40 byte program",
+			addr:    "bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y",
+			encoded: "bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7kt5nd6y",
+			valid:   true,
+			result: btcutil.TstAddressSegWit(
+				1,
+				[]byte{
+					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4,
+					0x54, 0x94, 0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23,
+					0xf1, 0x43, 0x3b, 0xd6, 0x75, 0x1e, 0x76, 0xe8,
+					0x19, 0x91, 0x96, 0xd4, 0x54, 0x94, 0x1c, 0x45,
+					0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6,
+				},
+				chaincfg.MainNetParams.Bech32HRPSegwit),
+			f: func() (btcutil.Address, er.R) {
+				program := []byte{
+					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4,
+					0x54, 0x94, 0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23,
+					0xf1, 0x43, 0x3b, 0xd6, 0x75, 0x1e, 0x76, 0xe8,
+					0x19, 0x91, 0x96, 0xd4, 0x54, 0x94, 0x1c, 0x45,
+					0xd1, 0xb3, 0xa3, 0x23, 0xf1, 0x43, 0x3b, 0xd6,
+				}
+				return btcutil.NewAddressSegWit(1, program, &chaincfg.MainNetParams)
+			},
+			net: &chaincfg.MainNetParams,
+		},
+		{
+			name:    "segwit mainnet witness v16",
+			addr:    "BC1SW50QGDZ25J",
+			encoded: "bc1sw50qgdz25j",
+			valid:   true,
+			result: btcutil.TstAddressSegWit(
+				16,
+				[]byte{
+					0x75, 0x1e,
+				},
+				chaincfg.MainNetParams.Bech32HRPSegwit),
+			f: func() (btcutil.Address, er.R) {
+				program := []byte{
+					0x75, 0x1e,
+				}
+				return btcutil.NewAddressSegWit(16, program, &chaincfg.MainNetParams)
+			},
+			net: &chaincfg.MainNetParams,
+		},
+		{
+			name:    "segwit mainnet witness v2",
+			addr:    "bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs",
+			encoded: "bc1zw508d6qejxtdg4y5r3zarvaryvaxxpcs",
+			valid:   true,
+			result: btcutil.TstAddressSegWit(
+				2,
+				[]byte{
+					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4,
+					0x54, 0x94, 0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23,
+				},
+				chaincfg.MainNetParams.Bech32HRPSegwit),
+			f: func() (btcutil.Address, er.R) {
+				program := []byte{
+					0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96, 0xd4,
+					0x54, 0x94, 0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23,
+				}
+				return btcutil.NewAddressSegWit(2, program, &chaincfg.MainNetParams)
+			},
+			net: &chaincfg.MainNetParams,
+		},
+		{
+			name:  "segwit mainnet witness v1 with bech32 checksum",
 			addr:  "bc1pw508d6qejxtdg4y5r3zarvary0c5xw7kw508d6qejxtdg4y5r3zarvary0c5xw7k7grplx",
 			valid: false,
 			net:   &chaincfg.MainNetParams,
 		},
 		{
-			name:  "segwit mainnet witness v16",
+			name:  "segwit mainnet witness v16 with bech32 checksum",
 			addr:  "BC1SW50QA3JX3S",
 			valid: false,
 			net:   &chaincfg.MainNetParams,
 		},
 		{
-			name:  "segwit mainnet witness v2",
+			name:  "segwit mainnet witness v2 with bech32 checksum",
 			addr:  "bc1zw508d6qejxtdg4y5r3zarvaryvg6kdaj",
 			valid: false,
 			net:   &chaincfg.MainNetParams,
@@ -805,6 +909,10 @@ func TestAddresses(t *testing.T) {
 				saddr = btcutil.TstAddressSegwitSAddr(encoded)
 			case *btcutil.AddressWitnessScriptHash:
 				saddr = btcutil.TstAddressSegwitSAddr(encoded)
+			case *btcutil.AddressTaproot:
+				saddr = btcutil.TstAddressSegwitSAddr(encoded)
+			case *btcutil.AddressSegWit:
+				saddr = btcutil.TstAddressSegwitSAddr(encoded)
 			}
 
 			// Check script address, as well as the Hash160 method for P2PKH and
@@ -863,6 +971,45 @@ func TestAddresses(t *testing.T) {
 					return
 				}
 
+				if p := a.WitnessProgram(); !bytes.Equal(saddr, p) {
+					t.Errorf("%v: witness programs do not match:\n%x != \n%x",
+						test.name, saddr, p)
+					return
+				}
+
+			case *btcutil.AddressSegWit:
+				if hrp := a.Hrp(); test.net.Bech32HRPSegwit != hrp {
+					t.Errorf("%v: hrps do not match:\n%x != \n%x",
+						test.name, test.net.Bech32HRPSegwit, hrp)
+					return
+				}
+
+				expVer := test.result.(*btcutil.AddressSegWit).WitnessVersion()
+				if v := a.WitnessVersion(); v != expVer {
+					t.Errorf("%v: witness versions do not match:\n%x != \n%x",
+						test.name, expVer, v)
+					return
+				}
+
+				if p := a.WitnessProgram(); !bytes.Equal(saddr, p) {
+					t.Errorf("%v: witness programs do not match:\n%x != \n%x",
+						test.name, saddr, p)
+					return
+				}
+
+			case *btcutil.AddressTaproot:
+				if hrp := a.Hrp(); test.net.Bech32HRPSegwit != hrp {
+					t.Errorf("%v: hrps do not match:\n%x != \n%x",
+						test.name, test.net.Bech32HRPSegwit, hrp)
+					return
+				}
+
+				if v := a.WitnessVersion(); v != 1 {
+					t.Errorf("%v: witness version %d, expected 1",
+						test.name, v)
+					return
+				}
+
 				if p := a.WitnessProgram(); !bytes.Equal(saddr, p) {
 					t.Errorf("%v: witness programs do not match:\n%x != \n%x",
 						test.name, saddr, p)
@@ -15,20 +15,58 @@ const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
 
 var gen = []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
 
+// Version is the checksum variant of a bech32 string.
+type Version uint8
+
+const (
+	// Version0 is the original bech32 checksum which is defined in
+	// BIP 173 and is used for segwit version 0 addresses.
+	Version0 Version = iota
+
+	// VersionM is the bech32m checksum which is defined in BIP 350 and is
+	// used for segwit version 1 and higher addresses.
+	VersionM
+)
+
+// checksumConst returns the constant which is xored into the checksum of the
+// version.
+func (v Version) checksumConst() int {
+	if v == VersionM {
+		return 0x2bc830a3
+	}
+	return 1
+}
+
 // Decode decodes a bech32 encoded string, returning the human-readable
-// part and the data part excluding the checksum.
+// part and the data part excluding the checksum.  Strings with a bech32m
+// checksum are rejected, see DecodeGeneric.
 func Decode(bech string) (string, []byte, er.R) {
+	hrp, data, version, err := DecodeGeneric(bech)
+	if err != nil {
+		return "", nil, err
+	}
+	if version != Version0 {
+		return "", nil, er.Errorf("checksum failed. String has a " +
+			"bech32m checksum, expected bech32")
+	}
+	return hrp, data, nil
+}
+
+// DecodeGeneric decodes a string with either a bech32 or a bech32m checksum,
+// returning the human-readable part, the data part excluding the checksum and
+// which checksum was used.
+func DecodeGeneric(bech string) (string, []byte, Version, er.R) {
 	// The maximum allowed length for a bech32 string is 90. It must also
 	// be at least 8 characters, since it needs a non-empty HRP, a
 	// separator, and a 6 character checksum.
 	if len(bech) < 8 || len(bech) > 90 {
-		return "", nil, er.Errorf("invalid bech32 string length %d",
+		return "", nil, 0, er.Errorf("invalid bech32 string length %d",
 			len(bech))
 	}
 	// Only	ASCII characters between 33 and 126 are allowed.
 	for i := 0; i < len(bech); i++ {
 		if bech[i] < 33 || bech[i] > 126 {
-			return "", nil, er.Errorf("invalid character in "+
+			return "", nil, 0, er.Errorf("invalid character in "+
 				"string: '%c'", bech[i])
 		}
 	}
@@ -37,7 +75,7 @@ func Decode(bech string) (string, []byte, er.R) {
 	lower := strings.ToLower(bech)
 	upper := strings.ToUpper(bech)
 	if bech != lower && bech != upper {
-		return "", nil, er.Errorf("string not all lowercase or all " +
+		return "", nil, 0, er.Errorf("string not all lowercase or all " +
 			"uppercase")
 	}
 
@@ -50,7 +88,7 @@ func Decode(bech string) (string, []byte, er.R) {
 	// or if the string is more than 90 characters in total.
 	one := strings.LastIndexByte(bech, '1')
 	if one < 1 || one+7 > len(bech) {
-		return "", nil, er.Errorf("invalid index of 1")
+		return "", nil, 0, er.Errorf("invalid index of 1")
 	}
 
 	// The human-readable part is everything before the last '1'.
@@ -61,32 +99,45 @@ func Decode(bech string) (string, []byte, er.R) {
 	// 'charset'.
 	decoded, err := toBytes(data)
 	if err != nil {
-		return "", nil, er.Errorf("failed converting data to bytes: "+
+		return "", nil, 0, er.Errorf("failed converting data to bytes: "+
 			"%v", err)
 	}
 
-	if !bech32VerifyChecksum(hrp, decoded) {
+	version, ok := bech32VerifyChecksum(hrp, decoded)
+	if !ok {
 		moreInfo := ""
 		checksum := bech[len(bech)-6:]
 		expected, err := toChars(bech32Checksum(hrp,
-			decoded[:len(decoded)-6]))
+			decoded[:len(decoded)-6], Version0))
 		if err == nil {
 			moreInfo = fmt.Sprintf("Expected %v, got %v.",
 				expected, checksum)
 		}
-		return "", nil, er.Errorf("checksum failed. " + moreInfo)
+		return "", nil, 0, er.Errorf("checksum failed. " + moreInfo)
 	}
 
 	// We exclude the last 6 bytes, which is the checksum.
-	return hrp, decoded[:len(decoded)-6], nil
+	return hrp, decoded[:len(decoded)-6], version, nil
 }
 
 // Encode encodes a byte slice into a bech32 string with the
 // human-readable part hrb. Note that the bytes must each encode 5 bits
 // (base32).
 func Encode(hrp string, data []byte) (string, er.R) {
+	return encode(hrp, data, Version0)
+}
+
+// EncodeM encodes a byte slice into a string with a bech32m checksum, as
+// defined in BIP 350.
+func EncodeM(hrp string, data []byte) (string, er.R) {
+	return encode(hrp, data, VersionM)
+}
+
+// encode encodes a byte slice into a string with the checksum of the given
+// version.
+func encode(hrp string, data []byte, version Version) (string, er.R) {
 	// Calculate the checksum of the data and append it at the end.
-	checksum := bech32Checksum(hrp, data)
+	checksum := bech32Checksum(hrp, data, version)
 	combined := append(data, checksum...)
 
 	// The resulting bech32 string is the concatenation of the hrp, the
@@ -204,8 +255,9 @@ func ConvertBits(data []byte, fromBits, toBits uint8, pad bool) ([]byte, er.R) {
 	return regrouped, nil
 }
 
-// For more details on the checksum calculation, please refer to BIP 173.
-func bech32Checksum(hrp string, data []byte) []byte {
+// For more details on the checksum calculation, please refer to BIP 173 and
+// BIP 350.
+func bech32Checksum(hrp string, data []byte, version Version) []byte {
 	// Convert the bytes to list of integers, as this is needed for the
 	// checksum calculation.
 	integers := make([]int, len(data))
@@ -214,7 +266,7 @@ func bech32Checksum(hrp string, data []byte) []byte {
 	}
 	values := append(bech32HrpExpand(hrp), integers...)
 	values = append(values, []int{0, 0, 0, 0, 0, 0}...)
-	polymod := bech32Polymod(values) ^ 1
+	polymod := bech32Polymod(values) ^ version.checksumConst()
 	var res []byte
 	for i := 0; i < 6; i++ {
 		res = append(res, byte((polymod>>uint(5*(5-i)))&31))
@@ -250,12 +302,19 @@ func bech32HrpExpand(hrp string) []int {
 	return v
 }
 
-// For more details on the checksum verification, please refer to BIP 173.
-func bech32VerifyChecksum(hrp string, data []byte) bool {
+// For more details on the checksum verification, please refer to BIP 173 and
+// BIP 350.  It returns which checksum the data has and false if it has neither.
+func bech32VerifyChecksum(hrp string, data []byte) (Version, bool) {
 	integers := make([]int, len(data))
 	for i, b := range data {
 		integers[i] = int(b)
 	}
 	concat := append(bech32HrpExpand(hrp), integers...)
-	return bech32Polymod(concat) == 1
+	switch bech32Polymod(concat) {
+	case Version0.checksumConst():
+		return Version0, true
+	case VersionM.checksumConst():
+		return VersionM, true
+	}
+	return 0, false
 }
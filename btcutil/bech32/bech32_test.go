@@ -36,7 +36,7 @@ func TestBech32(t *testing.T) {
 		if !test.valid {
 			// Invalid string decoding should result in error.
 			if err == nil {
-				t.Error("expected decoding to fail for "+
+				t.Errorf("expected decoding to fail for "+
 					"invalid string %v", test.str)
 			}
 			continue
@@ -67,3 +67,61 @@ func TestBech32(t *testing.T) {
 		}
 	}
 }
+
+// TestBech32M ensures that the bech32m test vectors from BIP 350 are decoded
+// as bech32m, encode back to the same string and are rejected by Decode.
+func TestBech32M(t *testing.T) {
+	tests := []struct {
+		str   string
+		valid bool
+	}{
+		{"A1LQFN3A", true},
+		{"a1lqfn3a", true},
+		{"an83characterlonghumanreadablepartthatcontainsthetheexcludedcharactersbioandnumber11sg7hg6", true},
+		{"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", true},
+		{"11llllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllllludsr8", false}, // too long
+		{"split1checkupstagehandshakeupstreamerranterredcaperredlc445v", true},
+		{"?1v759aa", true},
+		{"A12UEL5L", false},  // bech32 checksum
+		{"M1VUXWEZ", false},  // invalid checksum
+		{"16plkw9", false},   // empty hrp
+		{"1p2gdwpf", false},  // empty hrp
+		{"in1muywd", false},  // too short checksum
+		{"mm1crxm3i", false}, // invalid character (i) in checksum
+		{"au1s5cgom", false}, // invalid character (o) in checksum
+	}
+
+	for _, test := range tests {
+		str := test.str
+		hrp, decoded, version, err := bech32.DecodeGeneric(str)
+		if !test.valid {
+			if err == nil && version == bech32.VersionM {
+				t.Errorf("expected decoding to fail for "+
+					"invalid string %v", test.str)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("expected string to be valid bech32m: %v", err)
+			continue
+		}
+		if version != bech32.VersionM {
+			t.Errorf("%v: expected a bech32m checksum", str)
+		}
+
+		encoded, err := bech32.EncodeM(hrp, decoded)
+		if err != nil {
+			t.Errorf("encoding failed: %v", err)
+		}
+		if encoded != strings.ToLower(str) {
+			t.Errorf("expected data to encode to %v, but got %v",
+				str, encoded)
+		}
+
+		// Decode only accepts the original bech32 checksum.
+		if _, _, err := bech32.Decode(str); err == nil {
+			t.Errorf("%v: expected Decode to reject bech32m", str)
+		}
+	}
+}
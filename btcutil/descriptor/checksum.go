@@ -0,0 +1,116 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// inputCharset is the set of characters which may appear in a descriptor,
+// ordered so that the characters which are most likely to be confused with
+// one another fall in the same group of 32.
+const inputCharset = "0123456789()[],'/*abcdefgh@:$%{}" +
+	"IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~" +
+	"ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+
+// checksumCharset is the bech32 character set, used to render the checksum.
+const checksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// checksumLen is the number of characters in a descriptor checksum.
+const checksumLen = 8
+
+// polyMod is the BCH code generator step over GF(32) used by the descriptor
+// checksum.
+func polyMod(c uint64, val int) uint64 {
+	c0 := c >> 35
+	c = ((c & 0x7ffffffff) << 5) ^ uint64(val)
+	if c0&1 != 0 {
+		c ^= 0xf5dee51989
+	}
+	if c0&2 != 0 {
+		c ^= 0xa9fdca3312
+	}
+	if c0&4 != 0 {
+		c ^= 0x1bab10e32d
+	}
+	if c0&8 != 0 {
+		c ^= 0x3706b1677a
+	}
+	if c0&16 != 0 {
+		c ^= 0x644d626ffd
+	}
+	return c
+}
+
+// Checksum computes the 8 character checksum of a descriptor string which
+// does not already carry one.
+func Checksum(desc string) (string, bool) {
+	c := uint64(1)
+	cls := 0
+	clsCount := 0
+	for _, ch := range desc {
+		pos := strings.IndexRune(inputCharset, ch)
+		if pos < 0 {
+			return "", false
+		}
+		c = polyMod(c, pos&31)
+		cls = cls*3 + (pos >> 5)
+		clsCount++
+		if clsCount == 3 {
+			c = polyMod(c, cls)
+			cls = 0
+			clsCount = 0
+		}
+	}
+	if clsCount > 0 {
+		c = polyMod(c, cls)
+	}
+	for j := 0; j < checksumLen; j++ {
+		c = polyMod(c, 0)
+	}
+	c ^= 1
+
+	var out [checksumLen]byte
+	for j := 0; j < checksumLen; j++ {
+		out[j] = checksumCharset[(c>>(5*(7-uint(j))))&31]
+	}
+	return string(out[:]), true
+}
+
+// AddChecksum returns the descriptor with a '#' and its checksum appended.
+func AddChecksum(desc string) string {
+	sum, ok := Checksum(desc)
+	if !ok {
+		return desc
+	}
+	return desc + "#" + sum
+}
+
+// splitChecksum separates a descriptor from its checksum, verifying the
+// checksum if there is one.  If requireChecksum is true then a descriptor
+// without a checksum is rejected.
+func splitChecksum(desc string, requireChecksum bool) (string, er.R) {
+	idx := strings.LastIndexByte(desc, '#')
+	if idx < 0 {
+		if requireChecksum {
+			return "", ErrMissingChecksum.New(desc, nil)
+		}
+		return desc, nil
+	}
+	body, sum := desc[:idx], desc[idx+1:]
+	if len(sum) != checksumLen {
+		return "", ErrBadChecksum.New("expected 8 character checksum, got ["+sum+"]", nil)
+	}
+	expected, ok := Checksum(body)
+	if !ok {
+		return "", ErrInvalidDescriptor.New("invalid characters in descriptor", nil)
+	}
+	if expected != sum {
+		return "", ErrBadChecksum.New("expected ["+expected+"] got ["+sum+"]", nil)
+	}
+	return body, nil
+}
@@ -0,0 +1,466 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package descriptor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/txscript/scriptbuilder"
+)
+
+// Err is the error type for descriptor parsing and expansion.
+var Err er.ErrorType = er.NewErrorType("descriptor.Err")
+
+var (
+	// ErrInvalidDescriptor is returned when a descriptor cannot be parsed.
+	ErrInvalidDescriptor = Err.CodeWithDetail("ErrInvalidDescriptor",
+		"invalid descriptor")
+
+	// ErrInvalidKey is returned when a key expression cannot be parsed or
+	// derived.
+	ErrInvalidKey = Err.CodeWithDetail("ErrInvalidKey",
+		"invalid key expression")
+
+	// ErrBadChecksum is returned when the checksum following the '#' does
+	// not match the descriptor.
+	ErrBadChecksum = Err.CodeWithDetail("ErrBadChecksum",
+		"descriptor checksum mismatch")
+
+	// ErrMissingChecksum is returned by ParseWithChecksum when the
+	// descriptor has no checksum.
+	ErrMissingChecksum = Err.CodeWithDetail("ErrMissingChecksum",
+		"descriptor has no checksum")
+)
+
+const (
+	// maxMultiSh is the largest multisig which fits in a 520 byte P2SH
+	// redeem script.
+	maxMultiSh = 15
+
+	// maxMulti is the largest multisig which is allowed by
+	// OP_CHECKMULTISIG.
+	maxMulti = 20
+)
+
+// Type is the script function of a descriptor node.
+type Type int
+
+const (
+	// TypePkh is pkh(KEY), pay to public key hash.
+	TypePkh Type = iota
+
+	// TypeWpkh is wpkh(KEY), pay to witness public key hash.
+	TypeWpkh
+
+	// TypeSh is sh(SCRIPT), pay to script hash.
+	TypeSh
+
+	// TypeWsh is wsh(SCRIPT), pay to witness script hash.
+	TypeWsh
+
+	// TypeMulti is multi(k,KEY,...), bare k-of-n multisig.
+	TypeMulti
+
+	// TypeSortedMulti is sortedmulti(k,KEY,...), multisig with the keys
+	// sorted as per BIP-67.
+	TypeSortedMulti
+)
+
+var typeNames = map[Type]string{
+	TypePkh:         "pkh",
+	TypeWpkh:        "wpkh",
+	TypeSh:          "sh",
+	TypeWsh:         "wsh",
+	TypeMulti:       "multi",
+	TypeSortedMulti: "sortedmulti",
+}
+
+func (t Type) String() string {
+	return typeNames[t]
+}
+
+// context is where in the script tree a node is being parsed.
+type context int
+
+const (
+	ctxTop context = iota
+	ctxP2SH
+	ctxP2WSH
+)
+
+func (c context) isWitness() bool {
+	return c == ctxP2WSH
+}
+
+// Descriptor is a parsed output script descriptor.
+type Descriptor struct {
+	Type Type
+
+	// Keys holds the key of a pkh or wpkh or the keys of a multisig.
+	Keys []*Key
+
+	// Threshold is the number of required signatures for a multisig.
+	Threshold int
+
+	// Sub is the inner script of an sh or wsh.
+	Sub *Descriptor
+}
+
+// Expansion is a descriptor expanded at a particular index.
+type Expansion struct {
+	// PkScript is the output script.
+	PkScript []byte
+
+	// RedeemScript is the P2SH redeem script, if any.
+	RedeemScript []byte
+
+	// WitnessScript is the P2WSH witness script, if any.
+	WitnessScript []byte
+
+	// Keys are the keys which appear in the expansion, in script order.
+	Keys []*DerivedKey
+}
+
+// Parse parses a descriptor, if it has a checksum then the checksum is
+// verified.
+func Parse(desc string) (*Descriptor, er.R) {
+	return parse(desc, false)
+}
+
+// ParseWithChecksum parses a descriptor, failing if it does not carry a
+// valid checksum.
+func ParseWithChecksum(desc string) (*Descriptor, er.R) {
+	return parse(desc, true)
+}
+
+func parse(desc string, requireChecksum bool) (*Descriptor, er.R) {
+	body, err := splitChecksum(strings.TrimSpace(desc), requireChecksum)
+	if err != nil {
+		return nil, err
+	}
+	return parseScript(body, ctxTop)
+}
+
+// splitArgs splits the arguments of a function at the top level commas,
+// ignoring those which are inside of nested parentheses.
+func splitArgs(s string) []string {
+	var out []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				out = append(out, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(out, s[start:])
+}
+
+func parseScript(s string, ctx context) (*Descriptor, er.R) {
+	open := strings.IndexByte(s, '(')
+	if open < 0 || !strings.HasSuffix(s, ")") {
+		return nil, ErrInvalidDescriptor.New("expected function call in ["+s+"]", nil)
+	}
+	name := s[:open]
+	inner := s[open+1 : len(s)-1]
+	switch name {
+	case "pkh":
+		k, err := parseKey(inner, ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &Descriptor{Type: TypePkh, Keys: []*Key{k}}, nil
+
+	case "wpkh":
+		if ctx == ctxP2WSH {
+			return nil, ErrInvalidDescriptor.New("wpkh() is not allowed inside of wsh()", nil)
+		}
+		k, err := parseKey(inner, ctxP2WSH)
+		if err != nil {
+			return nil, err
+		}
+		return &Descriptor{Type: TypeWpkh, Keys: []*Key{k}}, nil
+
+	case "sh":
+		if ctx != ctxTop {
+			return nil, ErrInvalidDescriptor.New("sh() is only allowed at the top level", nil)
+		}
+		sub, err := parseScript(inner, ctxP2SH)
+		if err != nil {
+			return nil, err
+		}
+		return &Descriptor{Type: TypeSh, Sub: sub}, nil
+
+	case "wsh":
+		if ctx == ctxP2WSH {
+			return nil, ErrInvalidDescriptor.New("wsh() is not allowed inside of wsh()", nil)
+		}
+		sub, err := parseScript(inner, ctxP2WSH)
+		if err != nil {
+			return nil, err
+		}
+		if sub.Type == TypeWpkh {
+			return nil, ErrInvalidDescriptor.New("wpkh() is not allowed inside of wsh()", nil)
+		}
+		return &Descriptor{Type: TypeWsh, Sub: sub}, nil
+
+	case "multi", "sortedmulti":
+		args := splitArgs(inner)
+		if len(args) < 2 {
+			return nil, ErrInvalidDescriptor.New(name+"() requires a threshold and at least one key", nil)
+		}
+		thresh, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, ErrInvalidDescriptor.New("multisig threshold ["+args[0]+"] is not a number", nil)
+		}
+		d := &Descriptor{Type: TypeMulti, Threshold: thresh}
+		if name == "sortedmulti" {
+			d.Type = TypeSortedMulti
+		}
+		for _, a := range args[1:] {
+			k, err := parseKey(a, ctx)
+			if err != nil {
+				return nil, err
+			}
+			d.Keys = append(d.Keys, k)
+		}
+		max := maxMulti
+		if ctx == ctxP2SH {
+			max = maxMultiSh
+		}
+		if len(d.Keys) > max {
+			return nil, ErrInvalidDescriptor.New("too many keys in "+name+"()", nil)
+		}
+		if thresh < 1 || thresh > len(d.Keys) {
+			return nil, ErrInvalidDescriptor.New("multisig threshold must be between 1 and the number of keys", nil)
+		}
+		return d, nil
+	}
+	return nil, ErrInvalidDescriptor.New("unknown script function ["+name+"]", nil)
+}
+
+// String returns the public form of the descriptor without a checksum.
+func (d *Descriptor) String() string {
+	switch d.Type {
+	case TypePkh, TypeWpkh:
+		return d.Type.String() + "(" + d.Keys[0].String() + ")"
+	case TypeSh, TypeWsh:
+		return d.Type.String() + "(" + d.Sub.String() + ")"
+	}
+	parts := make([]string, 0, len(d.Keys)+1)
+	parts = append(parts, strconv.Itoa(d.Threshold))
+	for _, k := range d.Keys {
+		parts = append(parts, k.String())
+	}
+	return d.Type.String() + "(" + strings.Join(parts, ",") + ")"
+}
+
+// StringWithChecksum returns the public form of the descriptor followed by
+// '#' and the checksum.
+func (d *Descriptor) StringWithChecksum() string {
+	return AddChecksum(d.String())
+}
+
+// Public returns an equivalent descriptor with all private key material
+// removed.  Descriptors containing hardened wildcards cannot be made public.
+func (d *Descriptor) Public() (*Descriptor, er.R) {
+	out := &Descriptor{Type: d.Type, Threshold: d.Threshold}
+	if d.Sub != nil {
+		sub, err := d.Sub.Public()
+		if err != nil {
+			return nil, err
+		}
+		out.Sub = sub
+	}
+	for _, k := range d.Keys {
+		pk, err := k.public()
+		if err != nil {
+			return nil, err
+		}
+		out.Keys = append(out.Keys, pk)
+	}
+	return out, nil
+}
+
+// IsRange returns true if any key in the descriptor has a wildcard.
+func (d *Descriptor) IsRange() bool {
+	if d.Sub != nil && d.Sub.IsRange() {
+		return true
+	}
+	for _, k := range d.Keys {
+		if k.IsRange() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPrivate returns true if any key in the descriptor carries private key
+// material.
+func (d *Descriptor) HasPrivate() bool {
+	if d.Sub != nil && d.Sub.HasPrivate() {
+		return true
+	}
+	for _, k := range d.Keys {
+		if k.HasPrivate() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsForNet returns false if any extended key or WIF in the descriptor is
+// encoded for a different network.
+func (d *Descriptor) IsForNet(net *chaincfg.Params) bool {
+	if d.Sub != nil && !d.Sub.IsForNet(net) {
+		return false
+	}
+	for _, k := range d.Keys {
+		if !k.IsForNet(net) {
+			return false
+		}
+	}
+	return true
+}
+
+func multiScript(thresh int, keys []*DerivedKey) ([]byte, er.R) {
+	b := scriptbuilder.NewScriptBuilder().AddInt64(int64(thresh))
+	for _, k := range keys {
+		b.AddData(k.SerializePubKey())
+	}
+	b.AddInt64(int64(len(keys)))
+	b.AddOp(opcode.OP_CHECKMULTISIG)
+	return b.Script()
+}
+
+// innerScript returns the script which is committed to by this node along
+// with the derived keys which appear in it.
+func (d *Descriptor) innerScript(index uint32) ([]byte, []*DerivedKey, er.R) {
+	keys := make([]*DerivedKey, 0, len(d.Keys))
+	for _, k := range d.Keys {
+		dk, err := k.Derive(index)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, dk)
+	}
+	switch d.Type {
+	case TypePkh:
+		s, err := scriptbuilder.NewScriptBuilder().
+			AddOp(opcode.OP_DUP).AddOp(opcode.OP_HASH160).
+			AddData(btcutil.Hash160(keys[0].SerializePubKey())).
+			AddOp(opcode.OP_EQUALVERIFY).AddOp(opcode.OP_CHECKSIG).
+			Script()
+		return s, keys, err
+	case TypeWpkh:
+		s, err := scriptbuilder.NewScriptBuilder().
+			AddOp(opcode.OP_0).
+			AddData(btcutil.Hash160(keys[0].SerializePubKey())).
+			Script()
+		return s, keys, err
+	case TypeSortedMulti:
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].SerializePubKey(), keys[j].SerializePubKey()) < 0
+		})
+		fallthrough
+	case TypeMulti:
+		s, err := multiScript(d.Threshold, keys)
+		return s, keys, err
+	}
+	return nil, nil, ErrInvalidDescriptor.New(d.Type.String()+"() cannot be nested here", nil)
+}
+
+// Expand derives the scripts for the descriptor at the given index, the
+// index is ignored if the descriptor is not ranged.
+func (d *Descriptor) Expand(index uint32) (*Expansion, er.R) {
+	out := &Expansion{}
+	switch d.Type {
+	case TypeSh:
+		redeem, keys, err := d.Sub.innerScriptOrWsh(index, out)
+		if err != nil {
+			return nil, err
+		}
+		out.RedeemScript = redeem
+		out.Keys = keys
+		out.PkScript, err = scriptbuilder.NewScriptBuilder().
+			AddOp(opcode.OP_HASH160).AddData(btcutil.Hash160(redeem)).
+			AddOp(opcode.OP_EQUAL).Script()
+		if err != nil {
+			return nil, err
+		}
+	case TypeWsh:
+		pk, keys, err := d.innerScriptOrWsh(index, out)
+		if err != nil {
+			return nil, err
+		}
+		out.PkScript = pk
+		out.Keys = keys
+	default:
+		pk, keys, err := d.innerScript(index)
+		if err != nil {
+			return nil, err
+		}
+		out.PkScript = pk
+		out.Keys = keys
+	}
+	return out, nil
+}
+
+// innerScriptOrWsh returns the script for d, if d is a wsh() then the
+// witness script is stored in exp and the P2WSH program is returned.
+func (d *Descriptor) innerScriptOrWsh(index uint32, exp *Expansion) ([]byte, []*DerivedKey, er.R) {
+	if d.Type != TypeWsh {
+		return d.innerScript(index)
+	}
+	ws, keys, err := d.Sub.innerScript(index)
+	if err != nil {
+		return nil, nil, err
+	}
+	exp.WitnessScript = ws
+	h := sha256.Sum256(ws)
+	s, err := scriptbuilder.NewScriptBuilder().AddOp(opcode.OP_0).AddData(h[:]).Script()
+	return s, keys, err
+}
+
+// Address returns the address of the descriptor's output script at the
+// given index.
+func (d *Descriptor) Address(index uint32, net *chaincfg.Params) (btcutil.Address, er.R) {
+	exp, err := d.Expand(index)
+	if err != nil {
+		return nil, err
+	}
+	return exp.Address(d.Type, net)
+}
+
+// Address returns the address which pays to the expansion's PkScript, t is
+// the top level type of the descriptor which was expanded.
+func (e *Expansion) Address(t Type, net *chaincfg.Params) (btcutil.Address, er.R) {
+	switch t {
+	case TypePkh:
+		return btcutil.NewAddressPubKeyHash(e.PkScript[3:23], net)
+	case TypeWpkh:
+		return btcutil.NewAddressWitnessPubKeyHash(e.PkScript[2:], net)
+	case TypeSh:
+		return btcutil.NewAddressScriptHashFromHash(e.PkScript[2:22], net)
+	case TypeWsh:
+		return btcutil.NewAddressWitnessScriptHash(e.PkScript[2:], net)
+	}
+	return nil, ErrInvalidDescriptor.New("bare "+t.String()+"() has no address", nil)
+}
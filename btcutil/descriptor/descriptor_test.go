@@ -0,0 +1,229 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package descriptor_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil/descriptor"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
+	"github.com/pkt-cash/pktd/chaincfg"
+)
+
+const (
+	// BIP32 test vector 1
+	tv1MasterPriv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+	tv1MasterPub  = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	tv1Child0HPub = "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+
+	// The public key of private key 1, the generator point.
+	pubKeyG = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	pubKey2 = "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5"
+	pubKey3 = "02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9"
+)
+
+// TestChecksum checks the checksum against the vector from BIP 380.
+func TestChecksum(t *testing.T) {
+	sum, ok := descriptor.Checksum("raw(deadbeef)")
+	if !ok {
+		t.Fatalf("Checksum: unexpected invalid character")
+	}
+	if sum != "89f8spxm" {
+		t.Fatalf("Checksum: got %s want 89f8spxm", sum)
+	}
+	if _, ok := descriptor.Checksum("raw(deadbeef)é"); ok {
+		t.Fatalf("Checksum: expected failure on non-descriptor character")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+	}{
+		{"bad checksum", "pkh(" + pubKeyG + ")#aaaaaaaa"},
+		{"short checksum", "pkh(" + pubKeyG + ")#aaaa"},
+		{"unknown function", "tr(" + pubKeyG + ")"},
+		{"sh in sh", "sh(sh(pkh(" + pubKeyG + ")))"},
+		{"wsh in wsh", "wsh(wsh(pkh(" + pubKeyG + ")))"},
+		{"wpkh in wsh", "wsh(wpkh(" + pubKeyG + "))"},
+		{"threshold too big", "multi(3," + pubKeyG + "," + pubKey2 + ")"},
+		{"threshold zero", "multi(0," + pubKeyG + "," + pubKey2 + ")"},
+		{"uncompressed in wpkh", "wpkh(0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+			"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8)"},
+		{"hardened from xpub", "pkh(" + tv1MasterPub + "/0')"},
+		{"hardened wildcard from xpub", "pkh(" + tv1MasterPub + "/*')"},
+		{"bad origin", "pkh([3442193/0']" + pubKeyG + ")"},
+		{"garbage key", "pkh(notakey)"},
+	}
+	for _, test := range tests {
+		if _, err := descriptor.Parse(test.desc); err == nil {
+			t.Errorf("%s: expected error parsing [%s]", test.name, test.desc)
+		}
+	}
+	if _, err := descriptor.ParseWithChecksum("pkh(" + pubKeyG + ")"); !descriptor.ErrMissingChecksum.Is(err) {
+		t.Errorf("ParseWithChecksum: expected ErrMissingChecksum, got %v", err)
+	}
+}
+
+func TestScripts(t *testing.T) {
+	tests := []struct {
+		name     string
+		desc     string
+		pkScript string
+		redeem   string
+		witness  string
+	}{
+		{
+			name:     "pkh",
+			desc:     "pkh(" + pubKeyG + ")",
+			pkScript: "76a914751e76e8199196d454941c45d1b3a323f1433bd688ac",
+		},
+		{
+			name:     "wpkh",
+			desc:     "wpkh(" + pubKeyG + ")",
+			pkScript: "0014751e76e8199196d454941c45d1b3a323f1433bd6",
+		},
+		{
+			name:     "sh-wpkh",
+			desc:     "sh(wpkh(" + pubKeyG + "))",
+			pkScript: "a914bcfeb728b584253d5f3f70bcb780e9ef218a68f487",
+			redeem:   "0014751e76e8199196d454941c45d1b3a323f1433bd6",
+		},
+		{
+			name:     "multi",
+			desc:     "multi(1," + pubKey2 + "," + pubKeyG + ")",
+			pkScript: "5121" + pubKey2 + "21" + pubKeyG + "52ae",
+		},
+		{
+			name:     "sortedmulti",
+			desc:     "sortedmulti(1," + pubKey2 + "," + pubKeyG + ")",
+			pkScript: "5121" + pubKeyG + "21" + pubKey2 + "52ae",
+		},
+		{
+			name:    "wsh-multi",
+			desc:    "wsh(multi(2," + pubKeyG + "," + pubKey2 + "," + pubKey3 + "))",
+			witness: "5221" + pubKeyG + "21" + pubKey2 + "21" + pubKey3 + "53ae",
+		},
+	}
+	for _, test := range tests {
+		d, err := descriptor.Parse(test.desc)
+		if err != nil {
+			t.Errorf("%s: Parse: %v", test.name, err)
+			continue
+		}
+		exp, err := d.Expand(0)
+		if err != nil {
+			t.Errorf("%s: Expand: %v", test.name, err)
+			continue
+		}
+		if test.pkScript != "" && hex.EncodeToString(exp.PkScript) != test.pkScript {
+			t.Errorf("%s: pkScript got %x want %s", test.name, exp.PkScript, test.pkScript)
+		}
+		if hex.EncodeToString(exp.RedeemScript) != test.redeem {
+			t.Errorf("%s: redeemScript got %x want %s", test.name, exp.RedeemScript, test.redeem)
+		}
+		if hex.EncodeToString(exp.WitnessScript) != test.witness {
+			t.Errorf("%s: witnessScript got %x want %s", test.name, exp.WitnessScript, test.witness)
+		}
+		if test.witness != "" && (len(exp.PkScript) != 34 || exp.PkScript[0] != 0) {
+			t.Errorf("%s: expected a P2WSH program, got %x", test.name, exp.PkScript)
+		}
+	}
+}
+
+// TestRoundTrip checks that a descriptor survives String and
+// StringWithChecksum unchanged.
+func TestRoundTrip(t *testing.T) {
+	descs := []string{
+		"pkh(" + pubKeyG + ")",
+		"wpkh([d34db33f/84'/0'/0']" + tv1Child0HPub + "/0/*)",
+		"sh(wsh(sortedmulti(2," + tv1MasterPub + "/1/*," + tv1Child0HPub + "/1/*)))",
+		"wsh(multi(1," + pubKeyG + "," + pubKey2 + "))",
+	}
+	for _, s := range descs {
+		d, err := descriptor.Parse(s)
+		if err != nil {
+			t.Errorf("Parse [%s]: %v", s, err)
+			continue
+		}
+		if d.String() != s {
+			t.Errorf("String: got [%s] want [%s]", d.String(), s)
+		}
+		d2, err := descriptor.ParseWithChecksum(d.StringWithChecksum())
+		if err != nil {
+			t.Errorf("ParseWithChecksum [%s]: %v", d.StringWithChecksum(), err)
+			continue
+		}
+		if d2.String() != s {
+			t.Errorf("String after checksum: got [%s] want [%s]", d2.String(), s)
+		}
+	}
+}
+
+// TestRange checks that wildcard expansion matches hdkeychain derivation and
+// that hardened steps are moved into the origin by Public.
+func TestRange(t *testing.T) {
+	d, err := descriptor.Parse("wpkh(" + tv1MasterPriv + "/0'/1/*)")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !d.IsRange() || !d.HasPrivate() {
+		t.Fatalf("expected a ranged descriptor with private keys")
+	}
+	pub, err := d.Public()
+	if err != nil {
+		t.Fatalf("Public: %v", err)
+	}
+	want := "wpkh([3442193e/0']" + tv1Child0HPub + "/1/*)"
+	if pub.String() != want {
+		t.Fatalf("Public: got [%s] want [%s]", pub.String(), want)
+	}
+	if pub.HasPrivate() {
+		t.Fatalf("Public: descriptor still has private keys")
+	}
+
+	xk, err := hdkeychain.NewKeyFromString(tv1Child0HPub)
+	if err != nil {
+		t.Fatalf("NewKeyFromString: %v", err)
+	}
+	xk, err = xk.Derive(1)
+	if err != nil {
+		t.Fatalf("Derive: %v", err)
+	}
+	for i := uint32(0); i < 5; i++ {
+		child, err := xk.Derive(i)
+		if err != nil {
+			t.Fatalf("Derive: %v", err)
+		}
+		pk, err := child.ECPubKey()
+		if err != nil {
+			t.Fatalf("ECPubKey: %v", err)
+		}
+		for _, dd := range []*descriptor.Descriptor{d, pub} {
+			exp, err := dd.Expand(i)
+			if err != nil {
+				t.Fatalf("Expand(%d): %v", i, err)
+			}
+			if !bytes.Equal(exp.Keys[0].SerializePubKey(), pk.SerializeCompressed()) {
+				t.Fatalf("Expand(%d): key mismatch", i)
+			}
+			origin := exp.Keys[0].Origin
+			if origin.Fingerprint != 0x3442193e || len(origin.Path) != 3 || origin.Path[2] != i {
+				t.Fatalf("Expand(%d): bad origin %v", i, origin)
+			}
+		}
+	}
+
+	addr, err := pub.Address(0, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("Address: %v", err)
+	}
+	if !addr.IsForNet(&chaincfg.MainNetParams) {
+		t.Fatalf("Address: wrong network")
+	}
+}
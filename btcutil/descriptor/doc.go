@@ -0,0 +1,25 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package descriptor implements parsing and serialization of output script
+descriptors as described in BIP 380 and following.
+
+A descriptor is a human readable description of a set of output scripts,
+for example:
+
+	wsh(sortedmulti(2,[d34db33f/48'/0'/0'/2']xpub.../0/*,xpub.../0/*))#checksum
+
+The supported script functions are pkh, wpkh, sh, wsh, multi and
+sortedmulti.  Keys may be hex encoded public keys, WIF private keys or
+extended keys with a derivation path which optionally ends in a wildcard.
+A descriptor with a wildcard describes a range of scripts, each one is
+obtained with Expand.
+
+The checksum which follows the '#' is optional when parsing, but when it is
+present it is always verified.
+
+More info: https://github.com/bitcoin/bips/blob/master/bip-0380.mediawiki
+*/
+package descriptor
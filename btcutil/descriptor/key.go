@@ -0,0 +1,419 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package descriptor
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/btcutil/hdkeychain"
+	"github.com/pkt-cash/pktd/chaincfg"
+)
+
+// Wildcard describes whether the final step of a key's derivation path is
+// substituted by the index which the descriptor is expanded at.
+type Wildcard int
+
+const (
+	// WildcardNone means the key is not ranged.
+	WildcardNone Wildcard = iota
+
+	// WildcardUnhardened means the key ends in /*
+	WildcardUnhardened
+
+	// WildcardHardened means the key ends in /*' and can only be expanded
+	// when the extended private key is known.
+	WildcardHardened
+)
+
+// KeyOrigin is the optional [fingerprint/path] prefix of a key expression,
+// it records where the key came from in the signer's hierarchy.
+type KeyOrigin struct {
+	Fingerprint uint32
+	Path        []uint32
+}
+
+// Key is a single key expression inside of a descriptor.  It is either a
+// fixed public key, a fixed private key (WIF) or an extended key with an
+// optional derivation path and wildcard.
+type Key struct {
+	Origin *KeyOrigin
+
+	// Exactly one of pubKey, wif and xkey is set.
+	pubKey     *btcec.PublicKey
+	compressed bool
+	wif        *btcutil.WIF
+	xkey       *hdkeychain.ExtendedKey
+
+	// Path is the derivation applied to xkey before the wildcard.
+	Path     []uint32
+	Wildcard Wildcard
+}
+
+// DerivedKey is the result of expanding a Key at a particular index.
+type DerivedKey struct {
+	PubKey     *btcec.PublicKey
+	Compressed bool
+
+	// PrivKey is only set if the descriptor carried private key material.
+	PrivKey *btcec.PrivateKey
+
+	// Origin is the full origin of the derived key, including the
+	// derivation steps which were applied to reach it.
+	Origin *KeyOrigin
+}
+
+// SerializePubKey returns the serialized public key in the form which is
+// used in scripts.
+func (dk *DerivedKey) SerializePubKey() []byte {
+	if dk.Compressed {
+		return dk.PubKey.SerializeCompressed()
+	}
+	return dk.PubKey.SerializeUncompressed()
+}
+
+// WIF returns the derived private key in wallet import format or nil if the
+// private key is not known.
+func (dk *DerivedKey) WIF(net *chaincfg.Params) (*btcutil.WIF, er.R) {
+	if dk.PrivKey == nil {
+		return nil, nil
+	}
+	return btcutil.NewWIF(dk.PrivKey, net, dk.Compressed)
+}
+
+// IsRange returns true if the key has a wildcard.
+func (k *Key) IsRange() bool {
+	return k.Wildcard != WildcardNone
+}
+
+// HasPrivate returns true if the key expression contains private key material.
+func (k *Key) HasPrivate() bool {
+	return k.wif != nil || (k.xkey != nil && k.xkey.IsPrivate())
+}
+
+// IsCompressed returns true if the key always derives compressed public keys.
+func (k *Key) IsCompressed() bool {
+	switch {
+	case k.pubKey != nil:
+		return k.compressed
+	case k.wif != nil:
+		return k.wif.CompressPubKey
+	}
+	return true
+}
+
+// IsForNet returns false if the key is an extended key or WIF which is
+// encoded for a network other than net.  Raw public keys are valid on
+// every network.
+func (k *Key) IsForNet(net *chaincfg.Params) bool {
+	switch {
+	case k.wif != nil:
+		return k.wif.IsForNet(net)
+	case k.xkey != nil:
+		return k.xkey.IsForNet(net)
+	}
+	return true
+}
+
+// Derive expands the key at the given index, the index is ignored if the
+// key is not ranged.
+func (k *Key) Derive(index uint32) (*DerivedKey, er.R) {
+	out := &DerivedKey{}
+	if k.Origin != nil {
+		out.Origin = &KeyOrigin{
+			Fingerprint: k.Origin.Fingerprint,
+			Path:        append([]uint32{}, k.Origin.Path...),
+		}
+	}
+	switch {
+	case k.pubKey != nil:
+		out.PubKey = k.pubKey
+		out.Compressed = k.compressed
+		return out, nil
+	case k.wif != nil:
+		out.PubKey = k.wif.PrivKey.PubKey()
+		out.PrivKey = k.wif.PrivKey
+		out.Compressed = k.wif.CompressPubKey
+		return out, nil
+	}
+
+	if out.Origin == nil {
+		out.Origin = &KeyOrigin{Fingerprint: k.fingerprint()}
+	}
+	xk := k.xkey
+	path := k.Path
+	switch k.Wildcard {
+	case WildcardUnhardened:
+		if index >= hdkeychain.HardenedKeyStart {
+			return nil, ErrInvalidKey.New("wildcard index out of range", nil)
+		}
+		path = append(append([]uint32{}, path...), index)
+	case WildcardHardened:
+		if index >= hdkeychain.HardenedKeyStart {
+			return nil, ErrInvalidKey.New("wildcard index out of range", nil)
+		}
+		path = append(append([]uint32{}, path...), index+hdkeychain.HardenedKeyStart)
+	}
+	for _, step := range path {
+		var err er.R
+		if xk, err = xk.Derive(step); err != nil {
+			return nil, err
+		}
+		out.Origin.Path = append(out.Origin.Path, step)
+	}
+	pk, err := xk.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+	out.PubKey = pk
+	out.Compressed = true
+	if xk.IsPrivate() {
+		if out.PrivKey, err = xk.ECPrivKey(); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// fingerprint is the BIP32 fingerprint of the extended key itself.
+func (k *Key) fingerprint() uint32 {
+	pk, err := k.xkey.ECPubKey()
+	if err != nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(btcutil.Hash160(pk.SerializeCompressed())[:4])
+}
+
+// String returns the public form of the key expression, private keys are
+// replaced by their corresponding public keys.
+func (k *Key) String() string {
+	var sb strings.Builder
+	if k.Origin != nil {
+		sb.WriteString("[")
+		sb.WriteString(fmt.Sprintf("%08x", k.Origin.Fingerprint))
+		sb.WriteString(pathString(k.Origin.Path))
+		sb.WriteString("]")
+	}
+	switch {
+	case k.pubKey != nil:
+		if k.compressed {
+			sb.WriteString(hex.EncodeToString(k.pubKey.SerializeCompressed()))
+		} else {
+			sb.WriteString(hex.EncodeToString(k.pubKey.SerializeUncompressed()))
+		}
+		return sb.String()
+	case k.wif != nil:
+		sb.WriteString(hex.EncodeToString(k.wif.SerializePubKey()))
+		return sb.String()
+	}
+	xk := k.xkey
+	if xk.IsPrivate() {
+		if pub, err := xk.Neuter(); err == nil {
+			xk = pub
+		}
+	}
+	sb.WriteString(xk.String())
+	sb.WriteString(pathString(k.Path))
+	switch k.Wildcard {
+	case WildcardUnhardened:
+		sb.WriteString("/*")
+	case WildcardHardened:
+		sb.WriteString("/*'")
+	}
+	return sb.String()
+}
+
+// public returns an equivalent key expression which carries no private key
+// material.  Hardened steps in the derivation path are applied using the
+// private key so that the remaining path can be derived from the xpub.
+func (k *Key) public() (*Key, er.R) {
+	out := &Key{
+		Origin:     k.Origin,
+		pubKey:     k.pubKey,
+		compressed: k.compressed,
+		Path:       k.Path,
+		Wildcard:   k.Wildcard,
+	}
+	switch {
+	case k.pubKey != nil:
+		return out, nil
+	case k.wif != nil:
+		out.pubKey = k.wif.PrivKey.PubKey()
+		out.compressed = k.wif.CompressPubKey
+		return out, nil
+	case !k.xkey.IsPrivate():
+		out.xkey = k.xkey
+		return out, nil
+	case k.Wildcard == WildcardHardened:
+		return nil, ErrInvalidKey.New("hardened wildcard keys have no public form", nil)
+	}
+
+	lastHardened := -1
+	for i, step := range k.Path {
+		if step >= hdkeychain.HardenedKeyStart {
+			lastHardened = i
+		}
+	}
+	xk := k.xkey
+	if lastHardened >= 0 {
+		origin := &KeyOrigin{Fingerprint: k.fingerprint()}
+		if k.Origin != nil {
+			origin.Fingerprint = k.Origin.Fingerprint
+			origin.Path = append(origin.Path, k.Origin.Path...)
+		}
+		for _, step := range k.Path[:lastHardened+1] {
+			var err er.R
+			if xk, err = xk.Derive(step); err != nil {
+				return nil, err
+			}
+			origin.Path = append(origin.Path, step)
+		}
+		out.Origin = origin
+		out.Path = k.Path[lastHardened+1:]
+	}
+	pub, err := xk.Neuter()
+	if err != nil {
+		return nil, err
+	}
+	out.xkey = pub
+	return out, nil
+}
+
+func pathString(path []uint32) string {
+	var sb strings.Builder
+	for _, step := range path {
+		if step >= hdkeychain.HardenedKeyStart {
+			sb.WriteString(fmt.Sprintf("/%d'", step-hdkeychain.HardenedKeyStart))
+		} else {
+			sb.WriteString(fmt.Sprintf("/%d", step))
+		}
+	}
+	return sb.String()
+}
+
+// parsePathStep parses one element of a derivation path, either ' or h may
+// be used to mark a step as hardened.
+func parsePathStep(s string) (uint32, er.R) {
+	hardened := false
+	if strings.HasSuffix(s, "'") || strings.HasSuffix(s, "h") {
+		hardened = true
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil || n >= hdkeychain.HardenedKeyStart {
+		return 0, ErrInvalidKey.New("invalid derivation step ["+s+"]", nil)
+	}
+	if hardened {
+		n += hdkeychain.HardenedKeyStart
+	}
+	return uint32(n), nil
+}
+
+func parseOrigin(s string) (*KeyOrigin, er.R) {
+	parts := strings.Split(s, "/")
+	if len(parts[0]) != 8 {
+		return nil, ErrInvalidKey.New("key origin fingerprint must be 8 hex characters", nil)
+	}
+	fp, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidKey.New("key origin fingerprint is not hex", er.E(err))
+	}
+	out := &KeyOrigin{Fingerprint: binary.BigEndian.Uint32(fp)}
+	for _, p := range parts[1:] {
+		step, err := parsePathStep(p)
+		if err != nil {
+			return nil, err
+		}
+		out.Path = append(out.Path, step)
+	}
+	return out, nil
+}
+
+// parseKey parses a key expression, ctx is the script context which it
+// appears in so that uncompressed keys can be rejected inside of segwit.
+func parseKey(s string, ctx context) (*Key, er.R) {
+	k := &Key{}
+	if strings.HasPrefix(s, "[") {
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return nil, ErrInvalidKey.New("key origin start '[' has no matching ']'", nil)
+		}
+		origin, err := parseOrigin(s[1:end])
+		if err != nil {
+			return nil, err
+		}
+		k.Origin = origin
+		s = s[end+1:]
+	}
+	if s == "" {
+		return nil, ErrInvalidKey.New("empty key expression", nil)
+	}
+
+	parts := strings.Split(s, "/")
+	if len(parts) == 1 {
+		if b, err := hex.DecodeString(s); err == nil {
+			if len(b) != 33 && len(b) != 65 {
+				return nil, ErrInvalidKey.New("public key ["+s+"] has invalid length", nil)
+			}
+			pk, err := btcec.ParsePubKey(b, btcec.S256())
+			if err != nil {
+				return nil, ErrInvalidKey.New("public key ["+s+"] is not valid", err)
+			}
+			k.pubKey = pk
+			k.compressed = len(b) == 33
+			if !k.compressed && ctx.isWitness() {
+				return nil, ErrInvalidKey.New("uncompressed keys are not allowed in segwit", nil)
+			}
+			return k, nil
+		}
+		if wif, err := btcutil.DecodeWIF(s); err == nil {
+			if !wif.CompressPubKey && ctx.isWitness() {
+				return nil, ErrInvalidKey.New("uncompressed keys are not allowed in segwit", nil)
+			}
+			k.wif = wif
+			return k, nil
+		}
+	}
+
+	xk, err := hdkeychain.NewKeyFromString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidKey.New("key ["+parts[0]+"] is not a valid key", err)
+	}
+	k.xkey = xk
+	for i, p := range parts[1:] {
+		if i == len(parts)-2 {
+			switch p {
+			case "*":
+				k.Wildcard = WildcardUnhardened
+				continue
+			case "*'", "*h":
+				k.Wildcard = WildcardHardened
+				continue
+			}
+		}
+		step, err := parsePathStep(p)
+		if err != nil {
+			return nil, err
+		}
+		k.Path = append(k.Path, step)
+	}
+	if !xk.IsPrivate() {
+		if k.Wildcard == WildcardHardened {
+			return nil, ErrInvalidKey.New("hardened wildcard requires an extended private key", nil)
+		}
+		for _, step := range k.Path {
+			if step >= hdkeychain.HardenedKeyStart {
+				return nil, ErrInvalidKey.New("hardened derivation requires an extended private key", nil)
+			}
+		}
+	}
+	return k, nil
+}
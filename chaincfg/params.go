@@ -99,6 +99,11 @@ const (
 	// includes the deployment of BIPS 141, 142, 144, 145, 147 and 173.
 	DeploymentSegwit
 
+	// DeploymentTaproot defines the rule change deployment ID for the
+	// taproot soft-fork package. The taproot package includes the
+	// deployment of BIPS 340, 341 and 342.
+	DeploymentTaproot
+
 	// NOTE: DefinedDeployments must always come last since it is used to
 	// determine how many defined deployments there currently are.
 
@@ -309,6 +314,11 @@ var MainNetParams = Params{
 			StartTime:  1479168000, // November 15, 2016 UTC
 			ExpireTime: 1510704000, // November 15, 2017 UTC.
 		},
+		DeploymentTaproot: {
+			BitNumber:  2,
+			StartTime:  math.MaxInt64, // Never available for vote
+			ExpireTime: 0,
+		},
 	},
 
 	// Mempool parameters
@@ -385,6 +395,11 @@ var RegressionNetParams = Params{
 			StartTime:  0,             // Always available for vote
 			ExpireTime: math.MaxInt64, // Never expires.
 		},
+		DeploymentTaproot: {
+			BitNumber:  2,
+			StartTime:  math.MaxInt64, // Always active
+			ExpireTime: math.MaxInt64,
+		},
 	},
 
 	// Mempool parameters
@@ -479,6 +494,11 @@ var TestNet3Params = Params{
 			StartTime:  1462060800, // May 1, 2016 UTC
 			ExpireTime: 1493596800, // May 1, 2017 UTC.
 		},
+		DeploymentTaproot: {
+			BitNumber:  2,
+			StartTime:  math.MaxInt64, // Never available for vote
+			ExpireTime: 0,
+		},
 	},
 
 	// Mempool parameters
@@ -563,6 +583,11 @@ var PktTestNetParams = Params{
 			StartTime:  math.MaxInt64,
 			ExpireTime: math.MaxInt64,
 		},
+		DeploymentTaproot: {
+			BitNumber:  2,
+			StartTime:  math.MaxInt64, // Never available for vote
+			ExpireTime: 0,
+		},
 	},
 
 	// Mempool parameters
@@ -712,6 +737,11 @@ var PktMainNetParams = Params{
 			StartTime:  math.MaxInt64,
 			ExpireTime: math.MaxInt64,
 		},
+		DeploymentTaproot: {
+			BitNumber:  2,
+			StartTime:  math.MaxInt64, // Never available for vote
+			ExpireTime: 0,
+		},
 	},
 
 	// Mempool parameters
@@ -794,6 +824,11 @@ var SimNetParams = Params{
 			StartTime:  0,             // Always available for vote
 			ExpireTime: math.MaxInt64, // Never expires.
 		},
+		DeploymentTaproot: {
+			BitNumber:  2,
+			StartTime:  math.MaxInt64, // Always active
+			ExpireTime: math.MaxInt64,
+		},
 	},
 
 	// Mempool parameters
@@ -46,6 +46,7 @@ const (
 	defaultMaxRPCClients         = 16
 	defaultMaxRPCWebsockets      = 32
 	defaultMaxRPCConcurrentReqs  = 36
+	defaultMaxRPCBatch           = 1000
 	defaultFreeTxRelayLimit      = 15.0
 	defaultTrickleInterval       = peer.DefaultTrickleInterval
 	defaultBlockMinSize          = 0
@@ -60,6 +61,8 @@ const (
 	defaultMaxOrphanTransactions = 1024
 	defaultMaxOrphanTxSize       = 256000
 	defaultSigCacheMaxSize       = 256000
+	defaultAnnCacheMaxSize       = 50000
+	defaultMaxPcAnns             = 4096
 	defaultTxIndex               = false
 	defaultAddrIndex             = false
 )
@@ -110,12 +113,15 @@ type config struct {
 	RPCPass              string        `short:"P" long:"rpcpass" default-mask:"-" description:"Password for RPC connections"`
 	RPCLimitUser         string        `long:"rpclimituser" description:"Username for limited RPC connections"`
 	RPCLimitPass         string        `long:"rpclimitpass" default-mask:"-" description:"Password for limited RPC connections"`
+	RPCAuth              []string      `long:"rpcauth" description:"Add a hashed RPC credential in the form user:salt$hmac[:method,@group,...] where hmac is the hex HMAC-SHA256 of the password keyed with the salt, the user may only call the listed methods and method groups (@limited, @mining) if any are given"`
 	RPCListeners         []string      `long:"rpclisten" description:"Add an interface/port to listen for RPC connections (default port: 8334, testnet: 18334)"`
 	RPCCert              string        `long:"rpccert" description:"File containing the certificate file"`
 	RPCKey               string        `long:"rpckey" description:"File containing the certificate key"`
 	RPCMaxClients        int           `long:"rpcmaxclients" description:"Max number of RPC clients for standard connections"`
 	RPCMaxWebsockets     int           `long:"rpcmaxwebsockets" description:"Max number of RPC websocket connections"`
 	RPCMaxConcurrentReqs int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
+	RPCMaxBatch          int           `long:"rpcmaxbatch" description:"Max number of requests in a single JSON-RPC batch"`
+	Rest                 bool          `long:"rest" description:"Serve the unauthenticated, read-only REST interface for chain data on the RPC listeners"`
 	RPCQuirks            bool          `long:"rpcquirks" description:"Mirror some JSON-RPC quirks of Bitcoin Core -- NOTE: Discouraged unless interoperability issues need to be worked around"`
 	DisableRPC           bool          `long:"norpc" description:"Disable built-in RPC server -- NOTE: The RPC server is disabled by default if no rpcuser/rpcpass or rpclimituser/rpclimitpass is specified"`
 	DisableTLS           bool          `long:"notls" description:"Nolonger used, see --tls" hidden:"true"`
@@ -138,8 +144,10 @@ type config struct {
 	MinRelayTxFee        float64       `long:"minrelaytxfee" description:"The minimum transaction fee in BTC/kB to be considered a non-zero fee."`
 	FreeTxRelayLimit     float64       `long:"limitfreerelay" description:"Limit relay of transactions with no transaction fee to the given amount in thousands of bytes per minute"`
 	NoRelayPriority      bool          `long:"norelaypriority" description:"Do not require free or low-fee transactions to have high priority for relaying"`
-	TrickleInterval      time.Duration `long:"trickleinterval" description:"Minimum time between attempts to send new inventory to a connected peer"`
+	TrickleInterval      time.Duration `long:"trickleinterval" description:"Average time between attempts to send new inventory to an outbound peer, the actual delays are random"`
+	InboundTrickle       time.Duration `long:"inboundtrickleinterval" description:"Average time between attempts to send new inventory to an inbound peer, the actual delays are random"`
 	MaxOrphanTxs         int           `long:"maxorphantx" description:"Max number of orphan transactions to keep in memory"`
+	MaxMempool           int64         `long:"maxmempool" description:"Max total virtual size of the transactions in the mempool in megabytes, the ones with the lowest fee rates are evicted beyond it -- 0 to disable the limit"`
 	Generate             bool          `long:"generate" description:"Generate (mine) bitcoins using the CPU"`
 	MiningAddrs          []string      `long:"miningaddr" description:"Add the specified payment address to the list of addresses to use for generated blocks -- At least one address is required if the generate option is set"`
 	BlockMinSize         uint32        `long:"blockminsize" description:"Mininum block size in bytes to be used when creating a block"`
@@ -152,11 +160,19 @@ type config struct {
 	NoCFilters           bool          `long:"nocfilters" description:"Disable committed filtering (CF) support"`
 	DropCfIndex          bool          `long:"dropcfindex" description:"Deletes the index used for committed filtering (CF) support from the database on start up and then exits."`
 	SigCacheMaxSize      uint          `long:"sigcachemaxsize" description:"The maximum number of entries in the signature verification cache"`
+	AnnCacheMaxSize      uint          `long:"anncachemaxsize" description:"The maximum number of entries in the PacketCrypt announcement verification cache"`
+	PcProofWorkers       int           `long:"pcproofworkers" description:"The number of goroutines validating the PacketCrypt proofs of downloaded blocks ahead of them being connected (0 = one per CPU)"`
+	PcAnnRelay           bool          `long:"pcannrelay" description:"Relay PacketCrypt announcements between peers which support it and keep them in an announcement pool for solo block mining"`
+	MaxPcAnns            int           `long:"maxpcanns" description:"The maximum number of PacketCrypt announcements kept in the announcement pool"`
+	NoPersistMempool     bool          `long:"nopersistmempool" description:"Do not save the mempool on shutdown and load it on startup"`
+	MempoolExpiry        time.Duration `long:"mempoolexpiry" description:"Do not load saved mempool transactions older than this on startup.  Valid time units are {s, m, h}"`
 	BlocksOnly           bool          `long:"blocksonly" description:"Do not accept transactions from remote peers."`
 	TxIndex              bool          `long:"txindex" description:"Maintain a full hash-based transaction index which makes all transactions available via the getrawtransaction RPC"`
 	DropTxIndex          bool          `long:"droptxindex" description:"Deletes the hash-based transaction index from the database on start up and then exits."`
 	AddrIndex            bool          `long:"addrindex" description:"Maintain a full address-based transaction index which makes the searchrawtransactions RPC available"`
 	DropAddrIndex        bool          `long:"dropaddrindex" description:"Deletes the address-based transaction index from the database on start up and then exits."`
+	SpentIndex           bool          `long:"spentindex" description:"Maintain an index of the input spending each output which makes the getspentinfo RPC available"`
+	DropSpentIndex       bool          `long:"dropspentindex" description:"Deletes the spent output index from the database on start up and then exits."`
 	RelayNonStd          bool          `long:"relaynonstd" description:"Relay non-standard transactions regardless of the default settings for the active network."`
 	RejectNonStd         bool          `long:"rejectnonstd" description:"Reject non-standard transactions regardless of the default settings for the active network."`
 	RejectReplacement    bool          `long:"rejectreplacement" description:"Reject transactions that attempt to replace existing transactions within the mempool through the Replace-By-Fee (RBF) signaling policy."`
@@ -397,6 +413,7 @@ func loadConfig() (*config, []string, er.R) {
 		RPCMaxClients:        defaultMaxRPCClients,
 		RPCMaxWebsockets:     defaultMaxRPCWebsockets,
 		RPCMaxConcurrentReqs: defaultMaxRPCConcurrentReqs,
+		RPCMaxBatch:          defaultMaxRPCBatch,
 		HomeDir:              defaultHomeDir,
 		DataDir:              defaultDataDir,
 		LogDir:               defaultLogDir,
@@ -405,13 +422,18 @@ func loadConfig() (*config, []string, er.R) {
 		MinRelayTxFee:        -1, // this gets configured later
 		FreeTxRelayLimit:     defaultFreeTxRelayLimit,
 		TrickleInterval:      defaultTrickleInterval,
+		InboundTrickle:       peer.DefaultInboundTrickleInterval,
 		BlockMinSize:         defaultBlockMinSize,
 		BlockMaxSize:         defaultBlockMaxSize,
 		BlockMinWeight:       defaultBlockMinWeight,
 		BlockMaxWeight:       defaultBlockMaxWeight,
 		BlockPrioritySize:    mempool.DefaultBlockPrioritySize,
 		MaxOrphanTxs:         defaultMaxOrphanTransactions,
+		MaxMempool:           mempool.DefaultMaxPoolSize,
 		SigCacheMaxSize:      defaultSigCacheMaxSize,
+		AnnCacheMaxSize:      defaultAnnCacheMaxSize,
+		MaxPcAnns:            defaultMaxPcAnns,
+		MempoolExpiry:        mempool.DefaultMempoolExpiry,
 		Generate:             defaultGenerate,
 		TxIndex:              defaultTxIndex,
 		AddrIndex:            defaultAddrIndex,
@@ -714,6 +736,14 @@ func loadConfig() (*config, []string, er.R) {
 		return nil, nil, err
 	}
 
+	// Check that the hashed credentials are well formed.
+	if _, err := parseRPCAuths(cfg.RPCAuth, cfg.RPCUser, cfg.RPCLimitUser); err != nil {
+		err := er.Errorf("%s: %s", funcName, err.Message())
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	if cfg.RPCUser == "" || cfg.RPCPass == "" {
 		pktdLog.Infof("Creating a new .pktcookie authorization file")
 		cookiePath := filepath.Join(defaultHomeDir, ".pktcookie")
@@ -737,7 +767,8 @@ func loadConfig() (*config, []string, er.R) {
 
 	// The RPC server is disabled if no username or password is provided.
 	if (cfg.RPCUser == "" || cfg.RPCPass == "") &&
-		(cfg.RPCLimitUser == "" || cfg.RPCLimitPass == "") {
+		(cfg.RPCLimitUser == "" || cfg.RPCLimitPass == "") &&
+		len(cfg.RPCAuth) == 0 {
 		cfg.DisableRPC = true
 	}
 
@@ -767,6 +798,15 @@ func loadConfig() (*config, []string, er.R) {
 		return nil, nil, err
 	}
 
+	if cfg.RPCMaxBatch < 1 {
+		str := "%s: The rpcmaxbatch option may not be less than 1 " +
+			"-- parsed [%d]"
+		err := er.Errorf(str, funcName, cfg.RPCMaxBatch)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Validate the the minrelaytxfee.
 	if cfg.MinRelayTxFee >= 0 {
 		mrf, err := globalcfg.NewAmount(cfg.MinRelayTxFee)
@@ -819,6 +859,26 @@ func loadConfig() (*config, []string, er.R) {
 		return nil, nil, err
 	}
 
+	// The mempool size limit may not be negative.
+	if cfg.MaxMempool < 0 {
+		str := "%s: The maxmempool option may not be less than 0 " +
+			"-- parsed [%d]"
+		err := er.Errorf(str, funcName, cfg.MaxMempool)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
+	// The announcement pool must be able to hold announcements.
+	if cfg.MaxPcAnns < 1 {
+		str := "%s: The maxpcanns option may not be less than 1 " +
+			"-- parsed [%d]"
+		err := er.Errorf(str, funcName, cfg.MaxPcAnns)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Limit the block priority and minimum block sizes to max block size.
 	cfg.BlockPrioritySize = minUint32(cfg.BlockPrioritySize, cfg.BlockMaxSize)
 	cfg.BlockMinSize = minUint32(cfg.BlockMinSize, cfg.BlockMaxSize)
@@ -873,6 +933,16 @@ func loadConfig() (*config, []string, er.R) {
 		return nil, nil, err
 	}
 
+	// --spentindex and --dropspentindex do not mix.
+	if cfg.SpentIndex && cfg.DropSpentIndex {
+		err := er.Errorf("%s: the --spentindex and --dropspentindex "+
+			"options may not be activated at the same time",
+			funcName)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// --addrindex and --droptxindex do not mix.
 	if cfg.AddrIndex && cfg.DropTxIndex {
 		err := er.Errorf("%s: the --addrindex and --droptxindex "+
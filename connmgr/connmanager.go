@@ -56,6 +56,40 @@ const (
 	ConnDisconnected
 )
 
+// ConnClass is the class of an outbound connection, which determines what is
+// relayed over it.
+type ConnClass uint8
+
+const (
+	// ConnFullRelay connections relay blocks, transactions and addresses.
+	ConnFullRelay ConnClass = iota
+
+	// ConnBlockRelayOnly connections only relay blocks, so that the peers
+	// they are made to are harder to learn about by observing transaction
+	// and address relay, which makes the node harder to eclipse.
+	ConnBlockRelayOnly
+
+	// ConnFeeler connections are short lived connections made to test
+	// whether addresses that were never connected to are reachable.
+	ConnFeeler
+)
+
+// connClassStrings is a map of connection classes back to their constant names
+// for pretty printing.
+var connClassStrings = map[ConnClass]string{
+	ConnFullRelay:      "full-relay",
+	ConnBlockRelayOnly: "block-relay-only",
+	ConnFeeler:         "feeler",
+}
+
+// String returns the ConnClass in human-readable form.
+func (c ConnClass) String() string {
+	if s, ok := connClassStrings[c]; ok {
+		return s
+	}
+	return fmt.Sprintf("Unknown ConnClass (%d)", uint8(c))
+}
+
 // ConnReq is the connection request to a network address. If permanent, the
 // connection will be retried on disconnection.
 type ConnReq struct {
@@ -65,6 +99,10 @@ type ConnReq struct {
 	Addr      net.Addr
 	Permanent bool
 
+	// Class is the class of the connection.  The class of requests made
+	// by NewConnReq is assigned by the connection manager.
+	Class ConnClass
+
 	conn       net.Conn
 	state      ConnState
 	stateMtx   sync.RWMutex
@@ -96,6 +134,10 @@ func (c *ConnReq) String() string {
 	if c.Addr == nil || c.Addr.String() == "" {
 		return fmt.Sprintf("reqid %d", atomic.LoadUint64(&c.id))
 	}
+	if c.Class != ConnFullRelay {
+		return fmt.Sprintf("%s (reqid %d, %s)", c.Addr,
+			atomic.LoadUint64(&c.id), c.Class)
+	}
 	return fmt.Sprintf("%s (reqid %d)", c.Addr, atomic.LoadUint64(&c.id))
 }
 
@@ -124,10 +166,19 @@ type Config struct {
 	// connections in that case.
 	OnAccept func(net.Conn)
 
-	// TargetOutbound is the number of outbound network connections to
-	// maintain. Defaults to 8.
+	// TargetOutbound is the number of full relay outbound network
+	// connections to maintain. Defaults to 14.
 	TargetOutbound uint32
 
+	// TargetBlockRelayOnly is the number of block relay only outbound
+	// network connections to maintain in addition to TargetOutbound.
+	TargetBlockRelayOnly uint32
+
+	// FeelerInterval is the interval at which feeler connections are made
+	// once all full relay outbound connections are established.  No
+	// feeler connections are made when it is zero.
+	FeelerInterval time.Duration
+
 	// RetryDuration is the duration to wait before retrying connection
 	// requests. Defaults to 5s.
 	RetryDuration time.Duration
@@ -141,8 +192,9 @@ type Config struct {
 	OnDisconnection func(*ConnReq)
 
 	// GetNewAddress is a way to get an address to make a network connection
-	// to.  If nil, no new connections will be made automatically.
-	GetNewAddress func() (net.Addr, er.R)
+	// of the passed class to.  If nil, no new connections will be made
+	// automatically.
+	GetNewAddress func(ConnClass) (net.Addr, er.R)
 
 	// Dial connects to the address on the named network. It cannot be nil.
 	Dial func(net.Addr) (net.Conn, er.R)
@@ -151,10 +203,12 @@ type Config struct {
 // registerPending is used to register a pending connection attempt. By
 // registering pending connection attempts we allow callers to cancel pending
 // connection attempts before their successful or in the case they're not
-// longer wanted.
+// longer wanted.  When assignClass is set, the connection is made block relay
+// only if there are not enough such connections yet.
 type registerPending struct {
-	c    *ConnReq
-	done chan struct{}
+	c           *ConnReq
+	done        chan struct{}
+	assignClass bool
 }
 
 // handleConnected is used to queue a successful connection.
@@ -198,6 +252,12 @@ func (cm *ConnManager) handleFailedConn(c *ConnReq) {
 	if atomic.LoadInt32(&cm.stop) != 0 {
 		return
 	}
+	if c.Class == ConnFeeler {
+		// Feeler connections are never retried, another one is made
+		// at the next feeler interval.
+		go cm.Remove(c.id)
+		return
+	}
 	if c.Permanent {
 		c.retryCount++
 		d := time.Duration(c.retryCount) * cm.cfg.RetryDuration
@@ -244,14 +304,58 @@ func (cm *ConnManager) connHandler() {
 		conns = make(map[uint64]*ConnReq, cm.cfg.TargetOutbound)
 	)
 
+	// countClass returns the number of pending and established
+	// connections of the passed class.
+	countClass := func(class ConnClass, includePending bool) uint32 {
+		var n uint32
+		for _, connReq := range conns {
+			if connReq.Class == class {
+				n++
+			}
+		}
+		if includePending {
+			for _, connReq := range pending {
+				if connReq.Class == class {
+					n++
+				}
+			}
+		}
+		return n
+	}
+
+	// Feeler connections are only made when new connections are made
+	// automatically.
+	var feelerTicker <-chan time.Time
+	if cm.cfg.FeelerInterval > 0 && cm.cfg.GetNewAddress != nil {
+		ticker := time.NewTicker(cm.cfg.FeelerInterval)
+		defer ticker.Stop()
+		feelerTicker = ticker.C
+	}
+
 out:
 	for {
 		select {
+		case <-feelerTicker:
+			// Only make a feeler connection when all of the full
+			// relay outbound connections are established, and when
+			// the previous one is done.
+			if countClass(ConnFullRelay, false) < cm.cfg.TargetOutbound ||
+				countClass(ConnFeeler, true) > 0 {
+
+				continue
+			}
+			go cm.newConnReq(ConnFeeler, false)
+
 		case req := <-cm.requests:
 			switch msg := req.(type) {
 
 			case registerPending:
 				connReq := msg.c
+				if msg.assignClass && countClass(ConnBlockRelayOnly,
+					true) < cm.cfg.TargetBlockRelayOnly {
+
+					connReq.Class = ConnBlockRelayOnly
+				}
 				connReq.updateState(ConnPending)
 				pending[msg.c.id] = connReq
 				close(msg.done)
@@ -371,8 +475,16 @@ func (cm *ConnManager) NotifyConnectionRequestActuallyCompleted() {
 }
 
 // NewConnReq creates a new connection request and connects to the
-// corresponding address.
+// corresponding address.  The connection is block relay only when there are
+// less than TargetBlockRelayOnly such connections, and full relay otherwise.
 func (cm *ConnManager) NewConnReq() {
+	cm.newConnReq(ConnFullRelay, true)
+}
+
+// newConnReq creates a new connection request of the passed class and connects
+// to the corresponding address.  When assignClass is set, the class is chosen
+// by the connection handler instead.
+func (cm *ConnManager) newConnReq(class ConnClass, assignClass bool) {
 	if atomic.LoadInt32(&cm.stop) != 0 {
 		return
 	}
@@ -380,7 +492,7 @@ func (cm *ConnManager) NewConnReq() {
 		return
 	}
 
-	c := &ConnReq{}
+	c := &ConnReq{Class: class}
 	atomic.StoreUint64(&c.id, atomic.AddUint64(&cm.connReqCount, 1))
 
 	// Submit a request of a pending connection attempt to the connection
@@ -389,7 +501,7 @@ func (cm *ConnManager) NewConnReq() {
 	// Remove method.
 	done := make(chan struct{})
 	select {
-	case cm.requests <- registerPending{c, done}:
+	case cm.requests <- registerPending{c, done, assignClass}:
 	case <-cm.quit:
 		return
 	}
@@ -402,7 +514,7 @@ func (cm *ConnManager) NewConnReq() {
 		return
 	}
 
-	addr, err := cm.cfg.GetNewAddress()
+	addr, err := cm.cfg.GetNewAddress(c.Class)
 	if err != nil {
 		select {
 		case cm.requests <- handleFailed{c, err}:
@@ -442,7 +554,7 @@ func (cm *ConnManager) Connect(c *ConnReq) {
 		// cancel the connection via the Remove method.
 		done := make(chan struct{})
 		select {
-		case cm.requests <- registerPending{c, done}:
+		case cm.requests <- registerPending{c, done, false}:
 		case <-cm.quit:
 			return
 		}
@@ -543,7 +655,8 @@ func (cm *ConnManager) Start() {
 		}
 	}
 
-	for i := atomic.LoadUint64(&cm.connReqCount); i < uint64(cm.cfg.TargetOutbound); i++ {
+	target := uint64(cm.cfg.TargetOutbound) + uint64(cm.cfg.TargetBlockRelayOnly)
+	for i := atomic.LoadUint64(&cm.connReqCount); i < target; i++ {
 		go cm.NewConnReq()
 	}
 }
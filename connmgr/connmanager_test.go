@@ -90,7 +90,7 @@ func TestStartStop(t *testing.T) {
 	disconnected := make(chan *ConnReq)
 	cmgr, err := New(&Config{
 		TargetOutbound: 1,
-		GetNewAddress: func() (net.Addr, er.R) {
+		GetNewAddress: func(ConnClass) (net.Addr, er.R) {
 			return &net.TCPAddr{
 				IP:   net.ParseIP("127.0.0.1"),
 				Port: 18555,
@@ -189,7 +189,7 @@ func TestTargetOutbound(t *testing.T) {
 	cmgr, err := New(&Config{
 		TargetOutbound: targetOutbound,
 		Dial:           mockDialer,
-		GetNewAddress: func() (net.Addr, er.R) {
+		GetNewAddress: func(ConnClass) (net.Addr, er.R) {
 			return &net.TCPAddr{
 				IP:   net.ParseIP("127.0.0.1"),
 				Port: 18555,
@@ -216,6 +216,112 @@ func TestTargetOutbound(t *testing.T) {
 	cmgr.Stop()
 }
 
+// TestTargetBlockRelayOnly tests that the connection manager maintains the
+// target number of block relay only connections in addition to the full relay
+// ones, and asks for addresses for the right class of connections.
+func TestTargetBlockRelayOnly(t *testing.T) {
+	targetOutbound := uint32(3)
+	targetBlockRelayOnly := uint32(2)
+	connected := make(chan *ConnReq)
+	var numBlockRelayOnlyAddrs uint32
+	cmgr, err := New(&Config{
+		TargetOutbound:       targetOutbound,
+		TargetBlockRelayOnly: targetBlockRelayOnly,
+		Dial:                 mockDialer,
+		GetNewAddress: func(class ConnClass) (net.Addr, er.R) {
+			if class == ConnBlockRelayOnly {
+				atomic.AddUint32(&numBlockRelayOnlyAddrs, 1)
+			}
+			return &net.TCPAddr{
+				IP:   net.ParseIP("127.0.0.1"),
+				Port: 18555,
+			}, nil
+		},
+		OnConnection: func(c *ConnReq, conn net.Conn) {
+			connected <- c
+		},
+	})
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	cmgr.Start()
+	var numBlockRelayOnly uint32
+	var blockRelayOnly *ConnReq
+	for i := uint32(0); i < targetOutbound+targetBlockRelayOnly; i++ {
+		c := <-connected
+		if c.Class == ConnBlockRelayOnly {
+			numBlockRelayOnly++
+			blockRelayOnly = c
+		}
+	}
+	if numBlockRelayOnly != targetBlockRelayOnly {
+		t.Fatalf("block relay only: got %d connections want %d",
+			numBlockRelayOnly, targetBlockRelayOnly)
+	}
+	if n := atomic.LoadUint32(&numBlockRelayOnlyAddrs); n != targetBlockRelayOnly {
+		t.Fatalf("block relay only: got %d address requests want %d",
+			n, targetBlockRelayOnly)
+	}
+
+	// A block relay only connection is replaced by another one.
+	cmgr.Remove(blockRelayOnly.ID())
+	go cmgr.NewConnReq()
+	if c := <-connected; c.Class != ConnBlockRelayOnly {
+		t.Fatalf("block relay only: got %v connection want %v", c.Class,
+			ConnBlockRelayOnly)
+	}
+	cmgr.Stop()
+}
+
+// TestFeelerConnections tests that feeler connections are made once all of the
+// full relay connections are established, one at a time, and that they are not
+// retried when they fail.
+func TestFeelerConnections(t *testing.T) {
+	connected := make(chan *ConnReq)
+	var numFeelerDials uint32
+	cmgr, err := New(&Config{
+		TargetOutbound: 1,
+		FeelerInterval: time.Millisecond * 5,
+		Dial: func(addr net.Addr) (net.Conn, er.R) {
+			if addr.String() == "127.0.0.2:18555" {
+				atomic.AddUint32(&numFeelerDials, 1)
+				return nil, er.New("unreachable")
+			}
+			return mockDialer(addr)
+		},
+		GetNewAddress: func(class ConnClass) (net.Addr, er.R) {
+			ip := "127.0.0.1"
+			if class == ConnFeeler {
+				ip = "127.0.0.2"
+			}
+			return &net.TCPAddr{IP: net.ParseIP(ip), Port: 18555}, nil
+		},
+		OnConnection: func(c *ConnReq, conn net.Conn) {
+			connected <- c
+		},
+	})
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	cmgr.Start()
+	if c := <-connected; c.Class != ConnFullRelay {
+		t.Fatalf("got %v connection want %v", c.Class, ConnFullRelay)
+	}
+
+	// Failed feeler connections are not retried, but new ones are made at
+	// each interval.
+	time.Sleep(time.Millisecond * 100)
+	if n := atomic.LoadUint32(&numFeelerDials); n < 2 || n > 20 {
+		t.Fatalf("feeler: got %d connection attempts", n)
+	}
+	select {
+	case c := <-connected:
+		t.Fatalf("feeler: got unexpected connection - %v", c)
+	default:
+	}
+	cmgr.Stop()
+}
+
 // TestRetryPermanent tests that permanent connection requests are retried.
 //
 // We make a permanent connection request using Connect, disconnect it using
@@ -363,7 +469,7 @@ func TestNetworkFailure(t *testing.T) {
 		TargetOutbound: 5,
 		RetryDuration:  5 * time.Millisecond,
 		Dial:           errDialer,
-		GetNewAddress: func() (net.Addr, er.R) {
+		GetNewAddress: func(ConnClass) (net.Addr, er.R) {
 			return &net.TCPAddr{
 				IP:   net.ParseIP("127.0.0.1"),
 				Port: 18555,
@@ -683,4 +789,31 @@ func TestConnReqString(t *testing.T) {
 	_ = cr1.String()
 	cr2 := &ConnReq{}
 	_ = cr2.String()
+	cr3 := &ConnReq{
+		Addr: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 18555,
+		},
+		Class: ConnFeeler,
+	}
+	_ = cr3.String()
+}
+
+// TestConnClassStringer tests the stringized output for the ConnClass type.
+func TestConnClassStringer(t *testing.T) {
+	tests := []struct {
+		in   ConnClass
+		want string
+	}{
+		{ConnFullRelay, "full-relay"},
+		{ConnBlockRelayOnly, "block-relay-only"},
+		{ConnFeeler, "feeler"},
+		{0xff, "Unknown ConnClass (255)"},
+	}
+	for i, test := range tests {
+		if result := test.in.String(); result != test.want {
+			t.Errorf("String #%d\n got: %s want: %s", i, result,
+				test.want)
+		}
+	}
 }
@@ -47,6 +47,7 @@ Application Options:
       --rpcmaxclients=        Max number of RPC clients for standard connections (default: 10)
       --rpcmaxwebsockets=     Max number of RPC websocket connections (default: 25)
       --rpcmaxconcurrentreqs= Max number of concurrent RPC requests that may be processed concurrently (default: 20)
+      --rpcmaxbatch=          Max number of requests in a single JSON-RPC batch (default: 1000)
       --rpcquirks             Mirror some JSON-RPC quirks of Bitcoin Core -- NOTE: Discouraged unless interoperability issues need to be worked around
       --norpc                 Disable built-in RPC server -- NOTE: The RPC server is disabled by default if no rpcuser/rpcpass or rpclimituser/rpclimitpass is specified
       --tls                   Enable TLS for the RPC server -- default is disabled unless bound to non-localhost
@@ -69,8 +70,10 @@ Application Options:
       --minrelaytxfee=        The minimum transaction fee in BTC/kB to be considered a non-zero fee. (default: -1)
       --limitfreerelay=       Limit relay of transactions with no transaction fee to the given amount in thousands of bytes per minute (default: 15)
       --norelaypriority       Do not require free or low-fee transactions to have high priority for relaying
-      --trickleinterval=      Minimum time between attempts to send new inventory to a connected peer (default: 2s)
+      --trickleinterval=      Average time between attempts to send new inventory to an outbound peer, the actual delays are random (default: 1s)
+      --inboundtrickleinterval= Average time between attempts to send new inventory to an inbound peer, the actual delays are random (default: 5s)
       --maxorphantx=          Max number of orphan transactions to keep in memory (default: 100)
+      --maxmempool=           Max total virtual size of the transactions in the mempool in megabytes, the ones with the lowest fee rates are evicted beyond it -- 0 to disable the limit (default: 300)
       --generate              Generate (mine) bitcoins using the CPU
       --miningaddr=           Add the specified payment address to the list of addresses to use for generated blocks -- At least one address is required if the generate option is set
       --blockminsize=         Mininum block size in bytes to be used when creating a block
@@ -83,11 +86,19 @@ Application Options:
       --nocfilters            Disable committed filtering (CF) support
       --dropcfindex           Deletes the index used for committed filtering (CF) support from the database on start up and then exits.
       --sigcachemaxsize=      The maximum number of entries in the signature verification cache (default: 100000)
+      --anncachemaxsize=      The maximum number of entries in the PacketCrypt announcement verification cache (default: 50000)
+      --pcproofworkers=       The number of goroutines validating the PacketCrypt proofs of downloaded blocks ahead of them being connected (0 = one per CPU)
+      --pcannrelay            Relay PacketCrypt announcements between peers which support it and keep them in an announcement pool for solo block mining
+      --maxpcanns=            The maximum number of PacketCrypt announcements kept in the announcement pool (default: 4096)
+      --nopersistmempool      Do not save the mempool on shutdown and load it on startup
+      --mempoolexpiry=        Do not load saved mempool transactions older than this on startup.  Valid time units are {s, m, h} (default: 336h0m0s)
       --blocksonly            Do not accept transactions from remote peers.
       --txindex               Maintain a full hash-based transaction index which makes all transactions available via the getrawtransaction RPC
       --droptxindex           Deletes the hash-based transaction index from the database on start up and then exits.
       --addrindex             Maintain a full address-based transaction index which makes the searchrawtransactions RPC available
       --dropaddrindex         Deletes the address-based transaction index from the database on start up and then exits.
+      --spentindex            Maintain an index of the input spending each output which makes the getspentinfo RPC available
+      --dropspentindex        Deletes the spent output index from the database on start up and then exits.
       --relaynonstd           Relay non-standard transactions regardless of the default settings for the active network.
       --rejectnonstd          Reject non-standard transactions regardless of the default settings for the active network.
       --rejectreplacement     Reject transactions that attempt to replace existing transactions within the mempool through the Replace-By-Fee (RBF) signaling policy.
@@ -33,7 +33,7 @@ require (
 	golang.org/x/crypto v0.0.0-20201124201722-c8d3bf9c5392
 	golang.org/x/net v0.0.0-20201110031124-69a78807bb2b // indirect
 	golang.org/x/sys v0.0.0-20201126233918-771906719818
-	golang.org/x/text v0.3.5-0.20201125200606-c27b9fd57aec // indirect
+	golang.org/x/text v0.3.5-0.20201125200606-c27b9fd57aec
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
 	google.golang.org/genproto v0.0.0-20201119123407-9b1e624d6bc4 // indirect
 	google.golang.org/grpc v1.35.0-dev.0.20201125005357-44e408dab41e
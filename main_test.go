@@ -0,0 +1,20 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/pkt-cash/pktd/chaincfg/globalcfg"
+)
+
+// TestMain selects the global chain configuration before running the tests,
+// it is needed by the tests which convert amounts, such as TestDebugScript,
+// or compute block subsidies, such as TestComputeBlockStats.
+func TestMain(m *testing.M) {
+	globalcfg.SelectConfig(globalcfg.BitcoinDefaults())
+	os.Exit(m.Run())
+}
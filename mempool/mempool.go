@@ -138,6 +138,12 @@ type Policy struct {
 	// of big orphans.
 	MaxOrphanTxSize int
 
+	// MaxPoolSize is the maximum total virtual size in bytes of the
+	// transactions in the pool.  When it is exceeded, the transactions
+	// with the lowest fee rates are evicted along with their descendants.
+	// A value of zero disables the limit.
+	MaxPoolSize int64
+
 	// MaxSigOpCostPerTx is the cumulative maximum cost of all the signature
 	// operations in a single transaction we will relay or mine.  It is a
 	// fraction of the max signature operations for a block.
@@ -161,6 +167,15 @@ type TxDesc struct {
 	// StartingPriority is the priority of the transaction when it was added
 	// to the pool.
 	StartingPriority float64
+
+	// The virtual size of the transaction, and the total fee including fee
+	// deltas and total virtual size of the transaction along with its
+	// descendants in the pool.  The totals are kept up to date as
+	// transactions enter and leave the pool, they are used to choose the
+	// transactions to evict when the pool is full.
+	virtualSize    int64
+	descendantFee  int64
+	descendantSize int64
 }
 
 // orphanTx is normal transaction that references an ancestor transaction
@@ -188,6 +203,23 @@ type TxPool struct {
 	pennyTotal    float64 // exponentially decaying total for penny spends.
 	lastPennyUnix int64   // unix time of last ``penny spend''
 
+	// feeDeltas holds the fee deltas set with PrioritiseTransaction by
+	// transaction hash, including those of transactions which are not in
+	// the pool yet.
+	feeDeltas map[chainhash.Hash]int64
+
+	// totalSize is the total virtual size of the transactions in the pool.
+	totalSize int64
+
+	// rollingMinFee is the fee rate in satoshi/kB transactions must pay to
+	// enter the pool after transactions were evicted to keep it under
+	// MaxPoolSize.  It decays over time once a block has been connected
+	// since it was raised, as tracked by rollingMinFeeHeight, and
+	// rollingMinFeeUpdate is the time it last decayed.
+	rollingMinFee       float64
+	rollingMinFeeHeight int32
+	rollingMinFeeUpdate time.Time
+
 	// nextExpireScan is the time after which the orphan pool will be
 	// scanned in order to evict orphans.  This is NOT a hard deadline as
 	// the scan will only run when an orphan is added to the pool as opposed
@@ -489,11 +521,17 @@ func (mp *TxPool) removeTransaction(tx *btcutil.Tx, removeRedeemers bool) {
 			mp.cfg.AddrIndex.RemoveUnconfirmedTx(txHash)
 		}
 
+		// The transaction and the descendants it still has in the pool
+		// are no longer descendants of its ancestors.
+		mp.addToAncestorTotals(txDesc.Tx, -txDesc.descendantFee,
+			-txDesc.descendantSize)
+
 		// Mark the referenced outpoints as unspent by the pool.
 		for _, txIn := range txDesc.Tx.MsgTx().TxIn {
 			delete(mp.outpoints, txIn.PreviousOutPoint)
 		}
 		delete(mp.pool, *txHash)
+		mp.totalSize -= txDesc.virtualSize
 		atomic.StoreInt64(&mp.lastUpdated, time.Now().Unix())
 	}
 }
@@ -538,22 +576,31 @@ func (mp *TxPool) RemoveDoubleSpends(tx *btcutil.Tx) {
 // This function MUST be called with the mempool lock held (for writes).
 func (mp *TxPool) addTransaction(utxoView *blockchain.UtxoViewpoint, tx *btcutil.Tx, height int32, fee int64) *TxDesc {
 	// Add the transaction to the pool and mark the referenced outpoints
-	// as spent by the pool.
+	// as spent by the pool.  The transaction has no descendants in the
+	// pool yet, they would be orphans.
+	vsize := GetTxVirtualSize(tx)
+	feeDelta := mp.feeDeltas[*tx.Hash()]
 	txD := &TxDesc{
 		TxDesc: mining.TxDesc{
 			Tx:       tx,
 			Added:    time.Now(),
 			Height:   height,
 			Fee:      fee,
-			FeePerKB: fee * 1000 / GetTxVirtualSize(tx),
+			FeePerKB: fee * 1000 / vsize,
+			FeeDelta: feeDelta,
 		},
 		StartingPriority: mining.CalcPriority(tx.MsgTx(), utxoView, height),
+		virtualSize:      vsize,
+		descendantFee:    fee + feeDelta,
+		descendantSize:   vsize,
 	}
 
 	mp.pool[*tx.Hash()] = txD
+	mp.totalSize += vsize
 	for _, txIn := range tx.MsgTx().TxIn {
 		mp.outpoints[txIn.PreviousOutPoint] = tx
 	}
+	mp.addToAncestorTotals(tx, fee+feeDelta, vsize)
 	atomic.StoreInt64(&mp.lastUpdated, time.Now().Unix())
 
 	// Add unconfirmed address index entries associated with the transaction
@@ -828,7 +875,7 @@ func (mp *TxPool) FetchTransaction(txHash *chainhash.Hash) (*btcutil.Tx, er.R) {
 // validateReplacement determines whether a transaction is deemed as a valid
 // replacement of all of its conflicts according to the RBF policy. If it is
 // valid, no error is returned. Otherwise, an error is returned indicating what
-// went wrong.
+// went wrong.  Fees include the fee deltas of prioritised transactions.
 //
 // This function MUST be called with the mempool lock held (for reads).
 func (mp *TxPool) validateReplacement(tx *btcutil.Tx,
@@ -870,15 +917,17 @@ func (mp *TxPool) validateReplacement(tx *btcutil.Tx,
 		conflictsParents = make(map[chainhash.Hash]struct{})
 	)
 	for hash, conflict := range conflicts {
-		if txFeeRate <= mp.pool[hash].FeePerKB {
+		conflictDesc := mp.pool[hash]
+		conflictFee := conflictDesc.Fee + conflictDesc.FeeDelta
+		conflictFeeRate := conflictFee * 1000 / GetTxVirtualSize(conflict)
+		if txFeeRate <= conflictFeeRate {
 			str := fmt.Sprintf("replacement transaction %v has an "+
 				"insufficient fee rate: needs more than %v, "+
-				"has %v", tx.Hash(), mp.pool[hash].FeePerKB,
-				txFeeRate)
+				"has %v", tx.Hash(), conflictFeeRate, txFeeRate)
 			return nil, txRuleError(wire.RejectInsufficientFee, str)
 		}
 
-		conflictsFee += mp.pool[hash].Fee
+		conflictsFee += conflictFee
 
 		// We'll track each conflict's parents to ensure the replacement
 		// isn't spending any new unconfirmed inputs.
@@ -1104,21 +1153,42 @@ func (mp *TxPool) maybeAcceptTransaction(tx *btcutil.Tx, isNew, rateLimit, rejec
 	// which is more desirable.  Therefore, as long as the size of the
 	// transaction does not exceeed 1000 less than the reserved space for
 	// high-priority transactions, don't require a fee for it.
+	//
+	// The fee delta of a prioritised transaction counts towards its fee in
+	// the policy checks below.
+	modifiedFee := txFee + mp.feeDeltas[*txHash]
 	serializedSize := GetTxVirtualSize(tx)
 	minFee := calcMinRequiredTxRelayFee(serializedSize,
 		mp.cfg.Policy.MinRelayTxFee)
-	if serializedSize >= (DefaultBlockPrioritySize-1000) && txFee < minFee {
+	if serializedSize >= (DefaultBlockPrioritySize-1000) && modifiedFee < minFee {
 		str := fmt.Sprintf("transaction %v has %d fees which is under "+
-			"the required amount of %d", txHash, txFee,
+			"the required amount of %d", txHash, modifiedFee,
 			minFee)
 		return nil, nil, txRuleError(wire.RejectInsufficientFee, str)
 	}
 
+	// Once transactions have been evicted to keep the pool under its size
+	// limit, require that new transactions pay at least the rolling
+	// minimum fee.  Transactions which are being added back to the memory
+	// pool from blocks that have been disconnected during a reorg are
+	// exempted.
+	if isNew {
+		poolMinFee := mp.minFee()
+		if poolMinFee > 0 && modifiedFee < calcMinRequiredTxRelayFee(
+			serializedSize, poolMinFee) {
+
+			str := fmt.Sprintf("transaction %v has %d fees which is "+
+				"under the mempool minimum fee of %v/kB", txHash,
+				modifiedFee, poolMinFee)
+			return nil, nil, txRuleError(wire.RejectInsufficientFee, str)
+		}
+	}
+
 	// Require that free transactions have sufficient priority to be mined
 	// in the next block.  Transactions which are being added back to the
 	// memory pool from blocks that have been disconnected during a reorg
 	// are exempted.
-	if isNew && !mp.cfg.Policy.DisableRelayPriority && txFee < minFee {
+	if isNew && !mp.cfg.Policy.DisableRelayPriority && modifiedFee < minFee {
 		currentPriority := mining.CalcPriority(tx.MsgTx(), utxoView,
 			nextBlockHeight)
 		if currentPriority <= mining.MinHighPriority() {
@@ -1131,7 +1201,7 @@ func (mp *TxPool) maybeAcceptTransaction(tx *btcutil.Tx, isNew, rateLimit, rejec
 
 	// Free-to-relay transactions are rate limited here to prevent
 	// penny-flooding with tiny transactions as a form of attack.
-	if rateLimit && txFee < minFee {
+	if rateLimit && modifiedFee < minFee {
 		nowUnix := time.Now().Unix()
 		// Decay passed data with an exponentially decaying ~10 minute
 		// window - matches bitcoind handling.
@@ -1157,17 +1227,27 @@ func (mp *TxPool) maybeAcceptTransaction(tx *btcutil.Tx, isNew, rateLimit, rejec
 	// we're processing a potential replacement.
 	var conflicts map[chainhash.Hash]*btcutil.Tx
 	if isReplacement {
-		conflicts, err = mp.validateReplacement(tx, txFee)
+		conflicts, err = mp.validateReplacement(tx, modifiedFee)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
 
 	// Verify crypto signatures for each input and reject the transaction if
-	// any don't verify.
-	err = blockchain.ValidateTransactionScripts(tx, utxoView,
-		txscript.StandardVerifyFlags, mp.cfg.SigCache,
-		mp.cfg.HashCache)
+	// any don't verify.  Witness version 1 spends are only validated as
+	// taproot once the taproot soft-fork is active.
+	scriptFlags := txscript.StandardVerifyFlags
+	if tx.MsgTx().HasWitness() {
+		taprootActive, err := mp.cfg.IsDeploymentActive(chaincfg.DeploymentTaproot)
+		if err != nil {
+			return nil, nil, err
+		}
+		if taprootActive {
+			scriptFlags |= txscript.ScriptVerifyTaproot
+		}
+	}
+	err = blockchain.ValidateTransactionScripts(tx, utxoView, scriptFlags,
+		mp.cfg.SigCache, mp.cfg.HashCache)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1188,6 +1268,15 @@ func (mp *TxPool) maybeAcceptTransaction(tx *btcutil.Tx, isNew, rateLimit, rejec
 	}
 	txD := mp.addTransaction(utxoView, tx, bestHeight, txFee)
 
+	// Evict the transactions with the lowest fee rates if the pool grew
+	// over its size limit, which may include the new transaction itself.
+	mp.trimToSize()
+	if !mp.isTransactionInPool(txHash) {
+		str := fmt.Sprintf("transaction %v was evicted from the full "+
+			"mempool", txHash)
+		return nil, nil, txRuleError(wire.RejectInsufficientFee, str)
+	}
+
 	log.Debugf("Accepted transaction %v (pool size: %v)", txHash,
 		len(mp.pool))
 
@@ -1379,6 +1468,40 @@ func (mp *TxPool) ProcessTransaction(tx *btcutil.Tx, allowOrphan, rateLimit bool
 	return nil, err
 }
 
+// PrioritiseTransaction adds feeDelta to the fee delta of the transaction with
+// the passed hash.  The fee delta counts towards the fee of the transaction in
+// the acceptance policy checks, when choosing transactions to evict and when
+// ordering transactions for inclusion in a block, but does not change the fee
+// actually paid.  Fee deltas may be set for transactions which are not in the
+// pool yet, they apply once the transaction is accepted.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) PrioritiseTransaction(hash *chainhash.Hash, feeDelta int64) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	delta := mp.feeDeltas[*hash] + feeDelta
+	if delta == 0 {
+		delete(mp.feeDeltas, *hash)
+	} else {
+		mp.feeDeltas[*hash] = delta
+	}
+	if txDesc, exists := mp.pool[*hash]; exists {
+		mp.setFeeDelta(txDesc, delta)
+	}
+	log.Debugf("Fee delta of transaction %v set to %d", hash, delta)
+}
+
+// ClearPrioritisation removes the fee delta of the transaction with the passed
+// hash.  It is used once the transaction has been mined.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) ClearPrioritisation(hash *chainhash.Hash) {
+	mp.mtx.Lock()
+	delete(mp.feeDeltas, *hash)
+	mp.mtx.Unlock()
+}
+
 // Count returns the number of transactions in the main pool.  It does not
 // include the orphan pool.
 //
@@ -1454,6 +1577,7 @@ func (mp *TxPool) RawMempoolVerbose() map[string]*btcjson.GetRawMempoolVerboseRe
 			Size:             int32(tx.MsgTx().SerializeSize()),
 			Vsize:            int32(GetTxVirtualSize(tx)),
 			Fee:              btcutil.Amount(desc.Fee).ToBTC(),
+			ModifiedFee:      btcutil.Amount(desc.Fee + desc.FeeDelta).ToBTC(),
 			Time:             desc.Added.Unix(),
 			Height:           int64(desc.Height),
 			StartingPriority: desc.StartingPriority,
@@ -1492,5 +1616,6 @@ func New(cfg *Config) *TxPool {
 		orphansByPrev:  make(map[wire.OutPoint]map[chainhash.Hash]*btcutil.Tx),
 		nextExpireScan: time.Now().Add(orphanExpireScanInterval),
 		outpoints:      make(map[wire.OutPoint]*btcutil.Tx),
+		feeDeltas:      make(map[chainhash.Hash]int64),
 	}
 }
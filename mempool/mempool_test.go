@@ -557,7 +557,7 @@ func TestOrphanReject(t *testing.T) {
 
 		// Ensure no transactions were reported as accepted.
 		if len(acceptedTxns) != 0 {
-			t.Fatal("ProcessTransaction: reported %d accepted "+
+			t.Fatalf("ProcessTransaction: reported %d accepted "+
 				"transactions from failed orphan attempt",
 				len(acceptedTxns))
 		}
@@ -1787,3 +1787,65 @@ func TestRBF(t *testing.T) {
 		}
 	}
 }
+
+// TestPrioritiseTransaction ensures that fee deltas set before a transaction
+// is seen count towards its fee in the acceptance policy, that they apply to
+// transactions in the pool and that they are taken into account when
+// replacing transactions.
+func TestPrioritiseTransaction(t *testing.T) {
+	harness, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	ctx := &testContext{t, harness}
+
+	// Free transactions are rate limited and rejected once the limit is
+	// reached.
+	harness.txPool.cfg.Policy.FreeTxRelayLimit = 0
+	coinbase := ctx.addCoinbaseTx(2)
+	freeTx, err := harness.CreateSignedTx(
+		[]spendableOutput{txOutToSpendableOut(coinbase, 0)}, 1, 0, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	if _, err := harness.txPool.ProcessTransaction(freeTx, false, true, 0); err == nil {
+		t.Fatalf("ProcessTransaction: free transaction accepted")
+	}
+
+	// A fee delta set before the transaction is seen lets it in.
+	harness.txPool.PrioritiseTransaction(freeTx.Hash(), 1000000)
+	if _, err := harness.txPool.ProcessTransaction(freeTx, false, true, 0); err != nil {
+		t.Fatalf("ProcessTransaction: prioritised transaction rejected: %v", err)
+	}
+	testPoolMembership(ctx, freeTx, false, true)
+	harness.txPool.PrioritiseTransaction(freeTx.Hash(), 500000)
+	verbose := harness.txPool.RawMempoolVerbose()[freeTx.Hash().String()]
+	if want := btcutil.Amount(1500000).ToBTC(); verbose.ModifiedFee != want ||
+		verbose.Fee != 0 {
+
+		t.Fatalf("RawMempoolVerbose: got fee %v modified fee %v want 0 "+
+			"and %v", verbose.Fee, verbose.ModifiedFee, want)
+	}
+
+	// The replacement must pay more than the modified fee of the
+	// transaction it replaces.
+	replacement, err := harness.CreateSignedTx(
+		[]spendableOutput{txOutToSpendableOut(coinbase, 0)}, 1, 1000000, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	_, err = harness.txPool.ProcessTransaction(replacement, false, false, 0)
+	if err == nil || !strings.Contains(err.String(), "insufficient fee rate") {
+		t.Fatalf("ProcessTransaction: got %v want insufficient fee rate", err)
+	}
+	harness.txPool.PrioritiseTransaction(freeTx.Hash(), -1500000)
+	if _, err := harness.txPool.ProcessTransaction(replacement, false, false, 0); err != nil {
+		t.Fatalf("ProcessTransaction: replacement rejected: %v", err)
+	}
+	testPoolMembership(ctx, freeTx, false, false)
+	if len(harness.txPool.feeDeltas) != 0 {
+		t.Fatalf("feeDeltas: %d deltas remain", len(harness.txPool.feeDeltas))
+	}
+}
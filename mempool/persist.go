@@ -0,0 +1,236 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/ruleerror"
+)
+
+const (
+	// mempoolSaveVersion is the version of the format the transactions of
+	// the pool are saved with.  Saved pools of another version are not
+	// loaded, the node starts with an empty pool instead.
+	mempoolSaveVersion = 1
+
+	// DefaultMempoolExpiry is the default age after which saved transactions
+	// are not loaded into the pool anymore.
+	DefaultMempoolExpiry = time.Hour * 24 * 14
+)
+
+// ErrMempoolLoadInterrupted is returned when loading saved transactions is
+// interrupted.
+var ErrMempoolLoadInterrupted = er.GenericErrorType.CodeWithDetail(
+	"ErrMempoolLoadInterrupted", "loading the mempool was interrupted")
+
+// LoadStats describes the outcome of loading saved transactions into the pool.
+type LoadStats struct {
+	// Loaded is the number of transactions added to the pool.
+	Loaded int
+
+	// Expired is the number of transactions which were not loaded because
+	// they were older than the expiry.
+	Expired int
+
+	// Failed is the number of transactions which were rejected, typically
+	// because they have been mined or double spent in the meantime.
+	Failed int
+
+	// AlreadyThere is the number of transactions which were already in the
+	// pool.
+	AlreadyThere int
+}
+
+// txDepth returns the number of transactions in the pool which the provided
+// transaction descends from along its longest chain of unconfirmed parents.
+// Depths are memoized in the passed map.
+//
+// This function MUST be called with the mempool lock held (for reads).
+func (mp *TxPool) txDepth(tx *btcutil.Tx, depths map[chainhash.Hash]int) int {
+	if depth, ok := depths[*tx.Hash()]; ok {
+		return depth
+	}
+	depth := 0
+	for _, txIn := range tx.MsgTx().TxIn {
+		parent, ok := mp.pool[txIn.PreviousOutPoint.Hash]
+		if !ok {
+			continue
+		}
+		if d := mp.txDepth(parent.Tx, depths) + 1; d > depth {
+			depth = d
+		}
+	}
+	depths[*tx.Hash()] = depth
+	return depth
+}
+
+// feeDeltaEntry is a fee delta of a transaction which is not in the pool.
+type feeDeltaEntry struct {
+	Hash  chainhash.Hash
+	Delta int64
+}
+
+// Save writes the transactions of the pool along with the time they entered
+// the pool and their fee delta, so that they can be loaded again with Load
+// after a restart.  Transactions are written after the unconfirmed
+// transactions they spend.  They are followed by the fee deltas of the
+// transactions which are not in the pool.  The orphan pool is not saved.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) Save(w io.Writer) er.R {
+	mp.mtx.RLock()
+	descs := make([]*TxDesc, 0, len(mp.pool))
+	depths := make(map[chainhash.Hash]int, len(mp.pool))
+	for _, desc := range mp.pool {
+		descs = append(descs, desc)
+		mp.txDepth(desc.Tx, depths)
+	}
+	var deltas []feeDeltaEntry
+	for hash, delta := range mp.feeDeltas {
+		if _, ok := mp.pool[hash]; !ok {
+			deltas = append(deltas, feeDeltaEntry{Hash: hash, Delta: delta})
+		}
+	}
+	mp.mtx.RUnlock()
+
+	sort.Slice(descs, func(i, j int) bool {
+		di, dj := depths[*descs[i].Tx.Hash()], depths[*descs[j].Tx.Hash()]
+		if di != dj {
+			return di < dj
+		}
+		return descs[i].Added.Before(descs[j].Added)
+	})
+
+	bw := bufio.NewWriter(w)
+	if errr := binary.Write(bw, binary.BigEndian, uint32(mempoolSaveVersion)); errr != nil {
+		return er.E(errr)
+	}
+	if errr := binary.Write(bw, binary.BigEndian, uint32(len(descs))); errr != nil {
+		return er.E(errr)
+	}
+	for _, desc := range descs {
+		entry := [2]int64{desc.Added.Unix(), desc.FeeDelta}
+		if errr := binary.Write(bw, binary.BigEndian, entry); errr != nil {
+			return er.E(errr)
+		}
+		if err := desc.Tx.MsgTx().Serialize(bw); err != nil {
+			return err
+		}
+	}
+	if errr := binary.Write(bw, binary.BigEndian, uint32(len(deltas))); errr != nil {
+		return er.E(errr)
+	}
+	if errr := binary.Write(bw, binary.BigEndian, deltas); errr != nil {
+		return er.E(errr)
+	}
+	return er.E(bw.Flush())
+}
+
+// Load adds the transactions written by Save to the pool through the same
+// checks as transactions received from peers.  Transactions which entered the
+// pool longer than expiry ago are dropped, as are transactions which are no
+// longer valid.  Transactions keep the time they first entered the pool, and
+// their fee delta unless one was already set with PrioritiseTransaction.  The
+// same goes for the fee deltas of transactions which were not in the pool.
+//
+// Loading stops with ErrMempoolLoadInterrupted when interrupt is closed.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) Load(r io.Reader, expiry time.Duration,
+	interrupt <-chan struct{}) (*LoadStats, er.R) {
+
+	br := bufio.NewReader(r)
+	var version, count uint32
+	if errr := binary.Read(br, binary.BigEndian, &version); errr != nil {
+		return nil, er.E(errr)
+	}
+	if version != mempoolSaveVersion {
+		return nil, er.Errorf("unsupported mempool version: expected %d "+
+			"found %d", mempoolSaveVersion, version)
+	}
+	if errr := binary.Read(br, binary.BigEndian, &count); errr != nil {
+		return nil, er.E(errr)
+	}
+
+	stats := &LoadStats{}
+	now := time.Now()
+	for i := uint32(0); i < count; i++ {
+		select {
+		case <-interrupt:
+			return stats, ErrMempoolLoadInterrupted.Default()
+		default:
+		}
+
+		var entry [2]int64
+		if errr := binary.Read(br, binary.BigEndian, &entry); errr != nil {
+			return stats, er.E(errr)
+		}
+		var msgTx wire.MsgTx
+		if err := msgTx.Deserialize(br); err != nil {
+			return stats, err
+		}
+
+		added := time.Unix(entry[0], 0)
+		if now.Sub(added) > expiry {
+			stats.Expired++
+			continue
+		}
+
+		tx := btcutil.NewTx(&msgTx)
+		mp.mtx.Lock()
+		if _, ok := mp.feeDeltas[*tx.Hash()]; !ok && entry[1] != 0 {
+			mp.feeDeltas[*tx.Hash()] = entry[1]
+		}
+		missingParents, txD, err := mp.maybeAcceptTransaction(tx, true,
+			false, true)
+		switch {
+		case ruleerror.ErrTxExistsInMempool.Is(err):
+			stats.AlreadyThere++
+		case err != nil:
+			log.Debugf("Failed to load transaction %v: %v", tx.Hash(), err)
+			stats.Failed++
+		case len(missingParents) > 0:
+			log.Debugf("Failed to load transaction %v: orphan", tx.Hash())
+			stats.Failed++
+		default:
+			txD.Added = added
+			stats.Loaded++
+		}
+		mp.mtx.Unlock()
+	}
+
+	if errr := binary.Read(br, binary.BigEndian, &count); errr != nil {
+		return stats, er.E(errr)
+	}
+	var deltas []feeDeltaEntry
+	for i := uint32(0); i < count; i++ {
+		var entry feeDeltaEntry
+		if errr := binary.Read(br, binary.BigEndian, &entry); errr != nil {
+			return stats, er.E(errr)
+		}
+		deltas = append(deltas, entry)
+	}
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	for _, entry := range deltas {
+		if _, ok := mp.feeDeltas[entry.Hash]; ok || entry.Delta == 0 {
+			continue
+		}
+		mp.feeDeltas[entry.Hash] = entry.Delta
+		if txDesc, exists := mp.pool[entry.Hash]; exists {
+			mp.setFeeDelta(txDesc, entry.Delta)
+		}
+	}
+	return stats, nil
+}
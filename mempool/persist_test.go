@@ -0,0 +1,134 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+)
+
+// TestSaveLoad ensures that the transactions of a saved pool are loaded in a
+// new pool along with the time they entered the pool and their fee delta, that
+// the fee deltas of transactions which are not in the pool are loaded too, that
+// expired transactions are dropped and that the fee estimator does not observe
+// the loaded transactions again.
+func TestSaveLoad(t *testing.T) {
+	harness, spendableOuts, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	estimator := NewFeeEstimator(DefaultEstimateFeeMaxRollback,
+		DefaultEstimateFeeMinRegisteredBlocks)
+	estimator.lastKnownHeight = harness.chain.BestHeight()
+	harness.txPool.cfg.FeeEstimator = estimator
+
+	chainedTxns, err := harness.CreateTxChain(spendableOuts[0], 3)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	// Add the children first so saving has to put them after their parent.
+	for _, tx := range chainedTxns[1:] {
+		if _, err := harness.txPool.ProcessTransaction(tx, true, false, 0); err != nil {
+			t.Fatalf("ProcessTransaction: %v", err)
+		}
+	}
+	if _, err := harness.txPool.ProcessTransaction(chainedTxns[0], false, false, 0); err != nil {
+		t.Fatalf("ProcessTransaction: %v", err)
+	}
+	if harness.txPool.Count() != len(chainedTxns) {
+		t.Fatalf("Count: got %d want %d", harness.txPool.Count(),
+			len(chainedTxns))
+	}
+	added := time.Now().Add(-time.Hour).Truncate(time.Second)
+	harness.txPool.pool[*chainedTxns[0].Hash()].Added = added
+	harness.txPool.PrioritiseTransaction(chainedTxns[1].Hash(), 1234)
+	unknownHash := chainhash.Hash{0x01}
+	harness.txPool.PrioritiseTransaction(&unknownHash, 5678)
+
+	var buf bytes.Buffer
+	if err := harness.txPool.Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	saved := buf.Bytes()
+	estimatorState := estimator.Save()
+
+	// Load the transactions in a new pool with a restored fee estimator,
+	// as done when restarting.
+	restored, err := RestoreFeeEstimator(estimatorState)
+	if err != nil {
+		t.Fatalf("RestoreFeeEstimator: %v", err)
+	}
+	cfg := harness.txPool.cfg
+	cfg.FeeEstimator = restored
+	harness.chain.SetHeight(harness.chain.BestHeight() + 1)
+	pool := New(&cfg)
+	stats, err := pool.Load(bytes.NewReader(saved), DefaultMempoolExpiry, nil)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if *stats != (LoadStats{Loaded: 3}) {
+		t.Fatalf("Load: unexpected stats %+v", *stats)
+	}
+	for _, tx := range chainedTxns {
+		if !pool.IsTransactionInPool(tx.Hash()) {
+			t.Fatalf("Load: transaction %v not loaded", tx.Hash())
+		}
+	}
+	if got := pool.pool[*chainedTxns[0].Hash()].Added; !got.Equal(added) {
+		t.Errorf("Load: got entry time %v want %v", got, added)
+	}
+	if got := pool.pool[*chainedTxns[1].Hash()].FeeDelta; got != 1234 {
+		t.Errorf("Load: got fee delta %d want 1234", got)
+	}
+	if got := pool.feeDeltas[unknownHash]; got != 5678 {
+		t.Errorf("Load: got fee delta %d want 5678", got)
+	}
+	if len(restored.observed) != len(estimator.observed) {
+		t.Errorf("Load: fee estimator observed %d transactions want %d",
+			len(restored.observed), len(estimator.observed))
+	}
+	for hash, o := range estimator.observed {
+		if restored.observed[hash].observed != o.observed {
+			t.Errorf("Load: transaction %v observed again", hash)
+		}
+	}
+
+	// Loading again finds the transactions in the pool.
+	stats, err = pool.Load(bytes.NewReader(saved), DefaultMempoolExpiry, nil)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if *stats != (LoadStats{AlreadyThere: 3}) {
+		t.Fatalf("Load: unexpected stats %+v", *stats)
+	}
+
+	// The expired parent is dropped and its children can't be loaded.  Fee
+	// deltas which were already set are kept.
+	pool = New(&cfg)
+	pool.PrioritiseTransaction(&unknownHash, 42)
+	stats, err = pool.Load(bytes.NewReader(saved), time.Minute*30, nil)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if *stats != (LoadStats{Expired: 1, Failed: 2}) {
+		t.Fatalf("Load: unexpected stats %+v", *stats)
+	}
+	if got := pool.feeDeltas[unknownHash]; got != 42 {
+		t.Errorf("Load: got fee delta %d want 42", got)
+	}
+
+	// Loading stops when interrupted.
+	interrupt := make(chan struct{})
+	close(interrupt)
+	_, err = New(&cfg).Load(bytes.NewReader(saved), DefaultMempoolExpiry,
+		interrupt)
+	if !ErrMempoolLoadInterrupted.Is(err) {
+		t.Fatalf("Load: got %v want interruption", err)
+	}
+}
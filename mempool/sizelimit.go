@@ -0,0 +1,220 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"container/heap"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+)
+
+const (
+	// DefaultMaxPoolSize is the default maximum total virtual size of the
+	// transactions in the pool, in megabytes.
+	DefaultMaxPoolSize = 300
+
+	// rollingMinFeeHalfLife is the time it takes the rolling minimum fee to
+	// decay to half of its value while the pool is at least half full.  It
+	// decays faster when the pool is emptier.
+	rollingMinFeeHalfLife = time.Hour * 12
+
+	// rollingMinFeeDecayInterval is the minimum time between two updates
+	// of the decaying rolling minimum fee.
+	rollingMinFeeDecayInterval = time.Second * 10
+)
+
+// addToAncestorTotals adds the passed fee and virtual size to the descendant
+// totals of the ancestors of the transaction in the pool.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) addToAncestorTotals(tx *btcutil.Tx, fee, size int64) {
+	for hash := range mp.txAncestors(tx, nil) {
+		desc := mp.pool[hash]
+		desc.descendantFee += fee
+		desc.descendantSize += size
+	}
+}
+
+// setFeeDelta sets the fee delta of a transaction in the pool, updating the
+// descendant totals of the transaction and its ancestors.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) setFeeDelta(txD *TxDesc, delta int64) {
+	diff := delta - txD.FeeDelta
+	txD.FeeDelta = delta
+	txD.descendantFee += diff
+	mp.addToAncestorTotals(txD.Tx, diff, 0)
+	atomic.StoreInt64(&mp.lastUpdated, time.Now().Unix())
+}
+
+// evictionFeeRate returns the fee rate in satoshi/kB of the package made of the
+// transaction and its descendants in the pool, or the fee rate of the
+// transaction alone when it is higher.  Fee deltas set with
+// PrioritiseTransaction are included.
+func (txD *TxDesc) evictionFeeRate() int64 {
+	feePerKB := (txD.Fee + txD.FeeDelta) * 1000 / txD.virtualSize
+	packageFeePerKB := txD.descendantFee * 1000 / txD.descendantSize
+	if packageFeePerKB > feePerKB {
+		return packageFeePerKB
+	}
+	return feePerKB
+}
+
+// evictionCandidate is a transaction of the pool along with the eviction fee
+// rate it had when it was queued.
+type evictionCandidate struct {
+	txD      *TxDesc
+	feePerKB int64
+}
+
+// evictionQueue is a priority queue of eviction candidates which pops the
+// candidate with the lowest fee rate first.  It implements heap.Interface.
+type evictionQueue []evictionCandidate
+
+// Len returns the number of candidates in the queue.  It is part of the
+// heap.Interface implementation.
+func (q evictionQueue) Len() int { return len(q) }
+
+// Less returns whether the candidate at index i has a lower fee rate than the
+// one at index j.  It is part of the heap.Interface implementation.
+func (q evictionQueue) Less(i, j int) bool { return q[i].feePerKB < q[j].feePerKB }
+
+// Swap swaps the candidates at the passed indices.  It is part of the
+// heap.Interface implementation.
+func (q evictionQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+// Push adds a candidate to the queue.  It is part of the heap.Interface
+// implementation.
+func (q *evictionQueue) Push(x interface{}) {
+	*q = append(*q, x.(evictionCandidate))
+}
+
+// Pop removes the last candidate of the queue and returns it.  It is part of
+// the heap.Interface implementation.
+func (q *evictionQueue) Pop() interface{} {
+	old := *q
+	c := old[len(old)-1]
+	old[len(old)-1] = evictionCandidate{}
+	*q = old[:len(old)-1]
+	return c
+}
+
+// trimToSize evicts the transactions with the lowest package fee rates, along
+// with their descendants, until the pool is under its size limit.  The rolling
+// minimum fee is raised above the fee rate of the evicted packages so that
+// transactions paying less are not accepted again right away.
+//
+// The transactions are queued by fee rate once per trim.  Evicting a package
+// only ever raises the fee rates of the ancestors which remain in the pool,
+// since it was the cheapest one, so a candidate whose fee rate has changed
+// since it was queued is queued again with its new rate rather than evicted.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) trimToSize() {
+	maxSize := mp.cfg.Policy.MaxPoolSize
+	if maxSize <= 0 || mp.totalSize <= maxSize {
+		return
+	}
+
+	queue := make(evictionQueue, 0, len(mp.pool))
+	for _, txD := range mp.pool {
+		queue = append(queue, evictionCandidate{txD, txD.evictionFeeRate()})
+	}
+	heap.Init(&queue)
+
+	numEvicted := len(mp.pool)
+	for mp.totalSize > maxSize && queue.Len() > 0 {
+		worst := heap.Pop(&queue).(evictionCandidate)
+
+		// Skip the descendants of the packages evicted so far.
+		if mp.pool[*worst.txD.Tx.Hash()] != worst.txD {
+			continue
+		}
+		if feePerKB := worst.txD.evictionFeeRate(); feePerKB != worst.feePerKB {
+			worst.feePerKB = feePerKB
+			heap.Push(&queue, worst)
+			continue
+		}
+
+		minFee := float64(worst.feePerKB +
+			int64(mp.cfg.Policy.MinRelayTxFee))
+		if minFee > mp.rollingMinFee {
+			mp.rollingMinFee = minFee
+		}
+		mp.removeTransaction(worst.txD.Tx, true)
+	}
+	numEvicted -= len(mp.pool)
+
+	mp.rollingMinFeeHeight = mp.cfg.BestHeight()
+	mp.rollingMinFeeUpdate = time.Now()
+	log.Debugf("Evicted %d transactions from the full mempool, minimum "+
+		"fee raised to %v/kB", numEvicted,
+		btcutil.Amount(mp.rollingMinFee))
+}
+
+// minFee returns the fee rate per kB transactions must pay to enter the pool,
+// or zero when no transactions have been evicted recently.  The rolling
+// minimum fee only starts decaying once a block has been connected since it
+// was last raised, and decays faster when the pool is less than half full.
+//
+// This function MUST be called with the mempool lock held (for writes).
+func (mp *TxPool) minFee() btcutil.Amount {
+	if mp.rollingMinFee == 0 {
+		return 0
+	}
+	if mp.cfg.BestHeight() == mp.rollingMinFeeHeight {
+		return btcutil.Amount(mp.rollingMinFee)
+	}
+
+	now := time.Now()
+	if elapsed := now.Sub(mp.rollingMinFeeUpdate); elapsed > rollingMinFeeDecayInterval {
+		halfLife := rollingMinFeeHalfLife
+		switch maxSize := mp.cfg.Policy.MaxPoolSize; {
+		case mp.totalSize < maxSize/4:
+			halfLife /= 4
+		case mp.totalSize < maxSize/2:
+			halfLife /= 2
+		}
+		mp.rollingMinFee /= math.Pow(2, float64(elapsed)/float64(halfLife))
+		mp.rollingMinFeeUpdate = now
+
+		// Stop requiring a fee once it has decayed well under the
+		// minimum relay fee.
+		if mp.rollingMinFee < float64(mp.cfg.Policy.MinRelayTxFee)/2 {
+			mp.rollingMinFee = 0
+			return 0
+		}
+	}
+
+	minFee := btcutil.Amount(mp.rollingMinFee)
+	if minFee < mp.cfg.Policy.MinRelayTxFee {
+		minFee = mp.cfg.Policy.MinRelayTxFee
+	}
+	return minFee
+}
+
+// MinFee returns the fee rate per kB transactions must currently pay to enter
+// the pool because transactions were evicted to keep it under its size limit,
+// or zero when there is no such requirement.  It is advertised to peers with
+// feefilter messages.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) MinFee() btcutil.Amount {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.minFee()
+}
+
+// Size returns the total virtual size of the transactions in the pool.
+//
+// This function is safe for concurrent access.
+func (mp *TxPool) Size() int64 {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	return mp.totalSize
+}
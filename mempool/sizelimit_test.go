@@ -0,0 +1,208 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/chaincfg"
+)
+
+// TestTrimToSize ensures that the transactions with the lowest fee rates are
+// evicted when the pool is over its size limit, that the minimum fee then
+// rises so that transactions paying as little are rejected, and that it decays
+// once a block has been connected.  Transactions paying less than the ones
+// filling the pool are evicted as soon as they are accepted.
+func TestTrimToSize(t *testing.T) {
+	harness, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	ctx := &testContext{t, harness}
+	coinbase := ctx.addCoinbaseTx(4)
+
+	fees := []btcutil.Amount{1000, 5000, 10000, 1000}
+	txs := make([]*btcutil.Tx, len(fees))
+	for i, fee := range fees {
+		txs[i], err = harness.CreateSignedTx(
+			[]spendableOutput{txOutToSpendableOut(coinbase, uint32(i))},
+			1, fee, false,
+		)
+		if err != nil {
+			t.Fatalf("unable to create transaction: %v", err)
+		}
+	}
+
+	// Only two of the transactions fit in the pool.
+	size := GetTxVirtualSize(txs[0])
+	harness.txPool.cfg.Policy.MaxPoolSize = size*2 + size/2
+	for _, tx := range txs[:3] {
+		if _, err := harness.txPool.ProcessTransaction(tx, false, false, 0); err != nil {
+			t.Fatalf("ProcessTransaction: %v", err)
+		}
+	}
+	testPoolMembership(ctx, txs[0], false, false)
+	testPoolMembership(ctx, txs[1], false, true)
+	testPoolMembership(ctx, txs[2], false, true)
+	if got := harness.txPool.Size(); got != GetTxVirtualSize(txs[1])+
+		GetTxVirtualSize(txs[2]) {
+
+		t.Fatalf("Size: got %d want the size of two transactions", got)
+	}
+
+	// The minimum fee is above the fee rate of the evicted transaction.
+	wantMinFee := btcutil.Amount(int64(fees[0])*1000/size) +
+		harness.txPool.cfg.Policy.MinRelayTxFee
+	if got := harness.txPool.MinFee(); got != wantMinFee {
+		t.Fatalf("MinFee: got %v want %v", got, wantMinFee)
+	}
+	if _, err := harness.txPool.ProcessTransaction(txs[3], false, false, 0); err == nil {
+		t.Fatalf("ProcessTransaction: transaction under the minimum " +
+			"fee accepted")
+	}
+
+	// The minimum fee doesn't decay before a block is connected.
+	harness.txPool.rollingMinFeeUpdate = time.Now().Add(-rollingMinFeeHalfLife * 2)
+	if got := harness.txPool.MinFee(); got != wantMinFee {
+		t.Fatalf("MinFee: got %v want %v", got, wantMinFee)
+	}
+
+	// It decays by half for each half-life once one is, and disappears
+	// once it decayed under half the minimum relay fee.
+	harness.chain.SetHeight(harness.chain.BestHeight() + 1)
+	if got := harness.txPool.MinFee(); got > wantMinFee/4 || got < wantMinFee/4-1 {
+		t.Fatalf("MinFee: got %v want %v", got, wantMinFee/4)
+	}
+	harness.txPool.rollingMinFeeUpdate = time.Now().Add(-rollingMinFeeHalfLife * 10)
+	if got := harness.txPool.MinFee(); got != 0 {
+		t.Fatalf("MinFee: got %v want 0", got)
+	}
+
+	// The transaction is then accepted, but evicted right away as it pays
+	// less than the transactions filling the pool.
+	if _, err := harness.txPool.ProcessTransaction(txs[3], false, false, 0); err == nil {
+		t.Fatalf("ProcessTransaction: transaction accepted in a full pool")
+	}
+	testPoolMembership(ctx, txs[3], false, false)
+	wantMinFee = btcutil.Amount(int64(fees[3])*1000/GetTxVirtualSize(txs[3])) +
+		harness.txPool.cfg.Policy.MinRelayTxFee
+	if got := harness.txPool.MinFee(); got != wantMinFee {
+		t.Fatalf("MinFee: got %v want %v", got, wantMinFee)
+	}
+}
+
+// TestTrimToSizeRescoresAncestors ensures that the fee rate of a transaction
+// whose cheap descendant has been evicted is raised before it is compared with
+// the rest of the pool again.
+func TestTrimToSizeRescoresAncestors(t *testing.T) {
+	harness, _, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	ctx := &testContext{t, harness}
+	coinbase := ctx.addCoinbaseTx(2)
+	pool := harness.txPool
+
+	// The parent pays little itself and has a cheap child and an expensive
+	// one, so its package fee rate rises once the cheap child is evicted,
+	// above the one of the unrelated transaction.
+	parent, err := harness.CreateSignedTx(
+		[]spendableOutput{txOutToSpendableOut(coinbase, 0)}, 2, 2000, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	cheapChild, err := harness.CreateSignedTx(
+		[]spendableOutput{txOutToSpendableOut(parent, 0)}, 1, 1000, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	child, err := harness.CreateSignedTx(
+		[]spendableOutput{txOutToSpendableOut(parent, 1)}, 1, 12000, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	unrelated, err := harness.CreateSignedTx(
+		[]spendableOutput{txOutToSpendableOut(coinbase, 1)}, 1, 5500, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to create transaction: %v", err)
+	}
+	for _, tx := range []*btcutil.Tx{parent, cheapChild, child, unrelated} {
+		if _, err := pool.ProcessTransaction(tx, false, false, 0); err != nil {
+			t.Fatalf("ProcessTransaction: %v", err)
+		}
+	}
+
+	pool.cfg.Policy.MaxPoolSize = GetTxVirtualSize(parent) +
+		GetTxVirtualSize(child)
+	pool.mtx.Lock()
+	pool.trimToSize()
+	pool.mtx.Unlock()
+	testPoolMembership(ctx, parent, false, true)
+	testPoolMembership(ctx, cheapChild, false, false)
+	testPoolMembership(ctx, child, false, true)
+	testPoolMembership(ctx, unrelated, false, false)
+}
+
+// TestDescendantTotals ensures that the descendant totals used to choose the
+// transactions to evict stay consistent with the pool as transactions enter
+// and leave it and as their fee deltas change.
+func TestDescendantTotals(t *testing.T) {
+	harness, spendableOuts, err := newPoolHarness(&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create test pool: %v", err)
+	}
+	chainedTxns, err := harness.CreateTxChain(spendableOuts[0], 4)
+	if err != nil {
+		t.Fatalf("unable to create transaction chain: %v", err)
+	}
+	pool := harness.txPool
+
+	checkTotals := func(step string) {
+		t.Helper()
+		for hash, txD := range pool.pool {
+			fee := txD.Fee + txD.FeeDelta
+			size := GetTxVirtualSize(txD.Tx)
+			for descHash := range pool.txDescendants(txD.Tx, nil) {
+				desc := pool.pool[descHash]
+				fee += desc.Fee + desc.FeeDelta
+				size += GetTxVirtualSize(desc.Tx)
+			}
+			if txD.descendantFee != fee || txD.descendantSize != size {
+				t.Fatalf("%s: transaction %v has totals %d/%d want "+
+					"%d/%d", step, hash, txD.descendantFee,
+					txD.descendantSize, fee, size)
+			}
+		}
+	}
+
+	for _, tx := range chainedTxns {
+		if _, err := pool.ProcessTransaction(tx, false, false, 0); err != nil {
+			t.Fatalf("ProcessTransaction: %v", err)
+		}
+	}
+	checkTotals("accept")
+
+	pool.PrioritiseTransaction(chainedTxns[2].Hash(), 5000)
+	checkTotals("prioritise")
+
+	// Removing a transaction which has been mined leaves its descendants
+	// in the pool.
+	pool.RemoveTransaction(chainedTxns[0], false)
+	checkTotals("mined")
+
+	pool.RemoveTransaction(chainedTxns[3], false)
+	checkTotals("remove leaf")
+
+	pool.RemoveTransaction(chainedTxns[1], true)
+	if pool.Count() != 0 {
+		t.Fatalf("Count: got %d want 0", pool.Count())
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/mempool"
+)
+
+// mempoolFilename is the name of the file the transactions of the mempool are
+// saved to in the data directory.
+const mempoolFilename = "mempool.dat"
+
+// mempoolPath returns the path of the file the mempool is saved to.
+func mempoolPath() string {
+	return filepath.Join(cfg.DataDir, mempoolFilename)
+}
+
+// loadMempool loads the transactions saved by saveMempool into the mempool.
+// Saving the mempool is only allowed once it has been loaded, so that the
+// saved transactions are not overwritten by a partially loaded mempool.  It
+// must be run as a goroutine.
+func (s *server) loadMempool() {
+	defer s.wg.Done()
+
+	f, errr := os.Open(mempoolPath())
+	if os.IsNotExist(errr) {
+		atomic.StoreInt32(&s.mempoolLoaded, 1)
+		return
+	}
+	if errr != nil {
+		srvrLog.Errorf("Unable to open saved mempool: %v", errr)
+		atomic.StoreInt32(&s.mempoolLoaded, 1)
+		return
+	}
+	defer f.Close()
+
+	stats, err := s.txMemPool.Load(f, cfg.MempoolExpiry, s.quit)
+	if mempool.ErrMempoolLoadInterrupted.Is(err) {
+		// Do not save the partially loaded mempool.
+		atomic.StoreInt32(&s.mempoolLoaded, -1)
+		return
+	}
+	atomic.StoreInt32(&s.mempoolLoaded, 1)
+	if err != nil {
+		srvrLog.Errorf("Unable to load saved mempool: %v", err)
+	}
+	if stats != nil {
+		srvrLog.Infof("Loaded %d mempool transactions (%d expired, %d "+
+			"failed, %d already in the mempool)", stats.Loaded,
+			stats.Expired, stats.Failed, stats.AlreadyThere)
+	}
+}
+
+// saveMempool saves the transactions of the mempool so that they can be loaded
+// again after a restart.  The file is replaced atomically so a crash while
+// saving does not lose the previously saved transactions.
+func (s *server) saveMempool() er.R {
+	if atomic.LoadInt32(&s.mempoolLoaded) != 1 {
+		return er.New("the mempool has not been loaded yet")
+	}
+
+	path := mempoolPath()
+	tmpPath := path + ".new"
+	f, errr := os.Create(tmpPath)
+	if errr != nil {
+		return er.E(errr)
+	}
+	if err := s.txMemPool.Save(f); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if errr := f.Sync(); errr != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return er.E(errr)
+	}
+	if errr := f.Close(); errr != nil {
+		os.Remove(tmpPath)
+		return er.E(errr)
+	}
+	if errr := os.Rename(tmpPath, path); errr != nil {
+		return er.E(errr)
+	}
+	srvrLog.Infof("Saved %d mempool transactions", s.txMemPool.Count())
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pkt-cash/pktd/mempool"
+)
+
+// TestLoadMempoolInterrupted ensures that a mempool whose loading was
+// interrupted is not saved over the saved transactions.
+func TestLoadMempoolInterrupted(t *testing.T) {
+	defer func(saved *config) { cfg = saved }(cfg)
+	cfg = &config{
+		DataDir:       t.TempDir(),
+		MempoolExpiry: mempool.DefaultMempoolExpiry,
+	}
+
+	// Claim a saved transaction so that loading has something to
+	// interrupt.
+	var buf bytes.Buffer
+	if err := mempool.New(&mempool.Config{}).Save(&buf); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	saved := buf.Bytes()
+	binary.BigEndian.PutUint32(saved[4:8], 1)
+	if errr := ioutil.WriteFile(mempoolPath(), saved, 0600); errr != nil {
+		t.Fatalf("WriteFile: %v", errr)
+	}
+
+	s := &server{
+		txMemPool: mempool.New(&mempool.Config{}),
+		quit:      make(chan struct{}),
+	}
+	close(s.quit)
+	s.wg.Add(1)
+	s.loadMempool()
+	if s.mempoolLoaded != -1 {
+		t.Fatalf("mempoolLoaded: got %d, want -1", s.mempoolLoaded)
+	}
+	if err := s.saveMempool(); err == nil {
+		t.Fatalf("saveMempool: partially loaded mempool was saved")
+	}
+	got, errr := ioutil.ReadFile(mempoolPath())
+	if errr != nil {
+		t.Fatalf("ReadFile: %v", errr)
+	}
+	if !bytes.Equal(got, saved) {
+		t.Fatalf("saved mempool was overwritten")
+	}
+}
+
+// TestLoadMempoolMissing ensures that the mempool can be saved when there was
+// no saved mempool to load.
+func TestLoadMempoolMissing(t *testing.T) {
+	defer func(saved *config) { cfg = saved }(cfg)
+	cfg = &config{
+		DataDir:       t.TempDir(),
+		MempoolExpiry: mempool.DefaultMempoolExpiry,
+	}
+
+	s := &server{
+		txMemPool: mempool.New(&mempool.Config{}),
+		quit:      make(chan struct{}),
+	}
+	s.wg.Add(1)
+	s.loadMempool()
+	if s.mempoolLoaded != 1 {
+		t.Fatalf("mempoolLoaded: got %d, want 1", s.mempoolLoaded)
+	}
+	if err := s.saveMempool(); err != nil {
+		t.Fatalf("saveMempool: %v", err)
+	}
+}
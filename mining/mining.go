@@ -60,6 +60,11 @@ type TxDesc struct {
 
 	// FeePerKB is the fee the transaction pays in Satoshi per 1000 bytes.
 	FeePerKB int64
+
+	// FeeDelta is added to the fee of the transaction when it is ordered
+	// for inclusion in a block, it is set with the prioritisetransaction
+	// RPC.  It does not change the fee collected by the coinbase.
+	FeeDelta int64
 }
 
 // TxSource represents a source of transactions to consider for inclusion in
@@ -622,8 +627,17 @@ mempoolLoop:
 		prioItem.priority = CalcPriority(tx.MsgTx(), utxos,
 			nextBlockHeight)
 
-		// Calculate the fee in Satoshi/kB.
+		// Calculate the fee in Satoshi/kB, including any fee delta of
+		// the transaction so that prioritised transactions are ordered
+		// by their modified fee.
 		prioItem.feePerKB = txDesc.FeePerKB
+		if txDesc.FeeDelta != 0 {
+			vsize := (blockchain.GetTransactionWeight(tx) +
+				(blockchain.WitnessScaleFactor - 1)) /
+				blockchain.WitnessScaleFactor
+			prioItem.feePerKB = (txDesc.Fee + txDesc.FeeDelta) * 1000 /
+				vsize
+		}
 		prioItem.fee = txDesc.Fee
 
 		// Add the transaction to the priority queue to mark it ready
@@ -659,6 +673,17 @@ mempoolLoop:
 	}
 	segwitActive := segwitState == blockchain.ThresholdActive
 
+	// Witness version 1 spends are only validated as taproot once the
+	// taproot soft-fork is active.
+	scriptFlags := txscript.StandardVerifyFlags
+	taprootState, err := g.chain.ThresholdState(chaincfg.DeploymentTaproot)
+	if err != nil {
+		return nil, err
+	}
+	if taprootState == blockchain.ThresholdActive {
+		scriptFlags |= txscript.ScriptVerifyTaproot
+	}
+
 	witnessIncluded := false
 
 	// Choose which transactions make it into the block.
@@ -798,8 +823,7 @@ mempoolLoop:
 		if g.policy.SkipChecks&CheckTxns == 0 {
 			startTime := time.Now()
 			err = blockchain.ValidateTransactionScripts(tx, blockUtxos,
-				txscript.StandardVerifyFlags, g.sigCache,
-				g.hashCache)
+				scriptFlags, g.sigCache, g.hashCache)
 			if err != nil {
 				log.Infof("Skipping tx %s due to error in "+
 					"ValidateTransactionScripts: %v", tx.Hash(), err)
@@ -6,8 +6,9 @@
 Package netsync implements a concurrency safe block syncing protocol. The
 SyncManager communicates with connected peers to perform an initial block
 download, keep the chain and unconfirmed transaction pool in sync, and announce
-new blocks connected to the chain. Currently the sync manager selects a single
-sync peer that it downloads all blocks from until it is up to date with the
-longest chain the sync peer is aware of.
+new blocks connected to the chain. The sync manager selects a sync peer that it
+downloads the block headers from until it is up to date with the longest chain
+the sync peer is aware of, while the blocks themselves are downloaded in
+parallel from all sync candidates, preferring the fastest peers.
 */
 package netsync
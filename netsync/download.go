@@ -0,0 +1,410 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netsync
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	peerpkg "github.com/pkt-cash/pktd/peer"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/ruleerror"
+)
+
+const (
+	// blockDownloadWindow is the number of blocks, starting at the next
+	// block to be processed, which may be requested at once in
+	// headers-first mode.  Blocks which arrive out of order are buffered
+	// until the blocks before them are processed, so the window also
+	// bounds the number of buffered blocks.
+	blockDownloadWindow = 1024
+
+	// maxBlocksInFlightPerPeer is the maximum number of blocks requested
+	// from a single peer at once in headers-first mode.
+	maxBlocksInFlightPerPeer = 16
+
+	// blockRequestTimeout is the time a peer has to deliver a requested
+	// block before the block is requested from another peer.
+	blockRequestTimeout = 30 * time.Second
+
+	// maxBlockStalls is the number of times the requests of a peer may
+	// time out before the peer is disconnected to make room for a faster
+	// one.
+	maxBlockStalls = 3
+
+	// maxPendingHeaders is the number of headers of unprocessed blocks
+	// after which no more headers are requested until the blocks catch
+	// up, which bounds the memory used by the header list.
+	maxPendingHeaders = 100000
+
+	// unmeasuredBlockTime is the delivery time assumed for peers which
+	// have not delivered a block yet, so that they are given a chance
+	// ahead of peers known to be slow.
+	unmeasuredBlockTime = 2 * time.Second
+)
+
+// blockRequest is a block which has been requested from a peer during
+// headers-first mode.
+type blockRequest struct {
+	node      *headerNode
+	peer      *peerpkg.Peer
+	requested time.Time
+}
+
+// downloadedBlock is a block which has been downloaded during headers-first
+// mode and is waiting for the blocks before it to be processed.
+type downloadedBlock struct {
+	block *btcutil.Block
+	peer  *peerpkg.Peer
+}
+
+// recordBlockTime updates the average time the peer takes to deliver a
+// requested block.
+func (state *peerSyncState) recordBlockTime(d time.Duration) {
+	if state.blockTime == 0 {
+		state.blockTime = d
+		return
+	}
+	state.blockTime = (state.blockTime*7 + d) / 8
+}
+
+// downloadScore ranks the peer for block downloads, lower is better.
+func (state *peerSyncState) downloadScore() time.Duration {
+	blockTime := state.blockTime
+	if blockTime == 0 {
+		blockTime = unmeasuredBlockTime
+	}
+	return blockTime * time.Duration(1+state.blockStalls)
+}
+
+// downloadPeer is a peer which blocks may be requested from.
+type downloadPeer struct {
+	peer  *peerpkg.Peer
+	state *peerSyncState
+}
+
+// rankDownloadPeers sorts the peers from the best to the worst download
+// score, breaking ties by peer id.
+func rankDownloadPeers(peers []downloadPeer) {
+	sort.Slice(peers, func(i, j int) bool {
+		si, sj := peers[i].state.downloadScore(), peers[j].state.downloadScore()
+		if si != sj {
+			return si < sj
+		}
+		return peers[i].peer.ID() < peers[j].peer.ID()
+	})
+}
+
+// downloadPeers returns the peers which blocks may be requested from, ranked
+// from the best to the worst.
+func (sm *SyncManager) downloadPeers() []downloadPeer {
+	peers := make([]downloadPeer, 0, len(sm.peerStates))
+	for peer, state := range sm.peerStates {
+		if !state.syncCandidate || !peer.Connected() {
+			continue
+		}
+		peers = append(peers, downloadPeer{peer: peer, state: state})
+	}
+	rankDownloadPeers(peers)
+	return peers
+}
+
+// fetchHeaderBlocks requests the blocks of the download window which have
+// been neither requested nor downloaded yet.  Each block is requested from
+// the best ranked peer which knows of it and has room for more requests.
+func (sm *SyncManager) fetchHeaderBlocks() {
+	front := sm.headerList.Front()
+	if front == nil {
+		return
+	}
+	peers := sm.downloadPeers()
+	if len(peers) == 0 {
+		log.Debugf("No peers available to download blocks from")
+		return
+	}
+
+	windowEnd := front.Value.(*headerNode).height + blockDownloadWindow
+	getData := make(map[*peerpkg.Peer]*wire.MsgGetData)
+	now := time.Now()
+	for e := front; e != nil; e = e.Next() {
+		node := e.Value.(*headerNode)
+		if node.height >= windowEnd {
+			break
+		}
+		if _, ok := sm.blockRequests[*node.hash]; ok {
+			continue
+		}
+		if _, ok := sm.downloadedBlocks[*node.hash]; ok {
+			continue
+		}
+
+		var dp *downloadPeer
+		for i := range peers {
+			p := &peers[i]
+			if len(p.state.requestedBlocks) < maxBlocksInFlightPerPeer &&
+				p.peer.LastBlock() >= node.height {
+				dp = p
+				break
+			}
+		}
+		if dp == nil {
+			// Every peer which knows of the block is busy, the
+			// block is requested once one of them delivers.
+			continue
+		}
+
+		sm.blockRequests[*node.hash] = &blockRequest{
+			node:      node,
+			peer:      dp.peer,
+			requested: now,
+		}
+		sm.requestedBlocks[*node.hash] = struct{}{}
+		dp.state.requestedBlocks[*node.hash] = struct{}{}
+
+		// If we're fetching from a witness enabled peer post-fork,
+		// then ensure that we receive all the witness data in the
+		// blocks.
+		iv := wire.NewInvVect(wire.InvTypeBlock, node.hash)
+		if dp.peer.IsWitnessEnabled() {
+			iv.Type = wire.InvTypeWitnessBlock
+		}
+		gdmsg, ok := getData[dp.peer]
+		if !ok {
+			gdmsg = wire.NewMsgGetData()
+			getData[dp.peer] = gdmsg
+		}
+		gdmsg.AddInvVect(iv)
+	}
+	for peer, gdmsg := range getData {
+		log.Tracef("Requesting %d blocks from peer %s", len(gdmsg.InvList),
+			peer)
+		peer.QueueMessage(gdmsg, nil)
+	}
+}
+
+// handleDownloadedBlock buffers a block downloaded in headers-first mode and
+// processes the buffered blocks which are next in height order.
+func (sm *SyncManager) handleDownloadedBlock(bmsg *blockMsg, state *peerSyncState) {
+	peer := bmsg.peer
+	blockHash := bmsg.block.Hash()
+	delete(state.requestedBlocks, *blockHash)
+	delete(sm.requestedBlocks, *blockHash)
+
+	req, ok := sm.blockRequests[*blockHash]
+	if !ok {
+		// The block was requested again from another peer which
+		// delivered it first, or the download was restarted.
+		log.Debugf("Ignoring block %v from %s which is no longer "+
+			"needed", blockHash, peer)
+		return
+	}
+	delete(sm.blockRequests, *blockHash)
+	if req.peer == peer {
+		state.recordBlockTime(time.Since(req.requested))
+	} else if reqState, exists := sm.peerStates[req.peer]; exists {
+		// The block was requested again after it timed out, but the
+		// first peer delivered it after all.
+		delete(reqState.requestedBlocks, *blockHash)
+	}
+	sm.downloadedBlocks[*blockHash] = &downloadedBlock{
+		block: bmsg.block,
+		peer:  peer,
+	}
+
+	// Validate the PacketCrypt proof while the block waits for the blocks
+	// before it.
+	sm.chain.QueuePcProofValidation(bmsg.block, sm.headerHash)
+
+	sm.processDownloadedBlocks()
+	if sm.headersFirstMode {
+		sm.fetchHeaderBlocks()
+	}
+}
+
+// headerHash returns the hash of the block at the provided height in the
+// best chain or among the headers of the blocks which have not been processed
+// yet, or nil when it is unknown.
+func (sm *SyncManager) headerHash(height int32) *chainhash.Hash {
+	if hash, err := sm.chain.BlockHashByHeight(height); err == nil {
+		return hash
+	}
+	for e := sm.headerList.Front(); e != nil; e = e.Next() {
+		node := e.Value.(*headerNode)
+		if node.height == height {
+			return node.hash
+		}
+		if node.height > height {
+			break
+		}
+	}
+	return nil
+}
+
+// processDownloadedBlocks processes the buffered blocks in height order,
+// stopping at the first block which has not been downloaded yet.  Blocks up
+// to the latest verified checkpoint are eligible for less validation since
+// their headers have been verified to link together up to the checkpoint.
+func (sm *SyncManager) processDownloadedBlocks() {
+	processed := false
+	for e := sm.headerList.Front(); e != nil; e = sm.headerList.Front() {
+		node := e.Value.(*headerNode)
+		dl, ok := sm.downloadedBlocks[*node.hash]
+		if !ok {
+			break
+		}
+		delete(sm.downloadedBlocks, *node.hash)
+		sm.headerList.Remove(e)
+
+		behaviorFlags := blockchain.BFNone
+		if node.height <= sm.fastAddHeight {
+			behaviorFlags |= blockchain.BFFastAdd
+		}
+		_, isOrphan, err := sm.chain.ProcessBlock(dl.block, behaviorFlags)
+		if ruleerror.ErrPowCannotVerify.Is(err) {
+			err = nil
+		}
+		if err == nil && isOrphan {
+			err = ruleerror.ErrPrevBlockNotBest.New("block "+
+				node.hash.String()+" does not connect to the "+
+				"downloaded headers", nil)
+		}
+		if err != nil {
+			sm.rejectBlock(dl.block, dl.peer, err)
+
+			// The headers can no longer be trusted, start over
+			// from the best chain.
+			sm.abortHeadersFirst()
+			return
+		}
+		processed = true
+		sm.lastProgressTime = time.Now()
+		sm.progressLogger.LogBlockHeight(dl.block)
+		if dl.peer.LastBlock() < node.height {
+			dl.peer.UpdateLastBlockHeight(node.height)
+		}
+	}
+	if processed {
+		// Clear the rejected transactions.
+		sm.rejectedTxns = make(map[chainhash.Hash]struct{})
+	}
+
+	// Resume downloading headers once the blocks have caught up.
+	if !sm.headersSynced && !sm.headersRequested && sm.syncPeer != nil &&
+		sm.headerList.Len() < maxPendingHeaders/2 {
+
+		sm.requestHeaders(sm.syncPeer)
+	}
+
+	if sm.headerList.Len() == 0 && sm.headersSynced {
+		sm.finishHeadersFirst()
+	}
+}
+
+// handleBlockRequestTimeouts requests the blocks which were not delivered in
+// time from other peers.  Peers whose requests time out repeatedly are
+// disconnected, unless they are the only peer to download from.
+func (sm *SyncManager) handleBlockRequestTimeouts() {
+	now := time.Now()
+	stalled := make(map[*peerpkg.Peer]*peerSyncState)
+	for hash, req := range sm.blockRequests {
+		if now.Sub(req.requested) < blockRequestTimeout {
+			continue
+		}
+		state, exists := sm.peerStates[req.peer]
+		if !exists {
+			delete(sm.blockRequests, hash)
+			continue
+		}
+		sm.staleBlockRequest(hash, state)
+		stalled[req.peer] = state
+	}
+	if len(stalled) == 0 {
+		return
+	}
+
+	numPeers := len(sm.downloadPeers())
+	for peer, state := range stalled {
+		state.blockStalls++
+		log.Debugf("Block requests to peer %s timed out (%d times)",
+			peer, state.blockStalls)
+		if state.blockStalls >= maxBlockStalls && numPeers > 1 {
+			log.Infof("Disconnecting slow peer %s", peer)
+			peer.Disconnect()
+			numPeers--
+		}
+	}
+	sm.fetchHeaderBlocks()
+}
+
+// staleBlockRequest drops the pending request of a block from the peer with
+// the provided state so the block can be requested from another peer.  The
+// peer may still deliver the block, in which case it is ignored.
+func (sm *SyncManager) staleBlockRequest(hash chainhash.Hash, state *peerSyncState) {
+	delete(sm.blockRequests, hash)
+	delete(sm.requestedBlocks, hash)
+	delete(state.requestedBlocks, hash)
+	state.staleBlocks[hash] = struct{}{}
+}
+
+// releaseBlockRequests drops the pending block requests to a peer which has
+// disconnected so that the blocks are requested from other peers.
+func (sm *SyncManager) releaseBlockRequests(peer *peerpkg.Peer) {
+	for hash, req := range sm.blockRequests {
+		if req.peer == peer {
+			delete(sm.blockRequests, hash)
+		}
+	}
+}
+
+// abortHeadersFirst drops the downloaded headers and blocks and leaves
+// headers-first mode.  The pending block requests become stale so the blocks
+// are ignored if they arrive.  Syncing resumes from the best chain with the
+// next sync peer.
+func (sm *SyncManager) abortHeadersFirst() {
+	for hash, req := range sm.blockRequests {
+		if state, exists := sm.peerStates[req.peer]; exists {
+			sm.staleBlockRequest(hash, state)
+		}
+	}
+	best := sm.chain.BestSnapshot()
+	sm.resetHeaderState(&best.Hash, best.Height)
+}
+
+// finishHeadersFirst leaves headers-first mode once all blocks for the
+// downloaded headers have been processed, and asks the sync peer for any
+// blocks it learned of in the meantime.
+func (sm *SyncManager) finishHeadersFirst() {
+	best := sm.chain.BestSnapshot()
+	sm.resetHeaderState(&best.Hash, best.Height)
+	log.Infof("Downloaded all blocks to height %d -- switching to "+
+		"normal mode", best.Height)
+	if sm.syncPeer == nil {
+		return
+	}
+	locator := blockchain.BlockLocator([]*chainhash.Hash{&best.Hash})
+	err := sm.syncPeer.PushGetBlocksMsg(locator, &zeroHash)
+	if err != nil {
+		log.Warnf("Failed to send getblocks message to peer %s: %v",
+			sm.syncPeer.Addr(), err)
+	}
+}
+
+// requestHeaders asks the peer for the headers following the latest
+// downloaded header, up to the tip of its chain.
+func (sm *SyncManager) requestHeaders(peer *peerpkg.Peer) {
+	locator := blockchain.BlockLocator([]*chainhash.Hash{sm.headerTip.hash})
+	err := peer.PushGetHeadersMsg(locator, &zeroHash)
+	if err != nil {
+		log.Warnf("Failed to send getheaders message to peer %s: %v",
+			peer.Addr(), err)
+		return
+	}
+	sm.headersRequested = true
+	log.Infof("Downloading headers for blocks %d to %d from peer %s",
+		sm.headerTip.height+1, peer.LastBlock(), peer.Addr())
+}
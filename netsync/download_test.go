@@ -0,0 +1,610 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netsync
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/chaincfg/genesis"
+	"github.com/pkt-cash/pktd/chaincfg/globalcfg"
+	"github.com/pkt-cash/pktd/database/ffldb"
+	"github.com/pkt-cash/pktd/mempool"
+	peerpkg "github.com/pkt-cash/pktd/peer"
+	"github.com/pkt-cash/pktd/pktlog"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/txscript/scriptbuilder"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+	"github.com/pkt-cash/pktd/wire/protocol"
+)
+
+func TestMain(m *testing.M) {
+	globalcfg.SelectConfig(globalcfg.BitcoinDefaults())
+	UseLogger(pktlog.Disabled)
+	os.Exit(m.Run())
+}
+
+// testPeer is a peer handed to the SyncManager.  Its remote end is played by
+// the test, which answers the version handshake and records the requests it
+// receives.
+type testPeer struct {
+	peer       *peerpkg.Peer
+	remote     net.Conn
+	getData    chan *wire.MsgGetData
+	getHeaders chan *wire.MsgGetHeaders
+	getBlocks  chan *wire.MsgGetBlocks
+}
+
+// testPeerServices are the services advertised by the remote end of the test
+// peers.
+const testPeerServices = protocol.SFNodeNetwork | protocol.SFNodeWitness
+
+// newTestPeer connects a peer to a remote end which claims to have the chain
+// up to the provided height.
+func newTestPeer(t *testing.T, params *chaincfg.Params, id int, height int32) *testPeer {
+	local, remote := net.Pipe()
+	tp := &testPeer{
+		remote:     remote,
+		getData:    make(chan *wire.MsgGetData, 10),
+		getHeaders: make(chan *wire.MsgGetHeaders, 10),
+		getBlocks:  make(chan *wire.MsgGetBlocks, 10),
+	}
+	verack := make(chan struct{})
+	go tp.serveRemote(params, height, verack)
+
+	var err er.R
+	tp.peer, err = peerpkg.NewOutboundPeer(&peerpkg.Config{
+		ChainParams: params,
+		Services:    testPeerServices,
+	}, fmt.Sprintf("10.0.0.%d:8333", id))
+	if err != nil {
+		t.Fatalf("NewOutboundPeer: unexpected err %v", err)
+	}
+	tp.peer.AssociateConnection(local)
+	select {
+	case <-verack:
+	case <-time.After(time.Second):
+		t.Fatalf("verack timeout")
+	}
+	return tp
+}
+
+// serveRemote plays the remote end of the peer until the connection is
+// closed.  The verack channel is closed once the handshake is done.
+func (tp *testPeer) serveRemote(params *chaincfg.Params, height int32, verack chan struct{}) {
+	pver := protocol.ProtocolVersion
+	for {
+		msg, _, err := wire.ReadMessage(tp.remote, pver, params.Net)
+		if err != nil {
+			return
+		}
+		switch msg := msg.(type) {
+		case *wire.MsgVersion:
+			me := wire.NewNetAddressIPPort(net.ParseIP("10.0.1.1"), 8333,
+				testPeerServices)
+			version := wire.NewMsgVersion(me, &msg.AddrMe, 1, height)
+			version.Services = testPeerServices
+			if wire.WriteMessage(tp.remote, version, pver, params.Net) != nil ||
+				wire.WriteMessage(tp.remote, wire.NewMsgVerAck(), pver,
+					params.Net) != nil {
+
+				return
+			}
+		case *wire.MsgVerAck:
+			close(verack)
+		case *wire.MsgGetData:
+			tp.getData <- msg
+		case *wire.MsgGetHeaders:
+			tp.getHeaders <- msg
+		case *wire.MsgGetBlocks:
+			tp.getBlocks <- msg
+		}
+	}
+}
+
+// disconnect disconnects the peer and closes its remote end.
+func (tp *testPeer) disconnect() {
+	tp.peer.Disconnect()
+	tp.remote.Close()
+}
+
+// nextGetData returns the next getdata message received by the remote end of
+// the peer, or nil if none is received in time.
+func (tp *testPeer) nextGetData() *wire.MsgGetData {
+	select {
+	case msg := <-tp.getData:
+		return msg
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	}
+}
+
+// makeBlocks creates a chain of blocks containing only a coinbase on top of
+// the genesis block of the provided regression test parameters.
+func makeBlocks(t *testing.T, params *chaincfg.Params, count int) []*btcutil.Block {
+	prev := genesis.Block(params.GenesisHash).Header
+	blocks := make([]*btcutil.Block, 0, count)
+	for i := 1; i <= count; i++ {
+		coinbaseScript, err := scriptbuilder.NewScriptBuilder().
+			AddInt64(int64(i)).AddInt64(0).Script()
+		if err != nil {
+			t.Fatalf("unable to build coinbase script: %v", err)
+		}
+		coinbase := wire.NewMsgTx(1)
+		coinbase.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: *wire.NewOutPoint(&chainhash.Hash{},
+				constants.MaxPrevOutIndex),
+			Sequence:        constants.MaxTxInSequenceNum,
+			SignatureScript: coinbaseScript,
+		})
+		coinbase.AddTxOut(wire.NewTxOut(0, []byte{opcode.OP_TRUE}))
+		merkles := blockchain.BuildMerkleTreeStore(
+			[]*btcutil.Tx{btcutil.NewTx(coinbase)}, false)
+
+		msgBlock := wire.MsgBlock{
+			Header: wire.BlockHeader{
+				Version:    1,
+				PrevBlock:  prev.BlockHash(),
+				MerkleRoot: *merkles[len(merkles)-1],
+				Timestamp:  prev.Timestamp.Add(time.Minute),
+				Bits:       params.PowLimitBits,
+			},
+			Transactions: []*wire.MsgTx{coinbase},
+		}
+		target := blockchain.CompactToBig(params.PowLimitBits)
+		for {
+			hash := msgBlock.Header.BlockHash()
+			if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+				break
+			}
+			msgBlock.Header.Nonce++
+		}
+		blocks = append(blocks, btcutil.NewBlock(&msgBlock))
+		prev = msgBlock.Header
+	}
+	return blocks
+}
+
+// syncHarness drives a SyncManager syncing a chain of generated blocks from
+// test peers.  The handlers are called directly in place of the block handler
+// goroutine.
+type syncHarness struct {
+	t      *testing.T
+	params *chaincfg.Params
+	blocks []*btcutil.Block
+	sm     *SyncManager
+	peers  []*testPeer
+
+	teardown func()
+}
+
+// newSyncHarness creates a SyncManager for a chain holding only the genesis
+// block, with checkpoints at the provided heights of the generated blocks.
+// The parameters are a copy of the regression test parameters so that the
+// SyncManager uses headers-first mode.
+func newSyncHarness(t *testing.T, numBlocks int, checkpointHeights ...int32) *syncHarness {
+	params := chaincfg.RegressionNetParams
+	blocks := makeBlocks(t, &params, numBlocks)
+	checkpoints := make([]chaincfg.Checkpoint, 0, len(checkpointHeights))
+	for _, height := range checkpointHeights {
+		checkpoints = append(checkpoints, chaincfg.Checkpoint{
+			Height: height,
+			Hash:   blocks[height-1].Hash(),
+		})
+	}
+
+	dbPath, errr := ioutil.TempDir("", "netsynctest")
+	if errr != nil {
+		t.Fatalf("unable to create temp dir: %v", errr)
+	}
+	db, err := ffldb.OpenDB(filepath.Join(dbPath, "db"), params.Net, true)
+	if err != nil {
+		os.RemoveAll(dbPath)
+		t.Fatalf("unable to create db: %v", err)
+	}
+	chain, err := blockchain.New(&blockchain.Config{
+		DB:          db,
+		ChainParams: &params,
+		Checkpoints: checkpoints,
+		TimeSource:  blockchain.NewMedianTime(),
+		SigCache:    txscript.NewSigCache(1000),
+	})
+	if err != nil {
+		db.Close()
+		os.RemoveAll(dbPath)
+		t.Fatalf("unable to create chain: %v", err)
+	}
+	sm, err := New(&Config{
+		PeerNotifier: nopPeerNotifier{},
+		Chain:        chain,
+		ChainParams:  &params,
+		MaxPeers:     8,
+	})
+	if err != nil {
+		db.Close()
+		os.RemoveAll(dbPath)
+		t.Fatalf("unable to create sync manager: %v", err)
+	}
+
+	h := &syncHarness{
+		t:      t,
+		params: &params,
+		blocks: blocks,
+		sm:     sm,
+	}
+	h.teardown = func() {
+		for _, tp := range h.peers {
+			tp.disconnect()
+		}
+		db.Close()
+		os.RemoveAll(dbPath)
+	}
+	return h
+}
+
+// nopPeerNotifier is a PeerNotifier which does nothing.
+type nopPeerNotifier struct{}
+
+func (nopPeerNotifier) AnnounceNewTransactions([]*mempool.TxDesc)               {}
+func (nopPeerNotifier) UpdatePeerHeights(*chainhash.Hash, int32, *peerpkg.Peer) {}
+func (nopPeerNotifier) RelayInventory(*wire.InvVect, interface{})               {}
+func (nopPeerNotifier) TransactionConfirmed(*btcutil.Tx)                        {}
+
+// addPeer connects a peer which claims to have the chain up to the provided
+// height and hands it to the SyncManager.
+func (h *syncHarness) addPeer(height int32) *testPeer {
+	tp := newTestPeer(h.t, h.params, len(h.peers)+1, height)
+	h.peers = append(h.peers, tp)
+	h.sm.handleNewPeerMsg(tp.peer)
+	return tp
+}
+
+// sendHeaders delivers the headers of the generated blocks from the first
+// to the last height.
+func (h *syncHarness) sendHeaders(tp *testPeer, first, last int32) {
+	msg := wire.NewMsgHeaders()
+	for height := first; height <= last; height++ {
+		msg.AddBlockHeader(&h.blocks[height-1].MsgBlock().Header)
+	}
+	h.sm.handleHeadersMsg(&headersMsg{headers: msg, peer: tp.peer})
+}
+
+// sendBlock delivers the generated block at the provided height.
+func (h *syncHarness) sendBlock(tp *testPeer, height int32) {
+	h.sm.handleBlockMsg(&blockMsg{block: h.blocks[height-1], peer: tp.peer})
+}
+
+// expectGetData checks the next getdata message received by the peer asks
+// for the witness blocks from the first to the last height.
+func (h *syncHarness) expectGetData(tp *testPeer, first, last int32) {
+	h.t.Helper()
+	msg := tp.nextGetData()
+	if msg == nil {
+		h.t.Fatalf("peer %s did not receive a getdata message for blocks "+
+			"%d to %d", tp.peer, first, last)
+	}
+	if len(msg.InvList) != int(last-first+1) {
+		h.t.Fatalf("peer %s was asked for %d blocks, want %d", tp.peer,
+			len(msg.InvList), last-first+1)
+	}
+	for i, iv := range msg.InvList {
+		height := first + int32(i)
+		if iv.Type != wire.InvTypeWitnessBlock ||
+			iv.Hash != *h.blocks[height-1].Hash() {
+
+			h.t.Fatalf("peer %s was asked for %v, want the block at "+
+				"height %d", tp.peer, iv, height)
+		}
+	}
+}
+
+// expectNoGetData checks the peer receives no getdata message.
+func (h *syncHarness) expectNoGetData(tp *testPeer) {
+	h.t.Helper()
+	if msg := tp.nextGetData(); msg != nil {
+		h.t.Fatalf("peer %s was unexpectedly asked for %d blocks",
+			tp.peer, len(msg.InvList))
+	}
+}
+
+// bestHeight returns the height of the best chain.
+func (h *syncHarness) bestHeight() int32 {
+	return h.sm.chain.BestSnapshot().Height
+}
+
+// startDownload connects two peers with the full chain and delivers all of
+// the headers from the sync peer, which is returned first.  The first 16
+// blocks are requested from the sync peer and the rest from the other peer.
+func (h *syncHarness) startDownload(numBlocks int32) (*testPeer, *testPeer) {
+	h.t.Helper()
+	a := h.addPeer(numBlocks)
+	if h.sm.syncPeer != a.peer || !h.sm.headersFirstMode {
+		h.t.Fatalf("sync did not start in headers-first mode")
+	}
+	select {
+	case <-a.getHeaders:
+	case <-time.After(time.Second):
+		h.t.Fatalf("sync peer did not receive a getheaders message")
+	}
+	b := h.addPeer(numBlocks)
+	h.expectNoGetData(a)
+	h.expectNoGetData(b)
+
+	h.sendHeaders(a, 1, numBlocks)
+	h.expectGetData(a, 1, maxBlocksInFlightPerPeer)
+	h.expectGetData(b, maxBlocksInFlightPerPeer+1, h.sm.headerTip.height)
+	return a, b
+}
+
+func TestRecordBlockTime(t *testing.T) {
+	state := &peerSyncState{}
+	if score := state.downloadScore(); score != unmeasuredBlockTime {
+		t.Fatalf("unmeasured peer has score %v, want %v", score,
+			unmeasuredBlockTime)
+	}
+	tests := []struct {
+		blockTime time.Duration
+		want      time.Duration
+	}{
+		{8 * time.Second, 8 * time.Second},
+		{16 * time.Second, 9 * time.Second},
+		{time.Second, 8 * time.Second},
+	}
+	for i, test := range tests {
+		state.recordBlockTime(test.blockTime)
+		if state.blockTime != test.want {
+			t.Fatalf("%d: average block time is %v, want %v", i,
+				state.blockTime, test.want)
+		}
+	}
+	state.blockStalls = 2
+	if score := state.downloadScore(); score != 24*time.Second {
+		t.Fatalf("stalled peer has score %v, want %v", score,
+			24*time.Second)
+	}
+}
+
+func TestRankDownloadPeers(t *testing.T) {
+	params := chaincfg.RegressionNetParams
+	var peers []*testPeer
+	for i := 0; i < 4; i++ {
+		tp := newTestPeer(t, &params, i+1, 0)
+		defer tp.disconnect()
+		peers = append(peers, tp)
+	}
+
+	// The unmeasured peer ties with the stalled peer which has a fast
+	// block time, so they are ranked by id.
+	states := []*peerSyncState{
+		{},
+		{blockTime: time.Second, blockStalls: 1},
+		{blockTime: 3 * time.Second},
+		{blockTime: time.Second / 2},
+	}
+	ranked := []downloadPeer{
+		{peers[2].peer, states[2]},
+		{peers[1].peer, states[1]},
+		{peers[0].peer, states[0]},
+		{peers[3].peer, states[3]},
+	}
+	rankDownloadPeers(ranked)
+	for i, want := range []int{3, 0, 1, 2} {
+		if ranked[i].peer != peers[want].peer {
+			t.Fatalf("peer %s ranked at %d, want %s", ranked[i].peer, i,
+				peers[want].peer)
+		}
+	}
+}
+
+func TestHeadersFirstDownload(t *testing.T) {
+	h := newSyncHarness(t, 30, 12, 24)
+	defer h.teardown()
+
+	// The headers are downloaded up to the tip of the sync peer, past the
+	// final checkpoint.
+	a, b := h.startDownload(30)
+	if h.sm.headerTip.height != 30 || !h.sm.headersSynced {
+		t.Fatalf("header tip is at height %d, want 30",
+			h.sm.headerTip.height)
+	}
+	if h.sm.fastAddHeight != 24 {
+		t.Fatalf("fast add height is %d, want 24", h.sm.fastAddHeight)
+	}
+
+	// Blocks delivered out of order wait for the blocks before them.
+	for height := int32(30); height > 1; height-- {
+		tp := a
+		if height > maxBlocksInFlightPerPeer {
+			tp = b
+		}
+		h.sendBlock(tp, height)
+		if h.bestHeight() != 0 {
+			t.Fatalf("block at height %d was processed before the "+
+				"block at height 1", height)
+		}
+		if len(h.sm.downloadedBlocks) != int(31-height) {
+			t.Fatalf("%d blocks are buffered, want %d",
+				len(h.sm.downloadedBlocks), 31-height)
+		}
+	}
+	if !a.peer.Connected() || !b.peer.Connected() {
+		t.Fatalf("peer was disconnected")
+	}
+	if h.sm.peerStates[a.peer].blockTime == 0 {
+		t.Fatalf("block time of the peer was not recorded")
+	}
+
+	// The first block releases all of them, and the sync peer is asked for
+	// any blocks it learned of in the meantime.
+	h.sendBlock(a, 1)
+	if h.bestHeight() != 30 {
+		t.Fatalf("best height is %d, want 30", h.bestHeight())
+	}
+	if h.sm.headersFirstMode || len(h.sm.downloadedBlocks) != 0 ||
+		len(h.sm.blockRequests) != 0 {
+
+		t.Fatalf("headers-first mode did not finish")
+	}
+	select {
+	case msg := <-a.getBlocks:
+		if *msg.BlockLocatorHashes[0] != *h.blocks[29].Hash() {
+			t.Fatalf("getblocks starts from %v, want the tip",
+				msg.BlockLocatorHashes[0])
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("sync peer did not receive a getblocks message")
+	}
+}
+
+func TestInvalidHeaderAfterCheckpoint(t *testing.T) {
+	h := newSyncHarness(t, 8, 4)
+	defer h.teardown()
+	a := h.addPeer(8)
+
+	// A header past the final checkpoint without the required proof of work
+	// makes the sync peer misbehaving.
+	header := h.blocks[5].MsgBlock().Header
+	header.Bits--
+	msg := wire.NewMsgHeaders()
+	for height := int32(1); height <= 5; height++ {
+		msg.AddBlockHeader(&h.blocks[height-1].MsgBlock().Header)
+	}
+	msg.AddBlockHeader(&header)
+	h.sm.handleHeadersMsg(&headersMsg{headers: msg, peer: a.peer})
+	if a.peer.Connected() {
+		t.Fatalf("peer sending an invalid header was not disconnected")
+	}
+	if h.sm.headersFirstMode || h.sm.headerList.Len() != 0 ||
+		h.sm.headerTip.height != 0 {
+
+		t.Fatalf("headers of the misbehaving peer were kept")
+	}
+	h.expectNoGetData(a)
+}
+
+func TestBlockRequestTimeout(t *testing.T) {
+	h := newSyncHarness(t, 24, 24)
+	defer h.teardown()
+	a, b := h.startDownload(24)
+	aState := h.sm.peerStates[a.peer]
+
+	timeoutRequests := func(tp *testPeer) {
+		for _, req := range h.sm.blockRequests {
+			if req.peer == tp.peer {
+				req.requested = time.Now().Add(-blockRequestTimeout)
+			}
+		}
+		h.sm.handleBlockRequestTimeouts()
+	}
+
+	// The blocks of the stalled peer are requested from the other peer as
+	// far as it has room, since it is ranked first now, and the rest from
+	// the stalled peer again.
+	timeoutRequests(a)
+	if aState.blockStalls != 1 {
+		t.Fatalf("peer has %d stalls, want 1", aState.blockStalls)
+	}
+	if len(aState.staleBlocks) != maxBlocksInFlightPerPeer {
+		t.Fatalf("peer has %d stale blocks, want %d",
+			len(aState.staleBlocks), maxBlocksInFlightPerPeer)
+	}
+	h.expectGetData(b, 1, 8)
+	h.expectGetData(a, 9, 16)
+
+	// The stalled peer delivering the block after all is ignored.
+	h.sendBlock(a, 1)
+	if h.bestHeight() != 0 || len(h.sm.downloadedBlocks) != 0 {
+		t.Fatalf("stale block was not ignored")
+	}
+	if !a.peer.Connected() {
+		t.Fatalf("peer delivering a stale block was disconnected")
+	}
+	h.sendBlock(b, 1)
+	if h.bestHeight() != 1 {
+		t.Fatalf("best height is %d, want 1", h.bestHeight())
+	}
+
+	// A peer which stalls too often is disconnected.
+	aState.blockStalls = maxBlockStalls - 1
+	timeoutRequests(a)
+	if a.peer.Connected() {
+		t.Fatalf("stalling peer was not disconnected")
+	}
+	if !b.peer.Connected() {
+		t.Fatalf("other peer was disconnected")
+	}
+}
+
+func TestBlockRequestTimeoutOnlyPeer(t *testing.T) {
+	h := newSyncHarness(t, 8, 8)
+	defer h.teardown()
+	a := h.addPeer(8)
+	h.sendHeaders(a, 1, 8)
+	h.expectGetData(a, 1, 8)
+
+	// The only peer is asked again instead of being disconnected.
+	aState := h.sm.peerStates[a.peer]
+	aState.blockStalls = maxBlockStalls - 1
+	for _, req := range h.sm.blockRequests {
+		req.requested = time.Now().Add(-blockRequestTimeout)
+	}
+	h.sm.handleBlockRequestTimeouts()
+	if !a.peer.Connected() {
+		t.Fatalf("only peer was disconnected")
+	}
+	if aState.blockStalls != maxBlockStalls {
+		t.Fatalf("peer has %d stalls, want %d", aState.blockStalls,
+			maxBlockStalls)
+	}
+	h.expectGetData(a, 1, 8)
+}
+
+func TestAbortHeadersFirst(t *testing.T) {
+	h := newSyncHarness(t, 24, 24)
+	defer h.teardown()
+	a, b := h.startDownload(24)
+
+	h.sendBlock(a, 2)
+	h.sm.abortHeadersFirst()
+	if h.sm.headersFirstMode || h.sm.headerList.Len() != 0 ||
+		len(h.sm.blockRequests) != 0 || len(h.sm.downloadedBlocks) != 0 {
+
+		t.Fatalf("headers-first state was not reset")
+	}
+	if h.sm.headerTip.height != 0 ||
+		*h.sm.headerTip.hash != *h.params.GenesisHash {
+
+		t.Fatalf("header tip is at height %d, want the best chain",
+			h.sm.headerTip.height)
+	}
+	if n := len(h.sm.peerStates[a.peer].staleBlocks); n != 15 {
+		t.Fatalf("sync peer has %d stale blocks, want 15", n)
+	}
+	if n := len(h.sm.peerStates[b.peer].staleBlocks); n != 8 {
+		t.Fatalf("peer has %d stale blocks, want 8", n)
+	}
+
+	// The blocks which were requested are ignored when they arrive.
+	h.sendBlock(a, 1)
+	h.sendBlock(b, 17)
+	if h.bestHeight() != 0 || len(h.sm.downloadedBlocks) != 0 {
+		t.Fatalf("block requested before the abort was not ignored")
+	}
+	if !a.peer.Connected() || !b.peer.Connected() {
+		t.Fatalf("peer was disconnected")
+	}
+}
@@ -27,11 +27,6 @@ import (
 )
 
 const (
-	// minInFlightBlocks is the minimum number of blocks that should be
-	// in the request queue for headers-first mode before requesting
-	// more.
-	minInFlightBlocks = 10
-
 	// maxRejectedTxns is the maximum number of rejected transactions
 	// hashes to store in memory.
 	maxRejectedTxns = 1200
@@ -150,7 +145,7 @@ type pauseMsg struct {
 }
 
 // headerNode is used as a node in a list of headers that are linked together
-// between checkpoints.
+// during headers-first mode.
 type headerNode struct {
 	height int32
 	hash   *chainhash.Hash
@@ -166,6 +161,14 @@ type peerSyncState struct {
 	syncPeerMutex   sync.RWMutex
 	syncPeer        *peerpkg.Peer
 	peerStates      map[*peerpkg.Peer]*peerSyncState
+
+	// The following fields are used for downloading blocks in
+	// headers-first mode.  The stale blocks are blocks which were
+	// requested from the peer but have since been requested from another
+	// peer or are no longer needed.
+	staleBlocks map[chainhash.Hash]struct{}
+	blockTime   time.Duration
+	blockStalls int
 }
 
 // SyncManager is used to communicate block related messages with peers. The
@@ -193,11 +196,22 @@ type SyncManager struct {
 	peerStates       map[*peerpkg.Peer]*peerSyncState
 	lastProgressTime time.Time
 
-	// The following fields are used for headers-first mode.
+	// The following fields are used for headers-first mode.  The header
+	// list holds the headers of the blocks which have not been processed
+	// yet, in height order, and the header tip is the latest downloaded
+	// header.  Blocks up to the fast add height, the height of the latest
+	// checkpoint verified by the headers, need less validation.  The
+	// header chain checks the proof of work of the headers.
 	headersFirstMode bool
+	headersSynced    bool
+	headersRequested bool
 	headerList       *list.List
-	startHeader      *list.Element
+	headerTip        *headerNode
+	headerChain      *blockchain.HeaderChain
+	fastAddHeight    int32
 	nextCheckpoint   *chaincfg.Checkpoint
+	blockRequests    map[chainhash.Hash]*blockRequest
+	downloadedBlocks map[chainhash.Hash]*downloadedBlock
 
 	// An optional fee estimator.
 	feeEstimator  *mempool.FeeEstimator
@@ -214,16 +228,18 @@ func (sm *SyncManager) SyncPeer() *peerpkg.Peer {
 // syncing from a new peer.
 func (sm *SyncManager) resetHeaderState(newestHash *chainhash.Hash, newestHeight int32) {
 	sm.headersFirstMode = false
+	sm.headersSynced = false
+	sm.headersRequested = false
 	sm.headerList.Init()
-	sm.startHeader = nil
-
-	// When there is a next checkpoint, add an entry for the latest known
-	// block into the header pool.  This allows the next downloaded header
-	// to prove it links to the chain properly.
-	if sm.nextCheckpoint != nil {
-		node := headerNode{height: newestHeight, hash: newestHash}
-		sm.headerList.PushBack(&node)
-	}
+	sm.blockRequests = make(map[chainhash.Hash]*blockRequest)
+	sm.downloadedBlocks = make(map[chainhash.Hash]*downloadedBlock)
+	sm.fastAddHeight = newestHeight
+	sm.nextCheckpoint = sm.findNextHeaderCheckpoint(newestHeight)
+
+	// The latest known block is the header tip, this allows the next
+	// downloaded header to prove it links to the chain properly.
+	sm.headerTip = &headerNode{height: newestHeight, hash: newestHash}
+	sm.headerChain = sm.chain.NewHeaderChain()
 }
 
 // findNextHeaderCheckpoint returns the next checkpoint after the passed height.
@@ -313,9 +329,8 @@ func (sm *SyncManager) startSync() {
 
 	// Pick randomly from the set of peers greater than our block height,
 	// falling back to a random peer of the same height if none are greater.
-	//
-	// TODO(conner): Use a better algorithm to ranking peers based on
-	// observed metrics and/or sync in parallel.
+	// The headers are only downloaded from this peer, the blocks are
+	// downloaded in parallel from all candidates.
 	var bestPeer *peerpkg.Peer
 	switch {
 	case len(higherPeers) > 0:
@@ -327,51 +342,61 @@ func (sm *SyncManager) startSync() {
 
 	// Start syncing from the best peer if one was selected.
 	if bestPeer != nil {
-		// Clear the requestedBlocks if the sync peer changes, otherwise
-		// we may ignore blocks we need that the last sync peer failed
-		// to send.
-		sm.requestedBlocks = make(map[chainhash.Hash]struct{})
-
-		locator, err := sm.chain.LatestBlockLocator()
-		if err != nil {
-			log.Errorf("Failed to get block locator for the "+
-				"latest block: %v", err)
-			return
-		}
-
 		log.Infof("Syncing to block height %d from peer %v",
 			bestPeer.LastBlock(), bestPeer.Addr())
+		sm.syncPeer = bestPeer
 
-		// When the current height is less than a known checkpoint we
-		// can use block headers to learn about which blocks comprise
-		// the chain up to the checkpoint and perform less validation
-		// for them.  This is possible since each header contains the
-		// hash of the previous header and a merkle root.  Therefore if
-		// we validate all of the received headers link together
-		// properly and the checkpoint hashes match, we can be sure the
-		// hashes for the blocks in between are accurate.  Further, once
-		// the full blocks are downloaded, the merkle root is computed
-		// and compared against the value in the header which proves the
-		// full block hasn't been tampered with.
+		// When the sync peer is ahead of us, we use block headers to
+		// learn about which blocks comprise the chain up to its tip,
+		// and download the blocks in parallel from all candidate
+		// peers.  This is possible since each header contains the hash
+		// of the previous header and a merkle root.  Therefore if we
+		// validate all of the received headers link together properly,
+		// we can be sure which block to expect at each height.
+		// Further, once the full blocks are downloaded, the merkle
+		// root is computed and compared against the value in the
+		// header which proves the full block hasn't been tampered with.
+		// Blocks up to a checkpoint matching the headers perform less
+		// validation, later blocks are fully validated.  The headers
+		// after the final checkpoint must carry the required proof of
+		// work, so a peer can't make us download a fake chain.
 		//
-		// Once we have passed the final checkpoint, or checkpoints are
-		// disabled, use standard inv messages learn about the blocks
-		// and fully validate them.  Finally, regression test mode does
-		// not support the headers-first approach so do normal block
-		// downloads when in regression test mode.
-		if sm.nextCheckpoint != nil &&
-			best.Height < sm.nextCheckpoint.Height &&
-			sm.chainParams != &chaincfg.RegressionNetParams {
-
-			bestPeer.PushGetHeadersMsg(locator, sm.nextCheckpoint.Hash)
+		// When a previous sync peer was lost during headers-first
+		// mode, the headers and blocks downloaded so far are kept and
+		// the new sync peer continues from the latest header.
+		// Finally, regression test mode does not support the
+		// headers-first approach so do normal block downloads when in
+		// regression test mode.
+		if sm.headersFirstMode && !sm.headersSynced &&
+			bestPeer.LastBlock() < sm.headerTip.height {
+
+			// The new sync peer is behind the latest header, so it
+			// can't continue from there.
+			sm.abortHeadersFirst()
+		}
+		if sm.chainParams != &chaincfg.RegressionNetParams &&
+			(sm.headersFirstMode || bestPeer.LastBlock() > best.Height) {
+
 			sm.headersFirstMode = true
-			log.Infof("Downloading headers for blocks %d to "+
-				"%d from peer %s", best.Height+1,
-				sm.nextCheckpoint.Height, bestPeer.Addr())
+			if !sm.headersSynced {
+				sm.requestHeaders(bestPeer)
+			}
+			sm.fetchHeaderBlocks()
 		} else {
+			// Clear the requestedBlocks if the sync peer changes,
+			// otherwise we may ignore blocks we need that the last
+			// sync peer failed to send.
+			sm.requestedBlocks = make(map[chainhash.Hash]struct{})
+
+			locator, err := sm.chain.LatestBlockLocator()
+			if err != nil {
+				log.Errorf("Failed to get block locator for the "+
+					"latest block: %v", err)
+				sm.syncPeer = nil
+				return
+			}
 			bestPeer.PushGetBlocksMsg(locator, &zeroHash)
 		}
-		sm.syncPeer = bestPeer
 
 		// Reset the last progress time now that we have a non-nil
 		// syncPeer to avoid instantly detecting it as stalled in the
@@ -446,11 +471,15 @@ func (sm *SyncManager) handleNewPeerMsg(peer *peerpkg.Peer) {
 		syncCandidate:   isSyncCandidate,
 		requestedTxns:   make(map[chainhash.Hash]struct{}),
 		requestedBlocks: make(map[chainhash.Hash]struct{}),
+		staleBlocks:     make(map[chainhash.Hash]struct{}),
 	}
 
-	// Start syncing by choosing the best candidate if needed.
+	// Start syncing by choosing the best candidate if needed, otherwise
+	// have the new peer help download the blocks.
 	if isSyncCandidate && sm.syncPeer == nil {
 		sm.startSync()
+	} else if isSyncCandidate && sm.headersFirstMode {
+		sm.fetchHeaderBlocks()
 	}
 }
 
@@ -463,6 +492,12 @@ func (sm *SyncManager) handleStallSample() {
 		return
 	}
 
+	// Request the blocks which were not delivered in time from other
+	// peers.
+	if sm.headersFirstMode {
+		sm.handleBlockRequestTimeouts()
+	}
+
 	// If we don't have an active sync peer, exit early.
 	if sm.syncPeer == nil {
 		return
@@ -481,6 +516,13 @@ func (sm *SyncManager) handleStallSample() {
 
 	sm.clearRequestedState(state)
 
+	// No blocks could be processed for a while, so the headers of the
+	// sync peer may not match any blocks the other peers have.  Start over
+	// with the next sync peer.
+	if sm.headersFirstMode {
+		sm.abortHeadersFirst()
+	}
+
 	disconnectSyncPeer := sm.shouldDCStalledSyncPeer()
 	sm.updateSyncPeer(disconnectSyncPeer)
 }
@@ -524,11 +566,15 @@ func (sm *SyncManager) handleDonePeerMsg(peer *peerpkg.Peer) {
 	log.Infof("Lost peer %s", peer)
 
 	sm.clearRequestedState(state)
+	sm.releaseBlockRequests(peer)
 
 	if peer == sm.syncPeer {
 		// Update the sync peer. The server has already disconnected the
 		// peer before signaling to the sync manager.
 		sm.updateSyncPeer(false)
+	} else if sm.headersFirstMode {
+		// Request the blocks of the peer from other peers.
+		sm.fetchHeaderBlocks()
 	}
 }
 
@@ -553,8 +599,8 @@ func (sm *SyncManager) clearRequestedState(state *peerSyncState) {
 
 // updateSyncPeer choose a new sync peer to replace the current one. If
 // dcSyncPeer is true, this method will also disconnect the current sync peer.
-// If we are in header first mode, any header state related to prefetching is
-// also reset in preparation for the next sync peer.
+// If we are in headers-first mode, the next sync peer continues downloading
+// the headers from the latest one received.
 func (sm *SyncManager) updateSyncPeer(dcSyncPeer bool) {
 	log.Debugf("Updating sync peer, no progress for: %v",
 		time.Since(sm.lastProgressTime))
@@ -564,12 +610,6 @@ func (sm *SyncManager) updateSyncPeer(dcSyncPeer bool) {
 		sm.syncPeer.Disconnect()
 	}
 
-	// Reset any header state before we choose our next active sync peer.
-	if sm.headersFirstMode {
-		best := sm.chain.BestSnapshot()
-		sm.resetHeaderState(&best.Hash, best.Height)
-	}
-
 	sm.syncPeer = nil
 	sm.startSync()
 }
@@ -680,8 +720,16 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 		return
 	}
 
-	// If we didn't ask for this block then the peer is misbehaving.
+	// Ignore the block if it was requested from the peer during
+	// headers-first mode, but is no longer needed.
 	blockHash := bmsg.block.Hash()
+	if _, stale := state.staleBlocks[*blockHash]; stale {
+		delete(state.staleBlocks, *blockHash)
+		log.Debugf("Ignoring stale block %v from %s", blockHash, peer)
+		return
+	}
+
+	// If we didn't ask for this block then the peer is misbehaving.
 	if _, exists = state.requestedBlocks[*blockHash]; !exists {
 		// The regression test intentionally sends some blocks twice
 		// to test duplicate block insertion fails.  Don't disconnect
@@ -696,28 +744,11 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 		}
 	}
 
-	// When in headers-first mode, if the block matches the hash of the
-	// first header in the list of headers that are being fetched, it's
-	// eligible for less validation since the headers have already been
-	// verified to link together and are valid up to the next checkpoint.
-	// Also, remove the list entry for all blocks except the checkpoint
-	// since it is needed to verify the next round of headers links
-	// properly.
-	isCheckpointBlock := false
-	behaviorFlags := blockchain.BFNone
+	// In headers-first mode the blocks are buffered and processed in the
+	// order of the headers.
 	if sm.headersFirstMode {
-		firstNodeEl := sm.headerList.Front()
-		if firstNodeEl != nil {
-			firstNode := firstNodeEl.Value.(*headerNode)
-			if blockHash.IsEqual(firstNode.hash) {
-				behaviorFlags |= blockchain.BFFastAdd
-				if firstNode.hash.IsEqual(sm.nextCheckpoint.Hash) {
-					isCheckpointBlock = true
-				} else {
-					sm.headerList.Remove(firstNodeEl)
-				}
-			}
-		}
+		sm.handleDownloadedBlock(bmsg, state)
+		return
 	}
 
 	// Remove block from request maps. Either chain will know about it and
@@ -728,33 +759,12 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 
 	// Process the block to include validation, best chain selection, orphan
 	// handling, etc.
-	_, isOrphan, err := sm.chain.ProcessBlock(bmsg.block, behaviorFlags)
+	_, isOrphan, err := sm.chain.ProcessBlock(bmsg.block, blockchain.BFNone)
 	if ruleerror.ErrPowCannotVerify.Is(err) {
 		err = nil
 	}
 	if err != nil {
-		// When the error is a rule error, it means the block was simply
-		// rejected as opposed to something actually going wrong, so log
-		// it as such.  Otherwise, something really did go wrong, so log
-		// it as an actual error.
-		if ruleerror.Err.Is(err) {
-			if !ruleerror.ErrDuplicateBlock.Is(err) {
-				log.Infof("Rejected block %v from %s: %v - disconnecting peer",
-					blockHash, peer, err)
-			}
-		} else {
-			log.Errorf("Failed to process block %v: %v",
-				blockHash, err)
-		}
-		if database.ErrCorruption.Is(err) {
-			panic(err)
-		}
-
-		// Convert the error into an appropriate reject message and
-		// send it.
-		code, reason := ruleerror.ErrToRejectErr(err)
-		peer.PushRejectMsg(wire.CmdBlock, code, reason, blockHash, false)
-		peer.Disconnect()
+		sm.rejectBlock(bmsg.block, peer, err)
 		return
 	}
 
@@ -830,120 +840,33 @@ func (sm *SyncManager) handleBlockMsg(bmsg *blockMsg) {
 				peer)
 		}
 	}
-
-	// Nothing more to do if we aren't in headers-first mode.
-	if !sm.headersFirstMode {
-		return
-	}
-
-	// This is headers-first mode, so if the block is not a checkpoint
-	// request more blocks using the header list when the request queue is
-	// getting short.
-	if !isCheckpointBlock {
-		if sm.startHeader != nil &&
-			len(state.requestedBlocks) < minInFlightBlocks {
-			sm.fetchHeaderBlocks()
-		}
-		return
-	}
-
-	// This is headers-first mode and the block is a checkpoint.  When
-	// there is a next checkpoint, get the next round of headers by asking
-	// for headers starting from the block after this one up to the next
-	// checkpoint.
-	prevHeight := sm.nextCheckpoint.Height
-	prevHash := sm.nextCheckpoint.Hash
-	sm.nextCheckpoint = sm.findNextHeaderCheckpoint(prevHeight)
-	if sm.nextCheckpoint != nil {
-		locator := blockchain.BlockLocator([]*chainhash.Hash{prevHash})
-		err := peer.PushGetHeadersMsg(locator, sm.nextCheckpoint.Hash)
-		if err != nil {
-			log.Warnf("Failed to send getheaders message to "+
-				"peer %s: %v", peer.Addr(), err)
-			return
-		}
-		log.Infof("Downloading headers for blocks %d to %d from "+
-			"peer %s", prevHeight+1, sm.nextCheckpoint.Height,
-			sm.syncPeer.Addr())
-		return
-	}
-
-	// This is headers-first mode, the block is a checkpoint, and there are
-	// no more checkpoints, so switch to normal mode by requesting blocks
-	// from the block after this one up to the end of the chain (zero hash).
-	sm.headersFirstMode = false
-	sm.headerList.Init()
-	log.Infof("Reached the final checkpoint -- switching to normal mode")
-	locator := blockchain.BlockLocator([]*chainhash.Hash{blockHash})
-	err = peer.PushGetBlocksMsg(locator, &zeroHash)
-	if err != nil {
-		log.Warnf("Failed to send getblocks message to peer %s: %v",
-			peer.Addr(), err)
-		return
-	}
 }
 
-// fetchHeaderBlocks creates and sends a request to the syncPeer for the next
-// list of blocks to be downloaded based on the current list of headers.
-func (sm *SyncManager) fetchHeaderBlocks() {
-	sm.syncPeerMutex.Lock()
-	defer sm.syncPeerMutex.Unlock()
-
-	// Nothing to do if there is no sync peer.
-	if sm.syncPeer == nil {
-		log.Warnf("fetchHeaderBlocks called with no sync peer")
-		return
-	}
-
-	// Nothing to do if there is no start header.
-	if sm.startHeader == nil {
-		log.Warnf("fetchHeaderBlocks called with no start header")
-		return
-	}
-
-	// Build up a getdata request for the list of blocks the headers
-	// describe.  The size hint will be limited to wire.MaxInvPerMsg by
-	// the function, so no need to double check it here.
-	gdmsg := wire.NewMsgGetDataSizeHint(uint(sm.headerList.Len()))
-	numRequested := 0
-	for e := sm.startHeader; e != nil; e = e.Next() {
-		node, ok := e.Value.(*headerNode)
-		if !ok {
-			log.Warn("Header list node type is not a headerNode")
-			continue
-		}
-
-		iv := wire.NewInvVect(wire.InvTypeBlock, node.hash)
-		haveInv, err := sm.haveInventory(iv)
-		if err != nil {
-			log.Warnf("Unexpected failure when checking for "+
-				"existing inventory during header block "+
-				"fetch: %v", err)
-		}
-		if !haveInv {
-			syncPeerState := sm.peerStates[sm.syncPeer]
-
-			sm.requestedBlocks[*node.hash] = struct{}{}
-			syncPeerState.requestedBlocks[*node.hash] = struct{}{}
-
-			// If we're fetching from a witness enabled peer
-			// post-fork, then ensure that we receive all the
-			// witness data in the blocks.
-			if sm.syncPeer.IsWitnessEnabled() {
-				iv.Type = wire.InvTypeWitnessBlock
-			}
-
-			gdmsg.AddInvVect(iv)
-			numRequested++
-		}
-		sm.startHeader = e.Next()
-		if numRequested >= wire.MaxInvPerMsg {
-			break
+// rejectBlock logs the failure to process a block from a peer, sends a reject
+// message to the peer and disconnects it.
+func (sm *SyncManager) rejectBlock(block *btcutil.Block, peer *peerpkg.Peer, err er.R) {
+	// When the error is a rule error, it means the block was simply
+	// rejected as opposed to something actually going wrong, so log it as
+	// such.  Otherwise, something really did go wrong, so log it as an
+	// actual error.
+	blockHash := block.Hash()
+	if ruleerror.Err.Is(err) {
+		if !ruleerror.ErrDuplicateBlock.Is(err) {
+			log.Infof("Rejected block %v from %s: %v - disconnecting peer",
+				blockHash, peer, err)
 		}
+	} else {
+		log.Errorf("Failed to process block %v: %v",
+			blockHash, err)
 	}
-	if len(gdmsg.InvList) > 0 {
-		sm.syncPeer.QueueMessage(gdmsg, nil)
+	if database.ErrCorruption.Is(err) {
+		panic(err)
 	}
+
+	// Convert the error into an appropriate reject message and send it.
+	code, reason := ruleerror.ErrToRejectErr(err)
+	peer.PushRejectMsg(wire.CmdBlock, code, reason, blockHash, false)
+	peer.Disconnect()
 }
 
 // handleHeadersMsg handles block header messages from all peers.  Headers are
@@ -968,92 +891,82 @@ func (sm *SyncManager) handleHeadersMsg(hmsg *headersMsg) {
 		return
 	}
 
-	// Nothing to do for an empty headers message.
-	if numHeaders == 0 {
+	// Headers are only requested from the sync peer, the ones from a
+	// previous sync peer no longer link to the latest header.
+	if peer != sm.syncPeer {
+		log.Debugf("Ignoring %d headers from %s which is not the sync "+
+			"peer", numHeaders, peer.Addr())
 		return
 	}
 
 	// Process all of the received headers ensuring each one connects to the
-	// previous and that checkpoints match.
-	receivedCheckpoint := false
-	var finalHash *chainhash.Hash
+	// previous, has the required proof of work and that checkpoints match.
 	for _, blockHeader := range msg.Headers {
 		blockHash := blockHeader.BlockHash()
-		finalHash = &blockHash
-
-		// Ensure there is a previous header to compare against.
-		prevNodeEl := sm.headerList.Back()
-		if prevNodeEl == nil {
-			log.Warnf("Header list does not contain a previous" +
-				"element as expected -- disconnecting peer")
-			peer.Disconnect()
-			return
-		}
 
 		// Ensure the header properly connects to the previous one and
 		// add it to the list of headers.
-		node := headerNode{hash: &blockHash}
-		prevNode := prevNodeEl.Value.(*headerNode)
-		if prevNode.hash.IsEqual(&blockHeader.PrevBlock) {
-			node.height = prevNode.height + 1
-			e := sm.headerList.PushBack(&node)
-			if sm.startHeader == nil {
-				sm.startHeader = e
-			}
-		} else {
+		if !sm.headerTip.hash.IsEqual(&blockHeader.PrevBlock) {
 			log.Warnf("Received block header that does not "+
 				"properly connect to the chain from peer %s "+
 				"-- disconnecting", peer.Addr())
+			sm.abortHeadersFirst()
+			peer.Disconnect()
+			return
+		}
+		if err := sm.headerChain.Add(blockHeader); err != nil {
+			log.Warnf("Received invalid block header %s from peer "+
+				"%s: %v -- disconnecting", blockHash, peer.Addr(),
+				err)
+			sm.abortHeadersFirst()
 			peer.Disconnect()
 			return
 		}
+		node := &headerNode{height: sm.headerTip.height + 1, hash: &blockHash}
+		sm.headerList.PushBack(node)
+		sm.headerTip = node
 
 		// Verify the header at the next checkpoint height matches.
-		if node.height == sm.nextCheckpoint.Height {
-			if node.hash.IsEqual(sm.nextCheckpoint.Hash) {
-				receivedCheckpoint = true
-				log.Infof("Verified downloaded block "+
-					"header against checkpoint at height "+
-					"%d/hash %s", node.height, node.hash)
-			} else {
-				log.Warnf("Block header at height %d/hash "+
-					"%s from peer %s does NOT match "+
-					"expected checkpoint hash of %s -- "+
-					"disconnecting", node.height,
-					node.hash, peer.Addr(),
-					sm.nextCheckpoint.Hash)
-				peer.Disconnect()
-				return
-			}
-			break
+		if sm.nextCheckpoint == nil || node.height != sm.nextCheckpoint.Height {
+			continue
+		}
+		if !node.hash.IsEqual(sm.nextCheckpoint.Hash) {
+			log.Warnf("Block header at height %d/hash %s from peer "+
+				"%s does NOT match expected checkpoint hash of "+
+				"%s -- disconnecting", node.height, node.hash,
+				peer.Addr(), sm.nextCheckpoint.Hash)
+			sm.abortHeadersFirst()
+			peer.Disconnect()
+			return
 		}
+		log.Infof("Verified downloaded block header against "+
+			"checkpoint at height %d/hash %s", node.height, node.hash)
+		sm.fastAddHeight = node.height
+		sm.nextCheckpoint = sm.findNextHeaderCheckpoint(node.height)
 	}
-
-	// When this header is a checkpoint, switch to fetching the blocks for
-	// all of the headers since the last checkpoint.
-	if receivedCheckpoint {
-		// Since the first entry of the list is always the final block
-		// that is already in the database and is only used to ensure
-		// the next header links properly, it must be removed before
-		// fetching the blocks.
-		sm.headerList.Remove(sm.headerList.Front())
-		log.Infof("Received %v block headers: Fetching blocks",
-			sm.headerList.Len())
-		sm.progressLogger.SetLastLogTime(time.Now())
-		sm.fetchHeaderBlocks()
-		return
+	if numHeaders > 0 {
+		sm.lastProgressTime = time.Now()
 	}
 
-	// This header is not a checkpoint, so request the next batch of
-	// headers starting from the latest known header and ending with the
-	// next checkpoint.
-	locator := blockchain.BlockLocator([]*chainhash.Hash{finalHash})
-	err := peer.PushGetHeadersMsg(locator, sm.nextCheckpoint.Hash)
-	if err != nil {
-		log.Warnf("Failed to send getheaders message to "+
-			"peer %s: %v", peer.Addr(), err)
+	// A full message means the peer has more headers, otherwise the
+	// headers reached the tip of its chain.  More headers are requested
+	// once the blocks catch up when too many are pending.
+	sm.headersRequested = false
+	if numHeaders == wire.MaxBlockHeadersPerMsg {
+		if sm.headerList.Len() < maxPendingHeaders {
+			sm.requestHeaders(peer)
+		}
+	} else {
+		sm.headersSynced = true
+		log.Infof("Received all block headers to height %d: fetching "+
+			"%d blocks", sm.headerTip.height, sm.headerList.Len())
+	}
+	if sm.headerList.Len() == 0 && sm.headersSynced {
+		sm.finishHeadersFirst()
 		return
 	}
+	sm.progressLogger.SetLastLogTime(time.Now())
+	sm.fetchHeaderBlocks()
 }
 
 // handleNotFoundMsg handles notfound messages from all peers.
@@ -1064,15 +977,24 @@ func (sm *SyncManager) handleNotFoundMsg(nfmsg *notFoundMsg) {
 		log.Warnf("Received notfound message from unknown peer %s", peer)
 		return
 	}
+	refetch := false
 	for _, inv := range nfmsg.notFound.InvList {
 		// verify the hash was actually announced by the peer
 		// before deleting from the global requested maps.
 		switch inv.Type {
-		case wire.InvTypeBlock:
+		case wire.InvTypeBlock, wire.InvTypeWitnessBlock:
 			if _, exists := state.requestedBlocks[inv.Hash]; exists {
 				delete(state.requestedBlocks, inv.Hash)
 				delete(sm.requestedBlocks, inv.Hash)
 			}
+
+			// Request the block from another peer when downloading
+			// in headers-first mode.
+			if req, ok := sm.blockRequests[inv.Hash]; ok && req.peer == peer {
+				delete(sm.blockRequests, inv.Hash)
+				state.blockStalls++
+				refetch = true
+			}
 		case wire.InvTypeTx:
 			if _, exists := state.requestedTxns[inv.Hash]; exists {
 				delete(state.requestedTxns, inv.Hash)
@@ -1080,6 +1002,9 @@ func (sm *SyncManager) handleNotFoundMsg(nfmsg *notFoundMsg) {
 			}
 		}
 	}
+	if refetch && sm.headersFirstMode {
+		sm.fetchHeaderBlocks()
+	}
 }
 
 // haveInventory returns whether or not the inventory represented by the passed
@@ -1478,9 +1403,11 @@ func (sm *SyncManager) handleBlockchainNotification(notification *blockchain.Not
 		// new transactions.  Finally, remove any transaction that is
 		// no longer an orphan. Transactions which depend on a confirmed
 		// transaction are NOT removed recursively because they are still
-		// valid.
+		// valid.  The fee deltas of the mined transactions are no longer
+		// needed.
 		for _, tx := range block.Transactions()[1:] {
 			sm.txMemPool.RemoveTransaction(tx, false)
+			sm.txMemPool.ClearPrioritisation(tx.Hash())
 			sm.txMemPool.RemoveDoubleSpends(tx)
 			sm.txMemPool.RemoveOrphan(tx)
 			sm.peerNotifier.TransactionConfirmed(tx)
@@ -1695,16 +1622,14 @@ func New(config *Config) (*SyncManager, er.R) {
 		feeEstimator:    config.FeeEstimator,
 	}
 
-	best := sm.chain.BestSnapshot()
-	if !config.DisableCheckpoints {
-		// Initialize the next checkpoint based on the current height.
-		sm.nextCheckpoint = sm.findNextHeaderCheckpoint(best.Height)
-		if sm.nextCheckpoint != nil {
-			sm.resetHeaderState(&best.Hash, best.Height)
-		}
-	} else {
+	// Initialize the header state, including the next checkpoint, based
+	// on the current height.  The chain has no checkpoints when they are
+	// disabled.
+	if config.DisableCheckpoints {
 		log.Info("Checkpoints are disabled")
 	}
+	best := sm.chain.BestSnapshot()
+	sm.resetHeaderState(&best.Hash, best.Height)
 
 	sm.chain.Subscribe(sm.handleBlockchainNotification)
 
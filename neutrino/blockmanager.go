@@ -2464,7 +2464,7 @@ func checkPacketCryptProof(
 		hashes[i] = &hash
 	}
 	if _, err := packetcrypt.ValidatePcBlock(
-		block.MsgBlock(), height, 0, hashes,
+		block.MsgBlock(), height, 0, hashes, nil,
 	); err != nil {
 		str := fmt.Sprintf("Error validating PacketCrypt proof [%v]", err)
 		return ruleerror.ErrBadPow.New(str, nil)
@@ -747,9 +747,9 @@ func NewChainService(cfg Config) (*ChainService, er.R) {
 	// connect-only mode since it is only intended to connect to specified
 	// peers and actively avoid advertising and connecting to discovered
 	// peers in order to prevent it from becoming a public test network.
-	var newAddressFunc func() (net.Addr, er.R)
+	var newAddressFunc func(connmgr.ConnClass) (net.Addr, er.R)
 	if s.chainParams.Net != chaincfg.SimNetParams.Net {
-		newAddressFunc = func() (net.Addr, er.R) {
+		newAddressFunc = func(connmgr.ConnClass) (net.Addr, er.R) {
 			// Gather our set of currently connected peers to avoid
 			// connecting to them again.
 			connectedPeers := make(map[string]struct{})
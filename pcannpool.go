@@ -0,0 +1,352 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"container/list"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/announce"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/difficulty"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/randhash/util"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/chaincfg/globalcfg"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+const (
+	// pcAnnRequestTimeout is the time after which an announcement which was
+	// requested from a peer but not received may be requested again.
+	pcAnnRequestTimeout = 30 * time.Second
+
+	// maxPcAnnRequestsPerPeer is the maximum number of announcements which
+	// may be requested from a single peer at once.
+	maxPcAnnRequestsPerPeer = wire.MaxPcAnnsPerMsg
+
+	// maxPcAnnRequests is the maximum number of announcements which may be
+	// requested from all peers at once.
+	maxPcAnnRequests = 8 * wire.MaxPcAnnsPerMsg
+
+	// unusableAnnTarget is the aged target of announcements which can't be
+	// put in a block.
+	unusableAnnTarget = 0xffffffff
+)
+
+// errInvalidPcAnn is returned for announcements which fail validation, as
+// opposed to valid announcements which can't be used anymore.
+var errInvalidPcAnn = er.GenericErrorType.CodeWithDetail("errInvalidPcAnn",
+	"invalid PacketCrypt announcement")
+
+// errUnrequestedPcAnn is returned for announcements which were not requested
+// from the peer which sent them.
+var errUnrequestedPcAnn = er.GenericErrorType.CodeWithDetail(
+	"errUnrequestedPcAnn", "unrequested PacketCrypt announcement")
+
+// pcVersionAt returns the PacketCrypt version of blocks at the provided
+// height.
+func pcVersionAt(height int32) int {
+	if globalcfg.IsPacketCryptAllowedVersion(2, height) {
+		return 2
+	}
+	return 1
+}
+
+// annTargetAt returns the aged target of the announcement in a block at the
+// provided height.  Announcements which are too young are valued as if they
+// were in the first block they can be put in, so that they are not mistaken
+// for expired ones.
+func annTargetAt(ann *wire.PacketCryptAnn, height int32) uint32 {
+	parentHeight := int64(ann.GetParentBlockHeight())
+	if int64(height) < parentHeight+util.Conf_PacketCrypt_ANN_WAIT_PERIOD {
+		height = int32(parentHeight + util.Conf_PacketCrypt_ANN_WAIT_PERIOD)
+	}
+	age := uint32(int64(height) - parentHeight)
+	return difficulty.GetAgedAnnTarget(ann.GetWorkTarget(), age,
+		pcVersionAt(height))
+}
+
+// pcAnnRequest is an announcement which was requested from a peer.
+type pcAnnRequest struct {
+	hash      chainhash.Hash
+	peerID    int32
+	requested time.Time
+}
+
+// pcAnnPool holds the valid PacketCrypt announcements relayed by peers which
+// support protocol.SFNodePcAnn, so that solo block miners can source
+// announcements from the peer-to-peer network.  Announcements are validated
+// against the main chain, deduplicated by hash and dropped once they are too
+// old to be put in a block.  When the pool is full, the announcements with the
+// least work are evicted first.
+//
+// The announcement pool is safe for concurrent access.
+type pcAnnPool struct {
+	bestHeight func() int32
+	blockHash  func(height int32) (*chainhash.Hash, er.R)
+	annCache   *announce.Cache
+	maxAnns    int
+
+	mtx         sync.Mutex
+	anns        map[chainhash.Hash]*wire.PacketCryptAnn
+	prunedAtTip int32
+
+	// The pending requests are kept in the order they were made so that
+	// the ones which timed out are found at the front of the list.
+	requests     *list.List
+	requested    map[chainhash.Hash]*list.Element
+	peerRequests map[int32]int
+}
+
+// newPcAnnPool returns an announcement pool holding at most maxAnns
+// announcements.
+func newPcAnnPool(chain *blockchain.BlockChain, annCache *announce.Cache,
+	maxAnns int) *pcAnnPool {
+
+	return &pcAnnPool{
+		bestHeight: func() int32 {
+			return chain.BestSnapshot().Height
+		},
+		blockHash:    chain.BlockHashByHeight,
+		annCache:     annCache,
+		maxAnns:      maxAnns,
+		anns:         make(map[chainhash.Hash]*wire.PacketCryptAnn),
+		requests:     list.New(),
+		requested:    make(map[chainhash.Hash]*list.Element),
+		peerRequests: make(map[int32]int),
+	}
+}
+
+// pruneLocked removes the announcements which can no longer be put in the
+// next block.  It only does work once per new tip.
+//
+// This function MUST be called with the pool lock held.
+func (p *pcAnnPool) pruneLocked(tipHeight int32) {
+	if tipHeight == p.prunedAtTip {
+		return
+	}
+	p.prunedAtTip = tipHeight
+	for hash, ann := range p.anns {
+		if annTargetAt(ann, tipHeight+1) == unusableAnnTarget {
+			delete(p.anns, hash)
+		}
+	}
+}
+
+// dropRequestLocked forgets the pending request of an announcement, if any.
+//
+// This function MUST be called with the pool lock held.
+func (p *pcAnnPool) dropRequestLocked(hash *chainhash.Hash) {
+	e, ok := p.requested[*hash]
+	if !ok {
+		return
+	}
+	req := p.requests.Remove(e).(*pcAnnRequest)
+	delete(p.requested, *hash)
+	p.peerRequests[req.peerID]--
+	if p.peerRequests[req.peerID] == 0 {
+		delete(p.peerRequests, req.peerID)
+	}
+}
+
+// expireRequestsLocked forgets the requests which timed out so that the
+// announcements may be requested again.
+//
+// This function MUST be called with the pool lock held.
+func (p *pcAnnPool) expireRequestsLocked(now time.Time) {
+	for e := p.requests.Front(); e != nil; e = p.requests.Front() {
+		req := e.Value.(*pcAnnRequest)
+		if now.Sub(req.requested) < pcAnnRequestTimeout {
+			return
+		}
+		p.dropRequestLocked(&req.hash)
+	}
+}
+
+// want returns whether the announcement should be requested from the peer
+// with the provided id, marking it as requested when it does.  Announcements
+// are not requested while too many requests to the peer, or to all peers, are
+// pending.
+func (p *pcAnnPool) want(hash *chainhash.Hash, peerID int32) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if _, ok := p.anns[*hash]; ok {
+		return false
+	}
+	now := time.Now()
+	p.expireRequestsLocked(now)
+	if _, ok := p.requested[*hash]; ok {
+		return false
+	}
+	if len(p.requested) >= maxPcAnnRequests ||
+		p.peerRequests[peerID] >= maxPcAnnRequestsPerPeer {
+
+		return false
+	}
+	p.requested[*hash] = p.requests.PushBack(&pcAnnRequest{
+		hash:      *hash,
+		peerID:    peerID,
+		requested: now,
+	})
+	p.peerRequests[peerID]++
+	return true
+}
+
+// add validates an announcement received from the peer with the provided id
+// and adds it to the pool.  It returns whether the announcement is new to the
+// pool, it is not added when it is worse than all announcements of a full
+// pool.  Announcements which are not pending a request to the peer are
+// rejected with an errUnrequestedPcAnn error before they are validated.  An
+// error is returned for announcements which can't be put in a block anymore,
+// or whose parent block is not known yet, and an errInvalidPcAnn error for
+// invalid ones.
+func (p *pcAnnPool) add(ann *wire.PacketCryptAnn, peerID int32) (bool, er.R) {
+	hash := ann.Hash()
+	tip := p.bestHeight()
+
+	p.mtx.Lock()
+	e, ok := p.requested[hash]
+	if !ok || e.Value.(*pcAnnRequest).peerID != peerID {
+		p.mtx.Unlock()
+		return false, errUnrequestedPcAnn.New(hash.String(), nil)
+	}
+	p.dropRequestLocked(&hash)
+	_, exists := p.anns[hash]
+	p.mtx.Unlock()
+	if exists {
+		return false, nil
+	}
+
+	parentHeight := ann.GetParentBlockHeight()
+	if int64(parentHeight) > int64(tip) {
+		return false, er.Errorf("announcement %v has unknown parent "+
+			"block height %d", hash, parentHeight)
+	}
+	if annTargetAt(ann, tip+1) == unusableAnnTarget {
+		return false, er.Errorf("announcement %v is too old", hash)
+	}
+	parentHash, err := p.blockHash(int32(parentHeight))
+	if err != nil {
+		return false, err
+	}
+	_, err = p.annCache.CheckAnn(ann, parentHash, pcVersionAt(tip+1))
+	if err != nil {
+		return false, errInvalidPcAnn.New(hash.String(), err)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.pruneLocked(tip)
+	return p.insertLocked(&hash, ann, tip), nil
+}
+
+// insertLocked inserts a validated announcement unless it is already in the
+// pool, evicting the announcement with the least work when the pool is full.
+// It returns whether the announcement was inserted.
+//
+// This function MUST be called with the pool lock held.
+func (p *pcAnnPool) insertLocked(hash *chainhash.Hash, ann *wire.PacketCryptAnn,
+	tipHeight int32) bool {
+
+	if _, ok := p.anns[*hash]; ok {
+		return false
+	}
+	if len(p.anns) >= p.maxAnns {
+		target := annTargetAt(ann, tipHeight+1)
+		var worst *chainhash.Hash
+		var worstTarget uint32
+		for h, a := range p.anns {
+			t := annTargetAt(a, tipHeight+1)
+			if worst == nil || t > worstTarget {
+				h := h
+				worst, worstTarget = &h, t
+			}
+		}
+		if worst == nil || worstTarget <= target {
+			return false
+		}
+		delete(p.anns, *worst)
+	}
+	annCopy := *ann
+	p.anns[*hash] = &annCopy
+	return true
+}
+
+// get returns the announcement with the provided hash, or nil when it is not
+// in the pool.
+func (p *pcAnnPool) get(hash *chainhash.Hash) *wire.PacketCryptAnn {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	ann, ok := p.anns[*hash]
+	if !ok {
+		return nil
+	}
+	annCopy := *ann
+	return &annCopy
+}
+
+// hashes returns the hashes of the announcements in the pool.
+func (p *pcAnnPool) hashes() []*chainhash.Hash {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.pruneLocked(p.bestHeight())
+	hashes := make([]*chainhash.Hash, 0, len(p.anns))
+	for hash := range p.anns {
+		hash := hash
+		hashes = append(hashes, &hash)
+	}
+	return hashes
+}
+
+// bestAnns returns up to count announcements which can be put in the next
+// block, the ones with the most work first.  All of them are returned when
+// count is not positive.
+func (p *pcAnnPool) bestAnns(count int) []*wire.PacketCryptAnn {
+	tip := p.bestHeight()
+
+	p.mtx.Lock()
+	p.pruneLocked(tip)
+	type rankedAnn struct {
+		ann    wire.PacketCryptAnn
+		target uint32
+	}
+	ranked := make([]rankedAnn, 0, len(p.anns))
+	for _, ann := range p.anns {
+		// Announcements which are too young for the next block are
+		// left out.
+		age := int64(tip+1) - int64(ann.GetParentBlockHeight())
+		if age < util.Conf_PacketCrypt_ANN_WAIT_PERIOD {
+			continue
+		}
+		ranked = append(ranked, rankedAnn{
+			ann:    *ann,
+			target: annTargetAt(ann, tip+1),
+		})
+	}
+	p.mtx.Unlock()
+
+	sort.Slice(ranked, func(i, j int) bool {
+		return ranked[i].target < ranked[j].target
+	})
+	if count > 0 && count < len(ranked) {
+		ranked = ranked[:count]
+	}
+	anns := make([]*wire.PacketCryptAnn, len(ranked))
+	for i := range ranked {
+		anns[i] = &ranked[i].ann
+	}
+	return anns
+}
+
+// String returns a summary of the pool for logging.
+func (p *pcAnnPool) String() string {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return fmt.Sprintf("%d announcements", len(p.anns))
+}
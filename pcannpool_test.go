@@ -0,0 +1,212 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// testPcAnnTip is the height of the chain tip used in the announcement pool
+// tests, at which PacketCrypt version 2 is in use.
+const testPcAnnTip = 200000
+
+// newTestPcAnnPool returns an announcement pool following a chain whose tip is
+// at the provided height.
+func newTestPcAnnPool(tip *int32, maxAnns int) *pcAnnPool {
+	p := newPcAnnPool(nil, nil, maxAnns)
+	p.bestHeight = func() int32 { return *tip }
+	p.blockHash = func(height int32) (*chainhash.Hash, er.R) {
+		return &chainhash.Hash{byte(height)}, nil
+	}
+	return p
+}
+
+// testPcAnn returns an announcement with the provided parent block height and
+// work target.
+func testPcAnn(parentHeight, target uint32) *wire.PacketCryptAnn {
+	var ann wire.PacketCryptAnn
+	binary.LittleEndian.PutUint32(ann.Header[8:12], target)
+	binary.LittleEndian.PutUint32(ann.Header[12:16], parentHeight)
+	return &ann
+}
+
+// TestPcAnnPoolAdd ensures that announcements which can't be used, or which
+// are invalid, are rejected.
+func TestPcAnnPoolAdd(t *testing.T) {
+	tip := int32(testPcAnnTip)
+	p := newTestPcAnnPool(&tip, 10)
+
+	tests := []struct {
+		name    string
+		ann     *wire.PacketCryptAnn
+		invalid bool
+	}{
+		{"unknown parent", testPcAnn(testPcAnnTip+1, 0x1e00ffff), false},
+		{"too old", testPcAnn(testPcAnnTip-1000, 0x1e00ffff), false},
+		{"invalid", testPcAnn(testPcAnnTip-5, 0x1e00ffff), true},
+	}
+	for _, test := range tests {
+		hash := test.ann.Hash()
+		p.want(&hash, 1)
+		isNew, err := p.add(test.ann, 1)
+		if err == nil || isNew {
+			t.Errorf("%s: announcement accepted", test.name)
+			continue
+		}
+		if errInvalidPcAnn.Is(err) != test.invalid {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+	}
+	if hashes := p.hashes(); len(hashes) != 0 {
+		t.Errorf("hashes: got %d announcements want 0", len(hashes))
+	}
+}
+
+// TestPcAnnPoolBestAnns ensures that announcements are returned by decreasing
+// work, that announcements which are too young are left out, that the ones
+// with the least work are evicted from a full pool and that announcements are
+// dropped once they are too old.
+func TestPcAnnPoolBestAnns(t *testing.T) {
+	tip := int32(testPcAnnTip)
+	p := newTestPcAnnPool(&tip, 3)
+
+	anns := []*wire.PacketCryptAnn{
+		testPcAnn(testPcAnnTip-2, 0x1e00ffff),
+		testPcAnn(testPcAnnTip-5, 0x1d00ffff),
+		// Too young to be put in the next block.
+		testPcAnn(testPcAnnTip, 0x1c00ffff),
+		// Least work, evicted when the pool is full.
+		testPcAnn(testPcAnnTip-10, 0x1e00ffff),
+	}
+	p.mtx.Lock()
+	for i, ann := range anns[:3] {
+		hash := ann.Hash()
+		if !p.insertLocked(&hash, ann, tip) {
+			t.Fatalf("insertLocked: announcement %d not inserted", i)
+		}
+	}
+	hash := anns[3].Hash()
+	if p.insertLocked(&hash, anns[3], tip) {
+		t.Fatalf("insertLocked: announcement with the least work " +
+			"inserted in a full pool")
+	}
+	p.mtx.Unlock()
+
+	best := p.bestAnns(0)
+	if len(best) != 2 || best[0].Hash() != anns[1].Hash() ||
+		best[1].Hash() != anns[0].Hash() {
+
+		t.Fatalf("bestAnns: unexpected announcements %v", best)
+	}
+	if best = p.bestAnns(1); len(best) != 1 || best[0].Hash() != anns[1].Hash() {
+		t.Fatalf("bestAnns: unexpected announcements %v", best)
+	}
+	if p.get(&hash) != nil {
+		t.Fatalf("get: found announcement which was not inserted")
+	}
+
+	// Once the chain moves on, the announcements expire.
+	tip += 300
+	if hashes := p.hashes(); len(hashes) != 0 {
+		t.Fatalf("hashes: got %d announcements want 0", len(hashes))
+	}
+}
+
+// TestPcAnnPoolWant ensures that announcements are only requested once until
+// the request times out, and that the number of pending requests is capped
+// per peer and in total.
+func TestPcAnnPoolWant(t *testing.T) {
+	tip := int32(testPcAnnTip)
+	p := newTestPcAnnPool(&tip, 10)
+	hash := chainhash.Hash{1}
+	if !p.want(&hash, 1) {
+		t.Fatalf("want: new announcement not wanted")
+	}
+	if p.want(&hash, 2) {
+		t.Fatalf("want: requested announcement wanted again")
+	}
+
+	// Receiving an announcement ends its request, even when it is invalid.
+	ann := testPcAnn(testPcAnnTip-5, 0x1e00ffff)
+	annHash := ann.Hash()
+	if !p.want(&annHash, 1) {
+		t.Fatalf("want: new announcement not wanted")
+	}
+	p.add(ann, 1)
+	if n := p.peerRequests[1]; n != 1 || len(p.requested) != 1 {
+		t.Fatalf("want: got %d pending requests want 1", n)
+	}
+
+	// A request which timed out may be made again, to another peer.
+	p.requests.Front().Value.(*pcAnnRequest).requested =
+		time.Now().Add(-pcAnnRequestTimeout)
+	if !p.want(&hash, 2) {
+		t.Fatalf("want: timed out announcement not wanted")
+	}
+	if p.peerRequests[1] != 0 || p.peerRequests[2] != 1 {
+		t.Fatalf("want: timed out request still counted")
+	}
+
+	// Peer 2 has one pending request, peer 3 fills up the rest.
+	wantHashes := func(peerID int32, first, count int) int {
+		wanted := 0
+		for i := first; i < first+count; i++ {
+			var h chainhash.Hash
+			binary.LittleEndian.PutUint32(h[:], uint32(i))
+			if p.want(&h, peerID) {
+				wanted++
+			}
+		}
+		return wanted
+	}
+	if n := wantHashes(2, 1000, maxPcAnnRequestsPerPeer); n !=
+		maxPcAnnRequestsPerPeer-1 {
+
+		t.Fatalf("want: got %d requests to one peer want %d", n,
+			maxPcAnnRequestsPerPeer-1)
+	}
+	for id := int32(3); len(p.requested) < maxPcAnnRequests; id++ {
+		wantHashes(id, int(id)*10000, maxPcAnnRequestsPerPeer)
+	}
+	if n := wantHashes(100, 1000000, 10); n != 0 {
+		t.Fatalf("want: got %d requests beyond the total limit", n)
+	}
+}
+
+// TestPcAnnPoolUnrequested ensures that announcements are only accepted from
+// the peer they were requested from.
+func TestPcAnnPoolUnrequested(t *testing.T) {
+	tip := int32(testPcAnnTip)
+	p := newTestPcAnnPool(&tip, 10)
+	ann := testPcAnn(testPcAnnTip-5, 0x1e00ffff)
+	hash := ann.Hash()
+	if _, err := p.add(ann, 1); !errUnrequestedPcAnn.Is(err) {
+		t.Fatalf("add: unrequested announcement got %v", err)
+	}
+
+	// An announcement requested from another peer is unrequested too, and
+	// the pending request is kept.
+	if !p.want(&hash, 1) {
+		t.Fatalf("want: new announcement not wanted")
+	}
+	if _, err := p.add(ann, 2); !errUnrequestedPcAnn.Is(err) {
+		t.Fatalf("add: announcement from another peer got %v", err)
+	}
+	if p.peerRequests[1] != 1 {
+		t.Fatalf("add: request dropped by another peer")
+	}
+	if _, err := p.add(ann, 1); errUnrequestedPcAnn.Is(err) {
+		t.Fatalf("add: requested announcement rejected as unrequested")
+	}
+	if len(p.requested) != 0 {
+		t.Fatalf("add: request still pending after the announcement")
+	}
+}
@@ -11,8 +11,16 @@ The functions are only exported while the tests are being run.
 
 package peer
 
+import "time"
+
 // TstAllowSelfConns allows the test package to allow self connections by
 // disabling the detection logic.
 func TstAllowSelfConns() {
 	allowSelfConns = true
 }
+
+// TstNextTrickleDelay makes the internal nextTrickleDelay function available
+// to the test package.
+func (p *Peer) TstNextTrickleDelay() time.Duration {
+	return p.nextTrickleDelay()
+}
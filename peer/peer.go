@@ -34,8 +34,8 @@ const (
 	// MaxProtocolVersion is the max protocol version the peer supports.
 	MaxProtocolVersion = protocol.FeeFilterVersion
 
-	// DefaultTrickleInterval is the min time between attempts to send an
-	// inv message to a peer.
+	// DefaultTrickleInterval is the average time between attempts to send
+	// an inv message to an outbound peer.
 	//
 	//
 	// XXX(trn): The BTCD default is 10 - this controls the wait before
@@ -63,10 +63,18 @@ const (
 	// testing with the 2s interval in simulation (10,000 nodes) as well as
 	// on the pkt mainnet has been successful, without any negative effect.
 	//
-	// XXX(trn): TODO: Implement and test using per-node and per message
-	// poisson-distributed delays, as used by Bitcoin Core, post-0.13.0.
+	// The delays between inventory flushes are now Poisson-distributed per
+	// peer around this average, as done by Bitcoin Core since 0.13.0, so
+	// that the time a peer announces a transaction reveals less about
+	// whether it originates from it.
 	DefaultTrickleInterval = 1 * time.Second
 
+	// DefaultInboundTrickleInterval is the average time between attempts
+	// to send an inv message to an inbound peer.  It is longer than the
+	// one of outbound peers since a spy node can easily open many inbound
+	// connections to observe when transactions are announced.
+	DefaultInboundTrickleInterval = 5 * time.Second
+
 	// MinAcceptableProtocolVersion is the lowest protocol version that a
 	// connected peer may support.
 	MinAcceptableProtocolVersion = protocol.MultipleAddressVersion
@@ -235,6 +243,15 @@ type MessageListeners struct {
 	// message.
 	OnSendHeaders func(p *Peer, msg *wire.MsgSendHeaders)
 
+	// OnPcAnnInv is invoked when a peer receives a pcanninv message.
+	OnPcAnnInv func(p *Peer, msg *wire.MsgPcAnnInv)
+
+	// OnGetPcAnns is invoked when a peer receives a getpcanns message.
+	OnGetPcAnns func(p *Peer, msg *wire.MsgGetPcAnns)
+
+	// OnPcAnn is invoked when a peer receives a pcann message.
+	OnPcAnn func(p *Peer, msg *wire.MsgPcAnn)
+
 	// OnRead is invoked when a peer receives a bitcoin message.  It
 	// consists of the number of bytes read, the message, and whether or not
 	// an error in the read occurred.  Typically, callers will opt to use
@@ -301,9 +318,14 @@ type Config struct {
 	// messages.
 	Listeners MessageListeners `json:"-"`
 
-	// TrickleInterval is the duration of the ticker which trickles down the
-	// inventory to a peer.
+	// TrickleInterval is the average duration between two flushes of the
+	// inventory trickled down to an outbound peer.  The actual delays are
+	// drawn from an exponential distribution.
 	TrickleInterval time.Duration
+
+	// InboundTrickleInterval is the average duration between two flushes
+	// of the inventory trickled down to an inbound peer.
+	InboundTrickleInterval time.Duration
 }
 
 // minUint32 is a helper function to return the minimum of two uint32s.
@@ -1615,6 +1637,21 @@ out:
 				p.cfg.Listeners.OnSendHeaders(p, msg)
 			}
 
+		case *wire.MsgPcAnnInv:
+			if p.cfg.Listeners.OnPcAnnInv != nil {
+				p.cfg.Listeners.OnPcAnnInv(p, msg)
+			}
+
+		case *wire.MsgGetPcAnns:
+			if p.cfg.Listeners.OnGetPcAnns != nil {
+				p.cfg.Listeners.OnGetPcAnns(p, msg)
+			}
+
+		case *wire.MsgPcAnn:
+			if p.cfg.Listeners.OnPcAnn != nil {
+				p.cfg.Listeners.OnPcAnn(p, msg)
+			}
+
 		default:
 			log.Debugf("Received unhandled message of type %v "+
 				"from %v", rmsg.Command(), p)
@@ -1635,6 +1672,18 @@ out:
 	log.Tracef("Peer input handler done for %s", p)
 }
 
+// nextTrickleDelay returns the time to wait before the next flush of the
+// inventory trickled down to the peer.  The delays are exponentially
+// distributed, so that flushes happen as a Poisson process, around an average
+// which depends on whether the peer is inbound.
+func (p *Peer) nextTrickleDelay() time.Duration {
+	interval := p.cfg.TrickleInterval
+	if p.inbound {
+		interval = p.cfg.InboundTrickleInterval
+	}
+	return time.Duration(rand.ExpFloat64() * float64(interval))
+}
+
 // queueHandler handles the queuing of outgoing data for the peer. This runs as
 // a muxer for various sources of input so we can ensure that server and peer
 // handlers will not block on us sending a message.  That data is then passed on
@@ -1642,8 +1691,8 @@ out:
 func (p *Peer) queueHandler() {
 	pendingMsgs := list.New()
 	invSendQueue := list.New()
-	trickleTicker := time.NewTicker(p.cfg.TrickleInterval)
-	defer trickleTicker.Stop()
+	trickleTimer := time.NewTimer(p.nextTrickleDelay())
+	defer trickleTimer.Stop()
 
 	// We keep the waiting flag so that we know if we have a message queued
 	// to the outHandler or not.  We could use the presence of a head of
@@ -1704,7 +1753,9 @@ out:
 				}
 			}
 
-		case <-trickleTicker.C:
+		case <-trickleTimer.C:
+			trickleTimer.Reset(p.nextTrickleDelay())
+
 			// Don't send anything if we're disconnecting or there
 			// is no queued inventory.
 			// version is known if send queue has any entries.
@@ -1713,14 +1764,25 @@ out:
 				continue
 			}
 
-			// Create and send as many inv messages as needed to
-			// drain the inventory send queue.
-			invMsg := wire.NewMsgInvSizeHint(uint(invSendQueue.Len()))
+			// Shuffle the queued inventory so the order it is
+			// announced in doesn't reveal the order it was learned
+			// in.
+			invs := make([]*wire.InvVect, 0, invSendQueue.Len())
 			for e := invSendQueue.Front(); e != nil; e = invSendQueue.Front() {
 				iv := invSendQueue.Remove(e).(*wire.InvVect)
 				if iv == nil {
 					panic("queueHandler: iv == nil")
 				}
+				invs = append(invs, iv)
+			}
+			rand.Shuffle(len(invs), func(i, j int) {
+				invs[i], invs[j] = invs[j], invs[i]
+			})
+
+			// Create and send as many inv messages as needed to
+			// drain the inventory send queue.
+			invMsg := wire.NewMsgInvSizeHint(uint(len(invs)))
+			for _, iv := range invs {
 
 				// Don't send inventory that became known after
 				// the initial check.
@@ -1733,7 +1795,7 @@ out:
 					waiting = queuePacket(
 						outMsg{msg: invMsg},
 						pendingMsgs, waiting)
-					invMsg = wire.NewMsgInvSizeHint(uint(invSendQueue.Len()))
+					invMsg = wire.NewMsgInvSizeHint(uint(len(invs)))
 				}
 
 				// Add the inventory that is being relayed to
@@ -2244,10 +2306,13 @@ func newPeerBase(origCfg *Config, inbound bool) *Peer {
 		cfg.ChainParams = &chaincfg.TestNet3Params
 	}
 
-	// Set the trickle interval if a non-positive value is specified.
+	// Set the trickle intervals if a non-positive value is specified.
 	if cfg.TrickleInterval <= 0 {
 		cfg.TrickleInterval = DefaultTrickleInterval
 	}
+	if cfg.InboundTrickleInterval <= 0 {
+		cfg.InboundTrickleInterval = DefaultInboundTrickleInterval
+	}
 
 	p := Peer{
 		inbound:         inbound,
@@ -415,6 +415,15 @@ func TestPeerListeners(t *testing.T) {
 			OnSendHeaders: func(p *peer.Peer, msg *wire.MsgSendHeaders) {
 				ok <- msg
 			},
+			OnPcAnnInv: func(p *peer.Peer, msg *wire.MsgPcAnnInv) {
+				ok <- msg
+			},
+			OnGetPcAnns: func(p *peer.Peer, msg *wire.MsgGetPcAnns) {
+				ok <- msg
+			},
+			OnPcAnn: func(p *peer.Peer, msg *wire.MsgPcAnn) {
+				ok <- msg
+			},
 		},
 		UserAgentName:     "peer",
 		UserAgentVersion:  "1.0",
@@ -560,6 +569,18 @@ func TestPeerListeners(t *testing.T) {
 			"OnSendHeaders",
 			wire.NewMsgSendHeaders(),
 		},
+		{
+			"OnPcAnnInv",
+			wire.NewMsgPcAnnInv(),
+		},
+		{
+			"OnGetPcAnns",
+			wire.NewMsgGetPcAnns(),
+		},
+		{
+			"OnPcAnn",
+			wire.NewMsgPcAnn(&wire.PacketCryptAnn{}),
+		},
 	}
 	t.Logf("Running %d tests", len(tests))
 	for _, test := range tests {
@@ -576,6 +597,122 @@ func TestPeerListeners(t *testing.T) {
 	outPeer.Disconnect()
 }
 
+// TestNextTrickleDelay tests that the delays between two inventory flushes are
+// random and average the trickle interval of the direction of the peer.
+func TestNextTrickleDelay(t *testing.T) {
+	outPeer, err := peer.NewOutboundPeer(&peer.Config{}, "10.0.0.1:8333")
+	if err != nil {
+		t.Fatalf("NewOutboundPeer: unexpected err %v", err)
+	}
+	tests := []struct {
+		name string
+		peer *peer.Peer
+		mean time.Duration
+	}{
+		{"inbound", peer.NewInboundPeer(&peer.Config{}),
+			peer.DefaultInboundTrickleInterval},
+		{"outbound", outPeer, peer.DefaultTrickleInterval},
+	}
+	const samples = 10000
+	for _, test := range tests {
+		var total time.Duration
+		distinct := make(map[time.Duration]struct{})
+		for i := 0; i < samples; i++ {
+			d := test.peer.TstNextTrickleDelay()
+			total += d
+			distinct[d] = struct{}{}
+		}
+		mean := total / samples
+		if mean < test.mean*95/100 || mean > test.mean*105/100 {
+			t.Errorf("%s: mean delay %v, want about %v", test.name,
+				mean, test.mean)
+		}
+		if len(distinct) < samples/2 {
+			t.Errorf("%s: only %d distinct delays in %d samples",
+				test.name, len(distinct), samples)
+		}
+	}
+}
+
+// TestQueueInventory tests that the inventory queued for inbound and outbound
+// peers is announced to them, in a different order than it was queued in.
+func TestQueueInventory(t *testing.T) {
+	const numInvs = 100
+	verack := make(chan struct{}, 2)
+	invs := make(chan *wire.InvVect, numInvs)
+	peerCfg := &peer.Config{
+		Listeners: peer.MessageListeners{
+			OnInv: func(p *peer.Peer, msg *wire.MsgInv) {
+				for _, iv := range msg.InvList {
+					invs <- iv
+				}
+			},
+			OnVerAck: func(p *peer.Peer, msg *wire.MsgVerAck) {
+				verack <- struct{}{}
+			},
+		},
+		UserAgentName:          "peer",
+		UserAgentVersion:       "1.0",
+		ChainParams:            &chaincfg.MainNetParams,
+		TrickleInterval:        time.Millisecond * 10,
+		InboundTrickleInterval: time.Millisecond * 20,
+	}
+	inConn, outConn := pipe(
+		&conn{raddr: "10.0.0.1:8333"},
+		&conn{raddr: "10.0.0.2:8333"},
+	)
+	inPeer := peer.NewInboundPeer(peerCfg)
+	inPeer.AssociateConnection(inConn)
+	outPeer, err := peer.NewOutboundPeer(peerCfg, "10.0.0.1:8333")
+	if err != nil {
+		t.Fatalf("NewOutboundPeer: unexpected err %v", err)
+	}
+	outPeer.AssociateConnection(outConn)
+	defer inPeer.Disconnect()
+	defer outPeer.Disconnect()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-verack:
+		case <-time.After(time.Second * 1):
+			t.Fatalf("TestQueueInventory: verack timeout")
+		}
+	}
+
+	for _, p := range []*peer.Peer{inPeer, outPeer} {
+		want := make(map[wire.InvVect]struct{})
+		queued := make([]wire.InvVect, 0, numInvs)
+		for i := 0; i < numInvs; i++ {
+			iv := wire.NewInvVect(wire.InvTypeTx,
+				&chainhash.Hash{0: byte(i), 1: 0x01})
+			want[*iv] = struct{}{}
+			queued = append(queued, *iv)
+			p.QueueInventory(iv)
+		}
+		inOrder := true
+		for i := 0; len(want) > 0; i++ {
+			select {
+			case iv := <-invs:
+				if _, ok := want[*iv]; !ok {
+					t.Fatalf("TestQueueInventory: unexpected "+
+						"inventory %v", iv)
+				}
+				delete(want, *iv)
+				if *iv != queued[i] {
+					inOrder = false
+				}
+			case <-time.After(time.Second * 1):
+				t.Fatalf("TestQueueInventory: %d inventory "+
+					"vectors not announced", len(want))
+			}
+		}
+		if inOrder {
+			t.Fatalf("TestQueueInventory: inventory announced in " +
+				"the order it was queued in")
+		}
+	}
+}
+
 // TestOutboundPeer tests that the outbound peer works as expected.
 func TestOutboundPeer(t *testing.T) {
 	peerCfg := &peer.Config{
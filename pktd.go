@@ -163,6 +163,14 @@ func pktdMain(serverChan chan<- *server) er.R {
 
 		return nil
 	}
+	if cfg.DropSpentIndex {
+		if err := indexers.DropSpentIndex(db, interrupt); err != nil {
+			pktdLog.Errorf("%v", err)
+			return err
+		}
+
+		return nil
+	}
 	if cfg.DropCfIndex {
 		if err := indexers.DropCfIndex(db, interrupt); err != nil {
 			pktdLog.Errorf("%v", err)
@@ -263,23 +263,23 @@ func PublicPass(reader *bufio.Reader, privPass []byte,
 // the user along with prompting them for confirmation.  When the user answers
 // yes, a the user is prompted for it.  All prompts are repeated until the user
 // enters a valid response.
-func Seed(reader *bufio.Reader, passphrase []byte) ([]byte, *seedwords.Seed, er.R) {
+func Seed(reader *bufio.Reader, passphrase []byte) ([]byte, *seedwords.Seed, *seedwords.Bip39Seed, er.R) {
 	// Ascertain the wallet generation seed.
 	useUserSeed, err := promptListBool(reader, "Do you have an "+
 		"existing wallet seed you want to use?", "no")
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	if !useUserSeed {
 		seed, err := seedwords.RandomSeed()
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		fmt.Println("Encrypting your seed...")
 		seedEnc := seed.Encrypt(passphrase)
 		words, err := seedEnc.Words("english")
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		seedEnc.Zero()
 		fmt.Println("Your wallet generation seed is:")
@@ -296,7 +296,7 @@ func Seed(reader *bufio.Reader, passphrase []byte) ([]byte, *seedwords.Seed, er.
 				`and secure location, type "OK" to continue: `)
 			confirmSeed, err := reader.ReadString('\n')
 			if err != nil {
-				return nil, nil, er.E(err)
+				return nil, nil, nil, er.E(err)
 			}
 			confirmSeed = strings.TrimSpace(confirmSeed)
 			confirmSeed = strings.Trim(confirmSeed, `"`)
@@ -305,14 +305,14 @@ func Seed(reader *bufio.Reader, passphrase []byte) ([]byte, *seedwords.Seed, er.
 			}
 		}
 
-		return nil, seed, nil
+		return nil, seed, nil, nil
 	}
 
 	for {
-		fmt.Print("Enter existing wallet seed: ")
+		fmt.Print("Enter existing wallet seed, or one share of a split seed: ")
 		seedStr, err := reader.ReadString('\n')
 		if err != nil {
-			return nil, nil, er.E(err)
+			return nil, nil, nil, er.E(err)
 		}
 		seedStr = strings.TrimSpace(strings.ToLower(seedStr))
 
@@ -320,31 +320,117 @@ func Seed(reader *bufio.Reader, passphrase []byte) ([]byte, *seedwords.Seed, er.
 		} else if len(seed) < hdkeychain.MinSeedBytes {
 		} else if len(seed) > hdkeychain.MaxSeedBytes {
 		} else {
-			return []byte(seedStr), nil, nil
+			return []byte(seedStr), nil, nil, nil
 		}
 
-		if sw, err := seedwords.SeedFromWords(seedStr); err != nil {
-			fmt.Printf("Invalid seed specified [%s]", err.Message())
+		var sw *seedwords.SeedEnc
+		var swErr er.R
+		if sh, err := seedwords.ShareFromWords(seedStr); err == nil {
+			sw, swErr = combineShares(reader, sh)
+		} else {
+			sw, swErr = seedwords.SeedFromWords(seedStr)
+		}
+		if swErr != nil {
+			// Not a pktwallet seed, but it might be a BIP39 mnemonic which
+			// was exported from another wallet.
+			if _, e := seedwords.CheckBip39Mnemonic(seedStr); e == nil {
+				fmt.Println("This appears to be a BIP39 mnemonic from another wallet.")
+				seed, err := bip39Seed(reader, seedStr)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				return nil, nil, seed, nil
+			}
+			fmt.Printf("Invalid seed specified [%s]\n", swErr.Message())
 		} else if sw.NeedsPassphrase() {
 			fmt.Println("This seed was taken from a wallet protected by a password.")
 			for {
 				pass, err := promptPass(reader, "Enter the wallet password now", false)
 				if err != nil {
-					return nil, nil, err
+					return nil, nil, nil, err
 				}
 				fmt.Println("Decrypting your seed...")
 				if seed, err := sw.Decrypt(pass, false); err != nil {
 					fmt.Println("The seed did not decrypt properly, please try again.")
 				} else {
-					return nil, seed, nil
+					return nil, seed, nil, nil
 				}
 			}
 		} else {
 			if seed, err := sw.Decrypt(nil, false); err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			} else {
-				return nil, seed, nil
+				return nil, seed, nil, nil
 			}
 		}
 	}
 }
+
+// combineShares prompts for the rest of the shares of a split seed, starting
+// from the first share which has already been entered.
+func combineShares(reader *bufio.Reader, first *seedwords.Share) (*seedwords.SeedEnc, er.R) {
+	shares := []*seedwords.Share{first}
+	defer func() {
+		for _, sh := range shares {
+			sh.Zero()
+		}
+	}()
+	fmt.Printf("This is share %d of a seed which needs %d shares to recover.\n",
+		first.Index(), first.Threshold())
+	for len(shares) < first.Threshold() {
+		fmt.Printf("Enter share %d of %d: ", len(shares)+1, first.Threshold())
+		shareStr, errr := reader.ReadString('\n')
+		if errr != nil {
+			return nil, er.E(errr)
+		}
+		sh, err := seedwords.ShareFromWords(strings.TrimSpace(strings.ToLower(shareStr)))
+		if err != nil {
+			fmt.Printf("Invalid share specified [%s]\n", err.Message())
+			continue
+		}
+		dup := false
+		for _, s := range shares {
+			dup = dup || s.Index() == sh.Index()
+		}
+		if dup {
+			fmt.Printf("Share %d has already been entered\n", sh.Index())
+			continue
+		} else if sh.GroupID() != first.GroupID() {
+			fmt.Println("This share is from a different split of the seed")
+			continue
+		}
+		shares = append(shares, sh)
+	}
+	return seedwords.CombineShares(shares)
+}
+
+// bip39Seed prompts for the passphrase and birthday of a BIP39 mnemonic and
+// derives the root seed from it.
+func bip39Seed(reader *bufio.Reader, mnemonic string) (*seedwords.Bip39Seed, er.R) {
+	var pass []byte
+	hasPass, err := promptListBool(reader, "Does this mnemonic have a "+
+		"BIP39 passphrase?", "no")
+	if err != nil {
+		return nil, err
+	}
+	if hasPass {
+		pass, err = promptPass(reader, "Enter the BIP39 passphrase", false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for {
+		fmt.Print("Enter the date when the mnemonic was first used (YYYY-MM-DD): ")
+		bdayStr, errr := reader.ReadString('\n')
+		if errr != nil {
+			return nil, er.E(errr)
+		}
+		bday, err := seedwords.ParseBirthday(strings.TrimSpace(bdayStr))
+		if err != nil {
+			fmt.Println(err.Message())
+			continue
+		}
+		fmt.Println("Deriving your seed...")
+		return seedwords.Bip39SeedFromMnemonic(mnemonic, pass, bday)
+	}
+}
@@ -2,7 +2,8 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-//+build !generate
+//go:build !generate
+// +build !generate
 
 package rpchelp
 
@@ -21,6 +22,25 @@ var helpDescsEnUS = map[string]string{
 	"addp2shscript-script":    "The redeem script to import",
 	"addp2shscript--result0":  "The address corresponding to this script",
 
+	// AnalyzeMiniscriptCmd help.
+	"analyzeminiscript--synopsis":  "Type check and compile a miniscript expression and report whether it is safe to pay to, without importing it.",
+	"analyzeminiscript-miniscript": "The miniscript expression, keys are hex encoded compressed public keys",
+
+	// MiniscriptResult help.
+	"miniscriptresult-miniscript":          "The miniscript expression in its canonical form",
+	"miniscriptresult-address":             "The P2WSH address of the compiled script",
+	"miniscriptresult-witnessscript":       "The compiled witness script, hex encoded",
+	"miniscriptresult-type":                "The miniscript type properties of the expression",
+	"miniscriptresult-sane":                "True if the script is non-malleable, always needs a signature, has no timelock mix and is within the standard limits",
+	"miniscriptresult-insanereason":        "Why the script is not sane",
+	"miniscriptresult-nonmalleable":        "True if every satisfaction can be made without malleability",
+	"miniscriptresult-needssignature":      "True if every satisfaction needs a signature",
+	"miniscriptresult-timelockmix":         "True if some spending path needs both a height and a time timelock of the same kind",
+	"miniscriptresult-scriptsize":          "The size of the witness script in bytes",
+	"miniscriptresult-opcount":             "The largest number of non-push opcodes which may be executed",
+	"miniscriptresult-maxsatisfactionsize": "The largest size in bytes of the witness stack elements which satisfy the script, not including the script",
+	"miniscriptresult-maxstackitems":       "The largest number of witness stack elements which satisfy the script, not including the script",
+
 	// CreateTransactionCmd help.
 	"createtransaction--synopsis":      "Create a transaction but do not send it to the chain",
 	"createtransaction-vote":           "True if you wish for this transaction to contain a network steward vote",
@@ -33,6 +53,9 @@ var helpDescsEnUS = map[string]string{
 	"createtransaction-inputminheight": "The minimum block height to take inputs from (default: 0)",
 	"createtransaction-maxinputs":      "Maximum number of transaction inputs that are allowed",
 	"createtransaction-autolock":       "If specified, all txouts spent for this transaction will be locked under this name",
+	"createtransaction-inputs":         "Exact outpoints to spend, every one of them is spent and no others, cannot be used with fromaddresses",
+	"createtransaction-coinselection":  "The coin selection algorithm: default, bnb (look for a set of coins which needs no change, then fall back to knapsack) or knapsack",
+	"createtransaction-avoidmixing":    "If true, only spend coins which are paid to the same address",
 	"createtransaction--result0":       "The hex encoded transaction result",
 
 	// GetAddressBalancesCmd help.
@@ -53,6 +76,14 @@ var helpDescsEnUS = map[string]string{
 	"getwalletseed--synopsis": "Get the wallet seed words for this wallet",
 	"getwalletseed--result0":  "The seed words used, along with the wallet passphrase, to create the wallet",
 
+	"splitwalletseed--synopsis":       "Split the wallet seed into shares, any threshold of which can be combined to recover the seed when creating a wallet. Fewer than threshold shares reveal nothing about the seed. If the wallet has a passphrase, it is still needed to recover the wallet.",
+	"splitwalletseed-threshold":       "The number of shares which are needed to recover the seed",
+	"splitwalletseed-count":           "The number of shares to create, at most 16",
+	"splitwalletseed-language":        "The language of the share words (english, spanish, french, japanese or chinese_simplified)",
+	"splitwalletseedresult-groupid":   "An identifier which is the same for every share of this split, in hex",
+	"splitwalletseedresult-threshold": "The number of shares which are needed to recover the seed",
+	"splitwalletseedresult-shares":    "The shares, each one is 19 words",
+
 	"getsecret--synopsis": "Get a secret seed which is generated using the wallet's private key, this can be used as a password for another application",
 	"getsecret-name":      "A name which will be used to generate the secret seed, the same seed will always be provided given the same name",
 	"getsecret--result0":  "A 32 byte secret seed in hex form",
@@ -77,6 +108,17 @@ var helpDescsEnUS = map[string]string{
 	"stopresync--synopsis": "Stop a re-synchronization job before it's completion",
 	"stopresync--result0":  "The name of the sync job which was stopped",
 
+	// FoldAddressCmd help
+	"foldaddress--synopsis": "Consolidate the many small outputs paid to an address into a few large ones by spending them back to the same address. " +
+		"This runs in the background, making one standard size transaction at a time and waiting for each one to be accepted by the mempool before making the next. " +
+		"Locked outputs are not spent. Progress is shown in the WalletStats of getinfo, the job resumes if the wallet is restarted",
+	"foldaddress-address":  "The address to fold, it must belong to this wallet",
+	"foldaddress-minconf":  "Only fold outputs which have at least this number of confirmations",
+	"foldaddress--result0": "The name of the fold job which was started",
+
+	"stopfold--synopsis": "Stop a fold job before it's completion, transactions which have already been made are not affected",
+	"stopfold--result0":  "The name of the fold job which was stopped",
+
 	// CreateMultisigCmd help.
 	"createmultisig--synopsis": "Generate a multisig address and redeem script.",
 	"createmultisig-keys":      "Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address",
@@ -177,12 +219,35 @@ var helpDescsEnUS = map[string]string{
 	"gettransactiondetailsresult-vout":              "The transaction output index",
 	"gettransactiondetailsresult-involveswatchonly": "Unset",
 
+	// ImportDescriptorsCmd help.
+	"importdescriptors--synopsis":         "Import output descriptors (pkh, wpkh, sh, wsh, multi, sortedmulti) and watch the scripts which they describe.",
+	"importdescriptors-requests":          "An array of descriptors to import",
+	"importdescriptorsrequest-desc":       "The descriptor, a checksum is optional but it is verified if present",
+	"importdescriptorsrequest-range":      "For a ranged descriptor, the [start,end] indexes to import (default: [0,999])",
+	"importdescriptorsrequest-fromheight": "The earliest block height where the scripts may have been used (default: 0)",
+	"importdescriptorsrequest-rescan":     "Rescan the blockchain from fromheight for transactions involving the imported scripts",
+	"importdescriptorsresult-success":     "True if the descriptor was imported",
+	"importdescriptorsresult-addresses":   "The addresses of the imported scripts",
+	"importdescriptorsresult-error":       "The reason why the descriptor could not be imported",
+	"importdescriptors--result0":          "The result of importing each descriptor, in the same order as the request",
+
+	// ImportMiniscriptCmd help.
+	"importminiscript--synopsis":  "Compile a miniscript expression and import the resulting P2WSH script in order to watch it, the expression must be sane.",
+	"importminiscript-miniscript": "The miniscript expression, keys are hex encoded compressed public keys",
+
 	// ImportPrivKeyCmd help.
 	"importprivkey--synopsis": "Imports a WIF-encoded private key to the 'imported' account.",
 	"importprivkey-privkey":   "The WIF-encoded private key",
 	"importprivkey-label":     "Unused (must be unset or 'imported')",
 	"importprivkey-rescan":    "Rescan the blockchain (since the genesis block) for outputs controlled by the imported key",
 
+	// ListDescriptorsCmd help.
+	"listdescriptors--synopsis":         "List the descriptors which have been imported into the wallet, in their public form.",
+	"listdescriptorsresult-descriptors": "The imported descriptors",
+	"descriptorinfo-desc":               "The descriptor with checksum",
+	"descriptorinfo-timestamp":          "The time when the descriptor was imported (unix seconds)",
+	"descriptorinfo-range":              "For a ranged descriptor, the [start,end] indexes which have been imported",
+
 	// ListLockUnspentCmd help.
 	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
 
@@ -306,6 +371,9 @@ var helpDescsEnUS = map[string]string{
 	"sendmany-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
 	"sendmany-comment":        "Unused",
 	"sendmany-maxinputs":      "Maximum number of transaction inputs that are allowed",
+	"sendmany-inputs":         "Exact outpoints to spend, every one of them is spent and no others, cannot be used with fromaddresses",
+	"sendmany-coinselection":  "The coin selection algorithm: default, bnb (look for a set of coins which needs no change, then fall back to knapsack) or knapsack",
+	"sendmany-avoidmixing":    "If true, only spend coins which are paid to the same address",
 	"sendmany--result0":       "The transaction hash of the sent transaction",
 
 	// SendToAddressCmd help.
@@ -359,18 +427,21 @@ var helpDescsEnUS = map[string]string{
 	"validateaddress-address": "Address to validate",
 
 	// ValidateAddressWalletResult help.
-	"validateaddresswalletresult-isvalid":      "Whether or not the address is valid",
-	"validateaddresswalletresult-address":      "The payment address (only when isvalid is true)",
-	"validateaddresswalletresult-ismine":       "Whether this address is controlled by the wallet (only when isvalid is true)",
-	"validateaddresswalletresult-iswatchonly":  "Unset",
-	"validateaddresswalletresult-isscript":     "Whether the payment address is a pay-to-script-hash address (only when isvalid is true)",
-	"validateaddresswalletresult-pubkey":       "The associated public key of the payment address, if any (only when isvalid is true)",
-	"validateaddresswalletresult-iscompressed": "Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)",
-	"validateaddresswalletresult-account":      "The account this payment address belongs to (only when isvalid is true)",
-	"validateaddresswalletresult-addresses":    "All associated payment addresses of the script if address is a multisig address (only when isvalid is true)",
-	"validateaddresswalletresult-hex":          "The redeem script ",
-	"validateaddresswalletresult-script":       "The class of redeem script for a multisig address",
-	"validateaddresswalletresult-sigsrequired": "The number of required signatures to redeem outputs to the multisig address",
+	"validateaddresswalletresult-isvalid":         "Whether or not the address is valid",
+	"validateaddresswalletresult-address":         "The payment address (only when isvalid is true)",
+	"validateaddresswalletresult-ismine":          "Whether this address is controlled by the wallet (only when isvalid is true)",
+	"validateaddresswalletresult-iswatchonly":     "Unset",
+	"validateaddresswalletresult-isscript":        "Whether the payment address is a pay-to-script-hash address (only when isvalid is true)",
+	"validateaddresswalletresult-pubkey":          "The associated public key of the payment address, if any (only when isvalid is true)",
+	"validateaddresswalletresult-iscompressed":    "Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)",
+	"validateaddresswalletresult-account":         "The account this payment address belongs to (only when isvalid is true)",
+	"validateaddresswalletresult-addresses":       "All associated payment addresses of the script if address is a multisig address (only when isvalid is true)",
+	"validateaddresswalletresult-hex":             "The redeem script ",
+	"validateaddresswalletresult-script":          "The class of redeem script for a multisig address",
+	"validateaddresswalletresult-sigsrequired":    "The number of required signatures to redeem outputs to the multisig address",
+	"validateaddresswalletresult-iswitness":       "Whether the address pays to a segwit witness program (only when isvalid is true)",
+	"validateaddresswalletresult-witness_version": "The witness version of the address (only when iswitness is true)",
+	"validateaddresswalletresult-witness_program": "The hex encoded witness program of the address (only when iswitness is true)",
 
 	// VerifyMessageCmd help.
 	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.",
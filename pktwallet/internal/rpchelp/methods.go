@@ -30,7 +30,10 @@ var Methods = []struct {
 	{"getnetworkstewardvote", []interface{}{(*btcjson.GetNetworkStewardVoteResult)(nil)}},
 	{"resync", nil},
 	{"stopresync", returnsString},
+	{"foldaddress", returnsString},
+	{"stopfold", returnsString},
 	{"addp2shscript", returnsString},
+	{"analyzeminiscript", []interface{}{(*btcjson.MiniscriptResult)(nil)}},
 	{"dumpprivkey", returnsString},
 	{"getbalance", append(returnsNumber, returnsNumber[0])},
 	{"getbestblockhash", returnsString},
@@ -41,8 +44,12 @@ var Methods = []struct {
 	{"gettransaction", []interface{}{(*btcjson.GetTransactionResult)(nil)}},
 	{"getwalletseed", returnsString},
 	{"getsecret", returnsString},
+	{"splitwalletseed", []interface{}{(*btcjson.SplitWalletSeedResult)(nil)}},
 	{"help", append(returnsString, returnsString[0])},
+	{"importdescriptors", []interface{}{(*[]btcjson.ImportDescriptorsResult)(nil)}},
+	{"importminiscript", []interface{}{(*btcjson.MiniscriptResult)(nil)}},
 	{"importprivkey", nil},
+	{"listdescriptors", []interface{}{(*btcjson.ListDescriptorsResult)(nil)}},
 	{"listlockunspent", []interface{}{(*[]btcjson.TransactionInput)(nil)}},
 	{"listreceivedbyaddress", []interface{}{(*[]btcjson.ListReceivedByAddressResult)(nil)}},
 	{"listsinceblock", []interface{}{(*btcjson.ListSinceBlockResult)(nil)}},
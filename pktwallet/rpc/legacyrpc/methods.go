@@ -27,6 +27,7 @@ import (
 	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcjson"
 	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/descriptor"
 	"github.com/pkt-cash/pktd/chaincfg"
 	"github.com/pkt-cash/pktd/chaincfg/chainhash"
 	"github.com/pkt-cash/pktd/pktwallet/chain"
@@ -37,6 +38,7 @@ import (
 	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
 	"github.com/pkt-cash/pktd/rpcclient"
 	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/txscript/miniscript"
 	"github.com/pkt-cash/pktd/wire"
 )
 
@@ -94,7 +96,9 @@ var rpcHandlers = map[string]struct {
 	"getreceivedbyaddress":   {handler: getReceivedByAddress},
 	"gettransaction":         {handler: getTransaction},
 	"help":                   {handler: helpNoChainRPC, handlerRPC: helpWithChainRPC},
+	"importdescriptors":      {handler: importDescriptors},
 	"importprivkey":          {handler: importPrivKey},
+	"listdescriptors":        {handler: listDescriptors},
 	"listlockunspent":        {handler: listLockUnspent},
 	"listreceivedbyaddress":  {handler: listReceivedByAddress},
 	"listsinceblock":         {handlerChain: listSinceBlock},
@@ -118,12 +122,17 @@ var rpcHandlers = map[string]struct {
 	"setnetworkstewardvote": {handler: setNetworkStewardVote},
 	"getnetworkstewardvote": {handler: getNetworkStewardVote},
 	"addp2shscript":         {handler: addP2shScript},
+	"analyzeminiscript":     {handler: analyzeMiniscript},
+	"importminiscript":      {handler: importMiniscript},
 	"createtransaction":     {handler: createTransaction},
 	"resync":                {handler: resync},
 	"stopresync":            {handler: stopResync},
+	"foldaddress":           {handler: foldAddress},
+	"stopfold":              {handler: stopFold},
 	"getaddressbalances":    {handler: getAddressBalances},
 	"getwalletseed":         {handler: getWalletSeed},
 	"getsecret":             {handler: getSecret},
+	"splitwalletseed":       {handler: splitWalletSeed},
 	"walletmempool":         {handler: walletMempool},
 	// This was an extension but the reference implementation added it as
 	// well, but with a different API (no account parameter).  It's listed
@@ -276,6 +285,70 @@ func addP2shScript(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return p2shAddr.EncodeAddress(), nil
 }
 
+// miniscriptResult describes a compiled miniscript expression.
+func miniscriptResult(n *miniscript.Node, chainParams *chaincfg.Params) (*btcjson.MiniscriptResult, er.R) {
+	script, err := n.Script()
+	if err != nil {
+		return nil, err
+	}
+	addr, err := btcutil.NewAddressWitnessScriptHash(
+		chainhash.HashB(script), chainParams)
+	if err != nil {
+		return nil, err
+	}
+	a, err := n.Analyze()
+	if err != nil {
+		return nil, err
+	}
+	res := &btcjson.MiniscriptResult{
+		Miniscript:          n.String(),
+		Address:             addr.EncodeAddress(),
+		WitnessScript:       hex.EncodeToString(script),
+		Type:                a.Type.String(),
+		Sane:                true,
+		NonMalleable:        a.NonMalleable,
+		NeedsSignature:      a.NeedsSignature,
+		TimelockMix:         a.TimelockMix,
+		ScriptSize:          a.ScriptSize,
+		OpCount:             a.OpCount,
+		MaxSatisfactionSize: a.MaxSatisfactionSize,
+		MaxStackItems:       a.MaxStackItems,
+	}
+	if err := a.Sane(); err != nil {
+		res.Sane = false
+		res.InsaneReason = err.Message()
+	}
+	return res, nil
+}
+
+// analyzeMiniscript handles an analyzeminiscript request by compiling the
+// expression and reporting its properties.
+func analyzeMiniscript(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.AnalyzeMiniscriptCmd)
+	n, err := miniscript.Parse(cmd.Miniscript)
+	if err != nil {
+		return nil, btcjson.ErrRPCInvalidParameter.New("unable to parse miniscript", err)
+	}
+	return miniscriptResult(n, w.ChainParams())
+}
+
+// importMiniscript handles an importminiscript request by compiling the
+// expression and importing the resulting P2WSH script.
+func importMiniscript(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.ImportMiniscriptCmd)
+	n, err := miniscript.Parse(cmd.Miniscript)
+	if err != nil {
+		return nil, btcjson.ErrRPCInvalidParameter.New("unable to parse miniscript", err)
+	}
+	if _, _, err := w.ImportMiniscript(n); err != nil {
+		if miniscript.ErrInsane.Is(err) {
+			return nil, btcjson.ErrRPCInvalidParameter.New("refusing to import", err)
+		}
+		return nil, err
+	}
+	return miniscriptResult(n, w.ChainParams())
+}
+
 // addMultiSigAddress handles an addmultisigaddress request by adding a
 // multisig address to the given wallet.
 func addMultiSigAddress(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
@@ -386,6 +459,41 @@ func getWalletSeed(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return seed.Words("english")
 }
 
+// splitWalletSeed handles a splitwalletseed request by splitting the wallet
+// seed into shares.
+func splitWalletSeed(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.SplitWalletSeedCmd)
+	if w.Manager.IsLocked() {
+		return nil, btcjson.ErrRPCWalletUnlockNeeded.Default()
+	}
+	seed := w.Manager.Seed()
+	if seed == nil {
+		return nil, er.New("No seed found, this is probably a legacy wallet")
+	}
+	shares, err := seed.Split(cmd.Threshold, cmd.Count)
+	if err != nil {
+		return nil, btcjson.ErrRPCInvalidParameter.New(err.Message(), nil)
+	}
+	defer func() {
+		for _, sh := range shares {
+			sh.Zero()
+		}
+	}()
+	res := btcjson.SplitWalletSeedResult{
+		GroupID:   fmt.Sprintf("%04x", shares[0].GroupID()),
+		Threshold: cmd.Threshold,
+		Shares:    make([]string, 0, len(shares)),
+	}
+	for _, sh := range shares {
+		words, err := sh.Words(*cmd.Language)
+		if err != nil {
+			return nil, btcjson.ErrRPCInvalidParameter.New(err.Message(), nil)
+		}
+		res.Shares = append(res.Shares, words)
+	}
+	return res, nil
+}
+
 func getSecret(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	cmd := icmd.(*btcjson.GetSecretCmd)
 	return w.GetSecret(cmd.Name)
@@ -624,6 +732,115 @@ func importPrivKey(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return nil, err
 }
 
+// defaultDescriptorRange is the range of indexes which is imported from a
+// ranged descriptor if the request does not specify one.
+var defaultDescriptorRange = []uint32{0, 999}
+
+// importDescriptors handles an importdescriptors request by importing each
+// of the requested descriptors.  Each descriptor is imported independently,
+// a failure of one does not prevent the others from being imported.  The
+// chain is rescanned once for all of the descriptors which requested it.
+func importDescriptors(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.ImportDescriptorsCmd)
+
+	results := make([]btcjson.ImportDescriptorsResult, len(cmd.Requests))
+	imports := make([]wallet.DescriptorImport, 0, len(cmd.Requests))
+	indexes := make([]int, 0, len(cmd.Requests))
+	for i := range cmd.Requests {
+		di, err := descriptorImport(w, &cmd.Requests[i])
+		if err != nil {
+			results[i].Error = err.Message()
+			continue
+		}
+		imports = append(imports, *di)
+		indexes = append(indexes, i)
+	}
+
+	for j, ir := range w.ImportDescriptors(imports) {
+		res := &results[indexes[j]]
+		if waddrmgr.ErrLocked.Is(ir.Err) {
+			res.Error = btcjson.ErrRPCWalletUnlockNeeded.Default().Message()
+			continue
+		} else if ir.Err != nil {
+			res.Error = ir.Err.Message()
+			continue
+		}
+		res.Success = true
+		for _, addr := range ir.Addresses {
+			res.Addresses = append(res.Addresses, addr.EncodeAddress())
+		}
+	}
+	return results, nil
+}
+
+// descriptorImport parses one request of an importdescriptors call.
+func descriptorImport(w *wallet.Wallet,
+	req *btcjson.ImportDescriptorsRequest) (*wallet.DescriptorImport, er.R) {
+
+	desc, err := descriptor.Parse(req.Desc)
+	if err != nil {
+		return nil, btcjson.ErrRPCInvalidParameter.New("unable to parse descriptor", err)
+	}
+	rng := defaultDescriptorRange
+	if req.Range != nil {
+		rng = *req.Range
+		if len(rng) != 2 {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"range must be an array of [start,end]", nil)
+		}
+	}
+	di := wallet.DescriptorImport{
+		Descriptor: desc,
+		RangeStart: rng[0],
+		RangeEnd:   rng[1],
+		Rescan:     req.Rescan != nil && *req.Rescan,
+	}
+	if req.FromHeight != nil && *req.FromHeight > 0 {
+		chainClient := w.ChainClient()
+		if chainClient == nil {
+			return nil, er.New("fromheight requires the wallet to be " +
+				"connected to the chain")
+		}
+		hash, err := chainClient.GetBlockHash(int64(*req.FromHeight))
+		if err != nil {
+			return nil, err
+		}
+		header, err := chainClient.GetBlockHeader(hash)
+		if err != nil {
+			return nil, err
+		}
+		di.BlockStamp = &waddrmgr.BlockStamp{
+			Hash:      *hash,
+			Height:    *req.FromHeight,
+			Timestamp: header.Timestamp,
+		}
+	}
+	return &di, nil
+}
+
+// listDescriptors handles a listdescriptors request by returning the public
+// form of each descriptor which has been imported into the wallet.
+func listDescriptors(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	descs, err := w.ListDescriptors()
+	if err != nil {
+		return nil, err
+	}
+	res := btcjson.ListDescriptorsResult{
+		Descriptors: make([]btcjson.DescriptorInfo, 0, len(descs)),
+	}
+	for _, di := range descs {
+		info := btcjson.DescriptorInfo{
+			Desc:      di.Descriptor,
+			Timestamp: di.Timestamp.Unix(),
+		}
+		if d, err := descriptor.Parse(di.Descriptor); err == nil && d.IsRange() {
+			info.Range = []uint32{di.RangeStart, di.RangeEnd}
+		}
+		res.Descriptors = append(res.Descriptors, info)
+	}
+	return res, nil
+}
+
 // getNewAddress handles a getnewaddress request by returning a new
 // address for an account.  If the account does not exist an appropriate
 // error is returned.
@@ -1181,6 +1398,45 @@ func makeOutputs(pairs map[string]btcutil.Amount, vote *waddrmgr.NetworkStewardV
 	return outputs, nil
 }
 
+// coinControl holds the optional coin control parameters of createtransaction
+// and sendmany.
+type coinControl struct {
+	inputs        *[]btcjson.TransactionInput
+	coinSelection *string
+	avoidMixing   *bool
+}
+
+// apply sets the coin control options of a CreateTxReq.
+func (cc *coinControl) apply(req *wallet.CreateTxReq) er.R {
+	if cc == nil {
+		return nil
+	}
+	if cc.inputs != nil {
+		if req.InputAddresses != nil {
+			return btcjson.ErrRPCInvalidParameter.New(
+				"inputs and fromaddresses cannot both be specified", nil)
+		}
+		ops := make([]wire.OutPoint, 0, len(*cc.inputs))
+		for _, input := range *cc.inputs {
+			txHash, err := chainhash.NewHashFromStr(input.Txid)
+			if err != nil {
+				return errParse("unable to parse hash", err)
+			}
+			ops = append(ops, wire.OutPoint{Hash: *txHash, Index: input.Vout})
+		}
+		req.InputOutpoints = &ops
+	}
+	if cc.coinSelection != nil {
+		cs, err := wallet.ParseCoinSelection(*cc.coinSelection)
+		if err != nil {
+			return btcjson.ErrRPCInvalidParameter.New(err.Message(), nil)
+		}
+		req.CoinSelection = cs
+	}
+	req.AvoidMixing = cc.avoidMixing != nil && *cc.avoidMixing
+	return nil
+}
+
 func sendOutputs(
 	w *wallet.Wallet,
 	amounts map[string]btcutil.Amount,
@@ -1192,6 +1448,7 @@ func sendOutputs(
 	changeAddress *string,
 	inputMinHeight int,
 	maxInputs int,
+	cc *coinControl,
 ) (*txauthor.AuthoredTx, er.R) {
 	req := wallet.CreateTxReq{
 		Minconf:        minconf,
@@ -1231,6 +1488,9 @@ func sendOutputs(
 		}
 		req.InputAddresses = &addrs
 	}
+	if err := cc.apply(&req); err != nil {
+		return nil, err
+	}
 	tx, err := w.SendOutputs(req)
 	if err != nil {
 		if ruleerror.ErrNegativeTxOutValue.Is(err) {
@@ -1251,13 +1511,14 @@ func sendOutputs(
 // It returns the transaction hash in string format upon success
 // All errors are returned in btcjson.RPCError format
 func sendPairs(w *wallet.Wallet, amounts map[string]btcutil.Amount,
-	fromAddressses *[]string, minconf int32, feeSatPerKb btcutil.Amount, maxInputs, inputMinHeight int) (string, er.R) {
+	fromAddressses *[]string, minconf int32, feeSatPerKb btcutil.Amount, maxInputs, inputMinHeight int,
+	cc *coinControl) (string, er.R) {
 	vote, err := w.NetworkStewardVote(0, waddrmgr.KeyScopeBIP0044)
 	if err != nil {
 		return "", err
 	}
 
-	tx, err := sendOutputs(w, amounts, vote, fromAddressses, minconf, feeSatPerKb, false, nil, inputMinHeight, maxInputs)
+	tx, err := sendOutputs(w, amounts, vote, fromAddressses, minconf, feeSatPerKb, false, nil, inputMinHeight, maxInputs, cc)
 	if err != nil {
 		return "", err
 	}
@@ -1312,7 +1573,7 @@ func sendFrom(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 		minHeight = *cmd.MinHeight
 	}
 
-	return sendPairs(w, pairs, cmd.FromAddresses, minConf, txrules.DefaultRelayFeePerKb, maxInputs, minHeight)
+	return sendPairs(w, pairs, cmd.FromAddresses, minConf, txrules.DefaultRelayFeePerKb, maxInputs, minHeight, nil)
 }
 
 func createTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
@@ -1357,7 +1618,12 @@ func createTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	}
 
 	tx, err := sendOutputs(w, amounts, vote, cmd.FromAddresses, minconf,
-		feeSatPerKb, true, cmd.ChangeAddress, inputMinHeight, maxInputs)
+		feeSatPerKb, true, cmd.ChangeAddress, inputMinHeight, maxInputs,
+		&coinControl{
+			inputs:        cmd.Inputs,
+			coinSelection: cmd.CoinSelection,
+			avoidMixing:   cmd.AvoidMixing,
+		})
 	if err != nil {
 		return "", err
 	}
@@ -1405,6 +1671,25 @@ func resync(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	return nil, w.ResyncChain(fh, th, a, cmd.DropDb != nil && *cmd.DropDb)
 }
 
+// foldAddress handles a foldaddress request by starting a background job
+// which consolidates the outputs paid to an address.
+func foldAddress(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	cmd := icmd.(*btcjson.FoldAddressCmd)
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, errNeedPositiveMinconf()
+	}
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	return w.FoldAddress(addr, minConf)
+}
+
+func stopFold(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
+	return w.StopFold()
+}
+
 // sendMany handles a sendmany RPC request by creating a new transaction
 // spending unspent transaction outputs for a wallet to any number of
 // payment addresses.  Leftover inputs not sent to the payment address
@@ -1440,7 +1725,12 @@ func sendMany(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 		maxInputs = *cmd.MaxInputs
 	}
 
-	return sendPairs(w, pairs, cmd.FromAddresses, minConf, txrules.DefaultRelayFeePerKb, maxInputs, 0)
+	return sendPairs(w, pairs, cmd.FromAddresses, minConf, txrules.DefaultRelayFeePerKb, maxInputs, 0,
+		&coinControl{
+			inputs:        cmd.Inputs,
+			coinSelection: cmd.CoinSelection,
+			avoidMixing:   cmd.AvoidMixing,
+		})
 }
 
 // sendToAddress handles a sendtoaddress RPC request by creating a new
@@ -1473,7 +1763,7 @@ func sendToAddress(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	}
 
 	// sendtoaddress always spends from the default account, this matches bitcoind
-	return sendPairs(w, pairs, nil, 1, txrules.DefaultRelayFeePerKb, -1, 0)
+	return sendPairs(w, pairs, nil, 1, txrules.DefaultRelayFeePerKb, -1, 0, nil)
 }
 
 // setTxFee sets the transaction fee per kilobyte added to transactions.
@@ -1717,6 +2007,12 @@ func validateAddress(icmd interface{}, w *wallet.Wallet) (interface{}, er.R) {
 	// "ismine", and we follow that behavior.
 	result.Address = addr.EncodeAddress()
 	result.IsValid = true
+	if wa, ok := addr.(btcutil.WitnessAddress); ok {
+		version := int32(wa.WitnessVersion())
+		result.IsWitness = true
+		result.WitnessVersion = &version
+		result.WitnessProgram = hex.EncodeToString(wa.WitnessProgram())
+	}
 
 	ainfo, err := w.AddressInfo(addr)
 	if err != nil {
@@ -279,6 +279,16 @@ var (
 
 	// bucket containing dbNetworkStewardVote
 	networkStewardVoteName = []byte("nsvote")
+
+	// descriptorBucketName is the name of the bucket which stores the
+	// output descriptors which have been imported into the wallet.  It is
+	// created on first use and maps:
+	// descriptor string (public form, with checksum) => dbDescriptorRow
+	descriptorBucketName = []byte("descriptors")
+
+	// foldJobName is the key in the sync bucket of the address folding job
+	// which is in progress, if any.
+	foldJobName = []byte("foldjob")
 )
 
 // uint32ToBytes converts a 32 bit unsigned integer into a 4-byte slice in
@@ -2331,3 +2341,145 @@ func createManagerNS(ns walletdb.ReadWriteBucket,
 
 	return nil
 }
+
+// dbDescriptorRow is an imported output descriptor as it is stored in the
+// database.
+//
+// The serialized format is:
+//   [0:8]   import timestamp
+//   [8:12]  first index of the range
+//   [12:16] last index of the range
+type dbDescriptorRow struct {
+	timestamp  uint64
+	rangeStart uint32
+	rangeEnd   uint32
+}
+
+func serializeDescriptorRow(row *dbDescriptorRow) []byte {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[0:8], row.timestamp)
+	binary.BigEndian.PutUint32(buf[8:12], row.rangeStart)
+	binary.BigEndian.PutUint32(buf[12:16], row.rangeEnd)
+	return buf[:]
+}
+
+func deserializeDescriptorRow(b []byte) (*dbDescriptorRow, er.R) {
+	if len(b) != 16 {
+		str := "malformed descriptor stored in database"
+		return nil, managerError(ErrDatabase, str, nil)
+	}
+	return &dbDescriptorRow{
+		timestamp:  binary.BigEndian.Uint64(b[0:8]),
+		rangeStart: binary.BigEndian.Uint32(b[8:12]),
+		rangeEnd:   binary.BigEndian.Uint32(b[12:16]),
+	}, nil
+}
+
+// putDescriptor stores an imported descriptor, replacing any previous entry
+// for the same descriptor.
+func putDescriptor(ns walletdb.ReadWriteBucket, desc string,
+	row *dbDescriptorRow) er.R {
+	bucket := ns.NestedReadWriteBucket(descriptorBucketName)
+	if bucket == nil {
+		var err er.R
+		bucket, err = ns.CreateBucket(descriptorBucketName)
+		if err != nil {
+			str := "failed to create descriptor bucket"
+			return managerError(ErrDatabase, str, err)
+		}
+	}
+	if err := bucket.Put([]byte(desc), serializeDescriptorRow(row)); err != nil {
+		str := fmt.Sprintf("failed to store descriptor %s", desc)
+		return managerError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// fetchDescriptor loads an imported descriptor, returning nil if it has not
+// been imported.
+func fetchDescriptor(ns walletdb.ReadBucket, desc string) (*dbDescriptorRow, er.R) {
+	bucket := ns.NestedReadBucket(descriptorBucketName)
+	if bucket == nil {
+		return nil, nil
+	}
+	v := bucket.Get([]byte(desc))
+	if v == nil {
+		return nil, nil
+	}
+	return deserializeDescriptorRow(v)
+}
+
+// forEachDescriptor calls fn for each imported descriptor.
+func forEachDescriptor(ns walletdb.ReadBucket,
+	fn func(desc string, row *dbDescriptorRow) er.R) er.R {
+	bucket := ns.NestedReadBucket(descriptorBucketName)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.ForEach(func(k, v []byte) er.R {
+		row, err := deserializeDescriptorRow(v)
+		if err != nil {
+			return err
+		}
+		return fn(string(k), row)
+	})
+}
+
+// fetchFoldJob retrieves the address folding job from the database, it
+// returns nil if there is no job in progress.
+//
+// The job is serialized as follows:
+//   [0:8]   creation timestamp
+//   [8:12]  minconf
+//   [12:16] number of transactions made
+//   [16:24] number of inputs folded
+//   [24:32] amount folded
+//   [32:]   address
+func fetchFoldJob(ns walletdb.ReadBucket) (*FoldJob, er.R) {
+	bucket := ns.NestedReadBucket(syncBucketName)
+	v := bucket.Get(foldJobName)
+	if v == nil {
+		return nil, nil
+	}
+	if len(v) < 32 {
+		str := "malformed fold job stored in database"
+		return nil, managerError(ErrDatabase, str, nil)
+	}
+	return &FoldJob{
+		Created:      time.Unix(int64(binary.BigEndian.Uint64(v[0:8])), 0),
+		Minconf:      int32(binary.BigEndian.Uint32(v[8:12])),
+		Transactions: binary.BigEndian.Uint32(v[12:16]),
+		Inputs:       binary.BigEndian.Uint64(v[16:24]),
+		Amount:       int64(binary.BigEndian.Uint64(v[24:32])),
+		Address:      string(v[32:]),
+	}, nil
+}
+
+// putFoldJob stores the address folding job to the database, replacing any
+// job which was there before.
+func putFoldJob(ns walletdb.ReadWriteBucket, job *FoldJob) er.R {
+	v := make([]byte, 32+len(job.Address))
+	binary.BigEndian.PutUint64(v[0:8], uint64(job.Created.Unix()))
+	binary.BigEndian.PutUint32(v[8:12], uint32(job.Minconf))
+	binary.BigEndian.PutUint32(v[12:16], job.Transactions)
+	binary.BigEndian.PutUint64(v[16:24], job.Inputs)
+	binary.BigEndian.PutUint64(v[24:32], uint64(job.Amount))
+	copy(v[32:], job.Address)
+
+	bucket := ns.NestedReadWriteBucket(syncBucketName)
+	if err := bucket.Put(foldJobName, v); err != nil {
+		str := "failed to store fold job"
+		return managerError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// deleteFoldJob removes the address folding job from the database.
+func deleteFoldJob(ns walletdb.ReadWriteBucket) er.R {
+	bucket := ns.NestedReadWriteBucket(syncBucketName)
+	if err := bucket.Delete(foldJobName); err != nil {
+		str := "failed to remove fold job"
+		return managerError(ErrDatabase, str, err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package waddrmgr
 import (
 	"encoding/binary"
 	"testing"
+	"time"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
 
@@ -128,3 +129,99 @@ func TestStoreMaxReorgDepth(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// TestPutDescriptor ensures that re-importing a descriptor widens the stored
+// range rather than replacing it.
+func TestPutDescriptor(t *testing.T) {
+	teardown, db, mgr := setupManager(t)
+	defer teardown()
+
+	const desc = "wpkh(xpub/0/*)#checksum"
+	imports := []DescriptorInfo{
+		{Descriptor: desc, Timestamp: time.Unix(2000, 0), RangeStart: 10, RangeEnd: 20},
+		{Descriptor: desc, Timestamp: time.Unix(3000, 0), RangeStart: 0, RangeEnd: 15},
+	}
+	for i := range imports {
+		err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			return mgr.PutDescriptor(ns, &imports[i])
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []DescriptorInfo
+	err := walletdb.View(db, func(tx walletdb.ReadTx) er.R {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		return mgr.ForEachDescriptor(ns, func(di *DescriptorInfo) er.R {
+			got = append(got, *di)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("expected 1 descriptor, got %d", len(got))
+	}
+	if got[0].RangeStart != 0 || got[0].RangeEnd != 20 {
+		t.Fatalf("expected range [0,20], got [%d,%d]",
+			got[0].RangeStart, got[0].RangeEnd)
+	}
+	if got[0].Timestamp.Unix() != 2000 {
+		t.Fatalf("expected the earliest timestamp to be kept, got %v",
+			got[0].Timestamp)
+	}
+}
+
+// TestFoldJob ensures that an address folding job survives a round trip
+// through the database and that it can be removed.
+func TestFoldJob(t *testing.T) {
+	teardown, db, mgr := setupManager(t)
+	defer teardown()
+
+	job := FoldJob{
+		Address:      "pkt1q6hqsqhqdgqfd8t3xwgceulu7k9d9w5t2amath0qxyfjlvl3s3u4sjza2g2",
+		Minconf:      3,
+		Created:      time.Unix(1600000000, 0),
+		Transactions: 7,
+		Inputs:       10219,
+		Amount:       4096 * 1e9,
+	}
+	err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return mgr.SetFoldJob(ns, &job)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got *FoldJob
+	err = walletdb.View(db, func(tx walletdb.ReadTx) er.R {
+		var err er.R
+		got, err = mgr.FoldJob(tx.ReadBucket(waddrmgrNamespaceKey))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || *got != job {
+		t.Fatalf("expected %+v, got %+v", job, got)
+	}
+
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		if err := mgr.SetFoldJob(ns, nil); err != nil {
+			return err
+		}
+		got, err = mgr.FoldJob(ns)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Fatalf("expected the job to be removed, got %+v", got)
+	}
+}
@@ -661,6 +661,67 @@ func (m *Manager) ForEachAccountAddress(ns walletdb.ReadBucket, account uint32,
 	return nil
 }
 
+// DescriptorInfo is an output descriptor which has been imported into the
+// wallet along with the range of indexes which are being watched.
+type DescriptorInfo struct {
+	// Descriptor is the public form of the descriptor, with checksum.
+	Descriptor string
+
+	// Timestamp is the time when the descriptor was imported.
+	Timestamp time.Time
+
+	// RangeStart and RangeEnd are the first and last index which have
+	// been imported, both are zero for a descriptor without a wildcard.
+	RangeStart uint32
+	RangeEnd   uint32
+}
+
+// PutDescriptor records an imported descriptor.  If the descriptor has been
+// imported before then the stored range is widened to include the new one.
+func (m *Manager) PutDescriptor(ns walletdb.ReadWriteBucket, di *DescriptorInfo) er.R {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	row := &dbDescriptorRow{
+		timestamp:  uint64(di.Timestamp.Unix()),
+		rangeStart: di.RangeStart,
+		rangeEnd:   di.RangeEnd,
+	}
+	old, err := fetchDescriptor(ns, di.Descriptor)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if old.rangeStart < row.rangeStart {
+			row.rangeStart = old.rangeStart
+		}
+		if old.rangeEnd > row.rangeEnd {
+			row.rangeEnd = old.rangeEnd
+		}
+		if old.timestamp < row.timestamp {
+			row.timestamp = old.timestamp
+		}
+	}
+	return putDescriptor(ns, di.Descriptor, row)
+}
+
+// ForEachDescriptor calls fn with each descriptor which has been imported,
+// breaking early on error.
+func (m *Manager) ForEachDescriptor(ns walletdb.ReadBucket,
+	fn func(di *DescriptorInfo) er.R) er.R {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return forEachDescriptor(ns, func(desc string, row *dbDescriptorRow) er.R {
+		return fn(&DescriptorInfo{
+			Descriptor: desc,
+			Timestamp:  time.Unix(int64(row.timestamp), 0),
+			RangeStart: row.rangeStart,
+			RangeEnd:   row.rangeEnd,
+		})
+	})
+}
+
 func (m *Manager) Seed() *seedwords.SeedEnc {
 	return m.xseed
 }
@@ -1625,6 +1625,80 @@ func (s *ScopedKeyManager) ImportPrivateKey(ns walletdb.ReadWriteBucket,
 	return managedAddr, nil
 }
 
+// ImportPublicKey imports a public key into the address manager so that the
+// address derived from it is watched.  The address type is determined by the
+// scope's external address schema, just like ImportPrivateKey.
+//
+// All imported addresses will be part of the account defined by the
+// ImportedAddrAccount constant.
+//
+// This function will return an error if the address already exists.  Any
+// other errors returned are generally unexpected.
+func (s *ScopedKeyManager) ImportPublicKey(ns walletdb.ReadWriteBucket,
+	pubKey *btcec.PublicKey, compressed bool, bs *BlockStamp) (ManagedPubKeyAddress, er.R) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// Prevent duplicates.
+	var serializedPubKey []byte
+	if compressed {
+		serializedPubKey = pubKey.SerializeCompressed()
+	} else {
+		serializedPubKey = pubKey.SerializeUncompressed()
+	}
+	pubKeyHash := btcutil.Hash160(serializedPubKey)
+	if s.existsAddress(ns, pubKeyHash) {
+		str := fmt.Sprintf("address for public key %x already exists",
+			serializedPubKey)
+		return nil, managerError(ErrDuplicateAddress, str, nil)
+	}
+
+	// Encrypt public key.
+	encryptedPubKey, err := s.rootManager.cryptoKeyPub.Encrypt(
+		serializedPubKey,
+	)
+	if err != nil {
+		str := fmt.Sprintf("failed to encrypt public key for %x",
+			serializedPubKey)
+		return nil, managerError(ErrCrypto, str, err)
+	}
+
+	// The start block needs to be updated when the newly imported address
+	// is before the current one.
+	s.rootManager.mtx.Lock()
+	updateStartBlock := bs.Height < s.rootManager.syncState.startBlock.Height
+	s.rootManager.mtx.Unlock()
+
+	err = putImportedAddress(
+		ns, &s.scope, pubKeyHash, ImportedAddrAccount, ssNone,
+		encryptedPubKey, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if updateStartBlock {
+		if err := putStartBlock(ns, bs); err != nil {
+			return nil, err
+		}
+		s.rootManager.mtx.Lock()
+		s.rootManager.syncState.startBlock = *bs
+		s.rootManager.mtx.Unlock()
+	}
+
+	managedAddr, err := newManagedAddressWithoutPrivKey(
+		s, DerivationPath{Account: ImportedAddrAccount}, pubKey,
+		compressed, s.addrSchema.ExternalAddrType,
+	)
+	if err != nil {
+		return nil, err
+	}
+	managedAddr.imported = true
+
+	s.addrs[addrKey(managedAddr.Address().ScriptAddress())] = managedAddr
+	return managedAddr, nil
+}
+
 func (s *ScopedKeyManager) ImportWitnessScript(ns walletdb.ReadWriteBucket,
 	script []byte, bs *BlockStamp) (ManagedScriptAddress, er.R) {
 	s.mtx.Lock()
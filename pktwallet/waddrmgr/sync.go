@@ -131,3 +131,45 @@ func (m *Manager) SetBirthdayBlock(ns walletdb.ReadWriteBucket,
 	}
 	return putBirthdayBlockVerification(ns, verified)
 }
+
+// FoldJob is a job which consolidates the many small outputs paid to an
+// address into a few large ones.  It is stored in the database so that it
+// can be resumed if the wallet is restarted.
+type FoldJob struct {
+	// Address is the address which is being folded, the outputs are
+	// paid back to the same address.
+	Address string
+
+	// Minconf is the number of confirmations which an output must have
+	// before it is folded.
+	Minconf int32
+
+	// Created is the time when the job was started.
+	Created time.Time
+
+	// Transactions is the number of folding transactions which have been
+	// accepted so far.
+	Transactions uint32
+
+	// Inputs is the number of outputs which have been folded so far.
+	Inputs uint64
+
+	// Amount is the total value of the outputs which have been folded so
+	// far, in atomic units.
+	Amount int64
+}
+
+// FoldJob returns the address folding job which is in progress, or nil if
+// there is none.
+func (m *Manager) FoldJob(ns walletdb.ReadBucket) (*FoldJob, er.R) {
+	return fetchFoldJob(ns)
+}
+
+// SetFoldJob stores the progress of an address folding job, if job is nil
+// then the job is removed.
+func (m *Manager) SetFoldJob(ns walletdb.ReadWriteBucket, job *FoldJob) er.R {
+	if job == nil {
+		return deleteFoldJob(ns)
+	}
+	return putFoldJob(ns, job)
+}
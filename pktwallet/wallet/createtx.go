@@ -17,7 +17,9 @@ import (
 	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/chain"
 	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/internal/txsizes"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
 	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
 	"github.com/pkt-cash/pktd/pktwallet/walletdb"
@@ -66,6 +68,27 @@ func makeInputSource(eligible []*wtxmgr.Credit) txauthor.InputSource {
 	}
 }
 
+// makeFixedInputSource creates an input source which always provides every
+// one of the credits, it is used when the inputs have been chosen ahead of
+// time by coin control or a coin selection algorithm.
+func makeFixedInputSource(credits []*wtxmgr.Credit) txauthor.InputSource {
+	total := btcutil.Amount(0)
+	inputs := make([]*wire.TxIn, 0, len(credits))
+	additional := make([]wire.TxInAdditional, 0, len(credits))
+	for _, c := range credits {
+		total += c.Amount
+		inputs = append(inputs, wire.NewTxIn(&c.OutPoint, nil, nil))
+		v := int64(c.Amount)
+		additional = append(additional, wire.TxInAdditional{
+			PkScript: c.PkScript,
+			Value:    &v,
+		})
+	}
+	return func(btcutil.Amount) (btcutil.Amount, []*wire.TxIn, []wire.TxInAdditional, er.R) {
+		return total, inputs, additional, nil
+	}
+}
+
 // secretSource is an implementation of txauthor.SecretSource for the wallet's
 // address manager.
 type secretSource struct {
@@ -147,9 +170,7 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 	if sweepOutput != nil {
 		needAmount = 0
 	}
-	eligibleOuts, err := w.findEligibleOutputs(
-		dbtx, needAmount, txr.InputAddresses, txr.Minconf, bs,
-		txr.InputMinHeight, txr.InputComparator, txr.MaxInputs)
+	eligibleOuts, fixedInputs, err := w.selectInputs(dbtx, &txr, needAmount, bs)
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +192,9 @@ func (w *Wallet) txToOutputs(txr CreateTxReq) (tx *txauthor.AuthoredTx, err er.R
 	}
 
 	inputSource := makeInputSource(eligibleOuts.credits)
+	if fixedInputs {
+		inputSource = makeFixedInputSource(eligibleOuts.credits)
+	}
 	changeSource := func() ([]byte, er.R) {
 		// Derive the change output script.  As a hack to allow
 		// spending from the imported account, change addresses are
@@ -391,6 +415,93 @@ type eligibleOutputs struct {
 	unusedAmt        btcutil.Amount
 }
 
+// eligibility is the result of checking whether a credit may be spent.
+type eligibility int
+
+const (
+	eligible eligibility = iota
+	ineligibleHeight
+	ineligibleImmature
+	ineligibleBurned
+	ineligibleUnconfirmed
+	ineligibleLocked
+	ineligibleNotInChain
+)
+
+func (e eligibility) String() string {
+	switch e {
+	case eligible:
+		return "eligible"
+	case ineligibleHeight:
+		return "below the minimum input height"
+	case ineligibleImmature:
+		return "an immature coinbase"
+	case ineligibleBurned:
+		return "burned"
+	case ineligibleUnconfirmed:
+		return "not sufficiently confirmed"
+	case ineligibleLocked:
+		return "locked"
+	case ineligibleNotInChain:
+		return "in a block which is not in the chain"
+	}
+	return "unknown"
+}
+
+// checkEligible checks whether an output may be spent given the minconf and
+// inputMinHeight policy of the transaction.
+func (w *Wallet) checkEligible(
+	output *wtxmgr.Credit,
+	minconf int32,
+	bs *waddrmgr.BlockStamp,
+	inputMinHeight int,
+	chainClient chain.Interface,
+) eligibility {
+	if output.Height >= 0 && output.Height < int32(inputMinHeight) {
+		log.Debugf("Skipping output %s at height %d because it is below minimum %d",
+			output.String(), output.Height, inputMinHeight)
+		return ineligibleHeight
+	}
+
+	if output.FromCoinBase {
+		if !confirmed(int32(w.chainParams.CoinbaseMaturity), output.Height, bs.Height) {
+			log.Debugf("Skipping immature coinbase output [%s] at height %d",
+				output.OutPoint.String(), output.Height)
+			return ineligibleImmature
+		} else if txrules.IsBurned(output, w.chainParams, bs.Height+1440) {
+			log.Debugf("Skipping burned output at height %d", output.Height)
+			return ineligibleBurned
+		}
+	}
+
+	if minconf > 0 {
+		// Only include this output if it meets the required number of
+		// confirmations.  Coinbase transactions must have have reached
+		// maturity before their outputs may be spent.
+		if !confirmed(minconf, output.Height, bs.Height) {
+			log.Debugf("Skipping unconfirmed output [%s] at height %d [cur height: %d]",
+				output.OutPoint.String(), output.Height, bs.Height)
+			return ineligibleUnconfirmed
+		}
+	}
+
+	// Locked unspent outputs are skipped.
+	if w.LockedOutpoint(output.OutPoint) {
+		return ineligibleLocked
+	}
+
+	// If there is an unspent which references a block header which doesn't
+	// actually exist we've got some trouble. Lets make sure before we try to
+	// spend it.
+	if output.Height < 0 {
+	} else if _, err := chainClient.GetBlockHeader(&output.Block.Hash); err != nil {
+		log.Debugf("Input [%s] references block hash [%s] which is not in chain, skipping",
+			output.OutPoint.String(), output.Block.Hash)
+		return ineligibleNotInChain
+	}
+	return eligible
+}
+
 func (w *Wallet) findEligibleOutputs(
 	dbtx walletdb.ReadTx,
 	needAmount btcutil.Amount,
@@ -421,48 +532,13 @@ func (w *Wallet) findEligibleOutputs(
 			return nil
 		}
 
-		if output.Height >= 0 && output.Height < int32(inputMinHeight) {
-			log.Debugf("Skipping output %s at height %d because it is below minimum %d",
-				output.String(), output.Height, inputMinHeight)
+		switch w.checkEligible(output, minconf, bs, inputMinHeight, chainClient) {
+		case eligible:
+		case ineligibleUnconfirmed:
+			out.unconfirmedCount++
+			out.unconfirmedAmt += output.Amount
 			return nil
-		}
-
-		if output.FromCoinBase {
-			if !confirmed(int32(w.chainParams.CoinbaseMaturity), output.Height, bs.Height) {
-				log.Debugf("Skipping immature coinbase output [%s] at height %d",
-					output.OutPoint.String(), output.Height)
-				return nil
-			} else if txrules.IsBurned(output, w.chainParams, bs.Height+1440) {
-				log.Debugf("Skipping burned output at height %d", output.Height)
-				return nil
-			}
-		}
-
-		if minconf > 0 {
-			// Only include this output if it meets the required number of
-			// confirmations.  Coinbase transactions must have have reached
-			// maturity before their outputs may be spent.
-			if !confirmed(minconf, output.Height, bs.Height) {
-				log.Debugf("Skipping unconfirmed output [%s] at height %d [cur height: %d]",
-					output.OutPoint.String(), output.Height, bs.Height)
-				out.unconfirmedCount++
-				out.unconfirmedAmt += output.Amount
-				return nil
-			}
-		}
-
-		// Locked unspent outputs are skipped.
-		if w.LockedOutpoint(output.OutPoint) {
-			return nil
-		}
-
-		// If there is an unspent which references a block header which doesn't
-		// actually exist we've got some trouble. Lets make sure before we try to
-		// spend it.
-		if output.Height < 0 {
-		} else if _, err := chainClient.GetBlockHeader(&output.Block.Hash); err != nil {
-			log.Debugf("Input [%s] references block hash [%s] which is not in chain, skipping",
-				output.OutPoint.String(), output.Block.Hash)
+		default:
 			return nil
 		}
 
@@ -611,6 +687,270 @@ func (w *Wallet) findEligibleOutputs(
 	return out, nil
 }
 
+// CoinSelection is the algorithm which is used to choose the coins that fund
+// a transaction.
+type CoinSelection int
+
+const (
+	// CoinSelectDefault spends the coins of a single address if possible,
+	// ordered by the InputComparator.
+	CoinSelectDefault CoinSelection = iota
+
+	// CoinSelectBnB searches for a set of coins which pays for the
+	// transaction without needing a change output, if there is none then
+	// it falls back to CoinSelectKnapsack.
+	CoinSelectBnB
+
+	// CoinSelectKnapsack chooses a set of coins whose value is as close as
+	// possible to the amount needed, while leaving change which is not dust.
+	CoinSelectKnapsack
+)
+
+// ParseCoinSelection converts the name of a coin selection algorithm, as
+// used in the RPC, to a CoinSelection.
+func ParseCoinSelection(name string) (CoinSelection, er.R) {
+	switch name {
+	case "", "default":
+		return CoinSelectDefault, nil
+	case "bnb":
+		return CoinSelectBnB, nil
+	case "knapsack":
+		return CoinSelectKnapsack, nil
+	}
+	return CoinSelectDefault, er.Errorf("Unknown coin selection [%s], "+
+		"expected default, bnb or knapsack", name)
+}
+
+// mixesAddresses returns true if the credits are paid to more than one
+// address.
+func mixesAddresses(credits []*wtxmgr.Credit) bool {
+	for _, c := range credits {
+		if !bytes.Equal(c.PkScript, credits[0].PkScript) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectInputs chooses the credits which will fund a transaction, according
+// to the coin control and coin selection options of the request.  If the
+// result is fixed then every one of the credits must be spent.
+func (w *Wallet) selectInputs(
+	dbtx walletdb.ReadTx,
+	txr *CreateTxReq,
+	needAmount btcutil.Amount,
+	bs *waddrmgr.BlockStamp,
+) (eligibleOutputs, bool, er.R) {
+	if txr.InputOutpoints != nil {
+		out, err := w.findOutpoints(dbtx, *txr.InputOutpoints, txr.Minconf, bs,
+			txr.InputMinHeight)
+		if err == nil && txr.AvoidMixing && mixesAddresses(out.credits) {
+			err = er.New("the requested outpoints are paid to more than one " +
+				"address and avoidmixing is set")
+		}
+		return out, true, err
+	}
+	if needAmount > 0 && txr.CoinSelection != CoinSelectDefault {
+		out, err := w.selectCoins(dbtx, txr, bs)
+		return out, true, err
+	}
+	out, err := w.findEligibleOutputs(
+		dbtx, needAmount, txr.InputAddresses, txr.Minconf, bs,
+		txr.InputMinHeight, txr.InputComparator, txr.MaxInputs)
+	if err == nil && txr.AvoidMixing && mixesAddresses(out.credits) {
+		if needAmount == 0 {
+			err = er.New("sweeping would spend coins from more than one address " +
+				"and avoidmixing is set, specify a single from address")
+		} else {
+			err = InsufficientFundsError.New("no single address has enough balance "+
+				"and avoidmixing is set", nil)
+		}
+	}
+	return out, false, err
+}
+
+// findOutpoints looks up the credits for the outpoints which the caller has
+// explicitly chosen to spend, every one of them must be an eligible unspent
+// output of the wallet.
+func (w *Wallet) findOutpoints(
+	dbtx walletdb.ReadTx,
+	outpoints []wire.OutPoint,
+	minconf int32,
+	bs *waddrmgr.BlockStamp,
+	inputMinHeight int,
+) (eligibleOutputs, er.R) {
+	out := eligibleOutputs{}
+	if len(outpoints) == 0 {
+		return out, er.New("no outpoints specified")
+	}
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return out, err
+	}
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	found := make(map[wire.OutPoint]*wtxmgr.Credit, len(outpoints))
+	for _, op := range outpoints {
+		if _, ok := found[op]; ok {
+			return out, er.Errorf("outpoint [%s] is specified more than once", op.String())
+		}
+		found[op] = nil
+	}
+	if err := w.TxStore.ForEachUnspentOutput(txmgrNs, nil, func(_ []byte, output *wtxmgr.Credit) er.R {
+		if c, ok := found[output.OutPoint]; !ok || c != nil {
+			return nil
+		}
+		if e := w.checkEligible(output, minconf, bs, inputMinHeight, chainClient); e != eligible {
+			return er.Errorf("outpoint [%s] cannot be spent because it is %s",
+				output.OutPoint.String(), e.String())
+		}
+		found[output.OutPoint] = output
+		return nil
+	}); err != nil {
+		return out, err
+	}
+	for _, op := range outpoints {
+		c := found[op]
+		if c == nil {
+			return out, er.Errorf("outpoint [%s] is not an unspent output of this wallet",
+				op.String())
+		}
+		out.credits = append(out.credits, c)
+	}
+	return out, nil
+}
+
+// selectCoins chooses credits to fund the request using a branch and bound
+// search for a changeless solution, falling back on a knapsack solver.  If
+// AvoidMixing is set then each address is considered on it's own and the best
+// solution which spends from only one address is chosen.
+func (w *Wallet) selectCoins(
+	dbtx walletdb.ReadTx,
+	txr *CreateTxReq,
+	bs *waddrmgr.BlockStamp,
+) (eligibleOutputs, er.R) {
+	out := eligibleOutputs{}
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return out, err
+	}
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	var groupOrder []string
+	groups := make(map[string][]*wtxmgr.Credit)
+	if err := w.TxStore.ForEachUnspentOutput(txmgrNs, nil, func(_ []byte, output *wtxmgr.Credit) er.R {
+		match, _ := addrMatch(w, output.PkScript, txr.InputAddresses)
+		if txr.InputAddresses != nil && !match {
+			return nil
+		}
+		switch w.checkEligible(output, txr.Minconf, bs, txr.InputMinHeight, chainClient) {
+		case eligible:
+		case ineligibleUnconfirmed:
+			out.unconfirmedCount++
+			out.unconfirmedAmt += output.Amount
+			return nil
+		default:
+			return nil
+		}
+		key := ""
+		if txr.AvoidMixing {
+			key = hex.EncodeToString(output.PkScript)
+		}
+		if _, ok := groups[key]; !ok {
+			groupOrder = append(groupOrder, key)
+		}
+		groups[key] = append(groups[key], output)
+		return nil
+	}); err != nil {
+		return out, err
+	}
+
+	// The target includes the fee for everything except the inputs, the
+	// value of each input is reduced by the fee to spend it.  The fee always
+	// allows for a change output, as NewUnsignedTransaction does, and a
+	// solution is changeless if the leftover would be dust.
+	feePerKb := txr.FeeSatPerKB
+	feeFor := func(vsize int) btcutil.Amount {
+		return (feePerKb*btcutil.Amount(vsize) + 999) / 1000
+	}
+	var target btcutil.Amount
+	for _, o := range txr.Outputs {
+		target += btcutil.Amount(o.Value)
+	}
+	// 1 vbyte for the segwit marker and 2 for growth of the input count
+	target += feeFor(txsizes.EstimateVirtualSize(0, 0, 0, txr.Outputs, true) + 3)
+	window := txrules.GetDustThreshold(txsizes.P2WPKHPkScriptSize, txrules.DefaultRelayFeePerKb)
+
+	var best []*wtxmgr.Credit
+	bestChangeless := false
+	var bestTotal btcutil.Amount
+	for _, key := range groupOrder {
+		credits := groups[key]
+		values := make([]btcutil.Amount, len(credits))
+		for i, c := range credits {
+			values[i] = c.Amount - feeFor(txauthor.InputVirtualSize(c.PkScript))
+		}
+		var idx []int
+		changeless := false
+		if txr.CoinSelection == CoinSelectBnB {
+			idx = txauthor.SelectBranchAndBound(values, target, window)
+			changeless = idx != nil
+		}
+		if idx == nil {
+			idx = txauthor.SelectKnapsack(values, target, window)
+		}
+		if idx == nil {
+			continue
+		}
+		selected := amountCount{isSegwit: true, credits: redblacktree.NewWith(NilComparator)}
+		var total btcutil.Amount
+		for _, i := range idx {
+			selected.credits.Put(credits[i], nil)
+			selected.isSegwit = selected.isSegwit && txscript.IsWitnessProgram(credits[i].PkScript)
+			total += credits[i].Amount
+		}
+		if selected.overLimit(txr.MaxInputs) {
+			out.unusedCount += len(idx)
+			out.unusedAmt += total
+			continue
+		}
+		if best != nil {
+			if bestChangeless && !changeless {
+				continue
+			} else if bestChangeless == changeless &&
+				(len(idx) > len(best) || (len(idx) == len(best) && total >= bestTotal)) {
+				continue
+			}
+		}
+		best = best[:0]
+		for _, i := range idx {
+			best = append(best, credits[i])
+		}
+		bestChangeless = changeless
+		bestTotal = total
+	}
+	if best == nil {
+		if out.unusedCount > 0 {
+			return out, TooManyInputsError.New(
+				fmt.Sprintf("additional [%d] transactions containing [%f] coins",
+					out.unusedCount, out.unusedAmt.ToBTC()), nil)
+		} else if txr.AvoidMixing && len(groups) > 1 {
+			return out, InsufficientFundsError.New("no single address has enough "+
+				"balance and avoidmixing is set", nil)
+		} else if out.unconfirmedCount > 0 {
+			return out, UnconfirmedCoinsError.New(
+				fmt.Sprintf("there are [%f] coins available in [%d] unconfirmed transactions, "+
+					"to spend from these you need to specify minconf=0",
+					out.unconfirmedAmt.ToBTC(), out.unconfirmedCount), nil)
+		}
+		return out, InsufficientFundsError.New("wallet does not have enough balance", nil)
+	}
+	out.credits = best
+	log.Debugf("Coin selection chose [%d] inputs worth [%s], changeless [%v]",
+		len(best), bestTotal.String(), bestChangeless)
+	return out, nil
+}
+
 // validateMsgTx verifies transaction input scripts for tx.  All previous output
 // scripts from outputs redeemed by the transaction, in the same order they are
 // spent, must be passed in the prevScripts slice.
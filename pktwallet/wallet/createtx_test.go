@@ -212,3 +212,137 @@ func TestTxToOutputsDryRun(t *testing.T) {
 			"than wet run")
 	}
 }
+
+// coinControlWallet creates an unlocked wallet for the coin control tests
+// along with a function to clean it up.
+func coinControlWallet(t *testing.T) (*Wallet, func()) {
+	dir, errr := ioutil.TempDir("", "createtx_test")
+	if errr != nil {
+		t.Fatalf("Failed to create db dir: %v", errr)
+	}
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.MinSeedBytes)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	privPass := []byte("world")
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, "wallet.db", true, 250)
+	w, err := loader.CreateNewWallet([]byte("hello"), privPass, []byte(hex.EncodeToString(seed)), nil)
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	w.chainClient = &mockChainClient{}
+	if err := w.Unlock(privPass, time.After(10*time.Minute)); err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+	return w, func() { os.RemoveAll(dir) }
+}
+
+// addTestCredit adds a confirmed output paying value to pkScript and returns
+// its outpoint.
+func addTestCredit(t *testing.T, w *Wallet, pkScript []byte, value int64) wire.OutPoint {
+	incomingTx := &wire.MsgTx{
+		TxIn:  []*wire.TxIn{{}},
+		TxOut: []*wire.TxOut{wire.NewTxOut(value, pkScript)},
+	}
+	var b bytes.Buffer
+	if err := incomingTx.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+	blockHash, _ := chainhash.NewHashFromStr(
+		"00000000000000017188b968a371bab95aa43522665353b646e41865abae02a4")
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *blockHash, Height: 276425},
+		Time:  time.Unix(1387737310, 0),
+	}
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+			return err
+		}
+		return w.TxStore.AddCredit(ns, rec, block, 0, false)
+	}); err != nil {
+		t.Fatalf("failed inserting tx: %v", err)
+	}
+	return wire.OutPoint{Hash: rec.Hash, Index: 0}
+}
+
+func TestTxToOutputsCoinControl(t *testing.T) {
+	w, cleanup := coinControlWallet(t)
+	defer cleanup()
+
+	addrA, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get address: %v", err)
+	}
+	addrB, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to get address: %v", err)
+	}
+	scriptA, _ := txscript.PayToAddrScript(addrA)
+	scriptB, _ := txscript.PayToAddrScript(addrB)
+
+	addTestCredit(t, w, scriptA, 100000)
+	op50 := addTestCredit(t, w, scriptA, 50000)
+	op30 := addTestCredit(t, w, scriptA, 30000)
+	addTestCredit(t, w, scriptB, 60000)
+
+	// Explicit outpoints are spent exactly.
+	txr := CreateTxReq{
+		Outputs:        []*wire.TxOut{wire.NewTxOut(40000, scriptB)},
+		Minconf:        1,
+		FeeSatPerKB:    1000,
+		DryRun:         true,
+		InputOutpoints: &[]wire.OutPoint{op50},
+	}
+	tx, err := w.txToOutputs(txr)
+	if err != nil {
+		t.Fatalf("unable to author tx: %v", err)
+	}
+	if len(tx.Tx.TxIn) != 1 || tx.Tx.TxIn[0].PreviousOutPoint != op50 {
+		t.Fatalf("expected only input %v, got %v", op50, tx.Tx.TxIn)
+	}
+
+	// An outpoint which does not belong to the wallet is refused.
+	txr.InputOutpoints = &[]wire.OutPoint{{Index: 7}}
+	if _, err := w.txToOutputs(txr); err == nil {
+		t.Fatalf("expected error spending an unknown outpoint")
+	}
+
+	// Branch and bound finds the 50000 + 30000 coins which pay for
+	// 79400 plus the fee without a change output.
+	txr.InputOutpoints = nil
+	txr.Outputs = []*wire.TxOut{wire.NewTxOut(79400, scriptB)}
+	txr.CoinSelection = CoinSelectBnB
+	tx, err = w.txToOutputs(txr)
+	if err != nil {
+		t.Fatalf("unable to author tx: %v", err)
+	}
+	if tx.ChangeIndex != -1 {
+		t.Fatalf("expected a changeless transaction")
+	}
+	spent := map[wire.OutPoint]bool{}
+	for _, in := range tx.Tx.TxIn {
+		spent[in.PreviousOutPoint] = true
+	}
+	if len(spent) != 2 || !spent[op50] || !spent[op30] {
+		t.Fatalf("expected inputs %v and %v, got %v", op50, op30, tx.Tx.TxIn)
+	}
+
+	// 200000 needs coins from both addresses.
+	txr.Outputs = []*wire.TxOut{wire.NewTxOut(200000, scriptB)}
+	for _, cs := range []CoinSelection{CoinSelectDefault, CoinSelectKnapsack} {
+		txr.CoinSelection = cs
+		txr.AvoidMixing = false
+		if _, err := w.txToOutputs(txr); err != nil {
+			t.Fatalf("selection %d: unable to author tx: %v", cs, err)
+		}
+		txr.AvoidMixing = true
+		if _, err := w.txToOutputs(txr); err == nil {
+			t.Fatalf("selection %d: expected error when avoiding mixing", cs)
+		}
+	}
+}
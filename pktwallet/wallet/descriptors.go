@@ -0,0 +1,269 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/descriptor"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/genesis"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/watcher"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+)
+
+// MaxDescriptorRange is the largest number of indexes which can be imported
+// from a ranged descriptor in a single call.
+const MaxDescriptorRange = 10000
+
+// DescriptorImport is a request to import an output descriptor into the
+// wallet.
+type DescriptorImport struct {
+	Descriptor *descriptor.Descriptor
+
+	// RangeStart and RangeEnd are the first and last index to import,
+	// they are ignored if the descriptor has no wildcard.
+	RangeStart uint32
+	RangeEnd   uint32
+
+	// BlockStamp is the earliest block where the scripts may have been
+	// used, if nil then the genesis block is assumed.
+	BlockStamp *waddrmgr.BlockStamp
+
+	// Rescan causes the chain to be rescanned from BlockStamp for
+	// transactions involving the imported scripts.
+	Rescan bool
+}
+
+// importDescriptorKey imports a single key from an expanded descriptor into
+// the given scope.  If the private key is known and the wallet is not
+// watching-only then the private key is imported, otherwise only the public
+// key is watched.
+func (w *Wallet) importDescriptorKey(ns walletdb.ReadWriteBucket,
+	scope waddrmgr.KeyScope, dk *descriptor.DerivedKey,
+	bs *waddrmgr.BlockStamp) er.R {
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return err
+	}
+	if dk.PrivKey != nil && !w.Manager.WatchOnly() {
+		wif, err := dk.WIF(w.chainParams)
+		if err != nil {
+			return err
+		}
+		_, err = manager.ImportPrivateKey(ns, wif, bs)
+		return err
+	}
+	_, err = manager.ImportPublicKey(ns, dk.PubKey, dk.Compressed, bs)
+	return err
+}
+
+// importExpansion imports the scripts and keys of one expanded descriptor.
+func (w *Wallet) importExpansion(ns walletdb.ReadWriteBucket,
+	d *descriptor.Descriptor, exp *descriptor.Expansion,
+	bs *waddrmgr.BlockStamp) er.R {
+	ignoreDup := func(err er.R) er.R {
+		if waddrmgr.ErrDuplicateAddress.Is(err) {
+			return nil
+		}
+		return err
+	}
+	switch d.Type {
+	case descriptor.TypePkh:
+		return ignoreDup(w.importDescriptorKey(
+			ns, waddrmgr.KeyScopeBIP0044, exp.Keys[0], bs))
+	case descriptor.TypeWpkh:
+		return ignoreDup(w.importDescriptorKey(
+			ns, waddrmgr.KeyScopeBIP0084, exp.Keys[0], bs))
+	case descriptor.TypeSh, descriptor.TypeWsh:
+	default:
+		return er.Errorf("bare %s() descriptors have no address and "+
+			"cannot be imported", d.Type)
+	}
+
+	// Script descriptors are imported into the BIP0084 scope, in the same
+	// way as addp2shscript.
+	manager, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0084)
+	if err != nil {
+		return err
+	}
+	if exp.RedeemScript != nil {
+		if _, err := manager.ImportScript(ns, exp.RedeemScript, bs); ignoreDup(err) != nil {
+			return err
+		}
+	}
+	if exp.WitnessScript != nil {
+		if _, err := manager.ImportWitnessScript(ns, exp.WitnessScript, bs); ignoreDup(err) != nil {
+			return err
+		}
+	}
+
+	// Private keys are imported so that the wallet is able to sign for the
+	// scripts, keys which are only public are not imported because the
+	// script itself is what is being watched.
+	for _, dk := range exp.Keys {
+		if dk.PrivKey == nil {
+			continue
+		}
+		err := w.importDescriptorKey(ns, waddrmgr.KeyScopeBIP0044, dk, bs)
+		if ignoreDup(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DescriptorImportResult is the outcome of importing one descriptor with
+// ImportDescriptors.
+type DescriptorImportResult struct {
+	// Addresses are the addresses of the imported scripts.
+	Addresses []btcutil.Address
+
+	// Err is the reason why the descriptor could not be imported, if any.
+	Err er.R
+}
+
+// ImportDescriptors imports every script described by each descriptor, within
+// the requested range if the descriptor is ranged, and records the
+// descriptors so that they can be listed with ListDescriptors.  Each
+// descriptor is imported independently, a failure of one does not prevent the
+// others from being imported.  A single rescan is started from the earliest
+// BlockStamp of the descriptors which requested one.
+func (w *Wallet) ImportDescriptors(reqs []DescriptorImport) []DescriptorImportResult {
+	results := make([]DescriptorImportResult, len(reqs))
+
+	rescan := false
+	for _, req := range reqs {
+		rescan = rescan || req.Rescan
+	}
+	var rescanErr er.R
+	if rescan {
+		w.rescanJLock.Lock()
+		defer w.rescanJLock.Unlock()
+		if w.rescanJ != nil {
+			rescanErr = er.Errorf(
+				"You requested a rescan but there is already a rescan job"+
+					" ([%v]) running, use `stopresync` to stop it", w.rescanJ.name)
+		}
+	}
+
+	var rescanAddrs []btcutil.Address
+	var rescanFrom *waddrmgr.BlockStamp
+	for i := range reqs {
+		req := &reqs[i]
+		if req.Rescan && rescanErr != nil {
+			results[i].Err = rescanErr
+			continue
+		}
+		addrs, bs, err := w.importDescriptor(req)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		results[i].Addresses = addrs
+		if req.Rescan {
+			rescanAddrs = append(rescanAddrs, addrs...)
+			if rescanFrom == nil || bs.Height < rescanFrom.Height {
+				rescanFrom = bs
+			}
+		}
+	}
+
+	if len(rescanAddrs) > 0 {
+		watch := watcher.New()
+		watch.WatchAddrs(rescanAddrs)
+		w.rescanJ = &rescanJob{
+			name:       fmt.Sprintf("importdescriptors-rescan-%d", rescanFrom.Height),
+			height:     rescanFrom.Height,
+			stopHeight: -1,
+			watch:      &watch,
+		}
+	}
+	return results
+}
+
+// importDescriptor imports the scripts of a single descriptor and returns
+// their addresses along with the BlockStamp they were imported with.
+func (w *Wallet) importDescriptor(req *DescriptorImport) ([]btcutil.Address,
+	*waddrmgr.BlockStamp, er.R) {
+
+	d := req.Descriptor
+	if !d.IsForNet(w.chainParams) {
+		return nil, nil, er.Errorf("descriptor contains keys which are not "+
+			"intended for %s", w.chainParams.Name)
+	}
+	pub, err := d.Public()
+	if err != nil {
+		return nil, nil, err
+	}
+	start, end := req.RangeStart, req.RangeEnd
+	if !d.IsRange() {
+		start, end = 0, 0
+	} else if end < start || end-start >= MaxDescriptorRange {
+		return nil, nil, er.Errorf("descriptor range must be between 1 and %d "+
+			"indexes", MaxDescriptorRange)
+	}
+
+	bs := req.BlockStamp
+	if bs == nil {
+		bs = &waddrmgr.BlockStamp{
+			Hash:      *w.chainParams.GenesisHash,
+			Height:    0,
+			Timestamp: genesis.Block(w.chainParams.GenesisHash).Header.Timestamp,
+		}
+	}
+
+	var addrs []btcutil.Address
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for i := start; ; i++ {
+			exp, err := d.Expand(i)
+			if err != nil {
+				return err
+			}
+			if err := w.importExpansion(addrmgrNs, d, exp, bs); err != nil {
+				return err
+			}
+			addr, err := exp.Address(d.Type, w.chainParams)
+			if err != nil {
+				return err
+			}
+			addrs = append(addrs, addr)
+			if i == end {
+				break
+			}
+		}
+		return w.Manager.PutDescriptor(addrmgrNs, &waddrmgr.DescriptorInfo{
+			Descriptor: pub.StringWithChecksum(),
+			Timestamp:  time.Now(),
+			RangeStart: start,
+			RangeEnd:   end,
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	w.watch.WatchAddrs(addrs)
+
+	log.Infof("Imported descriptor %s (%d scripts)", pub.StringWithChecksum(), len(addrs))
+	return addrs, bs, nil
+}
+
+// ListDescriptors returns every descriptor which has been imported into the
+// wallet.
+func (w *Wallet) ListDescriptors() ([]waddrmgr.DescriptorInfo, er.R) {
+	var out []waddrmgr.DescriptorInfo
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		return w.Manager.ForEachDescriptor(addrmgrNs, func(di *waddrmgr.DescriptorInfo) er.R {
+			out = append(out, *di)
+			return nil
+		})
+	})
+	return out, err
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
+	"github.com/pkt-cash/pktd/pktwallet/wallet/txrules"
+	"github.com/pkt-cash/pktd/pktwallet/walletdb"
+	"github.com/pkt-cash/pktd/pktwallet/wtxmgr"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// foldJob is an address folding job which is in progress, one folding
+// transaction is made each time the main loop cycles until there is nothing
+// left to fold.
+type foldJob struct {
+	waddrmgr.FoldJob
+	addr     btcutil.Address
+	pkScript []byte
+	name     string
+}
+
+func newFoldJob(fj *waddrmgr.FoldJob, params *chaincfg.Params) (*foldJob, er.R) {
+	addr, err := btcutil.DecodeAddress(fj.Address, params)
+	if err != nil {
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &foldJob{
+		FoldJob:  *fj,
+		addr:     addr,
+		pkScript: pkScript,
+		name:     fmt.Sprintf("fold_%s_at_%d", fj.Address, fj.Created.Unix()),
+	}, nil
+}
+
+// FoldAddress starts a job which consolidates the outputs paid to an address
+// by spending them back to the same address, as many at a time as will fit
+// in a standard transaction.  The job runs in the background, each folding
+// transaction must be accepted by the mempool before the next one is made.
+// Outputs which are locked using LockOutpoint are not folded.  The job is
+// stored in the database so it resumes if the wallet is restarted, and it
+// can be stopped using StopFold.  The name of the job is returned.
+func (w *Wallet) FoldAddress(addr btcutil.Address, minconf int32) (string, er.R) {
+	w.foldJLock.Lock()
+	defer w.foldJLock.Unlock()
+	if w.foldJ != nil {
+		return "", er.Errorf("There is already a fold job ([%v]) running, "+
+			"use `stopfold` to stop it", w.foldJ.name)
+	}
+	fj, err := newFoldJob(&waddrmgr.FoldJob{
+		Address: addr.EncodeAddress(),
+		Minconf: minconf,
+		Created: time.Now(),
+	}, w.chainParams)
+	if err != nil {
+		return "", err
+	}
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		if _, err := w.Manager.Address(addrmgrNs, addr); err != nil {
+			if waddrmgr.ErrAddressNotFound.Is(err) {
+				return er.Errorf("Address [%s] does not belong to this wallet",
+					fj.Address)
+			}
+			return err
+		}
+		return w.Manager.SetFoldJob(addrmgrNs, &fj.FoldJob)
+	}); err != nil {
+		return "", err
+	}
+	w.foldJ = fj
+	w.updateFoldStats(fj, "starting")
+	return fj.name, nil
+}
+
+// StopFold stops the address folding job which is in progress, transactions
+// which have already been made are not affected.
+func (w *Wallet) StopFold() (string, er.R) {
+	w.foldJLock.Lock()
+	defer w.foldJLock.Unlock()
+	fj := w.foldJ
+	if fj == nil {
+		return "", er.Errorf("No fold job currently in progress")
+	}
+	if err := w.endFold(fj, "stopped"); err != nil {
+		return "", err
+	}
+	return fj.name, nil
+}
+
+// loadFoldJob resumes the address folding job which was running when the
+// wallet was last shut down, if any.
+func (w *Wallet) loadFoldJob() er.R {
+	var fj *waddrmgr.FoldJob
+	if err := walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		var err er.R
+		fj, err = w.Manager.FoldJob(tx.ReadBucket(waddrmgrNamespaceKey))
+		return err
+	}); err != nil || fj == nil {
+		return err
+	}
+	j, err := newFoldJob(fj, w.chainParams)
+	if err != nil {
+		return err
+	}
+	log.Infof("Resuming fold job [%s], [%d] outputs folded so far",
+		j.name, j.Inputs)
+	w.foldJLock.Lock()
+	defer w.foldJLock.Unlock()
+	w.foldJ = j
+	w.updateFoldStats(j, "resuming")
+	return nil
+}
+
+// endFold removes the job from the database and from the wallet, it must be
+// called with the foldJLock held.
+func (w *Wallet) endFold(fj *foldJob, status string) er.R {
+	if err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) er.R {
+		return w.Manager.SetFoldJob(tx.ReadWriteBucket(waddrmgrNamespaceKey), nil)
+	}); err != nil {
+		return err
+	}
+	w.foldJ = nil
+	w.updateFoldStats(fj, status)
+	w.UpdateStats(func(ws *btcjson.WalletStats) {
+		ws.FoldInProgress = false
+	})
+	return nil
+}
+
+func (w *Wallet) updateFoldStats(fj *foldJob, status string) {
+	w.UpdateStats(func(ws *btcjson.WalletStats) {
+		ws.FoldInProgress = true
+		ws.FoldName = fj.name
+		ws.FoldAddress = fj.Address
+		ws.FoldTransactions = int(fj.Transactions)
+		ws.FoldInputs = int64(fj.Inputs)
+		ws.FoldAmount = btcutil.Amount(fj.Amount).ToBTC()
+		ws.FoldStatus = status
+	})
+}
+
+// countFoldable counts the outputs paid to the address of the job which can
+// be folded now, as well as those which are waiting for confirmations.  It
+// stops counting once it knows that there is more than one output to fold.
+func (w *Wallet) countFoldable(fj *foldJob) (int, int, er.R) {
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return 0, 0, err
+	}
+	bs, err := chainClient.BlockStamp()
+	if err != nil {
+		return 0, 0, err
+	}
+	eligibleCount := 0
+	unconfirmedCount := 0
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) er.R {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ForEachUnspentOutput(txmgrNs, nil, func(_ []byte, output *wtxmgr.Credit) er.R {
+			if !bytes.Equal(output.PkScript, fj.pkScript) {
+				return nil
+			}
+			switch w.checkEligible(output, fj.Minconf, bs, 0, chainClient) {
+			case eligible:
+				eligibleCount++
+			case ineligibleUnconfirmed:
+				unconfirmedCount++
+			}
+			if eligibleCount > 1 {
+				return er.LoopBreak
+			}
+			return nil
+		})
+	})
+	if err != nil && !er.IsLoopBreak(err) {
+		return 0, 0, err
+	}
+	return eligibleCount, unconfirmedCount, nil
+}
+
+// fold makes the next transaction of the address folding job, if there is
+// one in progress.
+func (w *Wallet) fold() {
+	w.foldJLock.Lock()
+	defer w.foldJLock.Unlock()
+	fj := w.foldJ
+	if fj == nil {
+		return
+	}
+	if w.Manager.IsLocked() {
+		w.updateFoldStats(fj, "waiting for the wallet to be unlocked")
+		return
+	}
+
+	eligibleCount, unconfirmedCount, err := w.countFoldable(fj)
+	if err != nil {
+		log.Warnf("Error while running fold job [%s] [%s]", fj.name, err.String())
+		return
+	}
+	if eligibleCount < 2 {
+		if unconfirmedCount > 0 {
+			// The outputs of the last folding transaction need to be
+			// confirmed before they can be folded again.
+			w.updateFoldStats(fj, fmt.Sprintf("waiting for [%d] outputs to confirm",
+				unconfirmedCount))
+			return
+		}
+		log.Infof("Fold job [%s] complete, [%d] outputs folded in [%d] transactions",
+			fj.name, fj.Inputs, fj.Transactions)
+		if err := w.endFold(fj, "complete"); err != nil {
+			log.Warnf("Error while ending fold job [%s] [%s]", fj.name, err.String())
+		}
+		return
+	}
+
+	// MaxInputs of zero limits the transaction to the standard size and a
+	// zero value output sweeps every input to it.
+	tx, err := w.SendOutputs(CreateTxReq{
+		Outputs:        []*wire.TxOut{wire.NewTxOut(0, fj.pkScript)},
+		InputAddresses: &[]btcutil.Address{fj.addr},
+		Minconf:        fj.Minconf,
+		FeeSatPerKB:    txrules.DefaultRelayFeePerKb,
+	})
+	if err != nil {
+		if waddrmgr.ErrLocked.Is(err) {
+			w.updateFoldStats(fj, "waiting for the wallet to be unlocked")
+			return
+		}
+		log.Warnf("Fold job [%s] stopped because the transaction could not be made [%s]",
+			fj.name, err.String())
+		if err := w.endFold(fj, "failed: "+err.Message()); err != nil {
+			log.Warnf("Error while ending fold job [%s] [%s]", fj.name, err.String())
+		}
+		return
+	}
+
+	fj.Transactions++
+	fj.Inputs += uint64(len(tx.Tx.TxIn))
+	fj.Amount += int64(tx.TotalInput)
+	if err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) er.R {
+		return w.Manager.SetFoldJob(dbtx.ReadWriteBucket(waddrmgrNamespaceKey), &fj.FoldJob)
+	}); err != nil {
+		log.Warnf("Error while saving fold job [%s] [%s]", fj.name, err.String())
+	}
+	log.Infof("Fold job [%s] folded [%d] outputs in transaction [%s]",
+		fj.name, len(tx.Tx.TxIn), tx.Tx.TxHash())
+	w.updateFoldStats(fj, fmt.Sprintf("folded [%d] outputs in transaction [%s]",
+		len(tx.Tx.TxIn), tx.Tx.TxHash()))
+}
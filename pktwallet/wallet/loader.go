@@ -121,6 +121,22 @@ func WalletDbPath(netDir, walletName string) string {
 // this seed.  If nil, a secure random seed is generated.
 func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase []byte,
 	seedInput []byte, seed *seedwords.Seed) (*Wallet, er.R) {
+	return l.createNewWallet(pubPassphrase, func(db walletdb.DB) er.R {
+		return Create(db, pubPassphrase, privPassphrase, seedInput, seed, l.chainParams)
+	})
+}
+
+// CreateNewWalletFromBip39 creates a new wallet from the root seed of a BIP39
+// mnemonic, in the same way as CreateNewWallet.
+func (l *Loader) CreateNewWalletFromBip39(pubPassphrase, privPassphrase []byte,
+	seed *seedwords.Bip39Seed) (*Wallet, er.R) {
+	return l.createNewWallet(pubPassphrase, func(db walletdb.DB) er.R {
+		return CreateFromBip39(db, pubPassphrase, privPassphrase, seed, l.chainParams)
+	})
+}
+
+func (l *Loader) createNewWallet(pubPassphrase []byte,
+	create func(db walletdb.DB) er.R) (*Wallet, er.R) {
 	defer l.mu.Unlock()
 	l.mu.Lock()
 
@@ -152,7 +168,7 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase []byte,
 	}
 
 	// Initialize the newly created database for the wallet before opening.
-	err = Create(db, pubPassphrase, privPassphrase, seedInput, seed, l.chainParams)
+	err = create(db)
 	if err != nil {
 		return nil, err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/pkt-cash/pktd/pktwallet/waddrmgr"
 	"github.com/pkt-cash/pktd/pktwallet/walletdb"
 	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/txscript/miniscript"
 )
 
 // MakeMultiSigScript creates a multi-signature script that can be redeemed with
@@ -154,3 +155,22 @@ func (w *Wallet) ImportP2WSHRedeemScript(script []byte) (*btcutil.AddressWitness
 	})
 	return p2shAddr, err
 }
+
+// ImportMiniscript compiles a miniscript expression and adds the witness
+// script to the wallet with ImportP2WSHRedeemScript.  Expressions which are
+// not sane are rejected because funds sent to them might not be spendable
+// or might be spendable by a third party.
+func (w *Wallet) ImportMiniscript(n *miniscript.Node) (*btcutil.AddressWitnessScriptHash, []byte, er.R) {
+	if err := n.SanityCheck(); err != nil {
+		return nil, nil, err
+	}
+	script, err := n.Script()
+	if err != nil {
+		return nil, nil, err
+	}
+	addr, err := w.ImportP2WSHRedeemScript(script)
+	if err != nil {
+		return nil, nil, err
+	}
+	return addr, script, nil
+}
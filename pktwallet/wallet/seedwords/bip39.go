@@ -0,0 +1,147 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package seedwords
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"math/big"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/internal/zero"
+)
+
+const (
+	bip39Iterations = 2048
+	bip39SeedLen    = 64
+
+	// BirthdayFormat is the format of a birthday supplied by the user when
+	// importing a BIP39 mnemonic.
+	BirthdayFormat = "2006-01-02"
+)
+
+// Bip39Seed is a root seed which was derived from a BIP39 mnemonic, it is
+// what other wallets use to derive their BIP32 master key.  Because BIP39
+// mnemonics do not carry a birthday, the birthday must be supplied by the
+// user when the seed is imported.
+type Bip39Seed struct {
+	seed     [bip39SeedLen]byte
+	birthday time.Time
+}
+
+// Zero wipes the data of this seed from memory.
+func (s *Bip39Seed) Zero() {
+	zero.Bytes(s.seed[:])
+}
+
+// Birthday provides the date before which the wallet is known not to have
+// been used, as supplied when the seed was imported.
+func (s *Bip39Seed) Birthday() time.Time {
+	return s.birthday
+}
+
+// Bytes returns the 64 byte root seed.
+func (s *Bip39Seed) Bytes() []byte {
+	return s.seed[:]
+}
+
+// bip39Entropy decodes a BIP39 mnemonic which has already been split into
+// words, returning the entropy after verifying the checksum.
+func bip39Entropy(wd *wordsDesc, words []string) ([]byte, er.R) {
+	b := big.NewInt(0)
+	defer zero.BigInt(b)
+	for _, word := range words {
+		num, ok := wd.rwords[word]
+		if !ok {
+			return nil, er.Errorf("Word [%s] is not in the %s word list", word, wd.lang)
+		}
+		b.Lsh(b, 11)
+		b.Or(b, big.NewInt(int64(num)))
+	}
+	csBits := uint(len(words) * 11 / 33)
+	entLen := len(words) * 11 * 32 / 33 / 8
+
+	csum := byte(b.Uint64() & ((1 << csBits) - 1))
+	b.Rsh(b, csBits)
+	eb := b.Bytes()
+	defer zero.Bytes(eb)
+	entropy := make([]byte, entLen)
+	copy(entropy[entLen-len(eb):], eb)
+
+	h := sha256.Sum256(entropy)
+	if h[0]>>(8-csBits) != csum {
+		zero.Bytes(entropy)
+		return nil, er.New("Invalid BIP39 mnemonic: Checksum mismatch")
+	}
+	return entropy, nil
+}
+
+// CheckBip39Mnemonic verifies that a BIP39 mnemonic is well formed and that
+// its checksum is correct, returning the language of the mnemonic.
+func CheckBip39Mnemonic(mnemonic string) (string, er.R) {
+	words := splitWords(mnemonic)
+	defer zeroStr(words)
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return "", er.Errorf("A BIP39 mnemonic must have 12, 15, 18, 21 or 24 "+
+			"words, got %d", len(words))
+	}
+	var err er.R
+	for _, wd := range detectLanguages(words) {
+		entropy, e := bip39Entropy(wd, words)
+		if e != nil {
+			err = e
+			continue
+		}
+		zero.Bytes(entropy)
+		return wd.lang, nil
+	}
+	if err == nil {
+		err = er.New("Could not decode the words provided, check for typos")
+	}
+	return "", err
+}
+
+// Bip39SeedFromMnemonic verifies a BIP39 mnemonic and derives the root seed
+// from it and an optional passphrase, as specified by BIP39.  The passphrase
+// is not the same as a pktwallet seed passphrase, it forms part of the seed
+// and a different passphrase results in a different (valid) wallet.
+func Bip39SeedFromMnemonic(mnemonic string, passphrase []byte,
+	birthday time.Time) (*Bip39Seed, er.R) {
+	if _, err := CheckBip39Mnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	words := splitWords(mnemonic)
+	defer zeroStr(words)
+	password := []byte(strings.Join(words, " "))
+	defer zero.Bytes(password)
+	salt := append([]byte("mnemonic"), norm.NFKD.Bytes(passphrase)...)
+	defer zero.Bytes(salt)
+
+	key := pbkdf2.Key(password, salt, bip39Iterations, bip39SeedLen, sha512.New)
+	defer zero.Bytes(key)
+	out := Bip39Seed{birthday: birthday}
+	copy(out.seed[:], key)
+	return &out, nil
+}
+
+// ParseBirthday parses a user supplied birthday in BirthdayFormat, birthdays
+// which are in the future are rejected.
+func ParseBirthday(s string) (time.Time, er.R) {
+	bday, errr := time.Parse(BirthdayFormat, s)
+	if errr != nil {
+		return time.Time{}, er.Errorf("Invalid birthday [%s], expected YYYY-MM-DD", s)
+	}
+	if bday.After(time.Now()) {
+		return time.Time{}, er.Errorf("The birthday [%s] is in the future", s)
+	}
+	return bday, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package seedwords_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/pktwallet/wallet/seedwords"
+)
+
+// Vectors from the BIP39 reference implementation, all use the passphrase
+// "TREZOR".
+var bip39Vectors = []struct {
+	mnemonic string
+	seed     string
+}{
+	{
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo when",
+		"0cd6e5d827bb62eb8fc1e262254223817fd068a74b5b449cc2f667c3f1f985a76379b43348d952e2265b4cd129090758b3e3c2c49103b5051aac2eaeb890a528",
+	},
+	{
+		"letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless",
+		"c0c519bd0e91a2ed54357d9d1ebef6f5af218a153624cf4f2da911a0ed8f7a09e2ef61af0aca007096df430022f7a2b6fb91661a9589097069720d015e4e982f",
+	},
+}
+
+func TestBip39Seed(t *testing.T) {
+	bday := time.Date(2019, 8, 1, 0, 0, 0, 0, time.UTC)
+	for _, v := range bip39Vectors {
+		if lang, err := seedwords.CheckBip39Mnemonic(v.mnemonic); err != nil {
+			t.Errorf("CheckBip39Mnemonic [%s]: %v", v.mnemonic, err)
+			continue
+		} else if lang != "english" {
+			t.Errorf("CheckBip39Mnemonic: expected english, got %s", lang)
+		}
+		seed, err := seedwords.Bip39SeedFromMnemonic(v.mnemonic, []byte("TREZOR"), bday)
+		if err != nil {
+			t.Errorf("Bip39SeedFromMnemonic [%s]: %v", v.mnemonic, err)
+			continue
+		}
+		if hex.EncodeToString(seed.Bytes()) != v.seed {
+			t.Errorf("Bip39SeedFromMnemonic [%s]: got %x want %s", v.mnemonic, seed.Bytes(), v.seed)
+		}
+		if !seed.Birthday().Equal(bday) {
+			t.Errorf("Birthday: got %v want %v", seed.Birthday(), bday)
+		}
+	}
+}
+
+// TestBip39Japanese checks a vector which needs NFKD normalization of both
+// the mnemonic and the passphrase.
+func TestBip39Japanese(t *testing.T) {
+	mnemonic := "あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　" +
+		"あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら"
+	lang, err := seedwords.CheckBip39Mnemonic(mnemonic)
+	if err != nil {
+		t.Fatalf("CheckBip39Mnemonic: %v", err)
+	}
+	if lang != "japanese" {
+		t.Fatalf("CheckBip39Mnemonic: expected japanese, got %s", lang)
+	}
+	seed, err := seedwords.Bip39SeedFromMnemonic(mnemonic, []byte("㍍ガバヴァぱばぐゞちぢ十人十色"), time.Now())
+	if err != nil {
+		t.Fatalf("Bip39SeedFromMnemonic: %v", err)
+	}
+	want := "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c" +
+		"467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55"
+	if hex.EncodeToString(seed.Bytes()) != want {
+		t.Fatalf("Bip39SeedFromMnemonic: got %x want %s", seed.Bytes(), want)
+	}
+}
+
+func TestBip39Invalid(t *testing.T) {
+	bad := []string{
+		// bad checksum
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		// wrong number of words
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		// not a word
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abuot",
+	}
+	for _, m := range bad {
+		if _, err := seedwords.CheckBip39Mnemonic(m); err == nil {
+			t.Errorf("CheckBip39Mnemonic [%s]: expected error", m)
+		}
+	}
+	if _, err := seedwords.ParseBirthday("2999-01-01"); err == nil {
+		t.Errorf("ParseBirthday: expected error for a birthday in the future")
+	}
+	if _, err := seedwords.ParseBirthday("01/02/2020"); err == nil {
+		t.Errorf("ParseBirthday: expected error for a malformed birthday")
+	}
+}
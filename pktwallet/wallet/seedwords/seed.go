@@ -8,11 +8,13 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/dchest/blake2b"
 	"golang.org/x/crypto/argon2"
+	"golang.org/x/text/unicode/norm"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
 	"github.com/pkt-cash/pktd/pktwallet/internal/zero"
@@ -27,6 +29,19 @@ type wordsDesc struct {
 //go:generate go run -tags words genwords.go
 var allWords = make(map[string]*wordsDesc)
 
+// ideographicSpace is the separator of the words of Japanese seeds and shares,
+// as required by BIP39.
+const ideographicSpace = "\u3000"
+
+// joinWords joins the words of a seed or share with the separator used by
+// their language.
+func joinWords(words []string, lang string) string {
+	if lang == "japanese" {
+		return strings.Join(words, ideographicSpace)
+	}
+	return strings.Join(words, " ")
+}
+
 /**
  * Seed layout:
  *     0               1               2               3
@@ -263,7 +278,7 @@ func (s *SeedEnc) Words(lang string) (string, er.R) {
 	if !b.IsUint64() || b.Uint64() != 1 {
 		panic("Internal error: bignum should have resulted in 1")
 	}
-	return strings.Join(words, " "), nil
+	return joinWords(words, lang), nil
 }
 
 func fromNums(nums [wordCount]int16) (*SeedEnc, er.R) {
@@ -297,27 +312,65 @@ func fromNums(nums [wordCount]int16) (*SeedEnc, er.R) {
 	return nil, err
 }
 
+// splitWords normalizes a list of seed words and splits it on whitespace,
+// including the ideographic space which is used between Japanese words.
+func splitWords(words string) []string {
+	return strings.Fields(norm.NFKD.String(words))
+}
+
+// detectLanguages returns the word lists which contain every one of the
+// words, english first and then in alphabetical order.
+func detectLanguages(words []string) []*wordsDesc {
+	langs := make([]string, 0, len(allWords))
+	for lang := range allWords {
+		if lang != "english" {
+			langs = append(langs, lang)
+		}
+	}
+	sort.Strings(langs)
+	langs = append([]string{"english"}, langs...)
+	var out []*wordsDesc
+LANGUAGE:
+	for _, lang := range langs {
+		wd, ok := allWords[lang]
+		if !ok {
+			continue
+		}
+		for _, word := range words {
+			if _, ok := wd.rwords[word]; !ok {
+				continue LANGUAGE
+			}
+		}
+		out = append(out, wd)
+	}
+	return out
+}
+
 // SeedFromWords creates an encrypted seed from a set of words, the language
 // is auto-detected.
 func SeedFromWords(words string) (*SeedEnc, er.R) {
-	splitWords := strings.Split(words, " ")
+	splitWords := splitWords(words)
 	defer zeroStr(splitWords)
 	if len(splitWords) != wordCount {
 		return nil, er.Errorf("Expected a %d word seed", wordCount)
 	}
 	nums := [wordCount]int16{}
 	defer zeroNums(nums[:])
-LANGUAGE:
-	for _, wd := range allWords {
+	var err er.R
+	// Some words appear in more than one language so every language which
+	// has all of the words is tried until one of them passes the checksum.
+	for _, wd := range detectLanguages(splitWords) {
 		for i, word := range splitWords {
-			if num, ok := wd.rwords[word]; ok {
-				if !ok {
-					continue LANGUAGE
-				}
-				nums[i] = num
-			}
+			nums[i] = wd.rwords[word]
+		}
+		s, e := fromNums(nums)
+		if e == nil {
+			return s, nil
 		}
-		return fromNums(nums)
+		err = e
+	}
+	if err != nil {
+		return nil, err
 	}
 	return nil, er.New("Could not decode the words provided, check for typos")
 }
@@ -32,3 +32,30 @@ func TestEncrypt(t *testing.T) {
 		t.Error("Seed decrypt is not the same")
 	}
 }
+
+// TestWordsLanguages checks that a seed written in each language is detected
+// and decoded by SeedFromWords.
+func TestWordsLanguages(t *testing.T) {
+	seed, err := seedwords.RandomSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	se := seed.Encrypt(nil)
+	for _, lang := range []string{"english", "spanish", "french", "japanese", "chinese_simplified"} {
+		words, err := se.Words(lang)
+		if err != nil {
+			t.Errorf("Words(%s): %v", lang, err)
+			continue
+		}
+		se2, err := seedwords.SeedFromWords(words)
+		if err != nil {
+			t.Errorf("SeedFromWords(%s) [%s]: %v", lang, words, err)
+			continue
+		}
+		if words2, err := se2.Words(lang); err != nil {
+			t.Errorf("Words(%s): %v", lang, err)
+		} else if words2 != words {
+			t.Errorf("SeedFromWords(%s): seed mismatch", lang)
+		}
+	}
+}
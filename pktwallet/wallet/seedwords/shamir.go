@@ -0,0 +1,324 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package seedwords
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math/big"
+
+	"github.com/dchest/blake2b"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/pktwallet/internal/zero"
+)
+
+/**
+ * Share layout:
+ *     0               1               2               3
+ *     0 1 2 3 4 5 6 7 0 1 2 3 4 5 6 7 0 1 2 3 4 5 6 7 0 1 2 3 4 5 6 7
+ *    +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+ *  0 | Thresh| Index |            Group ID           |               |
+ *    +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+               +
+ *  4 |                                                               |
+ *    +                                                               +
+ *  8 |                                                               |
+ *    +                                                               +
+ * 12 |                      Share of the SeedEnc                     |
+ *    +                                                               +
+ * 16 |                                                               |
+ *    +                                                               +
+ * 20 |                                                               |
+ *    +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+ * 24 |           Checksum            |
+ *    +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+ *
+ * Thresh: number of shares needed to recover the seed, minus one
+ * Index: the x coordinate of the share, minus one
+ * Group ID: random number which is the same for every share of one split
+ * Share: the y coordinate of each byte of the SeedEnc, in GF(2^8)
+ * Checksum: first two bytes of blake2b of everything before the checksum
+ *
+ * Each byte of the SeedEnc is split with Shamir's secret sharing over
+ * GF(2^8), using the polynomial x^8 + x^4 + x^3 + x + 1.  Shares are
+ * represented as 19 words which makes them impossible to confuse with the
+ * 15 words of a seed.
+ */
+const (
+	// MaxShares is the maximum number of shares which a seed can be split
+	// into, it is also the maximum threshold.
+	MaxShares = 16
+
+	shareByteLen  = 26
+	shareWordLen  = 19
+	shareCsumOff  = shareByteLen - 2
+	shareValueOff = 3
+)
+
+// Share is one of the shares which result from splitting a SeedEnc.
+type Share struct {
+	Bytes [shareByteLen]byte
+}
+
+// Threshold is the number of shares which are needed to recover the seed.
+func (s *Share) Threshold() int {
+	return int(s.Bytes[0]>>4) + 1
+}
+
+// Index is the number of this share, from 1 to the total number of shares.
+func (s *Share) Index() int {
+	return int(s.Bytes[0]&0x0f) + 1
+}
+
+// GroupID identifies the split which this share is a part of, shares from
+// different splits cannot be combined.
+func (s *Share) GroupID() uint16 {
+	return binary.BigEndian.Uint16(s.Bytes[1:3])
+}
+
+// Zero wipes the data of this share from memory.
+func (s *Share) Zero() {
+	zero.Bytes(s.Bytes[:])
+}
+
+func (s *Share) computeCsum() [2]byte {
+	csum := blake2b.Sum256(s.Bytes[:shareCsumOff])
+	return [2]byte{csum[0], csum[1]}
+}
+
+// Words converts the share to it's representation as a list of words.
+func (s *Share) Words(lang string) (string, er.R) {
+	wd, ok := allWords[lang]
+	if !ok {
+		return "", er.Errorf("Language [%s] is not supported", lang)
+	}
+	words := make([]string, 0, shareWordLen)
+	defer zeroStr(words)
+	b := new(big.Int).SetBytes(s.Bytes[:])
+	defer zero.BigInt(b)
+	b_ := big.NewInt(0)
+	defer zero.BigInt(b_)
+	b2047 := big.NewInt(2047)
+	for i := 0; i < shareWordLen; i++ {
+		b_.And(b, b2047)
+		words = append(words, wd.words[b_.Uint64()])
+		b.Rsh(b, 11)
+	}
+	return joinWords(words, lang), nil
+}
+
+// ShareFromWords decodes a share from it's list of words, the language is
+// auto-detected.
+func ShareFromWords(words string) (*Share, er.R) {
+	splitWords := splitWords(words)
+	defer zeroStr(splitWords)
+	if len(splitWords) != shareWordLen {
+		return nil, er.Errorf("Expected a %d word share", shareWordLen)
+	}
+	var err er.R
+	for _, wd := range detectLanguages(splitWords) {
+		b := big.NewInt(0)
+		b_ := big.NewInt(0)
+		for i := len(splitWords) - 1; i >= 0; i-- {
+			b_.SetInt64(int64(wd.rwords[splitWords[i]]))
+			b.Lsh(b, 11)
+			b.Add(b, b_)
+		}
+		bytes := b.Bytes()
+		zero.BigInt(b)
+		zero.BigInt(b_)
+		if len(bytes) > shareByteLen {
+			err = er.New("Invalid share: Unexpected byte length")
+			zero.Bytes(bytes)
+			continue
+		}
+		s := Share{}
+		copy(s.Bytes[shareByteLen-len(bytes):], bytes)
+		zero.Bytes(bytes)
+		if csum := s.computeCsum(); csum[0] != s.Bytes[shareCsumOff] ||
+			csum[1] != s.Bytes[shareCsumOff+1] {
+			err = er.New("Invalid share: Checksum mismatch")
+			s.Zero()
+			continue
+		}
+		return &s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, er.New("Could not decode the words provided, check for typos")
+}
+
+// GF(2^8) arithmetic using 3 as the generator
+var gfExp [510]byte
+var gfLog [256]byte
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gfExp[i] = x
+		gfExp[i+255] = x
+		gfLog[x] = byte(i)
+		// multiply x by 3
+		hi := x & 0x80
+		x2 := x << 1
+		if hi != 0 {
+			x2 ^= 0x1b
+		}
+		x ^= x2
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// gfEval evaluates the polynomial with the given coefficients, lowest order
+// first, at x.
+func gfEval(coeffs []byte, x byte) byte {
+	var out byte
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		out = gfMul(out, x) ^ coeffs[i]
+	}
+	return out
+}
+
+// gfInterpolate finds the value at zero of the polynomial which passes
+// through every (xs[i], ys[i]).
+func gfInterpolate(xs, ys []byte) byte {
+	var out byte
+	for i := range xs {
+		// Lagrange basis polynomial for point i, evaluated at zero
+		basis := byte(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			basis = gfMul(basis, gfDiv(xs[j], xs[i]^xs[j]))
+		}
+		out ^= gfMul(ys[i], basis)
+	}
+	return out
+}
+
+func split(s *SeedEnc, threshold, count int, rnd io.Reader) ([]*Share, er.R) {
+	if threshold < 1 || count < threshold || count > MaxShares {
+		return nil, er.Errorf("Invalid split, threshold must be at least 1 and "+
+			"no more than the number of shares which must be at most %d", MaxShares)
+	}
+	var group [2]byte
+	if _, errr := io.ReadFull(rnd, group[:]); errr != nil {
+		return nil, er.E(errr)
+	}
+
+	// The unused bits are not part of the seed, they are set by Words()
+	secret := *s
+	defer secret.Zero()
+	secret.Bytes[0] &= 0x1f
+
+	out := make([]*Share, count)
+	for i := range out {
+		sh := &Share{}
+		sh.Bytes[0] = byte(threshold-1)<<4 | byte(i)
+		copy(sh.Bytes[1:3], group[:])
+		out[i] = sh
+	}
+	coeffs := make([]byte, threshold)
+	defer zero.Bytes(coeffs)
+	for j, b := range secret.Bytes {
+		coeffs[0] = b
+		if _, errr := io.ReadFull(rnd, coeffs[1:]); errr != nil {
+			return nil, er.E(errr)
+		}
+		for i, sh := range out {
+			sh.Bytes[shareValueOff+j] = gfEval(coeffs, byte(i+1))
+		}
+	}
+	for _, sh := range out {
+		csum := sh.computeCsum()
+		copy(sh.Bytes[shareCsumOff:], csum[:])
+	}
+	return out, nil
+}
+
+// Split divides the encrypted seed into count shares, any threshold of
+// which can be combined to recover the seed using CombineShares.  Fewer than
+// threshold shares reveal nothing about the seed.  The seed is split in
+// it's encrypted form so recovering it still requires the wallet passphrase
+// if the seed has one.
+func (s *SeedEnc) Split(threshold, count int) ([]*Share, er.R) {
+	return split(s, threshold, count, rand.Reader)
+}
+
+// CombineShares recovers an encrypted seed from a set of shares.  All of the
+// shares must come from the same split and there must be at least as many
+// of them as the threshold of the split.
+func CombineShares(shares []*Share) (*SeedEnc, er.R) {
+	if len(shares) == 0 {
+		return nil, er.New("No shares provided")
+	}
+	threshold := shares[0].Threshold()
+	group := shares[0].GroupID()
+	var seen [MaxShares]bool
+	var unique []*Share
+	for _, sh := range shares {
+		if sh.GroupID() != group {
+			return nil, er.Errorf("Share %d belongs to group [%04x] but share %d "+
+				"belongs to group [%04x], they are from different splits",
+				sh.Index(), sh.GroupID(), shares[0].Index(), group)
+		}
+		if sh.Threshold() != threshold {
+			return nil, er.Errorf("Share %d has a threshold of %d but share %d "+
+				"has a threshold of %d", sh.Index(), sh.Threshold(),
+				shares[0].Index(), threshold)
+		}
+		if seen[sh.Index()-1] {
+			continue
+		}
+		seen[sh.Index()-1] = true
+		unique = append(unique, sh)
+	}
+	if len(unique) < threshold {
+		return nil, er.Errorf("%d different shares are needed to recover the "+
+			"seed but only %d were provided", threshold, len(unique))
+	}
+	unique = unique[:threshold]
+
+	xs := make([]byte, threshold)
+	ys := make([]byte, threshold)
+	defer zero.Bytes(ys)
+	for i, sh := range unique {
+		xs[i] = byte(sh.Index())
+	}
+	out := SeedEnc{}
+	for j := range out.Bytes {
+		for i, sh := range unique {
+			ys[i] = sh.Bytes[shareValueOff+j]
+		}
+		out.Bytes[j] = gfInterpolate(xs, ys)
+	}
+	var err er.R
+	if out.getVer() != seedVersion {
+		err = er.Errorf("Invalid seed: Unknown version [%d]", out.getVer())
+	} else if out.getCsum() != out.computeCsum() {
+		err = er.New("Invalid seed: Checksum mismatch, the shares may be corrupt")
+	} else {
+		out.Bytes[0] |= expectUnused << 5
+		return &out, nil
+	}
+	out.Zero()
+	return nil, err
+}
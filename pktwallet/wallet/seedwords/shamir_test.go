@@ -0,0 +1,226 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package seedwords
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+)
+
+// testSeed makes a valid SeedEnc without paying for argon2.
+func testSeed(t *testing.T) *SeedEnc {
+	s := SeedEnc{}
+	if _, err := rand.Read(s.Bytes[2:]); err != nil {
+		t.Fatal(err)
+	}
+	s.putVer(seedVersion)
+	s.putE(true)
+	s.putCsum(s.computeCsum())
+	s.Bytes[0] |= expectUnused << 5
+	return &s
+}
+
+// countingReader is a deterministic source of "randomness" for tests.
+type countingReader struct{ n byte }
+
+func (r *countingReader) Read(b []byte) (int, error) {
+	for i := range b {
+		r.n = r.n*167 + 13
+		b[i] = r.n
+	}
+	return len(b), nil
+}
+
+// slowMul multiplies in GF(2^8) by shifting and reducing.
+func slowMul(a, b byte) byte {
+	var p byte
+	for b != 0 {
+		if b&1 != 0 {
+			p ^= a
+		}
+		hi := a & 0x80
+		a <<= 1
+		if hi != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return p
+}
+
+func TestGF(t *testing.T) {
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			p := gfMul(byte(a), byte(b))
+			if p != slowMul(byte(a), byte(b)) {
+				t.Fatalf("gfMul(%d, %d) = %d, want %d", a, b, p, slowMul(byte(a), byte(b)))
+			}
+			if b != 0 && gfDiv(p, byte(b)) != byte(a) {
+				t.Fatalf("gfDiv(%d, %d) != %d", p, b, a)
+			}
+		}
+	}
+}
+
+// subsets calls fn with every subset of the shares which has exactly k
+// members.
+func subsets(shares []*Share, k int, fn func([]*Share)) {
+	var rec func(start int, chosen []*Share)
+	rec = func(start int, chosen []*Share) {
+		if len(chosen) == k {
+			fn(chosen)
+			return
+		}
+		for i := start; i < len(shares); i++ {
+			rec(i+1, append(chosen, shares[i]))
+		}
+	}
+	rec(0, make([]*Share, 0, k))
+}
+
+// TestCombineEverySubset checks that for every split of up to 8 shares,
+// every subset of threshold shares recovers the seed and every subset of
+// fewer shares is refused.
+func TestCombineEverySubset(t *testing.T) {
+	seed := testSeed(t)
+	for count := 1; count <= 8; count++ {
+		for threshold := 1; threshold <= count; threshold++ {
+			shares, err := seed.Split(threshold, count)
+			if err != nil {
+				t.Fatalf("Split(%d, %d): %v", threshold, count, err)
+			}
+			for k := 1; k <= count; k++ {
+				subsets(shares, k, func(sub []*Share) {
+					got, err := CombineShares(sub)
+					if k < threshold {
+						if err == nil {
+							t.Fatalf("%d of %d-of-%d shares: expected error",
+								k, threshold, count)
+						}
+						return
+					}
+					if err != nil {
+						t.Fatalf("%d of %d-of-%d shares: %v", k, threshold, count, err)
+					}
+					if got.Bytes != seed.Bytes {
+						t.Fatalf("%d of %d-of-%d shares: wrong seed", k, threshold, count)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestSplitMax(t *testing.T) {
+	seed := testSeed(t)
+	shares, err := seed.Split(MaxShares, MaxShares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := CombineShares(shares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Bytes != seed.Bytes {
+		t.Fatalf("wrong seed")
+	}
+	if _, err := CombineShares(shares[1:]); err == nil {
+		t.Fatalf("expected error with %d shares", MaxShares-1)
+	}
+	for _, bad := range [][2]int{{0, 1}, {3, 2}, {2, MaxShares + 1}} {
+		if _, err := seed.Split(bad[0], bad[1]); err == nil {
+			t.Errorf("Split(%d, %d): expected error", bad[0], bad[1])
+		}
+	}
+}
+
+// TestSecrecy checks exhaustively that for every possible secret byte, the
+// values of threshold-1 shares are uniformly distributed over the random
+// coefficients, so they carry no information about the secret.
+func TestSecrecy(t *testing.T) {
+	// 2-of-n: one share at x=5
+	for secret := 0; secret < 256; secret++ {
+		var hist [256]int
+		for a := 0; a < 256; a++ {
+			hist[gfEval([]byte{byte(secret), byte(a)}, 5)]++
+		}
+		for y, n := range hist {
+			if n != 1 {
+				t.Fatalf("2-of-n secret %d: share value %d seen %d times", secret, y, n)
+			}
+		}
+	}
+	// 3-of-n: two shares at x=2 and x=7
+	hist := make([]uint8, 256*256)
+	for secret := 0; secret < 256; secret++ {
+		for i := range hist {
+			hist[i] = 0
+		}
+		for a := 0; a < 256; a++ {
+			for b := 0; b < 256; b++ {
+				coeffs := []byte{byte(secret), byte(a), byte(b)}
+				y1 := gfEval(coeffs, 2)
+				y2 := gfEval(coeffs, 7)
+				hist[int(y1)<<8|int(y2)]++
+			}
+		}
+		for i, n := range hist {
+			if n != 1 {
+				t.Fatalf("3-of-n secret %d: share values %04x seen %d times", secret, i, n)
+			}
+		}
+	}
+}
+
+func TestShareWords(t *testing.T) {
+	seed := testSeed(t)
+	shares, err := split(seed, 2, 3, &countingReader{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, lang := range []string{"english", "spanish", "french", "japanese", "chinese_simplified"} {
+		for _, sh := range shares {
+			words, err := sh.Words(lang)
+			if err != nil {
+				t.Fatalf("Words(%s): %v", lang, err)
+			}
+			sh2, err := ShareFromWords(words)
+			if err != nil {
+				t.Fatalf("ShareFromWords(%s) [%s]: %v", lang, words, err)
+			}
+			if !bytes.Equal(sh2.Bytes[:], sh.Bytes[:]) {
+				t.Fatalf("ShareFromWords(%s): share mismatch", lang)
+			}
+		}
+	}
+
+	// Swapping two words must be caught by the checksum
+	words, err := shares[0].Words("english")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := strings.Fields(words)
+	w[3], w[4] = w[4], w[3]
+	if _, err := ShareFromWords(strings.Join(w, " ")); err == nil {
+		t.Fatalf("ShareFromWords: expected checksum error")
+	}
+
+	// Shares from different splits cannot be mixed
+	other, err := split(seed, 2, 3, &countingReader{n: 99})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other[0].GroupID() == shares[0].GroupID() {
+		t.Fatalf("expected different group ids")
+	}
+	if _, err := CombineShares([]*Share{shares[0], other[1]}); err == nil {
+		t.Fatalf("CombineShares: expected error mixing groups")
+	}
+	if sh := shares[2]; sh.Threshold() != 2 || sh.Index() != 3 {
+		t.Fatalf("share 3: got threshold %d index %d", sh.Threshold(), sh.Index())
+	}
+}
@@ -7,6 +7,4106 @@ package seedwords
 // Auto-generated file (see genwords.go)
 // DO NOT EDIT
 
+var words_chinese_simplified = [2048]string{
+    "的",
+    "一",
+    "是",
+    "在",
+    "不",
+    "了",
+    "有",
+    "和",
+    "人",
+    "这",
+    "中",
+    "大",
+    "为",
+    "上",
+    "个",
+    "国",
+    "我",
+    "以",
+    "要",
+    "他",
+    "时",
+    "来",
+    "用",
+    "们",
+    "生",
+    "到",
+    "作",
+    "地",
+    "于",
+    "出",
+    "就",
+    "分",
+    "对",
+    "成",
+    "会",
+    "可",
+    "主",
+    "发",
+    "年",
+    "动",
+    "同",
+    "工",
+    "也",
+    "能",
+    "下",
+    "过",
+    "子",
+    "说",
+    "产",
+    "种",
+    "面",
+    "而",
+    "方",
+    "后",
+    "多",
+    "定",
+    "行",
+    "学",
+    "法",
+    "所",
+    "民",
+    "得",
+    "经",
+    "十",
+    "三",
+    "之",
+    "进",
+    "着",
+    "等",
+    "部",
+    "度",
+    "家",
+    "电",
+    "力",
+    "里",
+    "如",
+    "水",
+    "化",
+    "高",
+    "自",
+    "二",
+    "理",
+    "起",
+    "小",
+    "物",
+    "现",
+    "实",
+    "加",
+    "量",
+    "都",
+    "两",
+    "体",
+    "制",
+    "机",
+    "当",
+    "使",
+    "点",
+    "从",
+    "业",
+    "本",
+    "去",
+    "把",
+    "性",
+    "好",
+    "应",
+    "开",
+    "它",
+    "合",
+    "还",
+    "因",
+    "由",
+    "其",
+    "些",
+    "然",
+    "前",
+    "外",
+    "天",
+    "政",
+    "四",
+    "日",
+    "那",
+    "社",
+    "义",
+    "事",
+    "平",
+    "形",
+    "相",
+    "全",
+    "表",
+    "间",
+    "样",
+    "与",
+    "关",
+    "各",
+    "重",
+    "新",
+    "线",
+    "内",
+    "数",
+    "正",
+    "心",
+    "反",
+    "你",
+    "明",
+    "看",
+    "原",
+    "又",
+    "么",
+    "利",
+    "比",
+    "或",
+    "但",
+    "质",
+    "气",
+    "第",
+    "向",
+    "道",
+    "命",
+    "此",
+    "变",
+    "条",
+    "只",
+    "没",
+    "结",
+    "解",
+    "问",
+    "意",
+    "建",
+    "月",
+    "公",
+    "无",
+    "系",
+    "军",
+    "很",
+    "情",
+    "者",
+    "最",
+    "立",
+    "代",
+    "想",
+    "已",
+    "通",
+    "并",
+    "提",
+    "直",
+    "题",
+    "党",
+    "程",
+    "展",
+    "五",
+    "果",
+    "料",
+    "象",
+    "员",
+    "革",
+    "位",
+    "入",
+    "常",
+    "文",
+    "总",
+    "次",
+    "品",
+    "式",
+    "活",
+    "设",
+    "及",
+    "管",
+    "特",
+    "件",
+    "长",
+    "求",
+    "老",
+    "头",
+    "基",
+    "资",
+    "边",
+    "流",
+    "路",
+    "级",
+    "少",
+    "图",
+    "山",
+    "统",
+    "接",
+    "知",
+    "较",
+    "将",
+    "组",
+    "见",
+    "计",
+    "别",
+    "她",
+    "手",
+    "角",
+    "期",
+    "根",
+    "论",
+    "运",
+    "农",
+    "指",
+    "几",
+    "九",
+    "区",
+    "强",
+    "放",
+    "决",
+    "西",
+    "被",
+    "干",
+    "做",
+    "必",
+    "战",
+    "先",
+    "回",
+    "则",
+    "任",
+    "取",
+    "据",
+    "处",
+    "队",
+    "南",
+    "给",
+    "色",
+    "光",
+    "门",
+    "即",
+    "保",
+    "治",
+    "北",
+    "造",
+    "百",
+    "规",
+    "热",
+    "领",
+    "七",
+    "海",
+    "口",
+    "东",
+    "导",
+    "器",
+    "压",
+    "志",
+    "世",
+    "金",
+    "增",
+    "争",
+    "济",
+    "阶",
+    "油",
+    "思",
+    "术",
+    "极",
+    "交",
+    "受",
+    "联",
+    "什",
+    "认",
+    "六",
+    "共",
+    "权",
+    "收",
+    "证",
+    "改",
+    "清",
+    "美",
+    "再",
+    "采",
+    "转",
+    "更",
+    "单",
+    "风",
+    "切",
+    "打",
+    "白",
+    "教",
+    "速",
+    "花",
+    "带",
+    "安",
+    "场",
+    "身",
+    "车",
+    "例",
+    "真",
+    "务",
+    "具",
+    "万",
+    "每",
+    "目",
+    "至",
+    "达",
+    "走",
+    "积",
+    "示",
+    "议",
+    "声",
+    "报",
+    "斗",
+    "完",
+    "类",
+    "八",
+    "离",
+    "华",
+    "名",
+    "确",
+    "才",
+    "科",
+    "张",
+    "信",
+    "马",
+    "节",
+    "话",
+    "米",
+    "整",
+    "空",
+    "元",
+    "况",
+    "今",
+    "集",
+    "温",
+    "传",
+    "土",
+    "许",
+    "步",
+    "群",
+    "广",
+    "石",
+    "记",
+    "需",
+    "段",
+    "研",
+    "界",
+    "拉",
+    "林",
+    "律",
+    "叫",
+    "且",
+    "究",
+    "观",
+    "越",
+    "织",
+    "装",
+    "影",
+    "算",
+    "低",
+    "持",
+    "音",
+    "众",
+    "书",
+    "布",
+    "复",
+    "容",
+    "儿",
+    "须",
+    "际",
+    "商",
+    "非",
+    "验",
+    "连",
+    "断",
+    "深",
+    "难",
+    "近",
+    "矿",
+    "千",
+    "周",
+    "委",
+    "素",
+    "技",
+    "备",
+    "半",
+    "办",
+    "青",
+    "省",
+    "列",
+    "习",
+    "响",
+    "约",
+    "支",
+    "般",
+    "史",
+    "感",
+    "劳",
+    "便",
+    "团",
+    "往",
+    "酸",
+    "历",
+    "市",
+    "克",
+    "何",
+    "除",
+    "消",
+    "构",
+    "府",
+    "称",
+    "太",
+    "准",
+    "精",
+    "值",
+    "号",
+    "率",
+    "族",
+    "维",
+    "划",
+    "选",
+    "标",
+    "写",
+    "存",
+    "候",
+    "毛",
+    "亲",
+    "快",
+    "效",
+    "斯",
+    "院",
+    "查",
+    "江",
+    "型",
+    "眼",
+    "王",
+    "按",
+    "格",
+    "养",
+    "易",
+    "置",
+    "派",
+    "层",
+    "片",
+    "始",
+    "却",
+    "专",
+    "状",
+    "育",
+    "厂",
+    "京",
+    "识",
+    "适",
+    "属",
+    "圆",
+    "包",
+    "火",
+    "住",
+    "调",
+    "满",
+    "县",
+    "局",
+    "照",
+    "参",
+    "红",
+    "细",
+    "引",
+    "听",
+    "该",
+    "铁",
+    "价",
+    "严",
+    "首",
+    "底",
+    "液",
+    "官",
+    "德",
+    "随",
+    "病",
+    "苏",
+    "失",
+    "尔",
+    "死",
+    "讲",
+    "配",
+    "女",
+    "黄",
+    "推",
+    "显",
+    "谈",
+    "罪",
+    "神",
+    "艺",
+    "呢",
+    "席",
+    "含",
+    "企",
+    "望",
+    "密",
+    "批",
+    "营",
+    "项",
+    "防",
+    "举",
+    "球",
+    "英",
+    "氧",
+    "势",
+    "告",
+    "李",
+    "台",
+    "落",
+    "木",
+    "帮",
+    "轮",
+    "破",
+    "亚",
+    "师",
+    "围",
+    "注",
+    "远",
+    "字",
+    "材",
+    "排",
+    "供",
+    "河",
+    "态",
+    "封",
+    "另",
+    "施",
+    "减",
+    "树",
+    "溶",
+    "怎",
+    "止",
+    "案",
+    "言",
+    "士",
+    "均",
+    "武",
+    "固",
+    "叶",
+    "鱼",
+    "波",
+    "视",
+    "仅",
+    "费",
+    "紧",
+    "爱",
+    "左",
+    "章",
+    "早",
+    "朝",
+    "害",
+    "续",
+    "轻",
+    "服",
+    "试",
+    "食",
+    "充",
+    "兵",
+    "源",
+    "判",
+    "护",
+    "司",
+    "足",
+    "某",
+    "练",
+    "差",
+    "致",
+    "板",
+    "田",
+    "降",
+    "黑",
+    "犯",
+    "负",
+    "击",
+    "范",
+    "继",
+    "兴",
+    "似",
+    "余",
+    "坚",
+    "曲",
+    "输",
+    "修",
+    "故",
+    "城",
+    "夫",
+    "够",
+    "送",
+    "笔",
+    "船",
+    "占",
+    "右",
+    "财",
+    "吃",
+    "富",
+    "春",
+    "职",
+    "觉",
+    "汉",
+    "画",
+    "功",
+    "巴",
+    "跟",
+    "虽",
+    "杂",
+    "飞",
+    "检",
+    "吸",
+    "助",
+    "升",
+    "阳",
+    "互",
+    "初",
+    "创",
+    "抗",
+    "考",
+    "投",
+    "坏",
+    "策",
+    "古",
+    "径",
+    "换",
+    "未",
+    "跑",
+    "留",
+    "钢",
+    "曾",
+    "端",
+    "责",
+    "站",
+    "简",
+    "述",
+    "钱",
+    "副",
+    "尽",
+    "帝",
+    "射",
+    "草",
+    "冲",
+    "承",
+    "独",
+    "令",
+    "限",
+    "阿",
+    "宣",
+    "环",
+    "双",
+    "请",
+    "超",
+    "微",
+    "让",
+    "控",
+    "州",
+    "良",
+    "轴",
+    "找",
+    "否",
+    "纪",
+    "益",
+    "依",
+    "优",
+    "顶",
+    "础",
+    "载",
+    "倒",
+    "房",
+    "突",
+    "坐",
+    "粉",
+    "敌",
+    "略",
+    "客",
+    "袁",
+    "冷",
+    "胜",
+    "绝",
+    "析",
+    "块",
+    "剂",
+    "测",
+    "丝",
+    "协",
+    "诉",
+    "念",
+    "陈",
+    "仍",
+    "罗",
+    "盐",
+    "友",
+    "洋",
+    "错",
+    "苦",
+    "夜",
+    "刑",
+    "移",
+    "频",
+    "逐",
+    "靠",
+    "混",
+    "母",
+    "短",
+    "皮",
+    "终",
+    "聚",
+    "汽",
+    "村",
+    "云",
+    "哪",
+    "既",
+    "距",
+    "卫",
+    "停",
+    "烈",
+    "央",
+    "察",
+    "烧",
+    "迅",
+    "境",
+    "若",
+    "印",
+    "洲",
+    "刻",
+    "括",
+    "激",
+    "孔",
+    "搞",
+    "甚",
+    "室",
+    "待",
+    "核",
+    "校",
+    "散",
+    "侵",
+    "吧",
+    "甲",
+    "游",
+    "久",
+    "菜",
+    "味",
+    "旧",
+    "模",
+    "湖",
+    "货",
+    "损",
+    "预",
+    "阻",
+    "毫",
+    "普",
+    "稳",
+    "乙",
+    "妈",
+    "植",
+    "息",
+    "扩",
+    "银",
+    "语",
+    "挥",
+    "酒",
+    "守",
+    "拿",
+    "序",
+    "纸",
+    "医",
+    "缺",
+    "雨",
+    "吗",
+    "针",
+    "刘",
+    "啊",
+    "急",
+    "唱",
+    "误",
+    "训",
+    "愿",
+    "审",
+    "附",
+    "获",
+    "茶",
+    "鲜",
+    "粮",
+    "斤",
+    "孩",
+    "脱",
+    "硫",
+    "肥",
+    "善",
+    "龙",
+    "演",
+    "父",
+    "渐",
+    "血",
+    "欢",
+    "械",
+    "掌",
+    "歌",
+    "沙",
+    "刚",
+    "攻",
+    "谓",
+    "盾",
+    "讨",
+    "晚",
+    "粒",
+    "乱",
+    "燃",
+    "矛",
+    "乎",
+    "杀",
+    "药",
+    "宁",
+    "鲁",
+    "贵",
+    "钟",
+    "煤",
+    "读",
+    "班",
+    "伯",
+    "香",
+    "介",
+    "迫",
+    "句",
+    "丰",
+    "培",
+    "握",
+    "兰",
+    "担",
+    "弦",
+    "蛋",
+    "沉",
+    "假",
+    "穿",
+    "执",
+    "答",
+    "乐",
+    "谁",
+    "顺",
+    "烟",
+    "缩",
+    "征",
+    "脸",
+    "喜",
+    "松",
+    "脚",
+    "困",
+    "异",
+    "免",
+    "背",
+    "星",
+    "福",
+    "买",
+    "染",
+    "井",
+    "概",
+    "慢",
+    "怕",
+    "磁",
+    "倍",
+    "祖",
+    "皇",
+    "促",
+    "静",
+    "补",
+    "评",
+    "翻",
+    "肉",
+    "践",
+    "尼",
+    "衣",
+    "宽",
+    "扬",
+    "棉",
+    "希",
+    "伤",
+    "操",
+    "垂",
+    "秋",
+    "宜",
+    "氢",
+    "套",
+    "督",
+    "振",
+    "架",
+    "亮",
+    "末",
+    "宪",
+    "庆",
+    "编",
+    "牛",
+    "触",
+    "映",
+    "雷",
+    "销",
+    "诗",
+    "座",
+    "居",
+    "抓",
+    "裂",
+    "胞",
+    "呼",
+    "娘",
+    "景",
+    "威",
+    "绿",
+    "晶",
+    "厚",
+    "盟",
+    "衡",
+    "鸡",
+    "孙",
+    "延",
+    "危",
+    "胶",
+    "屋",
+    "乡",
+    "临",
+    "陆",
+    "顾",
+    "掉",
+    "呀",
+    "灯",
+    "岁",
+    "措",
+    "束",
+    "耐",
+    "剧",
+    "玉",
+    "赵",
+    "跳",
+    "哥",
+    "季",
+    "课",
+    "凯",
+    "胡",
+    "额",
+    "款",
+    "绍",
+    "卷",
+    "齐",
+    "伟",
+    "蒸",
+    "殖",
+    "永",
+    "宗",
+    "苗",
+    "川",
+    "炉",
+    "岩",
+    "弱",
+    "零",
+    "杨",
+    "奏",
+    "沿",
+    "露",
+    "杆",
+    "探",
+    "滑",
+    "镇",
+    "饭",
+    "浓",
+    "航",
+    "怀",
+    "赶",
+    "库",
+    "夺",
+    "伊",
+    "灵",
+    "税",
+    "途",
+    "灭",
+    "赛",
+    "归",
+    "召",
+    "鼓",
+    "播",
+    "盘",
+    "裁",
+    "险",
+    "康",
+    "唯",
+    "录",
+    "菌",
+    "纯",
+    "借",
+    "糖",
+    "盖",
+    "横",
+    "符",
+    "私",
+    "努",
+    "堂",
+    "域",
+    "枪",
+    "润",
+    "幅",
+    "哈",
+    "竟",
+    "熟",
+    "虫",
+    "泽",
+    "脑",
+    "壤",
+    "碳",
+    "欧",
+    "遍",
+    "侧",
+    "寨",
+    "敢",
+    "彻",
+    "虑",
+    "斜",
+    "薄",
+    "庭",
+    "纳",
+    "弹",
+    "饲",
+    "伸",
+    "折",
+    "麦",
+    "湿",
+    "暗",
+    "荷",
+    "瓦",
+    "塞",
+    "床",
+    "筑",
+    "恶",
+    "户",
+    "访",
+    "塔",
+    "奇",
+    "透",
+    "梁",
+    "刀",
+    "旋",
+    "迹",
+    "卡",
+    "氯",
+    "遇",
+    "份",
+    "毒",
+    "泥",
+    "退",
+    "洗",
+    "摆",
+    "灰",
+    "彩",
+    "卖",
+    "耗",
+    "夏",
+    "择",
+    "忙",
+    "铜",
+    "献",
+    "硬",
+    "予",
+    "繁",
+    "圈",
+    "雪",
+    "函",
+    "亦",
+    "抽",
+    "篇",
+    "阵",
+    "阴",
+    "丁",
+    "尺",
+    "追",
+    "堆",
+    "雄",
+    "迎",
+    "泛",
+    "爸",
+    "楼",
+    "避",
+    "谋",
+    "吨",
+    "野",
+    "猪",
+    "旗",
+    "累",
+    "偏",
+    "典",
+    "馆",
+    "索",
+    "秦",
+    "脂",
+    "潮",
+    "爷",
+    "豆",
+    "忽",
+    "托",
+    "惊",
+    "塑",
+    "遗",
+    "愈",
+    "朱",
+    "替",
+    "纤",
+    "粗",
+    "倾",
+    "尚",
+    "痛",
+    "楚",
+    "谢",
+    "奋",
+    "购",
+    "磨",
+    "君",
+    "池",
+    "旁",
+    "碎",
+    "骨",
+    "监",
+    "捕",
+    "弟",
+    "暴",
+    "割",
+    "贯",
+    "殊",
+    "释",
+    "词",
+    "亡",
+    "壁",
+    "顿",
+    "宝",
+    "午",
+    "尘",
+    "闻",
+    "揭",
+    "炮",
+    "残",
+    "冬",
+    "桥",
+    "妇",
+    "警",
+    "综",
+    "招",
+    "吴",
+    "付",
+    "浮",
+    "遭",
+    "徐",
+    "您",
+    "摇",
+    "谷",
+    "赞",
+    "箱",
+    "隔",
+    "订",
+    "男",
+    "吹",
+    "园",
+    "纷",
+    "唐",
+    "败",
+    "宋",
+    "玻",
+    "巨",
+    "耕",
+    "坦",
+    "荣",
+    "闭",
+    "湾",
+    "键",
+    "凡",
+    "驻",
+    "锅",
+    "救",
+    "恩",
+    "剥",
+    "凝",
+    "碱",
+    "齿",
+    "截",
+    "炼",
+    "麻",
+    "纺",
+    "禁",
+    "废",
+    "盛",
+    "版",
+    "缓",
+    "净",
+    "睛",
+    "昌",
+    "婚",
+    "涉",
+    "筒",
+    "嘴",
+    "插",
+    "岸",
+    "朗",
+    "庄",
+    "街",
+    "藏",
+    "姑",
+    "贸",
+    "腐",
+    "奴",
+    "啦",
+    "惯",
+    "乘",
+    "伙",
+    "恢",
+    "匀",
+    "纱",
+    "扎",
+    "辩",
+    "耳",
+    "彪",
+    "臣",
+    "亿",
+    "璃",
+    "抵",
+    "脉",
+    "秀",
+    "萨",
+    "俄",
+    "网",
+    "舞",
+    "店",
+    "喷",
+    "纵",
+    "寸",
+    "汗",
+    "挂",
+    "洪",
+    "贺",
+    "闪",
+    "柬",
+    "爆",
+    "烯",
+    "津",
+    "稻",
+    "墙",
+    "软",
+    "勇",
+    "像",
+    "滚",
+    "厘",
+    "蒙",
+    "芳",
+    "肯",
+    "坡",
+    "柱",
+    "荡",
+    "腿",
+    "仪",
+    "旅",
+    "尾",
+    "轧",
+    "冰",
+    "贡",
+    "登",
+    "黎",
+    "削",
+    "钻",
+    "勒",
+    "逃",
+    "障",
+    "氨",
+    "郭",
+    "峰",
+    "币",
+    "港",
+    "伏",
+    "轨",
+    "亩",
+    "毕",
+    "擦",
+    "莫",
+    "刺",
+    "浪",
+    "秘",
+    "援",
+    "株",
+    "健",
+    "售",
+    "股",
+    "岛",
+    "甘",
+    "泡",
+    "睡",
+    "童",
+    "铸",
+    "汤",
+    "阀",
+    "休",
+    "汇",
+    "舍",
+    "牧",
+    "绕",
+    "炸",
+    "哲",
+    "磷",
+    "绩",
+    "朋",
+    "淡",
+    "尖",
+    "启",
+    "陷",
+    "柴",
+    "呈",
+    "徒",
+    "颜",
+    "泪",
+    "稍",
+    "忘",
+    "泵",
+    "蓝",
+    "拖",
+    "洞",
+    "授",
+    "镜",
+    "辛",
+    "壮",
+    "锋",
+    "贫",
+    "虚",
+    "弯",
+    "摩",
+    "泰",
+    "幼",
+    "廷",
+    "尊",
+    "窗",
+    "纲",
+    "弄",
+    "隶",
+    "疑",
+    "氏",
+    "宫",
+    "姐",
+    "震",
+    "瑞",
+    "怪",
+    "尤",
+    "琴",
+    "循",
+    "描",
+    "膜",
+    "违",
+    "夹",
+    "腰",
+    "缘",
+    "珠",
+    "穷",
+    "森",
+    "枝",
+    "竹",
+    "沟",
+    "催",
+    "绳",
+    "忆",
+    "邦",
+    "剩",
+    "幸",
+    "浆",
+    "栏",
+    "拥",
+    "牙",
+    "贮",
+    "礼",
+    "滤",
+    "钠",
+    "纹",
+    "罢",
+    "拍",
+    "咱",
+    "喊",
+    "袖",
+    "埃",
+    "勤",
+    "罚",
+    "焦",
+    "潜",
+    "伍",
+    "墨",
+    "欲",
+    "缝",
+    "姓",
+    "刊",
+    "饱",
+    "仿",
+    "奖",
+    "铝",
+    "鬼",
+    "丽",
+    "跨",
+    "默",
+    "挖",
+    "链",
+    "扫",
+    "喝",
+    "袋",
+    "炭",
+    "污",
+    "幕",
+    "诸",
+    "弧",
+    "励",
+    "梅",
+    "奶",
+    "洁",
+    "灾",
+    "舟",
+    "鉴",
+    "苯",
+    "讼",
+    "抱",
+    "毁",
+    "懂",
+    "寒",
+    "智",
+    "埔",
+    "寄",
+    "届",
+    "跃",
+    "渡",
+    "挑",
+    "丹",
+    "艰",
+    "贝",
+    "碰",
+    "拔",
+    "爹",
+    "戴",
+    "码",
+    "梦",
+    "芽",
+    "熔",
+    "赤",
+    "渔",
+    "哭",
+    "敬",
+    "颗",
+    "奔",
+    "铅",
+    "仲",
+    "虎",
+    "稀",
+    "妹",
+    "乏",
+    "珍",
+    "申",
+    "桌",
+    "遵",
+    "允",
+    "隆",
+    "螺",
+    "仓",
+    "魏",
+    "锐",
+    "晓",
+    "氮",
+    "兼",
+    "隐",
+    "碍",
+    "赫",
+    "拨",
+    "忠",
+    "肃",
+    "缸",
+    "牵",
+    "抢",
+    "博",
+    "巧",
+    "壳",
+    "兄",
+    "杜",
+    "讯",
+    "诚",
+    "碧",
+    "祥",
+    "柯",
+    "页",
+    "巡",
+    "矩",
+    "悲",
+    "灌",
+    "龄",
+    "伦",
+    "票",
+    "寻",
+    "桂",
+    "铺",
+    "圣",
+    "恐",
+    "恰",
+    "郑",
+    "趣",
+    "抬",
+    "荒",
+    "腾",
+    "贴",
+    "柔",
+    "滴",
+    "猛",
+    "阔",
+    "辆",
+    "妻",
+    "填",
+    "撤",
+    "储",
+    "签",
+    "闹",
+    "扰",
+    "紫",
+    "砂",
+    "递",
+    "戏",
+    "吊",
+    "陶",
+    "伐",
+    "喂",
+    "疗",
+    "瓶",
+    "婆",
+    "抚",
+    "臂",
+    "摸",
+    "忍",
+    "虾",
+    "蜡",
+    "邻",
+    "胸",
+    "巩",
+    "挤",
+    "偶",
+    "弃",
+    "槽",
+    "劲",
+    "乳",
+    "邓",
+    "吉",
+    "仁",
+    "烂",
+    "砖",
+    "租",
+    "乌",
+    "舰",
+    "伴",
+    "瓜",
+    "浅",
+    "丙",
+    "暂",
+    "燥",
+    "橡",
+    "柳",
+    "迷",
+    "暖",
+    "牌",
+    "秧",
+    "胆",
+    "详",
+    "簧",
+    "踏",
+    "瓷",
+    "谱",
+    "呆",
+    "宾",
+    "糊",
+    "洛",
+    "辉",
+    "愤",
+    "竞",
+    "隙",
+    "怒",
+    "粘",
+    "乃",
+    "绪",
+    "肩",
+    "籍",
+    "敏",
+    "涂",
+    "熙",
+    "皆",
+    "侦",
+    "悬",
+    "掘",
+    "享",
+    "纠",
+    "醒",
+    "狂",
+    "锁",
+    "淀",
+    "恨",
+    "牲",
+    "霸",
+    "爬",
+    "赏",
+    "逆",
+    "玩",
+    "陵",
+    "祝",
+    "秒",
+    "浙",
+    "貌",
+    "役",
+    "彼",
+    "悉",
+    "鸭",
+    "趋",
+    "凤",
+    "晨",
+    "畜",
+    "辈",
+    "秩",
+    "卵",
+    "署",
+    "梯",
+    "炎",
+    "滩",
+    "棋",
+    "驱",
+    "筛",
+    "峡",
+    "冒",
+    "啥",
+    "寿",
+    "译",
+    "浸",
+    "泉",
+    "帽",
+    "迟",
+    "硅",
+    "疆",
+    "贷",
+    "漏",
+    "稿",
+    "冠",
+    "嫩",
+    "胁",
+    "芯",
+    "牢",
+    "叛",
+    "蚀",
+    "奥",
+    "鸣",
+    "岭",
+    "羊",
+    "凭",
+    "串",
+    "塘",
+    "绘",
+    "酵",
+    "融",
+    "盆",
+    "锡",
+    "庙",
+    "筹",
+    "冻",
+    "辅",
+    "摄",
+    "袭",
+    "筋",
+    "拒",
+    "僚",
+    "旱",
+    "钾",
+    "鸟",
+    "漆",
+    "沈",
+    "眉",
+    "疏",
+    "添",
+    "棒",
+    "穗",
+    "硝",
+    "韩",
+    "逼",
+    "扭",
+    "侨",
+    "凉",
+    "挺",
+    "碗",
+    "栽",
+    "炒",
+    "杯",
+    "患",
+    "馏",
+    "劝",
+    "豪",
+    "辽",
+    "勃",
+    "鸿",
+    "旦",
+    "吏",
+    "拜",
+    "狗",
+    "埋",
+    "辊",
+    "掩",
+    "饮",
+    "搬",
+    "骂",
+    "辞",
+    "勾",
+    "扣",
+    "估",
+    "蒋",
+    "绒",
+    "雾",
+    "丈",
+    "朵",
+    "姆",
+    "拟",
+    "宇",
+    "辑",
+    "陕",
+    "雕",
+    "偿",
+    "蓄",
+    "崇",
+    "剪",
+    "倡",
+    "厅",
+    "咬",
+    "驶",
+    "薯",
+    "刷",
+    "斥",
+    "番",
+    "赋",
+    "奉",
+    "佛",
+    "浇",
+    "漫",
+    "曼",
+    "扇",
+    "钙",
+    "桃",
+    "扶",
+    "仔",
+    "返",
+    "俗",
+    "亏",
+    "腔",
+    "鞋",
+    "棱",
+    "覆",
+    "框",
+    "悄",
+    "叔",
+    "撞",
+    "骗",
+    "勘",
+    "旺",
+    "沸",
+    "孤",
+    "吐",
+    "孟",
+    "渠",
+    "屈",
+    "疾",
+    "妙",
+    "惜",
+    "仰",
+    "狠",
+    "胀",
+    "谐",
+    "抛",
+    "霉",
+    "桑",
+    "岗",
+    "嘛",
+    "衰",
+    "盗",
+    "渗",
+    "脏",
+    "赖",
+    "涌",
+    "甜",
+    "曹",
+    "阅",
+    "肌",
+    "哩",
+    "厉",
+    "烃",
+    "纬",
+    "毅",
+    "昨",
+    "伪",
+    "症",
+    "煮",
+    "叹",
+    "钉",
+    "搭",
+    "茎",
+    "笼",
+    "酷",
+    "偷",
+    "弓",
+    "锥",
+    "恒",
+    "杰",
+    "坑",
+    "鼻",
+    "翼",
+    "纶",
+    "叙",
+    "狱",
+    "逮",
+    "罐",
+    "络",
+    "棚",
+    "抑",
+    "膨",
+    "蔬",
+    "寺",
+    "骤",
+    "穆",
+    "冶",
+    "枯",
+    "册",
+    "尸",
+    "凸",
+    "绅",
+    "坯",
+    "牺",
+    "焰",
+    "轰",
+    "欣",
+    "晋",
+    "瘦",
+    "御",
+    "锭",
+    "锦",
+    "丧",
+    "旬",
+    "锻",
+    "垄",
+    "搜",
+    "扑",
+    "邀",
+    "亭",
+    "酯",
+    "迈",
+    "舒",
+    "脆",
+    "酶",
+    "闲",
+    "忧",
+    "酚",
+    "顽",
+    "羽",
+    "涨",
+    "卸",
+    "仗",
+    "陪",
+    "辟",
+    "惩",
+    "杭",
+    "姚",
+    "肚",
+    "捉",
+    "飘",
+    "漂",
+    "昆",
+    "欺",
+    "吾",
+    "郎",
+    "烷",
+    "汁",
+    "呵",
+    "饰",
+    "萧",
+    "雅",
+    "邮",
+    "迁",
+    "燕",
+    "撒",
+    "姻",
+    "赴",
+    "宴",
+    "烦",
+    "债",
+    "帐",
+    "斑",
+    "铃",
+    "旨",
+    "醇",
+    "董",
+    "饼",
+    "雏",
+    "姿",
+    "拌",
+    "傅",
+    "腹",
+    "妥",
+    "揉",
+    "贤",
+    "拆",
+    "歪",
+    "葡",
+    "胺",
+    "丢",
+    "浩",
+    "徽",
+    "昂",
+    "垫",
+    "挡",
+    "览",
+    "贪",
+    "慰",
+    "缴",
+    "汪",
+    "慌",
+    "冯",
+    "诺",
+    "姜",
+    "谊",
+    "凶",
+    "劣",
+    "诬",
+    "耀",
+    "昏",
+    "躺",
+    "盈",
+    "骑",
+    "乔",
+    "溪",
+    "丛",
+    "卢",
+    "抹",
+    "闷",
+    "咨",
+    "刮",
+    "驾",
+    "缆",
+    "悟",
+    "摘",
+    "铒",
+    "掷",
+    "颇",
+    "幻",
+    "柄",
+    "惠",
+    "惨",
+    "佳",
+    "仇",
+    "腊",
+    "窝",
+    "涤",
+    "剑",
+    "瞧",
+    "堡",
+    "泼",
+    "葱",
+    "罩",
+    "霍",
+    "捞",
+    "胎",
+    "苍",
+    "滨",
+    "俩",
+    "捅",
+    "湘",
+    "砍",
+    "霞",
+    "邵",
+    "萄",
+    "疯",
+    "淮",
+    "遂",
+    "熊",
+    "粪",
+    "烘",
+    "宿",
+    "档",
+    "戈",
+    "驳",
+    "嫂",
+    "裕",
+    "徙",
+    "箭",
+    "捐",
+    "肠",
+    "撑",
+    "晒",
+    "辨",
+    "殿",
+    "莲",
+    "摊",
+    "搅",
+    "酱",
+    "屏",
+    "疫",
+    "哀",
+    "蔡",
+    "堵",
+    "沫",
+    "皱",
+    "畅",
+    "叠",
+    "阁",
+    "莱",
+    "敲",
+    "辖",
+    "钩",
+    "痕",
+    "坝",
+    "巷",
+    "饿",
+    "祸",
+    "丘",
+    "玄",
+    "溜",
+    "曰",
+    "逻",
+    "彭",
+    "尝",
+    "卿",
+    "妨",
+    "艇",
+    "吞",
+    "韦",
+    "怨",
+    "矮",
+    "歇",
+}
+var rwords_chinese_simplified = map[string]int16{
+    "的": 0,
+    "一": 1,
+    "是": 2,
+    "在": 3,
+    "不": 4,
+    "了": 5,
+    "有": 6,
+    "和": 7,
+    "人": 8,
+    "这": 9,
+    "中": 10,
+    "大": 11,
+    "为": 12,
+    "上": 13,
+    "个": 14,
+    "国": 15,
+    "我": 16,
+    "以": 17,
+    "要": 18,
+    "他": 19,
+    "时": 20,
+    "来": 21,
+    "用": 22,
+    "们": 23,
+    "生": 24,
+    "到": 25,
+    "作": 26,
+    "地": 27,
+    "于": 28,
+    "出": 29,
+    "就": 30,
+    "分": 31,
+    "对": 32,
+    "成": 33,
+    "会": 34,
+    "可": 35,
+    "主": 36,
+    "发": 37,
+    "年": 38,
+    "动": 39,
+    "同": 40,
+    "工": 41,
+    "也": 42,
+    "能": 43,
+    "下": 44,
+    "过": 45,
+    "子": 46,
+    "说": 47,
+    "产": 48,
+    "种": 49,
+    "面": 50,
+    "而": 51,
+    "方": 52,
+    "后": 53,
+    "多": 54,
+    "定": 55,
+    "行": 56,
+    "学": 57,
+    "法": 58,
+    "所": 59,
+    "民": 60,
+    "得": 61,
+    "经": 62,
+    "十": 63,
+    "三": 64,
+    "之": 65,
+    "进": 66,
+    "着": 67,
+    "等": 68,
+    "部": 69,
+    "度": 70,
+    "家": 71,
+    "电": 72,
+    "力": 73,
+    "里": 74,
+    "如": 75,
+    "水": 76,
+    "化": 77,
+    "高": 78,
+    "自": 79,
+    "二": 80,
+    "理": 81,
+    "起": 82,
+    "小": 83,
+    "物": 84,
+    "现": 85,
+    "实": 86,
+    "加": 87,
+    "量": 88,
+    "都": 89,
+    "两": 90,
+    "体": 91,
+    "制": 92,
+    "机": 93,
+    "当": 94,
+    "使": 95,
+    "点": 96,
+    "从": 97,
+    "业": 98,
+    "本": 99,
+    "去": 100,
+    "把": 101,
+    "性": 102,
+    "好": 103,
+    "应": 104,
+    "开": 105,
+    "它": 106,
+    "合": 107,
+    "还": 108,
+    "因": 109,
+    "由": 110,
+    "其": 111,
+    "些": 112,
+    "然": 113,
+    "前": 114,
+    "外": 115,
+    "天": 116,
+    "政": 117,
+    "四": 118,
+    "日": 119,
+    "那": 120,
+    "社": 121,
+    "义": 122,
+    "事": 123,
+    "平": 124,
+    "形": 125,
+    "相": 126,
+    "全": 127,
+    "表": 128,
+    "间": 129,
+    "样": 130,
+    "与": 131,
+    "关": 132,
+    "各": 133,
+    "重": 134,
+    "新": 135,
+    "线": 136,
+    "内": 137,
+    "数": 138,
+    "正": 139,
+    "心": 140,
+    "反": 141,
+    "你": 142,
+    "明": 143,
+    "看": 144,
+    "原": 145,
+    "又": 146,
+    "么": 147,
+    "利": 148,
+    "比": 149,
+    "或": 150,
+    "但": 151,
+    "质": 152,
+    "气": 153,
+    "第": 154,
+    "向": 155,
+    "道": 156,
+    "命": 157,
+    "此": 158,
+    "变": 159,
+    "条": 160,
+    "只": 161,
+    "没": 162,
+    "结": 163,
+    "解": 164,
+    "问": 165,
+    "意": 166,
+    "建": 167,
+    "月": 168,
+    "公": 169,
+    "无": 170,
+    "系": 171,
+    "军": 172,
+    "很": 173,
+    "情": 174,
+    "者": 175,
+    "最": 176,
+    "立": 177,
+    "代": 178,
+    "想": 179,
+    "已": 180,
+    "通": 181,
+    "并": 182,
+    "提": 183,
+    "直": 184,
+    "题": 185,
+    "党": 186,
+    "程": 187,
+    "展": 188,
+    "五": 189,
+    "果": 190,
+    "料": 191,
+    "象": 192,
+    "员": 193,
+    "革": 194,
+    "位": 195,
+    "入": 196,
+    "常": 197,
+    "文": 198,
+    "总": 199,
+    "次": 200,
+    "品": 201,
+    "式": 202,
+    "活": 203,
+    "设": 204,
+    "及": 205,
+    "管": 206,
+    "特": 207,
+    "件": 208,
+    "长": 209,
+    "求": 210,
+    "老": 211,
+    "头": 212,
+    "基": 213,
+    "资": 214,
+    "边": 215,
+    "流": 216,
+    "路": 217,
+    "级": 218,
+    "少": 219,
+    "图": 220,
+    "山": 221,
+    "统": 222,
+    "接": 223,
+    "知": 224,
+    "较": 225,
+    "将": 226,
+    "组": 227,
+    "见": 228,
+    "计": 229,
+    "别": 230,
+    "她": 231,
+    "手": 232,
+    "角": 233,
+    "期": 234,
+    "根": 235,
+    "论": 236,
+    "运": 237,
+    "农": 238,
+    "指": 239,
+    "几": 240,
+    "九": 241,
+    "区": 242,
+    "强": 243,
+    "放": 244,
+    "决": 245,
+    "西": 246,
+    "被": 247,
+    "干": 248,
+    "做": 249,
+    "必": 250,
+    "战": 251,
+    "先": 252,
+    "回": 253,
+    "则": 254,
+    "任": 255,
+    "取": 256,
+    "据": 257,
+    "处": 258,
+    "队": 259,
+    "南": 260,
+    "给": 261,
+    "色": 262,
+    "光": 263,
+    "门": 264,
+    "即": 265,
+    "保": 266,
+    "治": 267,
+    "北": 268,
+    "造": 269,
+    "百": 270,
+    "规": 271,
+    "热": 272,
+    "领": 273,
+    "七": 274,
+    "海": 275,
+    "口": 276,
+    "东": 277,
+    "导": 278,
+    "器": 279,
+    "压": 280,
+    "志": 281,
+    "世": 282,
+    "金": 283,
+    "增": 284,
+    "争": 285,
+    "济": 286,
+    "阶": 287,
+    "油": 288,
+    "思": 289,
+    "术": 290,
+    "极": 291,
+    "交": 292,
+    "受": 293,
+    "联": 294,
+    "什": 295,
+    "认": 296,
+    "六": 297,
+    "共": 298,
+    "权": 299,
+    "收": 300,
+    "证": 301,
+    "改": 302,
+    "清": 303,
+    "美": 304,
+    "再": 305,
+    "采": 306,
+    "转": 307,
+    "更": 308,
+    "单": 309,
+    "风": 310,
+    "切": 311,
+    "打": 312,
+    "白": 313,
+    "教": 314,
+    "速": 315,
+    "花": 316,
+    "带": 317,
+    "安": 318,
+    "场": 319,
+    "身": 320,
+    "车": 321,
+    "例": 322,
+    "真": 323,
+    "务": 324,
+    "具": 325,
+    "万": 326,
+    "每": 327,
+    "目": 328,
+    "至": 329,
+    "达": 330,
+    "走": 331,
+    "积": 332,
+    "示": 333,
+    "议": 334,
+    "声": 335,
+    "报": 336,
+    "斗": 337,
+    "完": 338,
+    "类": 339,
+    "八": 340,
+    "离": 341,
+    "华": 342,
+    "名": 343,
+    "确": 344,
+    "才": 345,
+    "科": 346,
+    "张": 347,
+    "信": 348,
+    "马": 349,
+    "节": 350,
+    "话": 351,
+    "米": 352,
+    "整": 353,
+    "空": 354,
+    "元": 355,
+    "况": 356,
+    "今": 357,
+    "集": 358,
+    "温": 359,
+    "传": 360,
+    "土": 361,
+    "许": 362,
+    "步": 363,
+    "群": 364,
+    "广": 365,
+    "石": 366,
+    "记": 367,
+    "需": 368,
+    "段": 369,
+    "研": 370,
+    "界": 371,
+    "拉": 372,
+    "林": 373,
+    "律": 374,
+    "叫": 375,
+    "且": 376,
+    "究": 377,
+    "观": 378,
+    "越": 379,
+    "织": 380,
+    "装": 381,
+    "影": 382,
+    "算": 383,
+    "低": 384,
+    "持": 385,
+    "音": 386,
+    "众": 387,
+    "书": 388,
+    "布": 389,
+    "复": 390,
+    "容": 391,
+    "儿": 392,
+    "须": 393,
+    "际": 394,
+    "商": 395,
+    "非": 396,
+    "验": 397,
+    "连": 398,
+    "断": 399,
+    "深": 400,
+    "难": 401,
+    "近": 402,
+    "矿": 403,
+    "千": 404,
+    "周": 405,
+    "委": 406,
+    "素": 407,
+    "技": 408,
+    "备": 409,
+    "半": 410,
+    "办": 411,
+    "青": 412,
+    "省": 413,
+    "列": 414,
+    "习": 415,
+    "响": 416,
+    "约": 417,
+    "支": 418,
+    "般": 419,
+    "史": 420,
+    "感": 421,
+    "劳": 422,
+    "便": 423,
+    "团": 424,
+    "往": 425,
+    "酸": 426,
+    "历": 427,
+    "市": 428,
+    "克": 429,
+    "何": 430,
+    "除": 431,
+    "消": 432,
+    "构": 433,
+    "府": 434,
+    "称": 435,
+    "太": 436,
+    "准": 437,
+    "精": 438,
+    "值": 439,
+    "号": 440,
+    "率": 441,
+    "族": 442,
+    "维": 443,
+    "划": 444,
+    "选": 445,
+    "标": 446,
+    "写": 447,
+    "存": 448,
+    "候": 449,
+    "毛": 450,
+    "亲": 451,
+    "快": 452,
+    "效": 453,
+    "斯": 454,
+    "院": 455,
+    "查": 456,
+    "江": 457,
+    "型": 458,
+    "眼": 459,
+    "王": 460,
+    "按": 461,
+    "格": 462,
+    "养": 463,
+    "易": 464,
+    "置": 465,
+    "派": 466,
+    "层": 467,
+    "片": 468,
+    "始": 469,
+    "却": 470,
+    "专": 471,
+    "状": 472,
+    "育": 473,
+    "厂": 474,
+    "京": 475,
+    "识": 476,
+    "适": 477,
+    "属": 478,
+    "圆": 479,
+    "包": 480,
+    "火": 481,
+    "住": 482,
+    "调": 483,
+    "满": 484,
+    "县": 485,
+    "局": 486,
+    "照": 487,
+    "参": 488,
+    "红": 489,
+    "细": 490,
+    "引": 491,
+    "听": 492,
+    "该": 493,
+    "铁": 494,
+    "价": 495,
+    "严": 496,
+    "首": 497,
+    "底": 498,
+    "液": 499,
+    "官": 500,
+    "德": 501,
+    "随": 502,
+    "病": 503,
+    "苏": 504,
+    "失": 505,
+    "尔": 506,
+    "死": 507,
+    "讲": 508,
+    "配": 509,
+    "女": 510,
+    "黄": 511,
+    "推": 512,
+    "显": 513,
+    "谈": 514,
+    "罪": 515,
+    "神": 516,
+    "艺": 517,
+    "呢": 518,
+    "席": 519,
+    "含": 520,
+    "企": 521,
+    "望": 522,
+    "密": 523,
+    "批": 524,
+    "营": 525,
+    "项": 526,
+    "防": 527,
+    "举": 528,
+    "球": 529,
+    "英": 530,
+    "氧": 531,
+    "势": 532,
+    "告": 533,
+    "李": 534,
+    "台": 535,
+    "落": 536,
+    "木": 537,
+    "帮": 538,
+    "轮": 539,
+    "破": 540,
+    "亚": 541,
+    "师": 542,
+    "围": 543,
+    "注": 544,
+    "远": 545,
+    "字": 546,
+    "材": 547,
+    "排": 548,
+    "供": 549,
+    "河": 550,
+    "态": 551,
+    "封": 552,
+    "另": 553,
+    "施": 554,
+    "减": 555,
+    "树": 556,
+    "溶": 557,
+    "怎": 558,
+    "止": 559,
+    "案": 560,
+    "言": 561,
+    "士": 562,
+    "均": 563,
+    "武": 564,
+    "固": 565,
+    "叶": 566,
+    "鱼": 567,
+    "波": 568,
+    "视": 569,
+    "仅": 570,
+    "费": 571,
+    "紧": 572,
+    "爱": 573,
+    "左": 574,
+    "章": 575,
+    "早": 576,
+    "朝": 577,
+    "害": 578,
+    "续": 579,
+    "轻": 580,
+    "服": 581,
+    "试": 582,
+    "食": 583,
+    "充": 584,
+    "兵": 585,
+    "源": 586,
+    "判": 587,
+    "护": 588,
+    "司": 589,
+    "足": 590,
+    "某": 591,
+    "练": 592,
+    "差": 593,
+    "致": 594,
+    "板": 595,
+    "田": 596,
+    "降": 597,
+    "黑": 598,
+    "犯": 599,
+    "负": 600,
+    "击": 601,
+    "范": 602,
+    "继": 603,
+    "兴": 604,
+    "似": 605,
+    "余": 606,
+    "坚": 607,
+    "曲": 608,
+    "输": 609,
+    "修": 610,
+    "故": 611,
+    "城": 612,
+    "夫": 613,
+    "够": 614,
+    "送": 615,
+    "笔": 616,
+    "船": 617,
+    "占": 618,
+    "右": 619,
+    "财": 620,
+    "吃": 621,
+    "富": 622,
+    "春": 623,
+    "职": 624,
+    "觉": 625,
+    "汉": 626,
+    "画": 627,
+    "功": 628,
+    "巴": 629,
+    "跟": 630,
+    "虽": 631,
+    "杂": 632,
+    "飞": 633,
+    "检": 634,
+    "吸": 635,
+    "助": 636,
+    "升": 637,
+    "阳": 638,
+    "互": 639,
+    "初": 640,
+    "创": 641,
+    "抗": 642,
+    "考": 643,
+    "投": 644,
+    "坏": 645,
+    "策": 646,
+    "古": 647,
+    "径": 648,
+    "换": 649,
+    "未": 650,
+    "跑": 651,
+    "留": 652,
+    "钢": 653,
+    "曾": 654,
+    "端": 655,
+    "责": 656,
+    "站": 657,
+    "简": 658,
+    "述": 659,
+    "钱": 660,
+    "副": 661,
+    "尽": 662,
+    "帝": 663,
+    "射": 664,
+    "草": 665,
+    "冲": 666,
+    "承": 667,
+    "独": 668,
+    "令": 669,
+    "限": 670,
+    "阿": 671,
+    "宣": 672,
+    "环": 673,
+    "双": 674,
+    "请": 675,
+    "超": 676,
+    "微": 677,
+    "让": 678,
+    "控": 679,
+    "州": 680,
+    "良": 681,
+    "轴": 682,
+    "找": 683,
+    "否": 684,
+    "纪": 685,
+    "益": 686,
+    "依": 687,
+    "优": 688,
+    "顶": 689,
+    "础": 690,
+    "载": 691,
+    "倒": 692,
+    "房": 693,
+    "突": 694,
+    "坐": 695,
+    "粉": 696,
+    "敌": 697,
+    "略": 698,
+    "客": 699,
+    "袁": 700,
+    "冷": 701,
+    "胜": 702,
+    "绝": 703,
+    "析": 704,
+    "块": 705,
+    "剂": 706,
+    "测": 707,
+    "丝": 708,
+    "协": 709,
+    "诉": 710,
+    "念": 711,
+    "陈": 712,
+    "仍": 713,
+    "罗": 714,
+    "盐": 715,
+    "友": 716,
+    "洋": 717,
+    "错": 718,
+    "苦": 719,
+    "夜": 720,
+    "刑": 721,
+    "移": 722,
+    "频": 723,
+    "逐": 724,
+    "靠": 725,
+    "混": 726,
+    "母": 727,
+    "短": 728,
+    "皮": 729,
+    "终": 730,
+    "聚": 731,
+    "汽": 732,
+    "村": 733,
+    "云": 734,
+    "哪": 735,
+    "既": 736,
+    "距": 737,
+    "卫": 738,
+    "停": 739,
+    "烈": 740,
+    "央": 741,
+    "察": 742,
+    "烧": 743,
+    "迅": 744,
+    "境": 745,
+    "若": 746,
+    "印": 747,
+    "洲": 748,
+    "刻": 749,
+    "括": 750,
+    "激": 751,
+    "孔": 752,
+    "搞": 753,
+    "甚": 754,
+    "室": 755,
+    "待": 756,
+    "核": 757,
+    "校": 758,
+    "散": 759,
+    "侵": 760,
+    "吧": 761,
+    "甲": 762,
+    "游": 763,
+    "久": 764,
+    "菜": 765,
+    "味": 766,
+    "旧": 767,
+    "模": 768,
+    "湖": 769,
+    "货": 770,
+    "损": 771,
+    "预": 772,
+    "阻": 773,
+    "毫": 774,
+    "普": 775,
+    "稳": 776,
+    "乙": 777,
+    "妈": 778,
+    "植": 779,
+    "息": 780,
+    "扩": 781,
+    "银": 782,
+    "语": 783,
+    "挥": 784,
+    "酒": 785,
+    "守": 786,
+    "拿": 787,
+    "序": 788,
+    "纸": 789,
+    "医": 790,
+    "缺": 791,
+    "雨": 792,
+    "吗": 793,
+    "针": 794,
+    "刘": 795,
+    "啊": 796,
+    "急": 797,
+    "唱": 798,
+    "误": 799,
+    "训": 800,
+    "愿": 801,
+    "审": 802,
+    "附": 803,
+    "获": 804,
+    "茶": 805,
+    "鲜": 806,
+    "粮": 807,
+    "斤": 808,
+    "孩": 809,
+    "脱": 810,
+    "硫": 811,
+    "肥": 812,
+    "善": 813,
+    "龙": 814,
+    "演": 815,
+    "父": 816,
+    "渐": 817,
+    "血": 818,
+    "欢": 819,
+    "械": 820,
+    "掌": 821,
+    "歌": 822,
+    "沙": 823,
+    "刚": 824,
+    "攻": 825,
+    "谓": 826,
+    "盾": 827,
+    "讨": 828,
+    "晚": 829,
+    "粒": 830,
+    "乱": 831,
+    "燃": 832,
+    "矛": 833,
+    "乎": 834,
+    "杀": 835,
+    "药": 836,
+    "宁": 837,
+    "鲁": 838,
+    "贵": 839,
+    "钟": 840,
+    "煤": 841,
+    "读": 842,
+    "班": 843,
+    "伯": 844,
+    "香": 845,
+    "介": 846,
+    "迫": 847,
+    "句": 848,
+    "丰": 849,
+    "培": 850,
+    "握": 851,
+    "兰": 852,
+    "担": 853,
+    "弦": 854,
+    "蛋": 855,
+    "沉": 856,
+    "假": 857,
+    "穿": 858,
+    "执": 859,
+    "答": 860,
+    "乐": 861,
+    "谁": 862,
+    "顺": 863,
+    "烟": 864,
+    "缩": 865,
+    "征": 866,
+    "脸": 867,
+    "喜": 868,
+    "松": 869,
+    "脚": 870,
+    "困": 871,
+    "异": 872,
+    "免": 873,
+    "背": 874,
+    "星": 875,
+    "福": 876,
+    "买": 877,
+    "染": 878,
+    "井": 879,
+    "概": 880,
+    "慢": 881,
+    "怕": 882,
+    "磁": 883,
+    "倍": 884,
+    "祖": 885,
+    "皇": 886,
+    "促": 887,
+    "静": 888,
+    "补": 889,
+    "评": 890,
+    "翻": 891,
+    "肉": 892,
+    "践": 893,
+    "尼": 894,
+    "衣": 895,
+    "宽": 896,
+    "扬": 897,
+    "棉": 898,
+    "希": 899,
+    "伤": 900,
+    "操": 901,
+    "垂": 902,
+    "秋": 903,
+    "宜": 904,
+    "氢": 905,
+    "套": 906,
+    "督": 907,
+    "振": 908,
+    "架": 909,
+    "亮": 910,
+    "末": 911,
+    "宪": 912,
+    "庆": 913,
+    "编": 914,
+    "牛": 915,
+    "触": 916,
+    "映": 917,
+    "雷": 918,
+    "销": 919,
+    "诗": 920,
+    "座": 921,
+    "居": 922,
+    "抓": 923,
+    "裂": 924,
+    "胞": 925,
+    "呼": 926,
+    "娘": 927,
+    "景": 928,
+    "威": 929,
+    "绿": 930,
+    "晶": 931,
+    "厚": 932,
+    "盟": 933,
+    "衡": 934,
+    "鸡": 935,
+    "孙": 936,
+    "延": 937,
+    "危": 938,
+    "胶": 939,
+    "屋": 940,
+    "乡": 941,
+    "临": 942,
+    "陆": 943,
+    "顾": 944,
+    "掉": 945,
+    "呀": 946,
+    "灯": 947,
+    "岁": 948,
+    "措": 949,
+    "束": 950,
+    "耐": 951,
+    "剧": 952,
+    "玉": 953,
+    "赵": 954,
+    "跳": 955,
+    "哥": 956,
+    "季": 957,
+    "课": 958,
+    "凯": 959,
+    "胡": 960,
+    "额": 961,
+    "款": 962,
+    "绍": 963,
+    "卷": 964,
+    "齐": 965,
+    "伟": 966,
+    "蒸": 967,
+    "殖": 968,
+    "永": 969,
+    "宗": 970,
+    "苗": 971,
+    "川": 972,
+    "炉": 973,
+    "岩": 974,
+    "弱": 975,
+    "零": 976,
+    "杨": 977,
+    "奏": 978,
+    "沿": 979,
+    "露": 980,
+    "杆": 981,
+    "探": 982,
+    "滑": 983,
+    "镇": 984,
+    "饭": 985,
+    "浓": 986,
+    "航": 987,
+    "怀": 988,
+    "赶": 989,
+    "库": 990,
+    "夺": 991,
+    "伊": 992,
+    "灵": 993,
+    "税": 994,
+    "途": 995,
+    "灭": 996,
+    "赛": 997,
+    "归": 998,
+    "召": 999,
+    "鼓": 1000,
+    "播": 1001,
+    "盘": 1002,
+    "裁": 1003,
+    "险": 1004,
+    "康": 1005,
+    "唯": 1006,
+    "录": 1007,
+    "菌": 1008,
+    "纯": 1009,
+    "借": 1010,
+    "糖": 1011,
+    "盖": 1012,
+    "横": 1013,
+    "符": 1014,
+    "私": 1015,
+    "努": 1016,
+    "堂": 1017,
+    "域": 1018,
+    "枪": 1019,
+    "润": 1020,
+    "幅": 1021,
+    "哈": 1022,
+    "竟": 1023,
+    "熟": 1024,
+    "虫": 1025,
+    "泽": 1026,
+    "脑": 1027,
+    "壤": 1028,
+    "碳": 1029,
+    "欧": 1030,
+    "遍": 1031,
+    "侧": 1032,
+    "寨": 1033,
+    "敢": 1034,
+    "彻": 1035,
+    "虑": 1036,
+    "斜": 1037,
+    "薄": 1038,
+    "庭": 1039,
+    "纳": 1040,
+    "弹": 1041,
+    "饲": 1042,
+    "伸": 1043,
+    "折": 1044,
+    "麦": 1045,
+    "湿": 1046,
+    "暗": 1047,
+    "荷": 1048,
+    "瓦": 1049,
+    "塞": 1050,
+    "床": 1051,
+    "筑": 1052,
+    "恶": 1053,
+    "户": 1054,
+    "访": 1055,
+    "塔": 1056,
+    "奇": 1057,
+    "透": 1058,
+    "梁": 1059,
+    "刀": 1060,
+    "旋": 1061,
+    "迹": 1062,
+    "卡": 1063,
+    "氯": 1064,
+    "遇": 1065,
+    "份": 1066,
+    "毒": 1067,
+    "泥": 1068,
+    "退": 1069,
+    "洗": 1070,
+    "摆": 1071,
+    "灰": 1072,
+    "彩": 1073,
+    "卖": 1074,
+    "耗": 1075,
+    "夏": 1076,
+    "择": 1077,
+    "忙": 1078,
+    "铜": 1079,
+    "献": 1080,
+    "硬": 1081,
+    "予": 1082,
+    "繁": 1083,
+    "圈": 1084,
+    "雪": 1085,
+    "函": 1086,
+    "亦": 1087,
+    "抽": 1088,
+    "篇": 1089,
+    "阵": 1090,
+    "阴": 1091,
+    "丁": 1092,
+    "尺": 1093,
+    "追": 1094,
+    "堆": 1095,
+    "雄": 1096,
+    "迎": 1097,
+    "泛": 1098,
+    "爸": 1099,
+    "楼": 1100,
+    "避": 1101,
+    "谋": 1102,
+    "吨": 1103,
+    "野": 1104,
+    "猪": 1105,
+    "旗": 1106,
+    "累": 1107,
+    "偏": 1108,
+    "典": 1109,
+    "馆": 1110,
+    "索": 1111,
+    "秦": 1112,
+    "脂": 1113,
+    "潮": 1114,
+    "爷": 1115,
+    "豆": 1116,
+    "忽": 1117,
+    "托": 1118,
+    "惊": 1119,
+    "塑": 1120,
+    "遗": 1121,
+    "愈": 1122,
+    "朱": 1123,
+    "替": 1124,
+    "纤": 1125,
+    "粗": 1126,
+    "倾": 1127,
+    "尚": 1128,
+    "痛": 1129,
+    "楚": 1130,
+    "谢": 1131,
+    "奋": 1132,
+    "购": 1133,
+    "磨": 1134,
+    "君": 1135,
+    "池": 1136,
+    "旁": 1137,
+    "碎": 1138,
+    "骨": 1139,
+    "监": 1140,
+    "捕": 1141,
+    "弟": 1142,
+    "暴": 1143,
+    "割": 1144,
+    "贯": 1145,
+    "殊": 1146,
+    "释": 1147,
+    "词": 1148,
+    "亡": 1149,
+    "壁": 1150,
+    "顿": 1151,
+    "宝": 1152,
+    "午": 1153,
+    "尘": 1154,
+    "闻": 1155,
+    "揭": 1156,
+    "炮": 1157,
+    "残": 1158,
+    "冬": 1159,
+    "桥": 1160,
+    "妇": 1161,
+    "警": 1162,
+    "综": 1163,
+    "招": 1164,
+    "吴": 1165,
+    "付": 1166,
+    "浮": 1167,
+    "遭": 1168,
+    "徐": 1169,
+    "您": 1170,
+    "摇": 1171,
+    "谷": 1172,
+    "赞": 1173,
+    "箱": 1174,
+    "隔": 1175,
+    "订": 1176,
+    "男": 1177,
+    "吹": 1178,
+    "园": 1179,
+    "纷": 1180,
+    "唐": 1181,
+    "败": 1182,
+    "宋": 1183,
+    "玻": 1184,
+    "巨": 1185,
+    "耕": 1186,
+    "坦": 1187,
+    "荣": 1188,
+    "闭": 1189,
+    "湾": 1190,
+    "键": 1191,
+    "凡": 1192,
+    "驻": 1193,
+    "锅": 1194,
+    "救": 1195,
+    "恩": 1196,
+    "剥": 1197,
+    "凝": 1198,
+    "碱": 1199,
+    "齿": 1200,
+    "截": 1201,
+    "炼": 1202,
+    "麻": 1203,
+    "纺": 1204,
+    "禁": 1205,
+    "废": 1206,
+    "盛": 1207,
+    "版": 1208,
+    "缓": 1209,
+    "净": 1210,
+    "睛": 1211,
+    "昌": 1212,
+    "婚": 1213,
+    "涉": 1214,
+    "筒": 1215,
+    "嘴": 1216,
+    "插": 1217,
+    "岸": 1218,
+    "朗": 1219,
+    "庄": 1220,
+    "街": 1221,
+    "藏": 1222,
+    "姑": 1223,
+    "贸": 1224,
+    "腐": 1225,
+    "奴": 1226,
+    "啦": 1227,
+    "惯": 1228,
+    "乘": 1229,
+    "伙": 1230,
+    "恢": 1231,
+    "匀": 1232,
+    "纱": 1233,
+    "扎": 1234,
+    "辩": 1235,
+    "耳": 1236,
+    "彪": 1237,
+    "臣": 1238,
+    "亿": 1239,
+    "璃": 1240,
+    "抵": 1241,
+    "脉": 1242,
+    "秀": 1243,
+    "萨": 1244,
+    "俄": 1245,
+    "网": 1246,
+    "舞": 1247,
+    "店": 1248,
+    "喷": 1249,
+    "纵": 1250,
+    "寸": 1251,
+    "汗": 1252,
+    "挂": 1253,
+    "洪": 1254,
+    "贺": 1255,
+    "闪": 1256,
+    "柬": 1257,
+    "爆": 1258,
+    "烯": 1259,
+    "津": 1260,
+    "稻": 1261,
+    "墙": 1262,
+    "软": 1263,
+    "勇": 1264,
+    "像": 1265,
+    "滚": 1266,
+    "厘": 1267,
+    "蒙": 1268,
+    "芳": 1269,
+    "肯": 1270,
+    "坡": 1271,
+    "柱": 1272,
+    "荡": 1273,
+    "腿": 1274,
+    "仪": 1275,
+    "旅": 1276,
+    "尾": 1277,
+    "轧": 1278,
+    "冰": 1279,
+    "贡": 1280,
+    "登": 1281,
+    "黎": 1282,
+    "削": 1283,
+    "钻": 1284,
+    "勒": 1285,
+    "逃": 1286,
+    "障": 1287,
+    "氨": 1288,
+    "郭": 1289,
+    "峰": 1290,
+    "币": 1291,
+    "港": 1292,
+    "伏": 1293,
+    "轨": 1294,
+    "亩": 1295,
+    "毕": 1296,
+    "擦": 1297,
+    "莫": 1298,
+    "刺": 1299,
+    "浪": 1300,
+    "秘": 1301,
+    "援": 1302,
+    "株": 1303,
+    "健": 1304,
+    "售": 1305,
+    "股": 1306,
+    "岛": 1307,
+    "甘": 1308,
+    "泡": 1309,
+    "睡": 1310,
+    "童": 1311,
+    "铸": 1312,
+    "汤": 1313,
+    "阀": 1314,
+    "休": 1315,
+    "汇": 1316,
+    "舍": 1317,
+    "牧": 1318,
+    "绕": 1319,
+    "炸": 1320,
+    "哲": 1321,
+    "磷": 1322,
+    "绩": 1323,
+    "朋": 1324,
+    "淡": 1325,
+    "尖": 1326,
+    "启": 1327,
+    "陷": 1328,
+    "柴": 1329,
+    "呈": 1330,
+    "徒": 1331,
+    "颜": 1332,
+    "泪": 1333,
+    "稍": 1334,
+    "忘": 1335,
+    "泵": 1336,
+    "蓝": 1337,
+    "拖": 1338,
+    "洞": 1339,
+    "授": 1340,
+    "镜": 1341,
+    "辛": 1342,
+    "壮": 1343,
+    "锋": 1344,
+    "贫": 1345,
+    "虚": 1346,
+    "弯": 1347,
+    "摩": 1348,
+    "泰": 1349,
+    "幼": 1350,
+    "廷": 1351,
+    "尊": 1352,
+    "窗": 1353,
+    "纲": 1354,
+    "弄": 1355,
+    "隶": 1356,
+    "疑": 1357,
+    "氏": 1358,
+    "宫": 1359,
+    "姐": 1360,
+    "震": 1361,
+    "瑞": 1362,
+    "怪": 1363,
+    "尤": 1364,
+    "琴": 1365,
+    "循": 1366,
+    "描": 1367,
+    "膜": 1368,
+    "违": 1369,
+    "夹": 1370,
+    "腰": 1371,
+    "缘": 1372,
+    "珠": 1373,
+    "穷": 1374,
+    "森": 1375,
+    "枝": 1376,
+    "竹": 1377,
+    "沟": 1378,
+    "催": 1379,
+    "绳": 1380,
+    "忆": 1381,
+    "邦": 1382,
+    "剩": 1383,
+    "幸": 1384,
+    "浆": 1385,
+    "栏": 1386,
+    "拥": 1387,
+    "牙": 1388,
+    "贮": 1389,
+    "礼": 1390,
+    "滤": 1391,
+    "钠": 1392,
+    "纹": 1393,
+    "罢": 1394,
+    "拍": 1395,
+    "咱": 1396,
+    "喊": 1397,
+    "袖": 1398,
+    "埃": 1399,
+    "勤": 1400,
+    "罚": 1401,
+    "焦": 1402,
+    "潜": 1403,
+    "伍": 1404,
+    "墨": 1405,
+    "欲": 1406,
+    "缝": 1407,
+    "姓": 1408,
+    "刊": 1409,
+    "饱": 1410,
+    "仿": 1411,
+    "奖": 1412,
+    "铝": 1413,
+    "鬼": 1414,
+    "丽": 1415,
+    "跨": 1416,
+    "默": 1417,
+    "挖": 1418,
+    "链": 1419,
+    "扫": 1420,
+    "喝": 1421,
+    "袋": 1422,
+    "炭": 1423,
+    "污": 1424,
+    "幕": 1425,
+    "诸": 1426,
+    "弧": 1427,
+    "励": 1428,
+    "梅": 1429,
+    "奶": 1430,
+    "洁": 1431,
+    "灾": 1432,
+    "舟": 1433,
+    "鉴": 1434,
+    "苯": 1435,
+    "讼": 1436,
+    "抱": 1437,
+    "毁": 1438,
+    "懂": 1439,
+    "寒": 1440,
+    "智": 1441,
+    "埔": 1442,
+    "寄": 1443,
+    "届": 1444,
+    "跃": 1445,
+    "渡": 1446,
+    "挑": 1447,
+    "丹": 1448,
+    "艰": 1449,
+    "贝": 1450,
+    "碰": 1451,
+    "拔": 1452,
+    "爹": 1453,
+    "戴": 1454,
+    "码": 1455,
+    "梦": 1456,
+    "芽": 1457,
+    "熔": 1458,
+    "赤": 1459,
+    "渔": 1460,
+    "哭": 1461,
+    "敬": 1462,
+    "颗": 1463,
+    "奔": 1464,
+    "铅": 1465,
+    "仲": 1466,
+    "虎": 1467,
+    "稀": 1468,
+    "妹": 1469,
+    "乏": 1470,
+    "珍": 1471,
+    "申": 1472,
+    "桌": 1473,
+    "遵": 1474,
+    "允": 1475,
+    "隆": 1476,
+    "螺": 1477,
+    "仓": 1478,
+    "魏": 1479,
+    "锐": 1480,
+    "晓": 1481,
+    "氮": 1482,
+    "兼": 1483,
+    "隐": 1484,
+    "碍": 1485,
+    "赫": 1486,
+    "拨": 1487,
+    "忠": 1488,
+    "肃": 1489,
+    "缸": 1490,
+    "牵": 1491,
+    "抢": 1492,
+    "博": 1493,
+    "巧": 1494,
+    "壳": 1495,
+    "兄": 1496,
+    "杜": 1497,
+    "讯": 1498,
+    "诚": 1499,
+    "碧": 1500,
+    "祥": 1501,
+    "柯": 1502,
+    "页": 1503,
+    "巡": 1504,
+    "矩": 1505,
+    "悲": 1506,
+    "灌": 1507,
+    "龄": 1508,
+    "伦": 1509,
+    "票": 1510,
+    "寻": 1511,
+    "桂": 1512,
+    "铺": 1513,
+    "圣": 1514,
+    "恐": 1515,
+    "恰": 1516,
+    "郑": 1517,
+    "趣": 1518,
+    "抬": 1519,
+    "荒": 1520,
+    "腾": 1521,
+    "贴": 1522,
+    "柔": 1523,
+    "滴": 1524,
+    "猛": 1525,
+    "阔": 1526,
+    "辆": 1527,
+    "妻": 1528,
+    "填": 1529,
+    "撤": 1530,
+    "储": 1531,
+    "签": 1532,
+    "闹": 1533,
+    "扰": 1534,
+    "紫": 1535,
+    "砂": 1536,
+    "递": 1537,
+    "戏": 1538,
+    "吊": 1539,
+    "陶": 1540,
+    "伐": 1541,
+    "喂": 1542,
+    "疗": 1543,
+    "瓶": 1544,
+    "婆": 1545,
+    "抚": 1546,
+    "臂": 1547,
+    "摸": 1548,
+    "忍": 1549,
+    "虾": 1550,
+    "蜡": 1551,
+    "邻": 1552,
+    "胸": 1553,
+    "巩": 1554,
+    "挤": 1555,
+    "偶": 1556,
+    "弃": 1557,
+    "槽": 1558,
+    "劲": 1559,
+    "乳": 1560,
+    "邓": 1561,
+    "吉": 1562,
+    "仁": 1563,
+    "烂": 1564,
+    "砖": 1565,
+    "租": 1566,
+    "乌": 1567,
+    "舰": 1568,
+    "伴": 1569,
+    "瓜": 1570,
+    "浅": 1571,
+    "丙": 1572,
+    "暂": 1573,
+    "燥": 1574,
+    "橡": 1575,
+    "柳": 1576,
+    "迷": 1577,
+    "暖": 1578,
+    "牌": 1579,
+    "秧": 1580,
+    "胆": 1581,
+    "详": 1582,
+    "簧": 1583,
+    "踏": 1584,
+    "瓷": 1585,
+    "谱": 1586,
+    "呆": 1587,
+    "宾": 1588,
+    "糊": 1589,
+    "洛": 1590,
+    "辉": 1591,
+    "愤": 1592,
+    "竞": 1593,
+    "隙": 1594,
+    "怒": 1595,
+    "粘": 1596,
+    "乃": 1597,
+    "绪": 1598,
+    "肩": 1599,
+    "籍": 1600,
+    "敏": 1601,
+    "涂": 1602,
+    "熙": 1603,
+    "皆": 1604,
+    "侦": 1605,
+    "悬": 1606,
+    "掘": 1607,
+    "享": 1608,
+    "纠": 1609,
+    "醒": 1610,
+    "狂": 1611,
+    "锁": 1612,
+    "淀": 1613,
+    "恨": 1614,
+    "牲": 1615,
+    "霸": 1616,
+    "爬": 1617,
+    "赏": 1618,
+    "逆": 1619,
+    "玩": 1620,
+    "陵": 1621,
+    "祝": 1622,
+    "秒": 1623,
+    "浙": 1624,
+    "貌": 1625,
+    "役": 1626,
+    "彼": 1627,
+    "悉": 1628,
+    "鸭": 1629,
+    "趋": 1630,
+    "凤": 1631,
+    "晨": 1632,
+    "畜": 1633,
+    "辈": 1634,
+    "秩": 1635,
+    "卵": 1636,
+    "署": 1637,
+    "梯": 1638,
+    "炎": 1639,
+    "滩": 1640,
+    "棋": 1641,
+    "驱": 1642,
+    "筛": 1643,
+    "峡": 1644,
+    "冒": 1645,
+    "啥": 1646,
+    "寿": 1647,
+    "译": 1648,
+    "浸": 1649,
+    "泉": 1650,
+    "帽": 1651,
+    "迟": 1652,
+    "硅": 1653,
+    "疆": 1654,
+    "贷": 1655,
+    "漏": 1656,
+    "稿": 1657,
+    "冠": 1658,
+    "嫩": 1659,
+    "胁": 1660,
+    "芯": 1661,
+    "牢": 1662,
+    "叛": 1663,
+    "蚀": 1664,
+    "奥": 1665,
+    "鸣": 1666,
+    "岭": 1667,
+    "羊": 1668,
+    "凭": 1669,
+    "串": 1670,
+    "塘": 1671,
+    "绘": 1672,
+    "酵": 1673,
+    "融": 1674,
+    "盆": 1675,
+    "锡": 1676,
+    "庙": 1677,
+    "筹": 1678,
+    "冻": 1679,
+    "辅": 1680,
+    "摄": 1681,
+    "袭": 1682,
+    "筋": 1683,
+    "拒": 1684,
+    "僚": 1685,
+    "旱": 1686,
+    "钾": 1687,
+    "鸟": 1688,
+    "漆": 1689,
+    "沈": 1690,
+    "眉": 1691,
+    "疏": 1692,
+    "添": 1693,
+    "棒": 1694,
+    "穗": 1695,
+    "硝": 1696,
+    "韩": 1697,
+    "逼": 1698,
+    "扭": 1699,
+    "侨": 1700,
+    "凉": 1701,
+    "挺": 1702,
+    "碗": 1703,
+    "栽": 1704,
+    "炒": 1705,
+    "杯": 1706,
+    "患": 1707,
+    "馏": 1708,
+    "劝": 1709,
+    "豪": 1710,
+    "辽": 1711,
+    "勃": 1712,
+    "鸿": 1713,
+    "旦": 1714,
+    "吏": 1715,
+    "拜": 1716,
+    "狗": 1717,
+    "埋": 1718,
+    "辊": 1719,
+    "掩": 1720,
+    "饮": 1721,
+    "搬": 1722,
+    "骂": 1723,
+    "辞": 1724,
+    "勾": 1725,
+    "扣": 1726,
+    "估": 1727,
+    "蒋": 1728,
+    "绒": 1729,
+    "雾": 1730,
+    "丈": 1731,
+    "朵": 1732,
+    "姆": 1733,
+    "拟": 1734,
+    "宇": 1735,
+    "辑": 1736,
+    "陕": 1737,
+    "雕": 1738,
+    "偿": 1739,
+    "蓄": 1740,
+    "崇": 1741,
+    "剪": 1742,
+    "倡": 1743,
+    "厅": 1744,
+    "咬": 1745,
+    "驶": 1746,
+    "薯": 1747,
+    "刷": 1748,
+    "斥": 1749,
+    "番": 1750,
+    "赋": 1751,
+    "奉": 1752,
+    "佛": 1753,
+    "浇": 1754,
+    "漫": 1755,
+    "曼": 1756,
+    "扇": 1757,
+    "钙": 1758,
+    "桃": 1759,
+    "扶": 1760,
+    "仔": 1761,
+    "返": 1762,
+    "俗": 1763,
+    "亏": 1764,
+    "腔": 1765,
+    "鞋": 1766,
+    "棱": 1767,
+    "覆": 1768,
+    "框": 1769,
+    "悄": 1770,
+    "叔": 1771,
+    "撞": 1772,
+    "骗": 1773,
+    "勘": 1774,
+    "旺": 1775,
+    "沸": 1776,
+    "孤": 1777,
+    "吐": 1778,
+    "孟": 1779,
+    "渠": 1780,
+    "屈": 1781,
+    "疾": 1782,
+    "妙": 1783,
+    "惜": 1784,
+    "仰": 1785,
+    "狠": 1786,
+    "胀": 1787,
+    "谐": 1788,
+    "抛": 1789,
+    "霉": 1790,
+    "桑": 1791,
+    "岗": 1792,
+    "嘛": 1793,
+    "衰": 1794,
+    "盗": 1795,
+    "渗": 1796,
+    "脏": 1797,
+    "赖": 1798,
+    "涌": 1799,
+    "甜": 1800,
+    "曹": 1801,
+    "阅": 1802,
+    "肌": 1803,
+    "哩": 1804,
+    "厉": 1805,
+    "烃": 1806,
+    "纬": 1807,
+    "毅": 1808,
+    "昨": 1809,
+    "伪": 1810,
+    "症": 1811,
+    "煮": 1812,
+    "叹": 1813,
+    "钉": 1814,
+    "搭": 1815,
+    "茎": 1816,
+    "笼": 1817,
+    "酷": 1818,
+    "偷": 1819,
+    "弓": 1820,
+    "锥": 1821,
+    "恒": 1822,
+    "杰": 1823,
+    "坑": 1824,
+    "鼻": 1825,
+    "翼": 1826,
+    "纶": 1827,
+    "叙": 1828,
+    "狱": 1829,
+    "逮": 1830,
+    "罐": 1831,
+    "络": 1832,
+    "棚": 1833,
+    "抑": 1834,
+    "膨": 1835,
+    "蔬": 1836,
+    "寺": 1837,
+    "骤": 1838,
+    "穆": 1839,
+    "冶": 1840,
+    "枯": 1841,
+    "册": 1842,
+    "尸": 1843,
+    "凸": 1844,
+    "绅": 1845,
+    "坯": 1846,
+    "牺": 1847,
+    "焰": 1848,
+    "轰": 1849,
+    "欣": 1850,
+    "晋": 1851,
+    "瘦": 1852,
+    "御": 1853,
+    "锭": 1854,
+    "锦": 1855,
+    "丧": 1856,
+    "旬": 1857,
+    "锻": 1858,
+    "垄": 1859,
+    "搜": 1860,
+    "扑": 1861,
+    "邀": 1862,
+    "亭": 1863,
+    "酯": 1864,
+    "迈": 1865,
+    "舒": 1866,
+    "脆": 1867,
+    "酶": 1868,
+    "闲": 1869,
+    "忧": 1870,
+    "酚": 1871,
+    "顽": 1872,
+    "羽": 1873,
+    "涨": 1874,
+    "卸": 1875,
+    "仗": 1876,
+    "陪": 1877,
+    "辟": 1878,
+    "惩": 1879,
+    "杭": 1880,
+    "姚": 1881,
+    "肚": 1882,
+    "捉": 1883,
+    "飘": 1884,
+    "漂": 1885,
+    "昆": 1886,
+    "欺": 1887,
+    "吾": 1888,
+    "郎": 1889,
+    "烷": 1890,
+    "汁": 1891,
+    "呵": 1892,
+    "饰": 1893,
+    "萧": 1894,
+    "雅": 1895,
+    "邮": 1896,
+    "迁": 1897,
+    "燕": 1898,
+    "撒": 1899,
+    "姻": 1900,
+    "赴": 1901,
+    "宴": 1902,
+    "烦": 1903,
+    "债": 1904,
+    "帐": 1905,
+    "斑": 1906,
+    "铃": 1907,
+    "旨": 1908,
+    "醇": 1909,
+    "董": 1910,
+    "饼": 1911,
+    "雏": 1912,
+    "姿": 1913,
+    "拌": 1914,
+    "傅": 1915,
+    "腹": 1916,
+    "妥": 1917,
+    "揉": 1918,
+    "贤": 1919,
+    "拆": 1920,
+    "歪": 1921,
+    "葡": 1922,
+    "胺": 1923,
+    "丢": 1924,
+    "浩": 1925,
+    "徽": 1926,
+    "昂": 1927,
+    "垫": 1928,
+    "挡": 1929,
+    "览": 1930,
+    "贪": 1931,
+    "慰": 1932,
+    "缴": 1933,
+    "汪": 1934,
+    "慌": 1935,
+    "冯": 1936,
+    "诺": 1937,
+    "姜": 1938,
+    "谊": 1939,
+    "凶": 1940,
+    "劣": 1941,
+    "诬": 1942,
+    "耀": 1943,
+    "昏": 1944,
+    "躺": 1945,
+    "盈": 1946,
+    "骑": 1947,
+    "乔": 1948,
+    "溪": 1949,
+    "丛": 1950,
+    "卢": 1951,
+    "抹": 1952,
+    "闷": 1953,
+    "咨": 1954,
+    "刮": 1955,
+    "驾": 1956,
+    "缆": 1957,
+    "悟": 1958,
+    "摘": 1959,
+    "铒": 1960,
+    "掷": 1961,
+    "颇": 1962,
+    "幻": 1963,
+    "柄": 1964,
+    "惠": 1965,
+    "惨": 1966,
+    "佳": 1967,
+    "仇": 1968,
+    "腊": 1969,
+    "窝": 1970,
+    "涤": 1971,
+    "剑": 1972,
+    "瞧": 1973,
+    "堡": 1974,
+    "泼": 1975,
+    "葱": 1976,
+    "罩": 1977,
+    "霍": 1978,
+    "捞": 1979,
+    "胎": 1980,
+    "苍": 1981,
+    "滨": 1982,
+    "俩": 1983,
+    "捅": 1984,
+    "湘": 1985,
+    "砍": 1986,
+    "霞": 1987,
+    "邵": 1988,
+    "萄": 1989,
+    "疯": 1990,
+    "淮": 1991,
+    "遂": 1992,
+    "熊": 1993,
+    "粪": 1994,
+    "烘": 1995,
+    "宿": 1996,
+    "档": 1997,
+    "戈": 1998,
+    "驳": 1999,
+    "嫂": 2000,
+    "裕": 2001,
+    "徙": 2002,
+    "箭": 2003,
+    "捐": 2004,
+    "肠": 2005,
+    "撑": 2006,
+    "晒": 2007,
+    "辨": 2008,
+    "殿": 2009,
+    "莲": 2010,
+    "摊": 2011,
+    "搅": 2012,
+    "酱": 2013,
+    "屏": 2014,
+    "疫": 2015,
+    "哀": 2016,
+    "蔡": 2017,
+    "堵": 2018,
+    "沫": 2019,
+    "皱": 2020,
+    "畅": 2021,
+    "叠": 2022,
+    "阁": 2023,
+    "莱": 2024,
+    "敲": 2025,
+    "辖": 2026,
+    "钩": 2027,
+    "痕": 2028,
+    "坝": 2029,
+    "巷": 2030,
+    "饿": 2031,
+    "祸": 2032,
+    "丘": 2033,
+    "玄": 2034,
+    "溜": 2035,
+    "曰": 2036,
+    "逻": 2037,
+    "彭": 2038,
+    "尝": 2039,
+    "卿": 2040,
+    "妨": 2041,
+    "艇": 2042,
+    "吞": 2043,
+    "韦": 2044,
+    "怨": 2045,
+    "矮": 2046,
+    "歇": 2047,
+}
 var words_english = [2048]string{
     "abandon",
     "ability",
@@ -4107,6 +8207,12310 @@ var rwords_english = map[string]int16{
     "zone": 2046,
     "zoo": 2047,
 }
+var words_french = [2048]string{
+    "abaisser",
+    "abandon",
+    "abdiquer",
+    "abeille",
+    "abolir",
+    "aborder",
+    "aboutir",
+    "aboyer",
+    "abrasif",
+    "abreuver",
+    "abriter",
+    "abroger",
+    "abrupt",
+    "absence",
+    "absolu",
+    "absurde",
+    "abusif",
+    "abyssal",
+    "académie",
+    "acajou",
+    "acarien",
+    "accabler",
+    "accepter",
+    "acclamer",
+    "accolade",
+    "accroche",
+    "accuser",
+    "acerbe",
+    "achat",
+    "acheter",
+    "aciduler",
+    "acier",
+    "acompte",
+    "acquérir",
+    "acronyme",
+    "acteur",
+    "actif",
+    "actuel",
+    "adepte",
+    "adéquat",
+    "adhésif",
+    "adjectif",
+    "adjuger",
+    "admettre",
+    "admirer",
+    "adopter",
+    "adorer",
+    "adoucir",
+    "adresse",
+    "adroit",
+    "adulte",
+    "adverbe",
+    "aérer",
+    "aéronef",
+    "affaire",
+    "affecter",
+    "affiche",
+    "affreux",
+    "affubler",
+    "agacer",
+    "agencer",
+    "agile",
+    "agiter",
+    "agrafer",
+    "agréable",
+    "agrume",
+    "aider",
+    "aiguille",
+    "ailier",
+    "aimable",
+    "aisance",
+    "ajouter",
+    "ajuster",
+    "alarmer",
+    "alchimie",
+    "alerte",
+    "algèbre",
+    "algue",
+    "aliéner",
+    "aliment",
+    "alléger",
+    "alliage",
+    "allouer",
+    "allumer",
+    "alourdir",
+    "alpaga",
+    "altesse",
+    "alvéole",
+    "amateur",
+    "ambigu",
+    "ambre",
+    "aménager",
+    "amertume",
+    "amidon",
+    "amiral",
+    "amorcer",
+    "amour",
+    "amovible",
+    "amphibie",
+    "ampleur",
+    "amusant",
+    "analyse",
+    "anaphore",
+    "anarchie",
+    "anatomie",
+    "ancien",
+    "anéantir",
+    "angle",
+    "angoisse",
+    "anguleux",
+    "animal",
+    "annexer",
+    "annonce",
+    "annuel",
+    "anodin",
+    "anomalie",
+    "anonyme",
+    "anormal",
+    "antenne",
+    "antidote",
+    "anxieux",
+    "apaiser",
+    "apéritif",
+    "aplanir",
+    "apologie",
+    "appareil",
+    "appeler",
+    "apporter",
+    "appuyer",
+    "aquarium",
+    "aqueduc",
+    "arbitre",
+    "arbuste",
+    "ardeur",
+    "ardoise",
+    "argent",
+    "arlequin",
+    "armature",
+    "armement",
+    "armoire",
+    "armure",
+    "arpenter",
+    "arracher",
+    "arriver",
+    "arroser",
+    "arsenic",
+    "artériel",
+    "article",
+    "aspect",
+    "asphalte",
+    "aspirer",
+    "assaut",
+    "asservir",
+    "assiette",
+    "associer",
+    "assurer",
+    "asticot",
+    "astre",
+    "astuce",
+    "atelier",
+    "atome",
+    "atrium",
+    "atroce",
+    "attaque",
+    "attentif",
+    "attirer",
+    "attraper",
+    "aubaine",
+    "auberge",
+    "audace",
+    "audible",
+    "augurer",
+    "aurore",
+    "automne",
+    "autruche",
+    "avaler",
+    "avancer",
+    "avarice",
+    "avenir",
+    "averse",
+    "aveugle",
+    "aviateur",
+    "avide",
+    "avion",
+    "aviser",
+    "avoine",
+    "avouer",
+    "avril",
+    "axial",
+    "axiome",
+    "badge",
+    "bafouer",
+    "bagage",
+    "baguette",
+    "baignade",
+    "balancer",
+    "balcon",
+    "baleine",
+    "balisage",
+    "bambin",
+    "bancaire",
+    "bandage",
+    "banlieue",
+    "bannière",
+    "banquier",
+    "barbier",
+    "baril",
+    "baron",
+    "barque",
+    "barrage",
+    "bassin",
+    "bastion",
+    "bataille",
+    "bateau",
+    "batterie",
+    "baudrier",
+    "bavarder",
+    "belette",
+    "bélier",
+    "belote",
+    "bénéfice",
+    "berceau",
+    "berger",
+    "berline",
+    "bermuda",
+    "besace",
+    "besogne",
+    "bétail",
+    "beurre",
+    "biberon",
+    "bicycle",
+    "bidule",
+    "bijou",
+    "bilan",
+    "bilingue",
+    "billard",
+    "binaire",
+    "biologie",
+    "biopsie",
+    "biotype",
+    "biscuit",
+    "bison",
+    "bistouri",
+    "bitume",
+    "bizarre",
+    "blafard",
+    "blague",
+    "blanchir",
+    "blessant",
+    "blinder",
+    "blond",
+    "bloquer",
+    "blouson",
+    "bobard",
+    "bobine",
+    "boire",
+    "boiser",
+    "bolide",
+    "bonbon",
+    "bondir",
+    "bonheur",
+    "bonifier",
+    "bonus",
+    "bordure",
+    "borne",
+    "botte",
+    "boucle",
+    "boueux",
+    "bougie",
+    "boulon",
+    "bouquin",
+    "bourse",
+    "boussole",
+    "boutique",
+    "boxeur",
+    "branche",
+    "brasier",
+    "brave",
+    "brebis",
+    "brèche",
+    "breuvage",
+    "bricoler",
+    "brigade",
+    "brillant",
+    "brioche",
+    "brique",
+    "brochure",
+    "broder",
+    "bronzer",
+    "brousse",
+    "broyeur",
+    "brume",
+    "brusque",
+    "brutal",
+    "bruyant",
+    "buffle",
+    "buisson",
+    "bulletin",
+    "bureau",
+    "burin",
+    "bustier",
+    "butiner",
+    "butoir",
+    "buvable",
+    "buvette",
+    "cabanon",
+    "cabine",
+    "cachette",
+    "cadeau",
+    "cadre",
+    "caféine",
+    "caillou",
+    "caisson",
+    "calculer",
+    "calepin",
+    "calibre",
+    "calmer",
+    "calomnie",
+    "calvaire",
+    "camarade",
+    "caméra",
+    "camion",
+    "campagne",
+    "canal",
+    "caneton",
+    "canon",
+    "cantine",
+    "canular",
+    "capable",
+    "caporal",
+    "caprice",
+    "capsule",
+    "capter",
+    "capuche",
+    "carabine",
+    "carbone",
+    "caresser",
+    "caribou",
+    "carnage",
+    "carotte",
+    "carreau",
+    "carton",
+    "cascade",
+    "casier",
+    "casque",
+    "cassure",
+    "causer",
+    "caution",
+    "cavalier",
+    "caverne",
+    "caviar",
+    "cédille",
+    "ceinture",
+    "céleste",
+    "cellule",
+    "cendrier",
+    "censurer",
+    "central",
+    "cercle",
+    "cérébral",
+    "cerise",
+    "cerner",
+    "cerveau",
+    "cesser",
+    "chagrin",
+    "chaise",
+    "chaleur",
+    "chambre",
+    "chance",
+    "chapitre",
+    "charbon",
+    "chasseur",
+    "chaton",
+    "chausson",
+    "chavirer",
+    "chemise",
+    "chenille",
+    "chéquier",
+    "chercher",
+    "cheval",
+    "chien",
+    "chiffre",
+    "chignon",
+    "chimère",
+    "chiot",
+    "chlorure",
+    "chocolat",
+    "choisir",
+    "chose",
+    "chouette",
+    "chrome",
+    "chute",
+    "cigare",
+    "cigogne",
+    "cimenter",
+    "cinéma",
+    "cintrer",
+    "circuler",
+    "cirer",
+    "cirque",
+    "citerne",
+    "citoyen",
+    "citron",
+    "civil",
+    "clairon",
+    "clameur",
+    "claquer",
+    "classe",
+    "clavier",
+    "client",
+    "cligner",
+    "climat",
+    "clivage",
+    "cloche",
+    "clonage",
+    "cloporte",
+    "cobalt",
+    "cobra",
+    "cocasse",
+    "cocotier",
+    "coder",
+    "codifier",
+    "coffre",
+    "cogner",
+    "cohésion",
+    "coiffer",
+    "coincer",
+    "colère",
+    "colibri",
+    "colline",
+    "colmater",
+    "colonel",
+    "combat",
+    "comédie",
+    "commande",
+    "compact",
+    "concert",
+    "conduire",
+    "confier",
+    "congeler",
+    "connoter",
+    "consonne",
+    "contact",
+    "convexe",
+    "copain",
+    "copie",
+    "corail",
+    "corbeau",
+    "cordage",
+    "corniche",
+    "corpus",
+    "correct",
+    "cortège",
+    "cosmique",
+    "costume",
+    "coton",
+    "coude",
+    "coupure",
+    "courage",
+    "couteau",
+    "couvrir",
+    "coyote",
+    "crabe",
+    "crainte",
+    "cravate",
+    "crayon",
+    "créature",
+    "créditer",
+    "crémeux",
+    "creuser",
+    "crevette",
+    "cribler",
+    "crier",
+    "cristal",
+    "critère",
+    "croire",
+    "croquer",
+    "crotale",
+    "crucial",
+    "cruel",
+    "crypter",
+    "cubique",
+    "cueillir",
+    "cuillère",
+    "cuisine",
+    "cuivre",
+    "culminer",
+    "cultiver",
+    "cumuler",
+    "cupide",
+    "curatif",
+    "curseur",
+    "cyanure",
+    "cycle",
+    "cylindre",
+    "cynique",
+    "daigner",
+    "damier",
+    "danger",
+    "danseur",
+    "dauphin",
+    "débattre",
+    "débiter",
+    "déborder",
+    "débrider",
+    "débutant",
+    "décaler",
+    "décembre",
+    "déchirer",
+    "décider",
+    "déclarer",
+    "décorer",
+    "décrire",
+    "décupler",
+    "dédale",
+    "déductif",
+    "déesse",
+    "défensif",
+    "défiler",
+    "défrayer",
+    "dégager",
+    "dégivrer",
+    "déglutir",
+    "dégrafer",
+    "déjeuner",
+    "délice",
+    "déloger",
+    "demander",
+    "demeurer",
+    "démolir",
+    "dénicher",
+    "dénouer",
+    "dentelle",
+    "dénuder",
+    "départ",
+    "dépenser",
+    "déphaser",
+    "déplacer",
+    "déposer",
+    "déranger",
+    "dérober",
+    "désastre",
+    "descente",
+    "désert",
+    "désigner",
+    "désobéir",
+    "dessiner",
+    "destrier",
+    "détacher",
+    "détester",
+    "détourer",
+    "détresse",
+    "devancer",
+    "devenir",
+    "deviner",
+    "devoir",
+    "diable",
+    "dialogue",
+    "diamant",
+    "dicter",
+    "différer",
+    "digérer",
+    "digital",
+    "digne",
+    "diluer",
+    "dimanche",
+    "diminuer",
+    "dioxyde",
+    "directif",
+    "diriger",
+    "discuter",
+    "disposer",
+    "dissiper",
+    "distance",
+    "divertir",
+    "diviser",
+    "docile",
+    "docteur",
+    "dogme",
+    "doigt",
+    "domaine",
+    "domicile",
+    "dompter",
+    "donateur",
+    "donjon",
+    "donner",
+    "dopamine",
+    "dortoir",
+    "dorure",
+    "dosage",
+    "doseur",
+    "dossier",
+    "dotation",
+    "douanier",
+    "double",
+    "douceur",
+    "douter",
+    "doyen",
+    "dragon",
+    "draper",
+    "dresser",
+    "dribbler",
+    "droiture",
+    "duperie",
+    "duplexe",
+    "durable",
+    "durcir",
+    "dynastie",
+    "éblouir",
+    "écarter",
+    "écharpe",
+    "échelle",
+    "éclairer",
+    "éclipse",
+    "éclore",
+    "écluse",
+    "école",
+    "économie",
+    "écorce",
+    "écouter",
+    "écraser",
+    "écrémer",
+    "écrivain",
+    "écrou",
+    "écume",
+    "écureuil",
+    "édifier",
+    "éduquer",
+    "effacer",
+    "effectif",
+    "effigie",
+    "effort",
+    "effrayer",
+    "effusion",
+    "égaliser",
+    "égarer",
+    "éjecter",
+    "élaborer",
+    "élargir",
+    "électron",
+    "élégant",
+    "éléphant",
+    "élève",
+    "éligible",
+    "élitisme",
+    "éloge",
+    "élucider",
+    "éluder",
+    "emballer",
+    "embellir",
+    "embryon",
+    "émeraude",
+    "émission",
+    "emmener",
+    "émotion",
+    "émouvoir",
+    "empereur",
+    "employer",
+    "emporter",
+    "emprise",
+    "émulsion",
+    "encadrer",
+    "enchère",
+    "enclave",
+    "encoche",
+    "endiguer",
+    "endosser",
+    "endroit",
+    "enduire",
+    "énergie",
+    "enfance",
+    "enfermer",
+    "enfouir",
+    "engager",
+    "engin",
+    "englober",
+    "énigme",
+    "enjamber",
+    "enjeu",
+    "enlever",
+    "ennemi",
+    "ennuyeux",
+    "enrichir",
+    "enrobage",
+    "enseigne",
+    "entasser",
+    "entendre",
+    "entier",
+    "entourer",
+    "entraver",
+    "énumérer",
+    "envahir",
+    "enviable",
+    "envoyer",
+    "enzyme",
+    "éolien",
+    "épaissir",
+    "épargne",
+    "épatant",
+    "épaule",
+    "épicerie",
+    "épidémie",
+    "épier",
+    "épilogue",
+    "épine",
+    "épisode",
+    "épitaphe",
+    "époque",
+    "épreuve",
+    "éprouver",
+    "épuisant",
+    "équerre",
+    "équipe",
+    "ériger",
+    "érosion",
+    "erreur",
+    "éruption",
+    "escalier",
+    "espadon",
+    "espèce",
+    "espiègle",
+    "espoir",
+    "esprit",
+    "esquiver",
+    "essayer",
+    "essence",
+    "essieu",
+    "essorer",
+    "estime",
+    "estomac",
+    "estrade",
+    "étagère",
+    "étaler",
+    "étanche",
+    "étatique",
+    "éteindre",
+    "étendoir",
+    "éternel",
+    "éthanol",
+    "éthique",
+    "ethnie",
+    "étirer",
+    "étoffer",
+    "étoile",
+    "étonnant",
+    "étourdir",
+    "étrange",
+    "étroit",
+    "étude",
+    "euphorie",
+    "évaluer",
+    "évasion",
+    "éventail",
+    "évidence",
+    "éviter",
+    "évolutif",
+    "évoquer",
+    "exact",
+    "exagérer",
+    "exaucer",
+    "exceller",
+    "excitant",
+    "exclusif",
+    "excuse",
+    "exécuter",
+    "exemple",
+    "exercer",
+    "exhaler",
+    "exhorter",
+    "exigence",
+    "exiler",
+    "exister",
+    "exotique",
+    "expédier",
+    "explorer",
+    "exposer",
+    "exprimer",
+    "exquis",
+    "extensif",
+    "extraire",
+    "exulter",
+    "fable",
+    "fabuleux",
+    "facette",
+    "facile",
+    "facture",
+    "faiblir",
+    "falaise",
+    "fameux",
+    "famille",
+    "farceur",
+    "farfelu",
+    "farine",
+    "farouche",
+    "fasciner",
+    "fatal",
+    "fatigue",
+    "faucon",
+    "fautif",
+    "faveur",
+    "favori",
+    "fébrile",
+    "féconder",
+    "fédérer",
+    "félin",
+    "femme",
+    "fémur",
+    "fendoir",
+    "féodal",
+    "fermer",
+    "féroce",
+    "ferveur",
+    "festival",
+    "feuille",
+    "feutre",
+    "février",
+    "fiasco",
+    "ficeler",
+    "fictif",
+    "fidèle",
+    "figure",
+    "filature",
+    "filetage",
+    "filière",
+    "filleul",
+    "filmer",
+    "filou",
+    "filtrer",
+    "financer",
+    "finir",
+    "fiole",
+    "firme",
+    "fissure",
+    "fixer",
+    "flairer",
+    "flamme",
+    "flasque",
+    "flatteur",
+    "fléau",
+    "flèche",
+    "fleur",
+    "flexion",
+    "flocon",
+    "flore",
+    "fluctuer",
+    "fluide",
+    "fluvial",
+    "folie",
+    "fonderie",
+    "fongible",
+    "fontaine",
+    "forcer",
+    "forgeron",
+    "formuler",
+    "fortune",
+    "fossile",
+    "foudre",
+    "fougère",
+    "fouiller",
+    "foulure",
+    "fourmi",
+    "fragile",
+    "fraise",
+    "franchir",
+    "frapper",
+    "frayeur",
+    "frégate",
+    "freiner",
+    "frelon",
+    "frémir",
+    "frénésie",
+    "frère",
+    "friable",
+    "friction",
+    "frisson",
+    "frivole",
+    "froid",
+    "fromage",
+    "frontal",
+    "frotter",
+    "fruit",
+    "fugitif",
+    "fuite",
+    "fureur",
+    "furieux",
+    "furtif",
+    "fusion",
+    "futur",
+    "gagner",
+    "galaxie",
+    "galerie",
+    "gambader",
+    "garantir",
+    "gardien",
+    "garnir",
+    "garrigue",
+    "gazelle",
+    "gazon",
+    "géant",
+    "gélatine",
+    "gélule",
+    "gendarme",
+    "général",
+    "génie",
+    "genou",
+    "gentil",
+    "géologie",
+    "géomètre",
+    "géranium",
+    "germe",
+    "gestuel",
+    "geyser",
+    "gibier",
+    "gicler",
+    "girafe",
+    "givre",
+    "glace",
+    "glaive",
+    "glisser",
+    "globe",
+    "gloire",
+    "glorieux",
+    "golfeur",
+    "gomme",
+    "gonfler",
+    "gorge",
+    "gorille",
+    "goudron",
+    "gouffre",
+    "goulot",
+    "goupille",
+    "gourmand",
+    "goutte",
+    "graduel",
+    "graffiti",
+    "graine",
+    "grand",
+    "grappin",
+    "gratuit",
+    "gravir",
+    "grenat",
+    "griffure",
+    "griller",
+    "grimper",
+    "grogner",
+    "gronder",
+    "grotte",
+    "groupe",
+    "gruger",
+    "grutier",
+    "gruyère",
+    "guépard",
+    "guerrier",
+    "guide",
+    "guimauve",
+    "guitare",
+    "gustatif",
+    "gymnaste",
+    "gyrostat",
+    "habitude",
+    "hachoir",
+    "halte",
+    "hameau",
+    "hangar",
+    "hanneton",
+    "haricot",
+    "harmonie",
+    "harpon",
+    "hasard",
+    "hélium",
+    "hématome",
+    "herbe",
+    "hérisson",
+    "hermine",
+    "héron",
+    "hésiter",
+    "heureux",
+    "hiberner",
+    "hibou",
+    "hilarant",
+    "histoire",
+    "hiver",
+    "homard",
+    "hommage",
+    "homogène",
+    "honneur",
+    "honorer",
+    "honteux",
+    "horde",
+    "horizon",
+    "horloge",
+    "hormone",
+    "horrible",
+    "houleux",
+    "housse",
+    "hublot",
+    "huileux",
+    "humain",
+    "humble",
+    "humide",
+    "humour",
+    "hurler",
+    "hydromel",
+    "hygiène",
+    "hymne",
+    "hypnose",
+    "idylle",
+    "ignorer",
+    "iguane",
+    "illicite",
+    "illusion",
+    "image",
+    "imbiber",
+    "imiter",
+    "immense",
+    "immobile",
+    "immuable",
+    "impact",
+    "impérial",
+    "implorer",
+    "imposer",
+    "imprimer",
+    "imputer",
+    "incarner",
+    "incendie",
+    "incident",
+    "incliner",
+    "incolore",
+    "indexer",
+    "indice",
+    "inductif",
+    "inédit",
+    "ineptie",
+    "inexact",
+    "infini",
+    "infliger",
+    "informer",
+    "infusion",
+    "ingérer",
+    "inhaler",
+    "inhiber",
+    "injecter",
+    "injure",
+    "innocent",
+    "inoculer",
+    "inonder",
+    "inscrire",
+    "insecte",
+    "insigne",
+    "insolite",
+    "inspirer",
+    "instinct",
+    "insulter",
+    "intact",
+    "intense",
+    "intime",
+    "intrigue",
+    "intuitif",
+    "inutile",
+    "invasion",
+    "inventer",
+    "inviter",
+    "invoquer",
+    "ironique",
+    "irradier",
+    "irréel",
+    "irriter",
+    "isoler",
+    "ivoire",
+    "ivresse",
+    "jaguar",
+    "jaillir",
+    "jambe",
+    "janvier",
+    "jardin",
+    "jauger",
+    "jaune",
+    "javelot",
+    "jetable",
+    "jeton",
+    "jeudi",
+    "jeunesse",
+    "joindre",
+    "joncher",
+    "jongler",
+    "joueur",
+    "jouissif",
+    "journal",
+    "jovial",
+    "joyau",
+    "joyeux",
+    "jubiler",
+    "jugement",
+    "junior",
+    "jupon",
+    "juriste",
+    "justice",
+    "juteux",
+    "juvénile",
+    "kayak",
+    "kimono",
+    "kiosque",
+    "label",
+    "labial",
+    "labourer",
+    "lacérer",
+    "lactose",
+    "lagune",
+    "laine",
+    "laisser",
+    "laitier",
+    "lambeau",
+    "lamelle",
+    "lampe",
+    "lanceur",
+    "langage",
+    "lanterne",
+    "lapin",
+    "largeur",
+    "larme",
+    "laurier",
+    "lavabo",
+    "lavoir",
+    "lecture",
+    "légal",
+    "léger",
+    "légume",
+    "lessive",
+    "lettre",
+    "levier",
+    "lexique",
+    "lézard",
+    "liasse",
+    "libérer",
+    "libre",
+    "licence",
+    "licorne",
+    "liège",
+    "lièvre",
+    "ligature",
+    "ligoter",
+    "ligue",
+    "limer",
+    "limite",
+    "limonade",
+    "limpide",
+    "linéaire",
+    "lingot",
+    "lionceau",
+    "liquide",
+    "lisière",
+    "lister",
+    "lithium",
+    "litige",
+    "littoral",
+    "livreur",
+    "logique",
+    "lointain",
+    "loisir",
+    "lombric",
+    "loterie",
+    "louer",
+    "lourd",
+    "loutre",
+    "louve",
+    "loyal",
+    "lubie",
+    "lucide",
+    "lucratif",
+    "lueur",
+    "lugubre",
+    "luisant",
+    "lumière",
+    "lunaire",
+    "lundi",
+    "luron",
+    "lutter",
+    "luxueux",
+    "machine",
+    "magasin",
+    "magenta",
+    "magique",
+    "maigre",
+    "maillon",
+    "maintien",
+    "mairie",
+    "maison",
+    "majorer",
+    "malaxer",
+    "maléfice",
+    "malheur",
+    "malice",
+    "mallette",
+    "mammouth",
+    "mandater",
+    "maniable",
+    "manquant",
+    "manteau",
+    "manuel",
+    "marathon",
+    "marbre",
+    "marchand",
+    "mardi",
+    "maritime",
+    "marqueur",
+    "marron",
+    "marteler",
+    "mascotte",
+    "massif",
+    "matériel",
+    "matière",
+    "matraque",
+    "maudire",
+    "maussade",
+    "mauve",
+    "maximal",
+    "méchant",
+    "méconnu",
+    "médaille",
+    "médecin",
+    "méditer",
+    "méduse",
+    "meilleur",
+    "mélange",
+    "mélodie",
+    "membre",
+    "mémoire",
+    "menacer",
+    "mener",
+    "menhir",
+    "mensonge",
+    "mentor",
+    "mercredi",
+    "mérite",
+    "merle",
+    "messager",
+    "mesure",
+    "métal",
+    "météore",
+    "méthode",
+    "métier",
+    "meuble",
+    "miauler",
+    "microbe",
+    "miette",
+    "mignon",
+    "migrer",
+    "milieu",
+    "million",
+    "mimique",
+    "mince",
+    "minéral",
+    "minimal",
+    "minorer",
+    "minute",
+    "miracle",
+    "miroiter",
+    "missile",
+    "mixte",
+    "mobile",
+    "moderne",
+    "moelleux",
+    "mondial",
+    "moniteur",
+    "monnaie",
+    "monotone",
+    "monstre",
+    "montagne",
+    "monument",
+    "moqueur",
+    "morceau",
+    "morsure",
+    "mortier",
+    "moteur",
+    "motif",
+    "mouche",
+    "moufle",
+    "moulin",
+    "mousson",
+    "mouton",
+    "mouvant",
+    "multiple",
+    "munition",
+    "muraille",
+    "murène",
+    "murmure",
+    "muscle",
+    "muséum",
+    "musicien",
+    "mutation",
+    "muter",
+    "mutuel",
+    "myriade",
+    "myrtille",
+    "mystère",
+    "mythique",
+    "nageur",
+    "nappe",
+    "narquois",
+    "narrer",
+    "natation",
+    "nation",
+    "nature",
+    "naufrage",
+    "nautique",
+    "navire",
+    "nébuleux",
+    "nectar",
+    "néfaste",
+    "négation",
+    "négliger",
+    "négocier",
+    "neige",
+    "nerveux",
+    "nettoyer",
+    "neurone",
+    "neutron",
+    "neveu",
+    "niche",
+    "nickel",
+    "nitrate",
+    "niveau",
+    "noble",
+    "nocif",
+    "nocturne",
+    "noirceur",
+    "noisette",
+    "nomade",
+    "nombreux",
+    "nommer",
+    "normatif",
+    "notable",
+    "notifier",
+    "notoire",
+    "nourrir",
+    "nouveau",
+    "novateur",
+    "novembre",
+    "novice",
+    "nuage",
+    "nuancer",
+    "nuire",
+    "nuisible",
+    "numéro",
+    "nuptial",
+    "nuque",
+    "nutritif",
+    "obéir",
+    "objectif",
+    "obliger",
+    "obscur",
+    "observer",
+    "obstacle",
+    "obtenir",
+    "obturer",
+    "occasion",
+    "occuper",
+    "océan",
+    "octobre",
+    "octroyer",
+    "octupler",
+    "oculaire",
+    "odeur",
+    "odorant",
+    "offenser",
+    "officier",
+    "offrir",
+    "ogive",
+    "oiseau",
+    "oisillon",
+    "olfactif",
+    "olivier",
+    "ombrage",
+    "omettre",
+    "onctueux",
+    "onduler",
+    "onéreux",
+    "onirique",
+    "opale",
+    "opaque",
+    "opérer",
+    "opinion",
+    "opportun",
+    "opprimer",
+    "opter",
+    "optique",
+    "orageux",
+    "orange",
+    "orbite",
+    "ordonner",
+    "oreille",
+    "organe",
+    "orgueil",
+    "orifice",
+    "ornement",
+    "orque",
+    "ortie",
+    "osciller",
+    "osmose",
+    "ossature",
+    "otarie",
+    "ouragan",
+    "ourson",
+    "outil",
+    "outrager",
+    "ouvrage",
+    "ovation",
+    "oxyde",
+    "oxygène",
+    "ozone",
+    "paisible",
+    "palace",
+    "palmarès",
+    "palourde",
+    "palper",
+    "panache",
+    "panda",
+    "pangolin",
+    "paniquer",
+    "panneau",
+    "panorama",
+    "pantalon",
+    "papaye",
+    "papier",
+    "papoter",
+    "papyrus",
+    "paradoxe",
+    "parcelle",
+    "paresse",
+    "parfumer",
+    "parler",
+    "parole",
+    "parrain",
+    "parsemer",
+    "partager",
+    "parure",
+    "parvenir",
+    "passion",
+    "pastèque",
+    "paternel",
+    "patience",
+    "patron",
+    "pavillon",
+    "pavoiser",
+    "payer",
+    "paysage",
+    "peigne",
+    "peintre",
+    "pelage",
+    "pélican",
+    "pelle",
+    "pelouse",
+    "peluche",
+    "pendule",
+    "pénétrer",
+    "pénible",
+    "pensif",
+    "pénurie",
+    "pépite",
+    "péplum",
+    "perdrix",
+    "perforer",
+    "période",
+    "permuter",
+    "perplexe",
+    "persil",
+    "perte",
+    "peser",
+    "pétale",
+    "petit",
+    "pétrir",
+    "peuple",
+    "pharaon",
+    "phobie",
+    "phoque",
+    "photon",
+    "phrase",
+    "physique",
+    "piano",
+    "pictural",
+    "pièce",
+    "pierre",
+    "pieuvre",
+    "pilote",
+    "pinceau",
+    "pipette",
+    "piquer",
+    "pirogue",
+    "piscine",
+    "piston",
+    "pivoter",
+    "pixel",
+    "pizza",
+    "placard",
+    "plafond",
+    "plaisir",
+    "planer",
+    "plaque",
+    "plastron",
+    "plateau",
+    "pleurer",
+    "plexus",
+    "pliage",
+    "plomb",
+    "plonger",
+    "pluie",
+    "plumage",
+    "pochette",
+    "poésie",
+    "poète",
+    "pointe",
+    "poirier",
+    "poisson",
+    "poivre",
+    "polaire",
+    "policier",
+    "pollen",
+    "polygone",
+    "pommade",
+    "pompier",
+    "ponctuel",
+    "pondérer",
+    "poney",
+    "portique",
+    "position",
+    "posséder",
+    "posture",
+    "potager",
+    "poteau",
+    "potion",
+    "pouce",
+    "poulain",
+    "poumon",
+    "pourpre",
+    "poussin",
+    "pouvoir",
+    "prairie",
+    "pratique",
+    "précieux",
+    "prédire",
+    "préfixe",
+    "prélude",
+    "prénom",
+    "présence",
+    "prétexte",
+    "prévoir",
+    "primitif",
+    "prince",
+    "prison",
+    "priver",
+    "problème",
+    "procéder",
+    "prodige",
+    "profond",
+    "progrès",
+    "proie",
+    "projeter",
+    "prologue",
+    "promener",
+    "propre",
+    "prospère",
+    "protéger",
+    "prouesse",
+    "proverbe",
+    "prudence",
+    "pruneau",
+    "psychose",
+    "public",
+    "puceron",
+    "puiser",
+    "pulpe",
+    "pulsar",
+    "punaise",
+    "punitif",
+    "pupitre",
+    "purifier",
+    "puzzle",
+    "pyramide",
+    "quasar",
+    "querelle",
+    "question",
+    "quiétude",
+    "quitter",
+    "quotient",
+    "racine",
+    "raconter",
+    "radieux",
+    "ragondin",
+    "raideur",
+    "raisin",
+    "ralentir",
+    "rallonge",
+    "ramasser",
+    "rapide",
+    "rasage",
+    "ratisser",
+    "ravager",
+    "ravin",
+    "rayonner",
+    "réactif",
+    "réagir",
+    "réaliser",
+    "réanimer",
+    "recevoir",
+    "réciter",
+    "réclamer",
+    "récolter",
+    "recruter",
+    "reculer",
+    "recycler",
+    "rédiger",
+    "redouter",
+    "refaire",
+    "réflexe",
+    "réformer",
+    "refrain",
+    "refuge",
+    "régalien",
+    "région",
+    "réglage",
+    "régulier",
+    "réitérer",
+    "rejeter",
+    "rejouer",
+    "relatif",
+    "relever",
+    "relief",
+    "remarque",
+    "remède",
+    "remise",
+    "remonter",
+    "remplir",
+    "remuer",
+    "renard",
+    "renfort",
+    "renifler",
+    "renoncer",
+    "rentrer",
+    "renvoi",
+    "replier",
+    "reporter",
+    "reprise",
+    "reptile",
+    "requin",
+    "réserve",
+    "résineux",
+    "résoudre",
+    "respect",
+    "rester",
+    "résultat",
+    "rétablir",
+    "retenir",
+    "réticule",
+    "retomber",
+    "retracer",
+    "réunion",
+    "réussir",
+    "revanche",
+    "revivre",
+    "révolte",
+    "révulsif",
+    "richesse",
+    "rideau",
+    "rieur",
+    "rigide",
+    "rigoler",
+    "rincer",
+    "riposter",
+    "risible",
+    "risque",
+    "rituel",
+    "rival",
+    "rivière",
+    "rocheux",
+    "romance",
+    "rompre",
+    "ronce",
+    "rondin",
+    "roseau",
+    "rosier",
+    "rotatif",
+    "rotor",
+    "rotule",
+    "rouge",
+    "rouille",
+    "rouleau",
+    "routine",
+    "royaume",
+    "ruban",
+    "rubis",
+    "ruche",
+    "ruelle",
+    "rugueux",
+    "ruiner",
+    "ruisseau",
+    "ruser",
+    "rustique",
+    "rythme",
+    "sabler",
+    "saboter",
+    "sabre",
+    "sacoche",
+    "safari",
+    "sagesse",
+    "saisir",
+    "salade",
+    "salive",
+    "salon",
+    "saluer",
+    "samedi",
+    "sanction",
+    "sanglier",
+    "sarcasme",
+    "sardine",
+    "saturer",
+    "saugrenu",
+    "saumon",
+    "sauter",
+    "sauvage",
+    "savant",
+    "savonner",
+    "scalpel",
+    "scandale",
+    "scélérat",
+    "scénario",
+    "sceptre",
+    "schéma",
+    "science",
+    "scinder",
+    "score",
+    "scrutin",
+    "sculpter",
+    "séance",
+    "sécable",
+    "sécher",
+    "secouer",
+    "sécréter",
+    "sédatif",
+    "séduire",
+    "seigneur",
+    "séjour",
+    "sélectif",
+    "semaine",
+    "sembler",
+    "semence",
+    "séminal",
+    "sénateur",
+    "sensible",
+    "sentence",
+    "séparer",
+    "séquence",
+    "serein",
+    "sergent",
+    "sérieux",
+    "serrure",
+    "sérum",
+    "service",
+    "sésame",
+    "sévir",
+    "sevrage",
+    "sextuple",
+    "sidéral",
+    "siècle",
+    "siéger",
+    "siffler",
+    "sigle",
+    "signal",
+    "silence",
+    "silicium",
+    "simple",
+    "sincère",
+    "sinistre",
+    "siphon",
+    "sirop",
+    "sismique",
+    "situer",
+    "skier",
+    "social",
+    "socle",
+    "sodium",
+    "soigneux",
+    "soldat",
+    "soleil",
+    "solitude",
+    "soluble",
+    "sombre",
+    "sommeil",
+    "somnoler",
+    "sonde",
+    "songeur",
+    "sonnette",
+    "sonore",
+    "sorcier",
+    "sortir",
+    "sosie",
+    "sottise",
+    "soucieux",
+    "soudure",
+    "souffle",
+    "soulever",
+    "soupape",
+    "source",
+    "soutirer",
+    "souvenir",
+    "spacieux",
+    "spatial",
+    "spécial",
+    "sphère",
+    "spiral",
+    "stable",
+    "station",
+    "sternum",
+    "stimulus",
+    "stipuler",
+    "strict",
+    "studieux",
+    "stupeur",
+    "styliste",
+    "sublime",
+    "substrat",
+    "subtil",
+    "subvenir",
+    "succès",
+    "sucre",
+    "suffixe",
+    "suggérer",
+    "suiveur",
+    "sulfate",
+    "superbe",
+    "supplier",
+    "surface",
+    "suricate",
+    "surmener",
+    "surprise",
+    "sursaut",
+    "survie",
+    "suspect",
+    "syllabe",
+    "symbole",
+    "symétrie",
+    "synapse",
+    "syntaxe",
+    "système",
+    "tabac",
+    "tablier",
+    "tactile",
+    "tailler",
+    "talent",
+    "talisman",
+    "talonner",
+    "tambour",
+    "tamiser",
+    "tangible",
+    "tapis",
+    "taquiner",
+    "tarder",
+    "tarif",
+    "tartine",
+    "tasse",
+    "tatami",
+    "tatouage",
+    "taupe",
+    "taureau",
+    "taxer",
+    "témoin",
+    "temporel",
+    "tenaille",
+    "tendre",
+    "teneur",
+    "tenir",
+    "tension",
+    "terminer",
+    "terne",
+    "terrible",
+    "tétine",
+    "texte",
+    "thème",
+    "théorie",
+    "thérapie",
+    "thorax",
+    "tibia",
+    "tiède",
+    "timide",
+    "tirelire",
+    "tiroir",
+    "tissu",
+    "titane",
+    "titre",
+    "tituber",
+    "toboggan",
+    "tolérant",
+    "tomate",
+    "tonique",
+    "tonneau",
+    "toponyme",
+    "torche",
+    "tordre",
+    "tornade",
+    "torpille",
+    "torrent",
+    "torse",
+    "tortue",
+    "totem",
+    "toucher",
+    "tournage",
+    "tousser",
+    "toxine",
+    "traction",
+    "trafic",
+    "tragique",
+    "trahir",
+    "train",
+    "trancher",
+    "travail",
+    "trèfle",
+    "tremper",
+    "trésor",
+    "treuil",
+    "triage",
+    "tribunal",
+    "tricoter",
+    "trilogie",
+    "triomphe",
+    "tripler",
+    "triturer",
+    "trivial",
+    "trombone",
+    "tronc",
+    "tropical",
+    "troupeau",
+    "tuile",
+    "tulipe",
+    "tumulte",
+    "tunnel",
+    "turbine",
+    "tuteur",
+    "tutoyer",
+    "tuyau",
+    "tympan",
+    "typhon",
+    "typique",
+    "tyran",
+    "ubuesque",
+    "ultime",
+    "ultrason",
+    "unanime",
+    "unifier",
+    "union",
+    "unique",
+    "unitaire",
+    "univers",
+    "uranium",
+    "urbain",
+    "urticant",
+    "usage",
+    "usine",
+    "usuel",
+    "usure",
+    "utile",
+    "utopie",
+    "vacarme",
+    "vaccin",
+    "vagabond",
+    "vague",
+    "vaillant",
+    "vaincre",
+    "vaisseau",
+    "valable",
+    "valise",
+    "vallon",
+    "valve",
+    "vampire",
+    "vanille",
+    "vapeur",
+    "varier",
+    "vaseux",
+    "vassal",
+    "vaste",
+    "vecteur",
+    "vedette",
+    "végétal",
+    "véhicule",
+    "veinard",
+    "véloce",
+    "vendredi",
+    "vénérer",
+    "venger",
+    "venimeux",
+    "ventouse",
+    "verdure",
+    "vérin",
+    "vernir",
+    "verrou",
+    "verser",
+    "vertu",
+    "veston",
+    "vétéran",
+    "vétuste",
+    "vexant",
+    "vexer",
+    "viaduc",
+    "viande",
+    "victoire",
+    "vidange",
+    "vidéo",
+    "vignette",
+    "vigueur",
+    "vilain",
+    "village",
+    "vinaigre",
+    "violon",
+    "vipère",
+    "virement",
+    "virtuose",
+    "virus",
+    "visage",
+    "viseur",
+    "vision",
+    "visqueux",
+    "visuel",
+    "vital",
+    "vitesse",
+    "viticole",
+    "vitrine",
+    "vivace",
+    "vivipare",
+    "vocation",
+    "voguer",
+    "voile",
+    "voisin",
+    "voiture",
+    "volaille",
+    "volcan",
+    "voltiger",
+    "volume",
+    "vorace",
+    "vortex",
+    "voter",
+    "vouloir",
+    "voyage",
+    "voyelle",
+    "wagon",
+    "xénon",
+    "yacht",
+    "zèbre",
+    "zénith",
+    "zeste",
+    "zoologie",
+}
+var rwords_french = map[string]int16{
+    "abaisser": 0,
+    "abandon": 1,
+    "abdiquer": 2,
+    "abeille": 3,
+    "abolir": 4,
+    "aborder": 5,
+    "aboutir": 6,
+    "aboyer": 7,
+    "abrasif": 8,
+    "abreuver": 9,
+    "abriter": 10,
+    "abroger": 11,
+    "abrupt": 12,
+    "absence": 13,
+    "absolu": 14,
+    "absurde": 15,
+    "abusif": 16,
+    "abyssal": 17,
+    "académie": 18,
+    "acajou": 19,
+    "acarien": 20,
+    "accabler": 21,
+    "accepter": 22,
+    "acclamer": 23,
+    "accolade": 24,
+    "accroche": 25,
+    "accuser": 26,
+    "acerbe": 27,
+    "achat": 28,
+    "acheter": 29,
+    "aciduler": 30,
+    "acier": 31,
+    "acompte": 32,
+    "acquérir": 33,
+    "acronyme": 34,
+    "acteur": 35,
+    "actif": 36,
+    "actuel": 37,
+    "adepte": 38,
+    "adéquat": 39,
+    "adhésif": 40,
+    "adjectif": 41,
+    "adjuger": 42,
+    "admettre": 43,
+    "admirer": 44,
+    "adopter": 45,
+    "adorer": 46,
+    "adoucir": 47,
+    "adresse": 48,
+    "adroit": 49,
+    "adulte": 50,
+    "adverbe": 51,
+    "aérer": 52,
+    "aéronef": 53,
+    "affaire": 54,
+    "affecter": 55,
+    "affiche": 56,
+    "affreux": 57,
+    "affubler": 58,
+    "agacer": 59,
+    "agencer": 60,
+    "agile": 61,
+    "agiter": 62,
+    "agrafer": 63,
+    "agréable": 64,
+    "agrume": 65,
+    "aider": 66,
+    "aiguille": 67,
+    "ailier": 68,
+    "aimable": 69,
+    "aisance": 70,
+    "ajouter": 71,
+    "ajuster": 72,
+    "alarmer": 73,
+    "alchimie": 74,
+    "alerte": 75,
+    "algèbre": 76,
+    "algue": 77,
+    "aliéner": 78,
+    "aliment": 79,
+    "alléger": 80,
+    "alliage": 81,
+    "allouer": 82,
+    "allumer": 83,
+    "alourdir": 84,
+    "alpaga": 85,
+    "altesse": 86,
+    "alvéole": 87,
+    "amateur": 88,
+    "ambigu": 89,
+    "ambre": 90,
+    "aménager": 91,
+    "amertume": 92,
+    "amidon": 93,
+    "amiral": 94,
+    "amorcer": 95,
+    "amour": 96,
+    "amovible": 97,
+    "amphibie": 98,
+    "ampleur": 99,
+    "amusant": 100,
+    "analyse": 101,
+    "anaphore": 102,
+    "anarchie": 103,
+    "anatomie": 104,
+    "ancien": 105,
+    "anéantir": 106,
+    "angle": 107,
+    "angoisse": 108,
+    "anguleux": 109,
+    "animal": 110,
+    "annexer": 111,
+    "annonce": 112,
+    "annuel": 113,
+    "anodin": 114,
+    "anomalie": 115,
+    "anonyme": 116,
+    "anormal": 117,
+    "antenne": 118,
+    "antidote": 119,
+    "anxieux": 120,
+    "apaiser": 121,
+    "apéritif": 122,
+    "aplanir": 123,
+    "apologie": 124,
+    "appareil": 125,
+    "appeler": 126,
+    "apporter": 127,
+    "appuyer": 128,
+    "aquarium": 129,
+    "aqueduc": 130,
+    "arbitre": 131,
+    "arbuste": 132,
+    "ardeur": 133,
+    "ardoise": 134,
+    "argent": 135,
+    "arlequin": 136,
+    "armature": 137,
+    "armement": 138,
+    "armoire": 139,
+    "armure": 140,
+    "arpenter": 141,
+    "arracher": 142,
+    "arriver": 143,
+    "arroser": 144,
+    "arsenic": 145,
+    "artériel": 146,
+    "article": 147,
+    "aspect": 148,
+    "asphalte": 149,
+    "aspirer": 150,
+    "assaut": 151,
+    "asservir": 152,
+    "assiette": 153,
+    "associer": 154,
+    "assurer": 155,
+    "asticot": 156,
+    "astre": 157,
+    "astuce": 158,
+    "atelier": 159,
+    "atome": 160,
+    "atrium": 161,
+    "atroce": 162,
+    "attaque": 163,
+    "attentif": 164,
+    "attirer": 165,
+    "attraper": 166,
+    "aubaine": 167,
+    "auberge": 168,
+    "audace": 169,
+    "audible": 170,
+    "augurer": 171,
+    "aurore": 172,
+    "automne": 173,
+    "autruche": 174,
+    "avaler": 175,
+    "avancer": 176,
+    "avarice": 177,
+    "avenir": 178,
+    "averse": 179,
+    "aveugle": 180,
+    "aviateur": 181,
+    "avide": 182,
+    "avion": 183,
+    "aviser": 184,
+    "avoine": 185,
+    "avouer": 186,
+    "avril": 187,
+    "axial": 188,
+    "axiome": 189,
+    "badge": 190,
+    "bafouer": 191,
+    "bagage": 192,
+    "baguette": 193,
+    "baignade": 194,
+    "balancer": 195,
+    "balcon": 196,
+    "baleine": 197,
+    "balisage": 198,
+    "bambin": 199,
+    "bancaire": 200,
+    "bandage": 201,
+    "banlieue": 202,
+    "bannière": 203,
+    "banquier": 204,
+    "barbier": 205,
+    "baril": 206,
+    "baron": 207,
+    "barque": 208,
+    "barrage": 209,
+    "bassin": 210,
+    "bastion": 211,
+    "bataille": 212,
+    "bateau": 213,
+    "batterie": 214,
+    "baudrier": 215,
+    "bavarder": 216,
+    "belette": 217,
+    "bélier": 218,
+    "belote": 219,
+    "bénéfice": 220,
+    "berceau": 221,
+    "berger": 222,
+    "berline": 223,
+    "bermuda": 224,
+    "besace": 225,
+    "besogne": 226,
+    "bétail": 227,
+    "beurre": 228,
+    "biberon": 229,
+    "bicycle": 230,
+    "bidule": 231,
+    "bijou": 232,
+    "bilan": 233,
+    "bilingue": 234,
+    "billard": 235,
+    "binaire": 236,
+    "biologie": 237,
+    "biopsie": 238,
+    "biotype": 239,
+    "biscuit": 240,
+    "bison": 241,
+    "bistouri": 242,
+    "bitume": 243,
+    "bizarre": 244,
+    "blafard": 245,
+    "blague": 246,
+    "blanchir": 247,
+    "blessant": 248,
+    "blinder": 249,
+    "blond": 250,
+    "bloquer": 251,
+    "blouson": 252,
+    "bobard": 253,
+    "bobine": 254,
+    "boire": 255,
+    "boiser": 256,
+    "bolide": 257,
+    "bonbon": 258,
+    "bondir": 259,
+    "bonheur": 260,
+    "bonifier": 261,
+    "bonus": 262,
+    "bordure": 263,
+    "borne": 264,
+    "botte": 265,
+    "boucle": 266,
+    "boueux": 267,
+    "bougie": 268,
+    "boulon": 269,
+    "bouquin": 270,
+    "bourse": 271,
+    "boussole": 272,
+    "boutique": 273,
+    "boxeur": 274,
+    "branche": 275,
+    "brasier": 276,
+    "brave": 277,
+    "brebis": 278,
+    "brèche": 279,
+    "breuvage": 280,
+    "bricoler": 281,
+    "brigade": 282,
+    "brillant": 283,
+    "brioche": 284,
+    "brique": 285,
+    "brochure": 286,
+    "broder": 287,
+    "bronzer": 288,
+    "brousse": 289,
+    "broyeur": 290,
+    "brume": 291,
+    "brusque": 292,
+    "brutal": 293,
+    "bruyant": 294,
+    "buffle": 295,
+    "buisson": 296,
+    "bulletin": 297,
+    "bureau": 298,
+    "burin": 299,
+    "bustier": 300,
+    "butiner": 301,
+    "butoir": 302,
+    "buvable": 303,
+    "buvette": 304,
+    "cabanon": 305,
+    "cabine": 306,
+    "cachette": 307,
+    "cadeau": 308,
+    "cadre": 309,
+    "caféine": 310,
+    "caillou": 311,
+    "caisson": 312,
+    "calculer": 313,
+    "calepin": 314,
+    "calibre": 315,
+    "calmer": 316,
+    "calomnie": 317,
+    "calvaire": 318,
+    "camarade": 319,
+    "caméra": 320,
+    "camion": 321,
+    "campagne": 322,
+    "canal": 323,
+    "caneton": 324,
+    "canon": 325,
+    "cantine": 326,
+    "canular": 327,
+    "capable": 328,
+    "caporal": 329,
+    "caprice": 330,
+    "capsule": 331,
+    "capter": 332,
+    "capuche": 333,
+    "carabine": 334,
+    "carbone": 335,
+    "caresser": 336,
+    "caribou": 337,
+    "carnage": 338,
+    "carotte": 339,
+    "carreau": 340,
+    "carton": 341,
+    "cascade": 342,
+    "casier": 343,
+    "casque": 344,
+    "cassure": 345,
+    "causer": 346,
+    "caution": 347,
+    "cavalier": 348,
+    "caverne": 349,
+    "caviar": 350,
+    "cédille": 351,
+    "ceinture": 352,
+    "céleste": 353,
+    "cellule": 354,
+    "cendrier": 355,
+    "censurer": 356,
+    "central": 357,
+    "cercle": 358,
+    "cérébral": 359,
+    "cerise": 360,
+    "cerner": 361,
+    "cerveau": 362,
+    "cesser": 363,
+    "chagrin": 364,
+    "chaise": 365,
+    "chaleur": 366,
+    "chambre": 367,
+    "chance": 368,
+    "chapitre": 369,
+    "charbon": 370,
+    "chasseur": 371,
+    "chaton": 372,
+    "chausson": 373,
+    "chavirer": 374,
+    "chemise": 375,
+    "chenille": 376,
+    "chéquier": 377,
+    "chercher": 378,
+    "cheval": 379,
+    "chien": 380,
+    "chiffre": 381,
+    "chignon": 382,
+    "chimère": 383,
+    "chiot": 384,
+    "chlorure": 385,
+    "chocolat": 386,
+    "choisir": 387,
+    "chose": 388,
+    "chouette": 389,
+    "chrome": 390,
+    "chute": 391,
+    "cigare": 392,
+    "cigogne": 393,
+    "cimenter": 394,
+    "cinéma": 395,
+    "cintrer": 396,
+    "circuler": 397,
+    "cirer": 398,
+    "cirque": 399,
+    "citerne": 400,
+    "citoyen": 401,
+    "citron": 402,
+    "civil": 403,
+    "clairon": 404,
+    "clameur": 405,
+    "claquer": 406,
+    "classe": 407,
+    "clavier": 408,
+    "client": 409,
+    "cligner": 410,
+    "climat": 411,
+    "clivage": 412,
+    "cloche": 413,
+    "clonage": 414,
+    "cloporte": 415,
+    "cobalt": 416,
+    "cobra": 417,
+    "cocasse": 418,
+    "cocotier": 419,
+    "coder": 420,
+    "codifier": 421,
+    "coffre": 422,
+    "cogner": 423,
+    "cohésion": 424,
+    "coiffer": 425,
+    "coincer": 426,
+    "colère": 427,
+    "colibri": 428,
+    "colline": 429,
+    "colmater": 430,
+    "colonel": 431,
+    "combat": 432,
+    "comédie": 433,
+    "commande": 434,
+    "compact": 435,
+    "concert": 436,
+    "conduire": 437,
+    "confier": 438,
+    "congeler": 439,
+    "connoter": 440,
+    "consonne": 441,
+    "contact": 442,
+    "convexe": 443,
+    "copain": 444,
+    "copie": 445,
+    "corail": 446,
+    "corbeau": 447,
+    "cordage": 448,
+    "corniche": 449,
+    "corpus": 450,
+    "correct": 451,
+    "cortège": 452,
+    "cosmique": 453,
+    "costume": 454,
+    "coton": 455,
+    "coude": 456,
+    "coupure": 457,
+    "courage": 458,
+    "couteau": 459,
+    "couvrir": 460,
+    "coyote": 461,
+    "crabe": 462,
+    "crainte": 463,
+    "cravate": 464,
+    "crayon": 465,
+    "créature": 466,
+    "créditer": 467,
+    "crémeux": 468,
+    "creuser": 469,
+    "crevette": 470,
+    "cribler": 471,
+    "crier": 472,
+    "cristal": 473,
+    "critère": 474,
+    "croire": 475,
+    "croquer": 476,
+    "crotale": 477,
+    "crucial": 478,
+    "cruel": 479,
+    "crypter": 480,
+    "cubique": 481,
+    "cueillir": 482,
+    "cuillère": 483,
+    "cuisine": 484,
+    "cuivre": 485,
+    "culminer": 486,
+    "cultiver": 487,
+    "cumuler": 488,
+    "cupide": 489,
+    "curatif": 490,
+    "curseur": 491,
+    "cyanure": 492,
+    "cycle": 493,
+    "cylindre": 494,
+    "cynique": 495,
+    "daigner": 496,
+    "damier": 497,
+    "danger": 498,
+    "danseur": 499,
+    "dauphin": 500,
+    "débattre": 501,
+    "débiter": 502,
+    "déborder": 503,
+    "débrider": 504,
+    "débutant": 505,
+    "décaler": 506,
+    "décembre": 507,
+    "déchirer": 508,
+    "décider": 509,
+    "déclarer": 510,
+    "décorer": 511,
+    "décrire": 512,
+    "décupler": 513,
+    "dédale": 514,
+    "déductif": 515,
+    "déesse": 516,
+    "défensif": 517,
+    "défiler": 518,
+    "défrayer": 519,
+    "dégager": 520,
+    "dégivrer": 521,
+    "déglutir": 522,
+    "dégrafer": 523,
+    "déjeuner": 524,
+    "délice": 525,
+    "déloger": 526,
+    "demander": 527,
+    "demeurer": 528,
+    "démolir": 529,
+    "dénicher": 530,
+    "dénouer": 531,
+    "dentelle": 532,
+    "dénuder": 533,
+    "départ": 534,
+    "dépenser": 535,
+    "déphaser": 536,
+    "déplacer": 537,
+    "déposer": 538,
+    "déranger": 539,
+    "dérober": 540,
+    "désastre": 541,
+    "descente": 542,
+    "désert": 543,
+    "désigner": 544,
+    "désobéir": 545,
+    "dessiner": 546,
+    "destrier": 547,
+    "détacher": 548,
+    "détester": 549,
+    "détourer": 550,
+    "détresse": 551,
+    "devancer": 552,
+    "devenir": 553,
+    "deviner": 554,
+    "devoir": 555,
+    "diable": 556,
+    "dialogue": 557,
+    "diamant": 558,
+    "dicter": 559,
+    "différer": 560,
+    "digérer": 561,
+    "digital": 562,
+    "digne": 563,
+    "diluer": 564,
+    "dimanche": 565,
+    "diminuer": 566,
+    "dioxyde": 567,
+    "directif": 568,
+    "diriger": 569,
+    "discuter": 570,
+    "disposer": 571,
+    "dissiper": 572,
+    "distance": 573,
+    "divertir": 574,
+    "diviser": 575,
+    "docile": 576,
+    "docteur": 577,
+    "dogme": 578,
+    "doigt": 579,
+    "domaine": 580,
+    "domicile": 581,
+    "dompter": 582,
+    "donateur": 583,
+    "donjon": 584,
+    "donner": 585,
+    "dopamine": 586,
+    "dortoir": 587,
+    "dorure": 588,
+    "dosage": 589,
+    "doseur": 590,
+    "dossier": 591,
+    "dotation": 592,
+    "douanier": 593,
+    "double": 594,
+    "douceur": 595,
+    "douter": 596,
+    "doyen": 597,
+    "dragon": 598,
+    "draper": 599,
+    "dresser": 600,
+    "dribbler": 601,
+    "droiture": 602,
+    "duperie": 603,
+    "duplexe": 604,
+    "durable": 605,
+    "durcir": 606,
+    "dynastie": 607,
+    "éblouir": 608,
+    "écarter": 609,
+    "écharpe": 610,
+    "échelle": 611,
+    "éclairer": 612,
+    "éclipse": 613,
+    "éclore": 614,
+    "écluse": 615,
+    "école": 616,
+    "économie": 617,
+    "écorce": 618,
+    "écouter": 619,
+    "écraser": 620,
+    "écrémer": 621,
+    "écrivain": 622,
+    "écrou": 623,
+    "écume": 624,
+    "écureuil": 625,
+    "édifier": 626,
+    "éduquer": 627,
+    "effacer": 628,
+    "effectif": 629,
+    "effigie": 630,
+    "effort": 631,
+    "effrayer": 632,
+    "effusion": 633,
+    "égaliser": 634,
+    "égarer": 635,
+    "éjecter": 636,
+    "élaborer": 637,
+    "élargir": 638,
+    "électron": 639,
+    "élégant": 640,
+    "éléphant": 641,
+    "élève": 642,
+    "éligible": 643,
+    "élitisme": 644,
+    "éloge": 645,
+    "élucider": 646,
+    "éluder": 647,
+    "emballer": 648,
+    "embellir": 649,
+    "embryon": 650,
+    "émeraude": 651,
+    "émission": 652,
+    "emmener": 653,
+    "émotion": 654,
+    "émouvoir": 655,
+    "empereur": 656,
+    "employer": 657,
+    "emporter": 658,
+    "emprise": 659,
+    "émulsion": 660,
+    "encadrer": 661,
+    "enchère": 662,
+    "enclave": 663,
+    "encoche": 664,
+    "endiguer": 665,
+    "endosser": 666,
+    "endroit": 667,
+    "enduire": 668,
+    "énergie": 669,
+    "enfance": 670,
+    "enfermer": 671,
+    "enfouir": 672,
+    "engager": 673,
+    "engin": 674,
+    "englober": 675,
+    "énigme": 676,
+    "enjamber": 677,
+    "enjeu": 678,
+    "enlever": 679,
+    "ennemi": 680,
+    "ennuyeux": 681,
+    "enrichir": 682,
+    "enrobage": 683,
+    "enseigne": 684,
+    "entasser": 685,
+    "entendre": 686,
+    "entier": 687,
+    "entourer": 688,
+    "entraver": 689,
+    "énumérer": 690,
+    "envahir": 691,
+    "enviable": 692,
+    "envoyer": 693,
+    "enzyme": 694,
+    "éolien": 695,
+    "épaissir": 696,
+    "épargne": 697,
+    "épatant": 698,
+    "épaule": 699,
+    "épicerie": 700,
+    "épidémie": 701,
+    "épier": 702,
+    "épilogue": 703,
+    "épine": 704,
+    "épisode": 705,
+    "épitaphe": 706,
+    "époque": 707,
+    "épreuve": 708,
+    "éprouver": 709,
+    "épuisant": 710,
+    "équerre": 711,
+    "équipe": 712,
+    "ériger": 713,
+    "érosion": 714,
+    "erreur": 715,
+    "éruption": 716,
+    "escalier": 717,
+    "espadon": 718,
+    "espèce": 719,
+    "espiègle": 720,
+    "espoir": 721,
+    "esprit": 722,
+    "esquiver": 723,
+    "essayer": 724,
+    "essence": 725,
+    "essieu": 726,
+    "essorer": 727,
+    "estime": 728,
+    "estomac": 729,
+    "estrade": 730,
+    "étagère": 731,
+    "étaler": 732,
+    "étanche": 733,
+    "étatique": 734,
+    "éteindre": 735,
+    "étendoir": 736,
+    "éternel": 737,
+    "éthanol": 738,
+    "éthique": 739,
+    "ethnie": 740,
+    "étirer": 741,
+    "étoffer": 742,
+    "étoile": 743,
+    "étonnant": 744,
+    "étourdir": 745,
+    "étrange": 746,
+    "étroit": 747,
+    "étude": 748,
+    "euphorie": 749,
+    "évaluer": 750,
+    "évasion": 751,
+    "éventail": 752,
+    "évidence": 753,
+    "éviter": 754,
+    "évolutif": 755,
+    "évoquer": 756,
+    "exact": 757,
+    "exagérer": 758,
+    "exaucer": 759,
+    "exceller": 760,
+    "excitant": 761,
+    "exclusif": 762,
+    "excuse": 763,
+    "exécuter": 764,
+    "exemple": 765,
+    "exercer": 766,
+    "exhaler": 767,
+    "exhorter": 768,
+    "exigence": 769,
+    "exiler": 770,
+    "exister": 771,
+    "exotique": 772,
+    "expédier": 773,
+    "explorer": 774,
+    "exposer": 775,
+    "exprimer": 776,
+    "exquis": 777,
+    "extensif": 778,
+    "extraire": 779,
+    "exulter": 780,
+    "fable": 781,
+    "fabuleux": 782,
+    "facette": 783,
+    "facile": 784,
+    "facture": 785,
+    "faiblir": 786,
+    "falaise": 787,
+    "fameux": 788,
+    "famille": 789,
+    "farceur": 790,
+    "farfelu": 791,
+    "farine": 792,
+    "farouche": 793,
+    "fasciner": 794,
+    "fatal": 795,
+    "fatigue": 796,
+    "faucon": 797,
+    "fautif": 798,
+    "faveur": 799,
+    "favori": 800,
+    "fébrile": 801,
+    "féconder": 802,
+    "fédérer": 803,
+    "félin": 804,
+    "femme": 805,
+    "fémur": 806,
+    "fendoir": 807,
+    "féodal": 808,
+    "fermer": 809,
+    "féroce": 810,
+    "ferveur": 811,
+    "festival": 812,
+    "feuille": 813,
+    "feutre": 814,
+    "février": 815,
+    "fiasco": 816,
+    "ficeler": 817,
+    "fictif": 818,
+    "fidèle": 819,
+    "figure": 820,
+    "filature": 821,
+    "filetage": 822,
+    "filière": 823,
+    "filleul": 824,
+    "filmer": 825,
+    "filou": 826,
+    "filtrer": 827,
+    "financer": 828,
+    "finir": 829,
+    "fiole": 830,
+    "firme": 831,
+    "fissure": 832,
+    "fixer": 833,
+    "flairer": 834,
+    "flamme": 835,
+    "flasque": 836,
+    "flatteur": 837,
+    "fléau": 838,
+    "flèche": 839,
+    "fleur": 840,
+    "flexion": 841,
+    "flocon": 842,
+    "flore": 843,
+    "fluctuer": 844,
+    "fluide": 845,
+    "fluvial": 846,
+    "folie": 847,
+    "fonderie": 848,
+    "fongible": 849,
+    "fontaine": 850,
+    "forcer": 851,
+    "forgeron": 852,
+    "formuler": 853,
+    "fortune": 854,
+    "fossile": 855,
+    "foudre": 856,
+    "fougère": 857,
+    "fouiller": 858,
+    "foulure": 859,
+    "fourmi": 860,
+    "fragile": 861,
+    "fraise": 862,
+    "franchir": 863,
+    "frapper": 864,
+    "frayeur": 865,
+    "frégate": 866,
+    "freiner": 867,
+    "frelon": 868,
+    "frémir": 869,
+    "frénésie": 870,
+    "frère": 871,
+    "friable": 872,
+    "friction": 873,
+    "frisson": 874,
+    "frivole": 875,
+    "froid": 876,
+    "fromage": 877,
+    "frontal": 878,
+    "frotter": 879,
+    "fruit": 880,
+    "fugitif": 881,
+    "fuite": 882,
+    "fureur": 883,
+    "furieux": 884,
+    "furtif": 885,
+    "fusion": 886,
+    "futur": 887,
+    "gagner": 888,
+    "galaxie": 889,
+    "galerie": 890,
+    "gambader": 891,
+    "garantir": 892,
+    "gardien": 893,
+    "garnir": 894,
+    "garrigue": 895,
+    "gazelle": 896,
+    "gazon": 897,
+    "géant": 898,
+    "gélatine": 899,
+    "gélule": 900,
+    "gendarme": 901,
+    "général": 902,
+    "génie": 903,
+    "genou": 904,
+    "gentil": 905,
+    "géologie": 906,
+    "géomètre": 907,
+    "géranium": 908,
+    "germe": 909,
+    "gestuel": 910,
+    "geyser": 911,
+    "gibier": 912,
+    "gicler": 913,
+    "girafe": 914,
+    "givre": 915,
+    "glace": 916,
+    "glaive": 917,
+    "glisser": 918,
+    "globe": 919,
+    "gloire": 920,
+    "glorieux": 921,
+    "golfeur": 922,
+    "gomme": 923,
+    "gonfler": 924,
+    "gorge": 925,
+    "gorille": 926,
+    "goudron": 927,
+    "gouffre": 928,
+    "goulot": 929,
+    "goupille": 930,
+    "gourmand": 931,
+    "goutte": 932,
+    "graduel": 933,
+    "graffiti": 934,
+    "graine": 935,
+    "grand": 936,
+    "grappin": 937,
+    "gratuit": 938,
+    "gravir": 939,
+    "grenat": 940,
+    "griffure": 941,
+    "griller": 942,
+    "grimper": 943,
+    "grogner": 944,
+    "gronder": 945,
+    "grotte": 946,
+    "groupe": 947,
+    "gruger": 948,
+    "grutier": 949,
+    "gruyère": 950,
+    "guépard": 951,
+    "guerrier": 952,
+    "guide": 953,
+    "guimauve": 954,
+    "guitare": 955,
+    "gustatif": 956,
+    "gymnaste": 957,
+    "gyrostat": 958,
+    "habitude": 959,
+    "hachoir": 960,
+    "halte": 961,
+    "hameau": 962,
+    "hangar": 963,
+    "hanneton": 964,
+    "haricot": 965,
+    "harmonie": 966,
+    "harpon": 967,
+    "hasard": 968,
+    "hélium": 969,
+    "hématome": 970,
+    "herbe": 971,
+    "hérisson": 972,
+    "hermine": 973,
+    "héron": 974,
+    "hésiter": 975,
+    "heureux": 976,
+    "hiberner": 977,
+    "hibou": 978,
+    "hilarant": 979,
+    "histoire": 980,
+    "hiver": 981,
+    "homard": 982,
+    "hommage": 983,
+    "homogène": 984,
+    "honneur": 985,
+    "honorer": 986,
+    "honteux": 987,
+    "horde": 988,
+    "horizon": 989,
+    "horloge": 990,
+    "hormone": 991,
+    "horrible": 992,
+    "houleux": 993,
+    "housse": 994,
+    "hublot": 995,
+    "huileux": 996,
+    "humain": 997,
+    "humble": 998,
+    "humide": 999,
+    "humour": 1000,
+    "hurler": 1001,
+    "hydromel": 1002,
+    "hygiène": 1003,
+    "hymne": 1004,
+    "hypnose": 1005,
+    "idylle": 1006,
+    "ignorer": 1007,
+    "iguane": 1008,
+    "illicite": 1009,
+    "illusion": 1010,
+    "image": 1011,
+    "imbiber": 1012,
+    "imiter": 1013,
+    "immense": 1014,
+    "immobile": 1015,
+    "immuable": 1016,
+    "impact": 1017,
+    "impérial": 1018,
+    "implorer": 1019,
+    "imposer": 1020,
+    "imprimer": 1021,
+    "imputer": 1022,
+    "incarner": 1023,
+    "incendie": 1024,
+    "incident": 1025,
+    "incliner": 1026,
+    "incolore": 1027,
+    "indexer": 1028,
+    "indice": 1029,
+    "inductif": 1030,
+    "inédit": 1031,
+    "ineptie": 1032,
+    "inexact": 1033,
+    "infini": 1034,
+    "infliger": 1035,
+    "informer": 1036,
+    "infusion": 1037,
+    "ingérer": 1038,
+    "inhaler": 1039,
+    "inhiber": 1040,
+    "injecter": 1041,
+    "injure": 1042,
+    "innocent": 1043,
+    "inoculer": 1044,
+    "inonder": 1045,
+    "inscrire": 1046,
+    "insecte": 1047,
+    "insigne": 1048,
+    "insolite": 1049,
+    "inspirer": 1050,
+    "instinct": 1051,
+    "insulter": 1052,
+    "intact": 1053,
+    "intense": 1054,
+    "intime": 1055,
+    "intrigue": 1056,
+    "intuitif": 1057,
+    "inutile": 1058,
+    "invasion": 1059,
+    "inventer": 1060,
+    "inviter": 1061,
+    "invoquer": 1062,
+    "ironique": 1063,
+    "irradier": 1064,
+    "irréel": 1065,
+    "irriter": 1066,
+    "isoler": 1067,
+    "ivoire": 1068,
+    "ivresse": 1069,
+    "jaguar": 1070,
+    "jaillir": 1071,
+    "jambe": 1072,
+    "janvier": 1073,
+    "jardin": 1074,
+    "jauger": 1075,
+    "jaune": 1076,
+    "javelot": 1077,
+    "jetable": 1078,
+    "jeton": 1079,
+    "jeudi": 1080,
+    "jeunesse": 1081,
+    "joindre": 1082,
+    "joncher": 1083,
+    "jongler": 1084,
+    "joueur": 1085,
+    "jouissif": 1086,
+    "journal": 1087,
+    "jovial": 1088,
+    "joyau": 1089,
+    "joyeux": 1090,
+    "jubiler": 1091,
+    "jugement": 1092,
+    "junior": 1093,
+    "jupon": 1094,
+    "juriste": 1095,
+    "justice": 1096,
+    "juteux": 1097,
+    "juvénile": 1098,
+    "kayak": 1099,
+    "kimono": 1100,
+    "kiosque": 1101,
+    "label": 1102,
+    "labial": 1103,
+    "labourer": 1104,
+    "lacérer": 1105,
+    "lactose": 1106,
+    "lagune": 1107,
+    "laine": 1108,
+    "laisser": 1109,
+    "laitier": 1110,
+    "lambeau": 1111,
+    "lamelle": 1112,
+    "lampe": 1113,
+    "lanceur": 1114,
+    "langage": 1115,
+    "lanterne": 1116,
+    "lapin": 1117,
+    "largeur": 1118,
+    "larme": 1119,
+    "laurier": 1120,
+    "lavabo": 1121,
+    "lavoir": 1122,
+    "lecture": 1123,
+    "légal": 1124,
+    "léger": 1125,
+    "légume": 1126,
+    "lessive": 1127,
+    "lettre": 1128,
+    "levier": 1129,
+    "lexique": 1130,
+    "lézard": 1131,
+    "liasse": 1132,
+    "libérer": 1133,
+    "libre": 1134,
+    "licence": 1135,
+    "licorne": 1136,
+    "liège": 1137,
+    "lièvre": 1138,
+    "ligature": 1139,
+    "ligoter": 1140,
+    "ligue": 1141,
+    "limer": 1142,
+    "limite": 1143,
+    "limonade": 1144,
+    "limpide": 1145,
+    "linéaire": 1146,
+    "lingot": 1147,
+    "lionceau": 1148,
+    "liquide": 1149,
+    "lisière": 1150,
+    "lister": 1151,
+    "lithium": 1152,
+    "litige": 1153,
+    "littoral": 1154,
+    "livreur": 1155,
+    "logique": 1156,
+    "lointain": 1157,
+    "loisir": 1158,
+    "lombric": 1159,
+    "loterie": 1160,
+    "louer": 1161,
+    "lourd": 1162,
+    "loutre": 1163,
+    "louve": 1164,
+    "loyal": 1165,
+    "lubie": 1166,
+    "lucide": 1167,
+    "lucratif": 1168,
+    "lueur": 1169,
+    "lugubre": 1170,
+    "luisant": 1171,
+    "lumière": 1172,
+    "lunaire": 1173,
+    "lundi": 1174,
+    "luron": 1175,
+    "lutter": 1176,
+    "luxueux": 1177,
+    "machine": 1178,
+    "magasin": 1179,
+    "magenta": 1180,
+    "magique": 1181,
+    "maigre": 1182,
+    "maillon": 1183,
+    "maintien": 1184,
+    "mairie": 1185,
+    "maison": 1186,
+    "majorer": 1187,
+    "malaxer": 1188,
+    "maléfice": 1189,
+    "malheur": 1190,
+    "malice": 1191,
+    "mallette": 1192,
+    "mammouth": 1193,
+    "mandater": 1194,
+    "maniable": 1195,
+    "manquant": 1196,
+    "manteau": 1197,
+    "manuel": 1198,
+    "marathon": 1199,
+    "marbre": 1200,
+    "marchand": 1201,
+    "mardi": 1202,
+    "maritime": 1203,
+    "marqueur": 1204,
+    "marron": 1205,
+    "marteler": 1206,
+    "mascotte": 1207,
+    "massif": 1208,
+    "matériel": 1209,
+    "matière": 1210,
+    "matraque": 1211,
+    "maudire": 1212,
+    "maussade": 1213,
+    "mauve": 1214,
+    "maximal": 1215,
+    "méchant": 1216,
+    "méconnu": 1217,
+    "médaille": 1218,
+    "médecin": 1219,
+    "méditer": 1220,
+    "méduse": 1221,
+    "meilleur": 1222,
+    "mélange": 1223,
+    "mélodie": 1224,
+    "membre": 1225,
+    "mémoire": 1226,
+    "menacer": 1227,
+    "mener": 1228,
+    "menhir": 1229,
+    "mensonge": 1230,
+    "mentor": 1231,
+    "mercredi": 1232,
+    "mérite": 1233,
+    "merle": 1234,
+    "messager": 1235,
+    "mesure": 1236,
+    "métal": 1237,
+    "météore": 1238,
+    "méthode": 1239,
+    "métier": 1240,
+    "meuble": 1241,
+    "miauler": 1242,
+    "microbe": 1243,
+    "miette": 1244,
+    "mignon": 1245,
+    "migrer": 1246,
+    "milieu": 1247,
+    "million": 1248,
+    "mimique": 1249,
+    "mince": 1250,
+    "minéral": 1251,
+    "minimal": 1252,
+    "minorer": 1253,
+    "minute": 1254,
+    "miracle": 1255,
+    "miroiter": 1256,
+    "missile": 1257,
+    "mixte": 1258,
+    "mobile": 1259,
+    "moderne": 1260,
+    "moelleux": 1261,
+    "mondial": 1262,
+    "moniteur": 1263,
+    "monnaie": 1264,
+    "monotone": 1265,
+    "monstre": 1266,
+    "montagne": 1267,
+    "monument": 1268,
+    "moqueur": 1269,
+    "morceau": 1270,
+    "morsure": 1271,
+    "mortier": 1272,
+    "moteur": 1273,
+    "motif": 1274,
+    "mouche": 1275,
+    "moufle": 1276,
+    "moulin": 1277,
+    "mousson": 1278,
+    "mouton": 1279,
+    "mouvant": 1280,
+    "multiple": 1281,
+    "munition": 1282,
+    "muraille": 1283,
+    "murène": 1284,
+    "murmure": 1285,
+    "muscle": 1286,
+    "muséum": 1287,
+    "musicien": 1288,
+    "mutation": 1289,
+    "muter": 1290,
+    "mutuel": 1291,
+    "myriade": 1292,
+    "myrtille": 1293,
+    "mystère": 1294,
+    "mythique": 1295,
+    "nageur": 1296,
+    "nappe": 1297,
+    "narquois": 1298,
+    "narrer": 1299,
+    "natation": 1300,
+    "nation": 1301,
+    "nature": 1302,
+    "naufrage": 1303,
+    "nautique": 1304,
+    "navire": 1305,
+    "nébuleux": 1306,
+    "nectar": 1307,
+    "néfaste": 1308,
+    "négation": 1309,
+    "négliger": 1310,
+    "négocier": 1311,
+    "neige": 1312,
+    "nerveux": 1313,
+    "nettoyer": 1314,
+    "neurone": 1315,
+    "neutron": 1316,
+    "neveu": 1317,
+    "niche": 1318,
+    "nickel": 1319,
+    "nitrate": 1320,
+    "niveau": 1321,
+    "noble": 1322,
+    "nocif": 1323,
+    "nocturne": 1324,
+    "noirceur": 1325,
+    "noisette": 1326,
+    "nomade": 1327,
+    "nombreux": 1328,
+    "nommer": 1329,
+    "normatif": 1330,
+    "notable": 1331,
+    "notifier": 1332,
+    "notoire": 1333,
+    "nourrir": 1334,
+    "nouveau": 1335,
+    "novateur": 1336,
+    "novembre": 1337,
+    "novice": 1338,
+    "nuage": 1339,
+    "nuancer": 1340,
+    "nuire": 1341,
+    "nuisible": 1342,
+    "numéro": 1343,
+    "nuptial": 1344,
+    "nuque": 1345,
+    "nutritif": 1346,
+    "obéir": 1347,
+    "objectif": 1348,
+    "obliger": 1349,
+    "obscur": 1350,
+    "observer": 1351,
+    "obstacle": 1352,
+    "obtenir": 1353,
+    "obturer": 1354,
+    "occasion": 1355,
+    "occuper": 1356,
+    "océan": 1357,
+    "octobre": 1358,
+    "octroyer": 1359,
+    "octupler": 1360,
+    "oculaire": 1361,
+    "odeur": 1362,
+    "odorant": 1363,
+    "offenser": 1364,
+    "officier": 1365,
+    "offrir": 1366,
+    "ogive": 1367,
+    "oiseau": 1368,
+    "oisillon": 1369,
+    "olfactif": 1370,
+    "olivier": 1371,
+    "ombrage": 1372,
+    "omettre": 1373,
+    "onctueux": 1374,
+    "onduler": 1375,
+    "onéreux": 1376,
+    "onirique": 1377,
+    "opale": 1378,
+    "opaque": 1379,
+    "opérer": 1380,
+    "opinion": 1381,
+    "opportun": 1382,
+    "opprimer": 1383,
+    "opter": 1384,
+    "optique": 1385,
+    "orageux": 1386,
+    "orange": 1387,
+    "orbite": 1388,
+    "ordonner": 1389,
+    "oreille": 1390,
+    "organe": 1391,
+    "orgueil": 1392,
+    "orifice": 1393,
+    "ornement": 1394,
+    "orque": 1395,
+    "ortie": 1396,
+    "osciller": 1397,
+    "osmose": 1398,
+    "ossature": 1399,
+    "otarie": 1400,
+    "ouragan": 1401,
+    "ourson": 1402,
+    "outil": 1403,
+    "outrager": 1404,
+    "ouvrage": 1405,
+    "ovation": 1406,
+    "oxyde": 1407,
+    "oxygène": 1408,
+    "ozone": 1409,
+    "paisible": 1410,
+    "palace": 1411,
+    "palmarès": 1412,
+    "palourde": 1413,
+    "palper": 1414,
+    "panache": 1415,
+    "panda": 1416,
+    "pangolin": 1417,
+    "paniquer": 1418,
+    "panneau": 1419,
+    "panorama": 1420,
+    "pantalon": 1421,
+    "papaye": 1422,
+    "papier": 1423,
+    "papoter": 1424,
+    "papyrus": 1425,
+    "paradoxe": 1426,
+    "parcelle": 1427,
+    "paresse": 1428,
+    "parfumer": 1429,
+    "parler": 1430,
+    "parole": 1431,
+    "parrain": 1432,
+    "parsemer": 1433,
+    "partager": 1434,
+    "parure": 1435,
+    "parvenir": 1436,
+    "passion": 1437,
+    "pastèque": 1438,
+    "paternel": 1439,
+    "patience": 1440,
+    "patron": 1441,
+    "pavillon": 1442,
+    "pavoiser": 1443,
+    "payer": 1444,
+    "paysage": 1445,
+    "peigne": 1446,
+    "peintre": 1447,
+    "pelage": 1448,
+    "pélican": 1449,
+    "pelle": 1450,
+    "pelouse": 1451,
+    "peluche": 1452,
+    "pendule": 1453,
+    "pénétrer": 1454,
+    "pénible": 1455,
+    "pensif": 1456,
+    "pénurie": 1457,
+    "pépite": 1458,
+    "péplum": 1459,
+    "perdrix": 1460,
+    "perforer": 1461,
+    "période": 1462,
+    "permuter": 1463,
+    "perplexe": 1464,
+    "persil": 1465,
+    "perte": 1466,
+    "peser": 1467,
+    "pétale": 1468,
+    "petit": 1469,
+    "pétrir": 1470,
+    "peuple": 1471,
+    "pharaon": 1472,
+    "phobie": 1473,
+    "phoque": 1474,
+    "photon": 1475,
+    "phrase": 1476,
+    "physique": 1477,
+    "piano": 1478,
+    "pictural": 1479,
+    "pièce": 1480,
+    "pierre": 1481,
+    "pieuvre": 1482,
+    "pilote": 1483,
+    "pinceau": 1484,
+    "pipette": 1485,
+    "piquer": 1486,
+    "pirogue": 1487,
+    "piscine": 1488,
+    "piston": 1489,
+    "pivoter": 1490,
+    "pixel": 1491,
+    "pizza": 1492,
+    "placard": 1493,
+    "plafond": 1494,
+    "plaisir": 1495,
+    "planer": 1496,
+    "plaque": 1497,
+    "plastron": 1498,
+    "plateau": 1499,
+    "pleurer": 1500,
+    "plexus": 1501,
+    "pliage": 1502,
+    "plomb": 1503,
+    "plonger": 1504,
+    "pluie": 1505,
+    "plumage": 1506,
+    "pochette": 1507,
+    "poésie": 1508,
+    "poète": 1509,
+    "pointe": 1510,
+    "poirier": 1511,
+    "poisson": 1512,
+    "poivre": 1513,
+    "polaire": 1514,
+    "policier": 1515,
+    "pollen": 1516,
+    "polygone": 1517,
+    "pommade": 1518,
+    "pompier": 1519,
+    "ponctuel": 1520,
+    "pondérer": 1521,
+    "poney": 1522,
+    "portique": 1523,
+    "position": 1524,
+    "posséder": 1525,
+    "posture": 1526,
+    "potager": 1527,
+    "poteau": 1528,
+    "potion": 1529,
+    "pouce": 1530,
+    "poulain": 1531,
+    "poumon": 1532,
+    "pourpre": 1533,
+    "poussin": 1534,
+    "pouvoir": 1535,
+    "prairie": 1536,
+    "pratique": 1537,
+    "précieux": 1538,
+    "prédire": 1539,
+    "préfixe": 1540,
+    "prélude": 1541,
+    "prénom": 1542,
+    "présence": 1543,
+    "prétexte": 1544,
+    "prévoir": 1545,
+    "primitif": 1546,
+    "prince": 1547,
+    "prison": 1548,
+    "priver": 1549,
+    "problème": 1550,
+    "procéder": 1551,
+    "prodige": 1552,
+    "profond": 1553,
+    "progrès": 1554,
+    "proie": 1555,
+    "projeter": 1556,
+    "prologue": 1557,
+    "promener": 1558,
+    "propre": 1559,
+    "prospère": 1560,
+    "protéger": 1561,
+    "prouesse": 1562,
+    "proverbe": 1563,
+    "prudence": 1564,
+    "pruneau": 1565,
+    "psychose": 1566,
+    "public": 1567,
+    "puceron": 1568,
+    "puiser": 1569,
+    "pulpe": 1570,
+    "pulsar": 1571,
+    "punaise": 1572,
+    "punitif": 1573,
+    "pupitre": 1574,
+    "purifier": 1575,
+    "puzzle": 1576,
+    "pyramide": 1577,
+    "quasar": 1578,
+    "querelle": 1579,
+    "question": 1580,
+    "quiétude": 1581,
+    "quitter": 1582,
+    "quotient": 1583,
+    "racine": 1584,
+    "raconter": 1585,
+    "radieux": 1586,
+    "ragondin": 1587,
+    "raideur": 1588,
+    "raisin": 1589,
+    "ralentir": 1590,
+    "rallonge": 1591,
+    "ramasser": 1592,
+    "rapide": 1593,
+    "rasage": 1594,
+    "ratisser": 1595,
+    "ravager": 1596,
+    "ravin": 1597,
+    "rayonner": 1598,
+    "réactif": 1599,
+    "réagir": 1600,
+    "réaliser": 1601,
+    "réanimer": 1602,
+    "recevoir": 1603,
+    "réciter": 1604,
+    "réclamer": 1605,
+    "récolter": 1606,
+    "recruter": 1607,
+    "reculer": 1608,
+    "recycler": 1609,
+    "rédiger": 1610,
+    "redouter": 1611,
+    "refaire": 1612,
+    "réflexe": 1613,
+    "réformer": 1614,
+    "refrain": 1615,
+    "refuge": 1616,
+    "régalien": 1617,
+    "région": 1618,
+    "réglage": 1619,
+    "régulier": 1620,
+    "réitérer": 1621,
+    "rejeter": 1622,
+    "rejouer": 1623,
+    "relatif": 1624,
+    "relever": 1625,
+    "relief": 1626,
+    "remarque": 1627,
+    "remède": 1628,
+    "remise": 1629,
+    "remonter": 1630,
+    "remplir": 1631,
+    "remuer": 1632,
+    "renard": 1633,
+    "renfort": 1634,
+    "renifler": 1635,
+    "renoncer": 1636,
+    "rentrer": 1637,
+    "renvoi": 1638,
+    "replier": 1639,
+    "reporter": 1640,
+    "reprise": 1641,
+    "reptile": 1642,
+    "requin": 1643,
+    "réserve": 1644,
+    "résineux": 1645,
+    "résoudre": 1646,
+    "respect": 1647,
+    "rester": 1648,
+    "résultat": 1649,
+    "rétablir": 1650,
+    "retenir": 1651,
+    "réticule": 1652,
+    "retomber": 1653,
+    "retracer": 1654,
+    "réunion": 1655,
+    "réussir": 1656,
+    "revanche": 1657,
+    "revivre": 1658,
+    "révolte": 1659,
+    "révulsif": 1660,
+    "richesse": 1661,
+    "rideau": 1662,
+    "rieur": 1663,
+    "rigide": 1664,
+    "rigoler": 1665,
+    "rincer": 1666,
+    "riposter": 1667,
+    "risible": 1668,
+    "risque": 1669,
+    "rituel": 1670,
+    "rival": 1671,
+    "rivière": 1672,
+    "rocheux": 1673,
+    "romance": 1674,
+    "rompre": 1675,
+    "ronce": 1676,
+    "rondin": 1677,
+    "roseau": 1678,
+    "rosier": 1679,
+    "rotatif": 1680,
+    "rotor": 1681,
+    "rotule": 1682,
+    "rouge": 1683,
+    "rouille": 1684,
+    "rouleau": 1685,
+    "routine": 1686,
+    "royaume": 1687,
+    "ruban": 1688,
+    "rubis": 1689,
+    "ruche": 1690,
+    "ruelle": 1691,
+    "rugueux": 1692,
+    "ruiner": 1693,
+    "ruisseau": 1694,
+    "ruser": 1695,
+    "rustique": 1696,
+    "rythme": 1697,
+    "sabler": 1698,
+    "saboter": 1699,
+    "sabre": 1700,
+    "sacoche": 1701,
+    "safari": 1702,
+    "sagesse": 1703,
+    "saisir": 1704,
+    "salade": 1705,
+    "salive": 1706,
+    "salon": 1707,
+    "saluer": 1708,
+    "samedi": 1709,
+    "sanction": 1710,
+    "sanglier": 1711,
+    "sarcasme": 1712,
+    "sardine": 1713,
+    "saturer": 1714,
+    "saugrenu": 1715,
+    "saumon": 1716,
+    "sauter": 1717,
+    "sauvage": 1718,
+    "savant": 1719,
+    "savonner": 1720,
+    "scalpel": 1721,
+    "scandale": 1722,
+    "scélérat": 1723,
+    "scénario": 1724,
+    "sceptre": 1725,
+    "schéma": 1726,
+    "science": 1727,
+    "scinder": 1728,
+    "score": 1729,
+    "scrutin": 1730,
+    "sculpter": 1731,
+    "séance": 1732,
+    "sécable": 1733,
+    "sécher": 1734,
+    "secouer": 1735,
+    "sécréter": 1736,
+    "sédatif": 1737,
+    "séduire": 1738,
+    "seigneur": 1739,
+    "séjour": 1740,
+    "sélectif": 1741,
+    "semaine": 1742,
+    "sembler": 1743,
+    "semence": 1744,
+    "séminal": 1745,
+    "sénateur": 1746,
+    "sensible": 1747,
+    "sentence": 1748,
+    "séparer": 1749,
+    "séquence": 1750,
+    "serein": 1751,
+    "sergent": 1752,
+    "sérieux": 1753,
+    "serrure": 1754,
+    "sérum": 1755,
+    "service": 1756,
+    "sésame": 1757,
+    "sévir": 1758,
+    "sevrage": 1759,
+    "sextuple": 1760,
+    "sidéral": 1761,
+    "siècle": 1762,
+    "siéger": 1763,
+    "siffler": 1764,
+    "sigle": 1765,
+    "signal": 1766,
+    "silence": 1767,
+    "silicium": 1768,
+    "simple": 1769,
+    "sincère": 1770,
+    "sinistre": 1771,
+    "siphon": 1772,
+    "sirop": 1773,
+    "sismique": 1774,
+    "situer": 1775,
+    "skier": 1776,
+    "social": 1777,
+    "socle": 1778,
+    "sodium": 1779,
+    "soigneux": 1780,
+    "soldat": 1781,
+    "soleil": 1782,
+    "solitude": 1783,
+    "soluble": 1784,
+    "sombre": 1785,
+    "sommeil": 1786,
+    "somnoler": 1787,
+    "sonde": 1788,
+    "songeur": 1789,
+    "sonnette": 1790,
+    "sonore": 1791,
+    "sorcier": 1792,
+    "sortir": 1793,
+    "sosie": 1794,
+    "sottise": 1795,
+    "soucieux": 1796,
+    "soudure": 1797,
+    "souffle": 1798,
+    "soulever": 1799,
+    "soupape": 1800,
+    "source": 1801,
+    "soutirer": 1802,
+    "souvenir": 1803,
+    "spacieux": 1804,
+    "spatial": 1805,
+    "spécial": 1806,
+    "sphère": 1807,
+    "spiral": 1808,
+    "stable": 1809,
+    "station": 1810,
+    "sternum": 1811,
+    "stimulus": 1812,
+    "stipuler": 1813,
+    "strict": 1814,
+    "studieux": 1815,
+    "stupeur": 1816,
+    "styliste": 1817,
+    "sublime": 1818,
+    "substrat": 1819,
+    "subtil": 1820,
+    "subvenir": 1821,
+    "succès": 1822,
+    "sucre": 1823,
+    "suffixe": 1824,
+    "suggérer": 1825,
+    "suiveur": 1826,
+    "sulfate": 1827,
+    "superbe": 1828,
+    "supplier": 1829,
+    "surface": 1830,
+    "suricate": 1831,
+    "surmener": 1832,
+    "surprise": 1833,
+    "sursaut": 1834,
+    "survie": 1835,
+    "suspect": 1836,
+    "syllabe": 1837,
+    "symbole": 1838,
+    "symétrie": 1839,
+    "synapse": 1840,
+    "syntaxe": 1841,
+    "système": 1842,
+    "tabac": 1843,
+    "tablier": 1844,
+    "tactile": 1845,
+    "tailler": 1846,
+    "talent": 1847,
+    "talisman": 1848,
+    "talonner": 1849,
+    "tambour": 1850,
+    "tamiser": 1851,
+    "tangible": 1852,
+    "tapis": 1853,
+    "taquiner": 1854,
+    "tarder": 1855,
+    "tarif": 1856,
+    "tartine": 1857,
+    "tasse": 1858,
+    "tatami": 1859,
+    "tatouage": 1860,
+    "taupe": 1861,
+    "taureau": 1862,
+    "taxer": 1863,
+    "témoin": 1864,
+    "temporel": 1865,
+    "tenaille": 1866,
+    "tendre": 1867,
+    "teneur": 1868,
+    "tenir": 1869,
+    "tension": 1870,
+    "terminer": 1871,
+    "terne": 1872,
+    "terrible": 1873,
+    "tétine": 1874,
+    "texte": 1875,
+    "thème": 1876,
+    "théorie": 1877,
+    "thérapie": 1878,
+    "thorax": 1879,
+    "tibia": 1880,
+    "tiède": 1881,
+    "timide": 1882,
+    "tirelire": 1883,
+    "tiroir": 1884,
+    "tissu": 1885,
+    "titane": 1886,
+    "titre": 1887,
+    "tituber": 1888,
+    "toboggan": 1889,
+    "tolérant": 1890,
+    "tomate": 1891,
+    "tonique": 1892,
+    "tonneau": 1893,
+    "toponyme": 1894,
+    "torche": 1895,
+    "tordre": 1896,
+    "tornade": 1897,
+    "torpille": 1898,
+    "torrent": 1899,
+    "torse": 1900,
+    "tortue": 1901,
+    "totem": 1902,
+    "toucher": 1903,
+    "tournage": 1904,
+    "tousser": 1905,
+    "toxine": 1906,
+    "traction": 1907,
+    "trafic": 1908,
+    "tragique": 1909,
+    "trahir": 1910,
+    "train": 1911,
+    "trancher": 1912,
+    "travail": 1913,
+    "trèfle": 1914,
+    "tremper": 1915,
+    "trésor": 1916,
+    "treuil": 1917,
+    "triage": 1918,
+    "tribunal": 1919,
+    "tricoter": 1920,
+    "trilogie": 1921,
+    "triomphe": 1922,
+    "tripler": 1923,
+    "triturer": 1924,
+    "trivial": 1925,
+    "trombone": 1926,
+    "tronc": 1927,
+    "tropical": 1928,
+    "troupeau": 1929,
+    "tuile": 1930,
+    "tulipe": 1931,
+    "tumulte": 1932,
+    "tunnel": 1933,
+    "turbine": 1934,
+    "tuteur": 1935,
+    "tutoyer": 1936,
+    "tuyau": 1937,
+    "tympan": 1938,
+    "typhon": 1939,
+    "typique": 1940,
+    "tyran": 1941,
+    "ubuesque": 1942,
+    "ultime": 1943,
+    "ultrason": 1944,
+    "unanime": 1945,
+    "unifier": 1946,
+    "union": 1947,
+    "unique": 1948,
+    "unitaire": 1949,
+    "univers": 1950,
+    "uranium": 1951,
+    "urbain": 1952,
+    "urticant": 1953,
+    "usage": 1954,
+    "usine": 1955,
+    "usuel": 1956,
+    "usure": 1957,
+    "utile": 1958,
+    "utopie": 1959,
+    "vacarme": 1960,
+    "vaccin": 1961,
+    "vagabond": 1962,
+    "vague": 1963,
+    "vaillant": 1964,
+    "vaincre": 1965,
+    "vaisseau": 1966,
+    "valable": 1967,
+    "valise": 1968,
+    "vallon": 1969,
+    "valve": 1970,
+    "vampire": 1971,
+    "vanille": 1972,
+    "vapeur": 1973,
+    "varier": 1974,
+    "vaseux": 1975,
+    "vassal": 1976,
+    "vaste": 1977,
+    "vecteur": 1978,
+    "vedette": 1979,
+    "végétal": 1980,
+    "véhicule": 1981,
+    "veinard": 1982,
+    "véloce": 1983,
+    "vendredi": 1984,
+    "vénérer": 1985,
+    "venger": 1986,
+    "venimeux": 1987,
+    "ventouse": 1988,
+    "verdure": 1989,
+    "vérin": 1990,
+    "vernir": 1991,
+    "verrou": 1992,
+    "verser": 1993,
+    "vertu": 1994,
+    "veston": 1995,
+    "vétéran": 1996,
+    "vétuste": 1997,
+    "vexant": 1998,
+    "vexer": 1999,
+    "viaduc": 2000,
+    "viande": 2001,
+    "victoire": 2002,
+    "vidange": 2003,
+    "vidéo": 2004,
+    "vignette": 2005,
+    "vigueur": 2006,
+    "vilain": 2007,
+    "village": 2008,
+    "vinaigre": 2009,
+    "violon": 2010,
+    "vipère": 2011,
+    "virement": 2012,
+    "virtuose": 2013,
+    "virus": 2014,
+    "visage": 2015,
+    "viseur": 2016,
+    "vision": 2017,
+    "visqueux": 2018,
+    "visuel": 2019,
+    "vital": 2020,
+    "vitesse": 2021,
+    "viticole": 2022,
+    "vitrine": 2023,
+    "vivace": 2024,
+    "vivipare": 2025,
+    "vocation": 2026,
+    "voguer": 2027,
+    "voile": 2028,
+    "voisin": 2029,
+    "voiture": 2030,
+    "volaille": 2031,
+    "volcan": 2032,
+    "voltiger": 2033,
+    "volume": 2034,
+    "vorace": 2035,
+    "vortex": 2036,
+    "voter": 2037,
+    "vouloir": 2038,
+    "voyage": 2039,
+    "voyelle": 2040,
+    "wagon": 2041,
+    "xénon": 2042,
+    "yacht": 2043,
+    "zèbre": 2044,
+    "zénith": 2045,
+    "zeste": 2046,
+    "zoologie": 2047,
+}
+var words_japanese = [2048]string{
+    "あいこくしん",
+    "あいさつ",
+    "あいだ",
+    "あおぞら",
+    "あかちゃん",
+    "あきる",
+    "あけがた",
+    "あける",
+    "あこがれる",
+    "あさい",
+    "あさひ",
+    "あしあと",
+    "あじわう",
+    "あずかる",
+    "あずき",
+    "あそぶ",
+    "あたえる",
+    "あたためる",
+    "あたりまえ",
+    "あたる",
+    "あつい",
+    "あつかう",
+    "あっしゅく",
+    "あつまり",
+    "あつめる",
+    "あてな",
+    "あてはまる",
+    "あひる",
+    "あぶら",
+    "あぶる",
+    "あふれる",
+    "あまい",
+    "あまど",
+    "あまやかす",
+    "あまり",
+    "あみもの",
+    "あめりか",
+    "あやまる",
+    "あゆむ",
+    "あらいぐま",
+    "あらし",
+    "あらすじ",
+    "あらためる",
+    "あらゆる",
+    "あらわす",
+    "ありがとう",
+    "あわせる",
+    "あわてる",
+    "あんい",
+    "あんがい",
+    "あんこ",
+    "あんぜん",
+    "あんてい",
+    "あんない",
+    "あんまり",
+    "いいだす",
+    "いおん",
+    "いがい",
+    "いがく",
+    "いきおい",
+    "いきなり",
+    "いきもの",
+    "いきる",
+    "いくじ",
+    "いくぶん",
+    "いけばな",
+    "いけん",
+    "いこう",
+    "いこく",
+    "いこつ",
+    "いさましい",
+    "いさん",
+    "いしき",
+    "いじゅう",
+    "いじょう",
+    "いじわる",
+    "いずみ",
+    "いずれ",
+    "いせい",
+    "いせえび",
+    "いせかい",
+    "いせき",
+    "いぜん",
+    "いそうろう",
+    "いそがしい",
+    "いだい",
+    "いだく",
+    "いたずら",
+    "いたみ",
+    "いたりあ",
+    "いちおう",
+    "いちじ",
+    "いちど",
+    "いちば",
+    "いちぶ",
+    "いちりゅう",
+    "いつか",
+    "いっしゅん",
+    "いっせい",
+    "いっそう",
+    "いったん",
+    "いっち",
+    "いってい",
+    "いっぽう",
+    "いてざ",
+    "いてん",
+    "いどう",
+    "いとこ",
+    "いない",
+    "いなか",
+    "いねむり",
+    "いのち",
+    "いのる",
+    "いはつ",
+    "いばる",
+    "いはん",
+    "いびき",
+    "いひん",
+    "いふく",
+    "いへん",
+    "いほう",
+    "いみん",
+    "いもうと",
+    "いもたれ",
+    "いもり",
+    "いやがる",
+    "いやす",
+    "いよかん",
+    "いよく",
+    "いらい",
+    "いらすと",
+    "いりぐち",
+    "いりょう",
+    "いれい",
+    "いれもの",
+    "いれる",
+    "いろえんぴつ",
+    "いわい",
+    "いわう",
+    "いわかん",
+    "いわば",
+    "いわゆる",
+    "いんげんまめ",
+    "いんさつ",
+    "いんしょう",
+    "いんよう",
+    "うえき",
+    "うえる",
+    "うおざ",
+    "うがい",
+    "うかぶ",
+    "うかべる",
+    "うきわ",
+    "うくらいな",
+    "うくれれ",
+    "うけたまわる",
+    "うけつけ",
+    "うけとる",
+    "うけもつ",
+    "うける",
+    "うごかす",
+    "うごく",
+    "うこん",
+    "うさぎ",
+    "うしなう",
+    "うしろがみ",
+    "うすい",
+    "うすぎ",
+    "うすぐらい",
+    "うすめる",
+    "うせつ",
+    "うちあわせ",
+    "うちがわ",
+    "うちき",
+    "うちゅう",
+    "うっかり",
+    "うつくしい",
+    "うったえる",
+    "うつる",
+    "うどん",
+    "うなぎ",
+    "うなじ",
+    "うなずく",
+    "うなる",
+    "うねる",
+    "うのう",
+    "うぶげ",
+    "うぶごえ",
+    "うまれる",
+    "うめる",
+    "うもう",
+    "うやまう",
+    "うよく",
+    "うらがえす",
+    "うらぐち",
+    "うらない",
+    "うりあげ",
+    "うりきれ",
+    "うるさい",
+    "うれしい",
+    "うれゆき",
+    "うれる",
+    "うろこ",
+    "うわき",
+    "うわさ",
+    "うんこう",
+    "うんちん",
+    "うんてん",
+    "うんどう",
+    "えいえん",
+    "えいが",
+    "えいきょう",
+    "えいご",
+    "えいせい",
+    "えいぶん",
+    "えいよう",
+    "えいわ",
+    "えおり",
+    "えがお",
+    "えがく",
+    "えきたい",
+    "えくせる",
+    "えしゃく",
+    "えすて",
+    "えつらん",
+    "えのぐ",
+    "えほうまき",
+    "えほん",
+    "えまき",
+    "えもじ",
+    "えもの",
+    "えらい",
+    "えらぶ",
+    "えりあ",
+    "えんえん",
+    "えんかい",
+    "えんぎ",
+    "えんげき",
+    "えんしゅう",
+    "えんぜつ",
+    "えんそく",
+    "えんちょう",
+    "えんとつ",
+    "おいかける",
+    "おいこす",
+    "おいしい",
+    "おいつく",
+    "おうえん",
+    "おうさま",
+    "おうじ",
+    "おうせつ",
+    "おうたい",
+    "おうふく",
+    "おうべい",
+    "おうよう",
+    "おえる",
+    "おおい",
+    "おおう",
+    "おおどおり",
+    "おおや",
+    "おおよそ",
+    "おかえり",
+    "おかず",
+    "おがむ",
+    "おかわり",
+    "おぎなう",
+    "おきる",
+    "おくさま",
+    "おくじょう",
+    "おくりがな",
+    "おくる",
+    "おくれる",
+    "おこす",
+    "おこなう",
+    "おこる",
+    "おさえる",
+    "おさない",
+    "おさめる",
+    "おしいれ",
+    "おしえる",
+    "おじぎ",
+    "おじさん",
+    "おしゃれ",
+    "おそらく",
+    "おそわる",
+    "おたがい",
+    "おたく",
+    "おだやか",
+    "おちつく",
+    "おっと",
+    "おつり",
+    "おでかけ",
+    "おとしもの",
+    "おとなしい",
+    "おどり",
+    "おどろかす",
+    "おばさん",
+    "おまいり",
+    "おめでとう",
+    "おもいで",
+    "おもう",
+    "おもたい",
+    "おもちゃ",
+    "おやつ",
+    "おやゆび",
+    "およぼす",
+    "おらんだ",
+    "おろす",
+    "おんがく",
+    "おんけい",
+    "おんしゃ",
+    "おんせん",
+    "おんだん",
+    "おんちゅう",
+    "おんどけい",
+    "かあつ",
+    "かいが",
+    "がいき",
+    "がいけん",
+    "がいこう",
+    "かいさつ",
+    "かいしゃ",
+    "かいすいよく",
+    "かいぜん",
+    "かいぞうど",
+    "かいつう",
+    "かいてん",
+    "かいとう",
+    "かいふく",
+    "がいへき",
+    "かいほう",
+    "かいよう",
+    "がいらい",
+    "かいわ",
+    "かえる",
+    "かおり",
+    "かかえる",
+    "かがく",
+    "かがし",
+    "かがみ",
+    "かくご",
+    "かくとく",
+    "かざる",
+    "がぞう",
+    "かたい",
+    "かたち",
+    "がちょう",
+    "がっきゅう",
+    "がっこう",
+    "がっさん",
+    "がっしょう",
+    "かなざわし",
+    "かのう",
+    "がはく",
+    "かぶか",
+    "かほう",
+    "かほご",
+    "かまう",
+    "かまぼこ",
+    "かめれおん",
+    "かゆい",
+    "かようび",
+    "からい",
+    "かるい",
+    "かろう",
+    "かわく",
+    "かわら",
+    "がんか",
+    "かんけい",
+    "かんこう",
+    "かんしゃ",
+    "かんそう",
+    "かんたん",
+    "かんち",
+    "がんばる",
+    "きあい",
+    "きあつ",
+    "きいろ",
+    "ぎいん",
+    "きうい",
+    "きうん",
+    "きえる",
+    "きおう",
+    "きおく",
+    "きおち",
+    "きおん",
+    "きかい",
+    "きかく",
+    "きかんしゃ",
+    "ききて",
+    "きくばり",
+    "きくらげ",
+    "きけんせい",
+    "きこう",
+    "きこえる",
+    "きこく",
+    "きさい",
+    "きさく",
+    "きさま",
+    "きさらぎ",
+    "ぎじかがく",
+    "ぎしき",
+    "ぎじたいけん",
+    "ぎじにってい",
+    "ぎじゅつしゃ",
+    "きすう",
+    "きせい",
+    "きせき",
+    "きせつ",
+    "きそう",
+    "きぞく",
+    "きぞん",
+    "きたえる",
+    "きちょう",
+    "きつえん",
+    "ぎっちり",
+    "きつつき",
+    "きつね",
+    "きてい",
+    "きどう",
+    "きどく",
+    "きない",
+    "きなが",
+    "きなこ",
+    "きぬごし",
+    "きねん",
+    "きのう",
+    "きのした",
+    "きはく",
+    "きびしい",
+    "きひん",
+    "きふく",
+    "きぶん",
+    "きぼう",
+    "きほん",
+    "きまる",
+    "きみつ",
+    "きむずかしい",
+    "きめる",
+    "きもだめし",
+    "きもち",
+    "きもの",
+    "きゃく",
+    "きやく",
+    "ぎゅうにく",
+    "きよう",
+    "きょうりゅう",
+    "きらい",
+    "きらく",
+    "きりん",
+    "きれい",
+    "きれつ",
+    "きろく",
+    "ぎろん",
+    "きわめる",
+    "ぎんいろ",
+    "きんかくじ",
+    "きんじょ",
+    "きんようび",
+    "ぐあい",
+    "くいず",
+    "くうかん",
+    "くうき",
+    "くうぐん",
+    "くうこう",
+    "ぐうせい",
+    "くうそう",
+    "ぐうたら",
+    "くうふく",
+    "くうぼ",
+    "くかん",
+    "くきょう",
+    "くげん",
+    "ぐこう",
+    "くさい",
+    "くさき",
+    "くさばな",
+    "くさる",
+    "くしゃみ",
+    "くしょう",
+    "くすのき",
+    "くすりゆび",
+    "くせげ",
+    "くせん",
+    "ぐたいてき",
+    "くださる",
+    "くたびれる",
+    "くちこみ",
+    "くちさき",
+    "くつした",
+    "ぐっすり",
+    "くつろぐ",
+    "くとうてん",
+    "くどく",
+    "くなん",
+    "くねくね",
+    "くのう",
+    "くふう",
+    "くみあわせ",
+    "くみたてる",
+    "くめる",
+    "くやくしょ",
+    "くらす",
+    "くらべる",
+    "くるま",
+    "くれる",
+    "くろう",
+    "くわしい",
+    "ぐんかん",
+    "ぐんしょく",
+    "ぐんたい",
+    "ぐんて",
+    "けあな",
+    "けいかく",
+    "けいけん",
+    "けいこ",
+    "けいさつ",
+    "げいじゅつ",
+    "けいたい",
+    "げいのうじん",
+    "けいれき",
+    "けいろ",
+    "けおとす",
+    "けおりもの",
+    "げきか",
+    "げきげん",
+    "げきだん",
+    "げきちん",
+    "げきとつ",
+    "げきは",
+    "げきやく",
+    "げこう",
+    "げこくじょう",
+    "げざい",
+    "けさき",
+    "げざん",
+    "けしき",
+    "けしごむ",
+    "けしょう",
+    "げすと",
+    "けたば",
+    "けちゃっぷ",
+    "けちらす",
+    "けつあつ",
+    "けつい",
+    "けつえき",
+    "けっこん",
+    "けつじょ",
+    "けっせき",
+    "けってい",
+    "けつまつ",
+    "げつようび",
+    "げつれい",
+    "けつろん",
+    "げどく",
+    "けとばす",
+    "けとる",
+    "けなげ",
+    "けなす",
+    "けなみ",
+    "けぬき",
+    "げねつ",
+    "けねん",
+    "けはい",
+    "げひん",
+    "けぶかい",
+    "げぼく",
+    "けまり",
+    "けみかる",
+    "けむし",
+    "けむり",
+    "けもの",
+    "けらい",
+    "けろけろ",
+    "けわしい",
+    "けんい",
+    "けんえつ",
+    "けんお",
+    "けんか",
+    "げんき",
+    "けんげん",
+    "けんこう",
+    "けんさく",
+    "けんしゅう",
+    "けんすう",
+    "げんそう",
+    "けんちく",
+    "けんてい",
+    "けんとう",
+    "けんない",
+    "けんにん",
+    "げんぶつ",
+    "けんま",
+    "けんみん",
+    "けんめい",
+    "けんらん",
+    "けんり",
+    "こあくま",
+    "こいぬ",
+    "こいびと",
+    "ごうい",
+    "こうえん",
+    "こうおん",
+    "こうかん",
+    "ごうきゅう",
+    "ごうけい",
+    "こうこう",
+    "こうさい",
+    "こうじ",
+    "こうすい",
+    "ごうせい",
+    "こうそく",
+    "こうたい",
+    "こうちゃ",
+    "こうつう",
+    "こうてい",
+    "こうどう",
+    "こうない",
+    "こうはい",
+    "ごうほう",
+    "ごうまん",
+    "こうもく",
+    "こうりつ",
+    "こえる",
+    "こおり",
+    "ごかい",
+    "ごがつ",
+    "ごかん",
+    "こくご",
+    "こくさい",
+    "こくとう",
+    "こくない",
+    "こくはく",
+    "こぐま",
+    "こけい",
+    "こける",
+    "ここのか",
+    "こころ",
+    "こさめ",
+    "こしつ",
+    "こすう",
+    "こせい",
+    "こせき",
+    "こぜん",
+    "こそだて",
+    "こたい",
+    "こたえる",
+    "こたつ",
+    "こちょう",
+    "こっか",
+    "こつこつ",
+    "こつばん",
+    "こつぶ",
+    "こてい",
+    "こてん",
+    "ことがら",
+    "ことし",
+    "ことば",
+    "ことり",
+    "こなごな",
+    "こねこね",
+    "このまま",
+    "このみ",
+    "このよ",
+    "ごはん",
+    "こひつじ",
+    "こふう",
+    "こふん",
+    "こぼれる",
+    "ごまあぶら",
+    "こまかい",
+    "ごますり",
+    "こまつな",
+    "こまる",
+    "こむぎこ",
+    "こもじ",
+    "こもち",
+    "こもの",
+    "こもん",
+    "こやく",
+    "こやま",
+    "こゆう",
+    "こゆび",
+    "こよい",
+    "こよう",
+    "こりる",
+    "これくしょん",
+    "ころっけ",
+    "こわもて",
+    "こわれる",
+    "こんいん",
+    "こんかい",
+    "こんき",
+    "こんしゅう",
+    "こんすい",
+    "こんだて",
+    "こんとん",
+    "こんなん",
+    "こんびに",
+    "こんぽん",
+    "こんまけ",
+    "こんや",
+    "こんれい",
+    "こんわく",
+    "ざいえき",
+    "さいかい",
+    "さいきん",
+    "ざいげん",
+    "ざいこ",
+    "さいしょ",
+    "さいせい",
+    "ざいたく",
+    "ざいちゅう",
+    "さいてき",
+    "ざいりょう",
+    "さうな",
+    "さかいし",
+    "さがす",
+    "さかな",
+    "さかみち",
+    "さがる",
+    "さぎょう",
+    "さくし",
+    "さくひん",
+    "さくら",
+    "さこく",
+    "さこつ",
+    "さずかる",
+    "ざせき",
+    "さたん",
+    "さつえい",
+    "ざつおん",
+    "ざっか",
+    "ざつがく",
+    "さっきょく",
+    "ざっし",
+    "さつじん",
+    "ざっそう",
+    "さつたば",
+    "さつまいも",
+    "さてい",
+    "さといも",
+    "さとう",
+    "さとおや",
+    "さとし",
+    "さとる",
+    "さのう",
+    "さばく",
+    "さびしい",
+    "さべつ",
+    "さほう",
+    "さほど",
+    "さます",
+    "さみしい",
+    "さみだれ",
+    "さむけ",
+    "さめる",
+    "さやえんどう",
+    "さゆう",
+    "さよう",
+    "さよく",
+    "さらだ",
+    "ざるそば",
+    "さわやか",
+    "さわる",
+    "さんいん",
+    "さんか",
+    "さんきゃく",
+    "さんこう",
+    "さんさい",
+    "ざんしょ",
+    "さんすう",
+    "さんせい",
+    "さんそ",
+    "さんち",
+    "さんま",
+    "さんみ",
+    "さんらん",
+    "しあい",
+    "しあげ",
+    "しあさって",
+    "しあわせ",
+    "しいく",
+    "しいん",
+    "しうち",
+    "しえい",
+    "しおけ",
+    "しかい",
+    "しかく",
+    "じかん",
+    "しごと",
+    "しすう",
+    "じだい",
+    "したうけ",
+    "したぎ",
+    "したて",
+    "したみ",
+    "しちょう",
+    "しちりん",
+    "しっかり",
+    "しつじ",
+    "しつもん",
+    "してい",
+    "してき",
+    "してつ",
+    "じてん",
+    "じどう",
+    "しなぎれ",
+    "しなもの",
+    "しなん",
+    "しねま",
+    "しねん",
+    "しのぐ",
+    "しのぶ",
+    "しはい",
+    "しばかり",
+    "しはつ",
+    "しはらい",
+    "しはん",
+    "しひょう",
+    "しふく",
+    "じぶん",
+    "しへい",
+    "しほう",
+    "しほん",
+    "しまう",
+    "しまる",
+    "しみん",
+    "しむける",
+    "じむしょ",
+    "しめい",
+    "しめる",
+    "しもん",
+    "しゃいん",
+    "しゃうん",
+    "しゃおん",
+    "じゃがいも",
+    "しやくしょ",
+    "しゃくほう",
+    "しゃけん",
+    "しゃこ",
+    "しゃざい",
+    "しゃしん",
+    "しゃせん",
+    "しゃそう",
+    "しゃたい",
+    "しゃちょう",
+    "しゃっきん",
+    "じゃま",
+    "しゃりん",
+    "しゃれい",
+    "じゆう",
+    "じゅうしょ",
+    "しゅくはく",
+    "じゅしん",
+    "しゅっせき",
+    "しゅみ",
+    "しゅらば",
+    "じゅんばん",
+    "しょうかい",
+    "しょくたく",
+    "しょっけん",
+    "しょどう",
+    "しょもつ",
+    "しらせる",
+    "しらべる",
+    "しんか",
+    "しんこう",
+    "じんじゃ",
+    "しんせいじ",
+    "しんちく",
+    "しんりん",
+    "すあげ",
+    "すあし",
+    "すあな",
+    "ずあん",
+    "すいえい",
+    "すいか",
+    "すいとう",
+    "ずいぶん",
+    "すいようび",
+    "すうがく",
+    "すうじつ",
+    "すうせん",
+    "すおどり",
+    "すきま",
+    "すくう",
+    "すくない",
+    "すける",
+    "すごい",
+    "すこし",
+    "ずさん",
+    "すずしい",
+    "すすむ",
+    "すすめる",
+    "すっかり",
+    "ずっしり",
+    "ずっと",
+    "すてき",
+    "すてる",
+    "すねる",
+    "すのこ",
+    "すはだ",
+    "すばらしい",
+    "ずひょう",
+    "ずぶぬれ",
+    "すぶり",
+    "すふれ",
+    "すべて",
+    "すべる",
+    "ずほう",
+    "すぼん",
+    "すまい",
+    "すめし",
+    "すもう",
+    "すやき",
+    "すらすら",
+    "するめ",
+    "すれちがう",
+    "すろっと",
+    "すわる",
+    "すんぜん",
+    "すんぽう",
+    "せあぶら",
+    "せいかつ",
+    "せいげん",
+    "せいじ",
+    "せいよう",
+    "せおう",
+    "せかいかん",
+    "せきにん",
+    "せきむ",
+    "せきゆ",
+    "せきらんうん",
+    "せけん",
+    "せこう",
+    "せすじ",
+    "せたい",
+    "せたけ",
+    "せっかく",
+    "せっきゃく",
+    "ぜっく",
+    "せっけん",
+    "せっこつ",
+    "せっさたくま",
+    "せつぞく",
+    "せつだん",
+    "せつでん",
+    "せっぱん",
+    "せつび",
+    "せつぶん",
+    "せつめい",
+    "せつりつ",
+    "せなか",
+    "せのび",
+    "せはば",
+    "せびろ",
+    "せぼね",
+    "せまい",
+    "せまる",
+    "せめる",
+    "せもたれ",
+    "せりふ",
+    "ぜんあく",
+    "せんい",
+    "せんえい",
+    "せんか",
+    "せんきょ",
+    "せんく",
+    "せんげん",
+    "ぜんご",
+    "せんさい",
+    "せんしゅ",
+    "せんすい",
+    "せんせい",
+    "せんぞ",
+    "せんたく",
+    "せんちょう",
+    "せんてい",
+    "せんとう",
+    "せんぬき",
+    "せんねん",
+    "せんぱい",
+    "ぜんぶ",
+    "ぜんぽう",
+    "せんむ",
+    "せんめんじょ",
+    "せんもん",
+    "せんやく",
+    "せんゆう",
+    "せんよう",
+    "ぜんら",
+    "ぜんりゃく",
+    "せんれい",
+    "せんろ",
+    "そあく",
+    "そいとげる",
+    "そいね",
+    "そうがんきょう",
+    "そうき",
+    "そうご",
+    "そうしん",
+    "そうだん",
+    "そうなん",
+    "そうび",
+    "そうめん",
+    "そうり",
+    "そえもの",
+    "そえん",
+    "そがい",
+    "そげき",
+    "そこう",
+    "そこそこ",
+    "そざい",
+    "そしな",
+    "そせい",
+    "そせん",
+    "そそぐ",
+    "そだてる",
+    "そつう",
+    "そつえん",
+    "そっかん",
+    "そつぎょう",
+    "そっけつ",
+    "そっこう",
+    "そっせん",
+    "そっと",
+    "そとがわ",
+    "そとづら",
+    "そなえる",
+    "そなた",
+    "そふぼ",
+    "そぼく",
+    "そぼろ",
+    "そまつ",
+    "そまる",
+    "そむく",
+    "そむりえ",
+    "そめる",
+    "そもそも",
+    "そよかぜ",
+    "そらまめ",
+    "そろう",
+    "そんかい",
+    "そんけい",
+    "そんざい",
+    "そんしつ",
+    "そんぞく",
+    "そんちょう",
+    "ぞんび",
+    "ぞんぶん",
+    "そんみん",
+    "たあい",
+    "たいいん",
+    "たいうん",
+    "たいえき",
+    "たいおう",
+    "だいがく",
+    "たいき",
+    "たいぐう",
+    "たいけん",
+    "たいこ",
+    "たいざい",
+    "だいじょうぶ",
+    "だいすき",
+    "たいせつ",
+    "たいそう",
+    "だいたい",
+    "たいちょう",
+    "たいてい",
+    "だいどころ",
+    "たいない",
+    "たいねつ",
+    "たいのう",
+    "たいはん",
+    "だいひょう",
+    "たいふう",
+    "たいへん",
+    "たいほ",
+    "たいまつばな",
+    "たいみんぐ",
+    "たいむ",
+    "たいめん",
+    "たいやき",
+    "たいよう",
+    "たいら",
+    "たいりょく",
+    "たいる",
+    "たいわん",
+    "たうえ",
+    "たえる",
+    "たおす",
+    "たおる",
+    "たおれる",
+    "たかい",
+    "たかね",
+    "たきび",
+    "たくさん",
+    "たこく",
+    "たこやき",
+    "たさい",
+    "たしざん",
+    "だじゃれ",
+    "たすける",
+    "たずさわる",
+    "たそがれ",
+    "たたかう",
+    "たたく",
+    "ただしい",
+    "たたみ",
+    "たちばな",
+    "だっかい",
+    "だっきゃく",
+    "だっこ",
+    "だっしゅつ",
+    "だったい",
+    "たてる",
+    "たとえる",
+    "たなばた",
+    "たにん",
+    "たぬき",
+    "たのしみ",
+    "たはつ",
+    "たぶん",
+    "たべる",
+    "たぼう",
+    "たまご",
+    "たまる",
+    "だむる",
+    "ためいき",
+    "ためす",
+    "ためる",
+    "たもつ",
+    "たやすい",
+    "たよる",
+    "たらす",
+    "たりきほんがん",
+    "たりょう",
+    "たりる",
+    "たると",
+    "たれる",
+    "たれんと",
+    "たろっと",
+    "たわむれる",
+    "だんあつ",
+    "たんい",
+    "たんおん",
+    "たんか",
+    "たんき",
+    "たんけん",
+    "たんご",
+    "たんさん",
+    "たんじょうび",
+    "だんせい",
+    "たんそく",
+    "たんたい",
+    "だんち",
+    "たんてい",
+    "たんとう",
+    "だんな",
+    "たんにん",
+    "だんねつ",
+    "たんのう",
+    "たんぴん",
+    "だんぼう",
+    "たんまつ",
+    "たんめい",
+    "だんれつ",
+    "だんろ",
+    "だんわ",
+    "ちあい",
+    "ちあん",
+    "ちいき",
+    "ちいさい",
+    "ちえん",
+    "ちかい",
+    "ちから",
+    "ちきゅう",
+    "ちきん",
+    "ちけいず",
+    "ちけん",
+    "ちこく",
+    "ちさい",
+    "ちしき",
+    "ちしりょう",
+    "ちせい",
+    "ちそう",
+    "ちたい",
+    "ちたん",
+    "ちちおや",
+    "ちつじょ",
+    "ちてき",
+    "ちてん",
+    "ちぬき",
+    "ちぬり",
+    "ちのう",
+    "ちひょう",
+    "ちへいせん",
+    "ちほう",
+    "ちまた",
+    "ちみつ",
+    "ちみどろ",
+    "ちめいど",
+    "ちゃんこなべ",
+    "ちゅうい",
+    "ちゆりょく",
+    "ちょうし",
+    "ちょさくけん",
+    "ちらし",
+    "ちらみ",
+    "ちりがみ",
+    "ちりょう",
+    "ちるど",
+    "ちわわ",
+    "ちんたい",
+    "ちんもく",
+    "ついか",
+    "ついたち",
+    "つうか",
+    "つうじょう",
+    "つうはん",
+    "つうわ",
+    "つかう",
+    "つかれる",
+    "つくね",
+    "つくる",
+    "つけね",
+    "つける",
+    "つごう",
+    "つたえる",
+    "つづく",
+    "つつじ",
+    "つつむ",
+    "つとめる",
+    "つながる",
+    "つなみ",
+    "つねづね",
+    "つのる",
+    "つぶす",
+    "つまらない",
+    "つまる",
+    "つみき",
+    "つめたい",
+    "つもり",
+    "つもる",
+    "つよい",
+    "つるぼ",
+    "つるみく",
+    "つわもの",
+    "つわり",
+    "てあし",
+    "てあて",
+    "てあみ",
+    "ていおん",
+    "ていか",
+    "ていき",
+    "ていけい",
+    "ていこく",
+    "ていさつ",
+    "ていし",
+    "ていせい",
+    "ていたい",
+    "ていど",
+    "ていねい",
+    "ていひょう",
+    "ていへん",
+    "ていぼう",
+    "てうち",
+    "ておくれ",
+    "てきとう",
+    "てくび",
+    "でこぼこ",
+    "てさぎょう",
+    "てさげ",
+    "てすり",
+    "てそう",
+    "てちがい",
+    "てちょう",
+    "てつがく",
+    "てつづき",
+    "でっぱ",
+    "てつぼう",
+    "てつや",
+    "でぬかえ",
+    "てぬき",
+    "てぬぐい",
+    "てのひら",
+    "てはい",
+    "てぶくろ",
+    "てふだ",
+    "てほどき",
+    "てほん",
+    "てまえ",
+    "てまきずし",
+    "てみじか",
+    "てみやげ",
+    "てらす",
+    "てれび",
+    "てわけ",
+    "てわたし",
+    "でんあつ",
+    "てんいん",
+    "てんかい",
+    "てんき",
+    "てんぐ",
+    "てんけん",
+    "てんごく",
+    "てんさい",
+    "てんし",
+    "てんすう",
+    "でんち",
+    "てんてき",
+    "てんとう",
+    "てんない",
+    "てんぷら",
+    "てんぼうだい",
+    "てんめつ",
+    "てんらんかい",
+    "でんりょく",
+    "でんわ",
+    "どあい",
+    "といれ",
+    "どうかん",
+    "とうきゅう",
+    "どうぐ",
+    "とうし",
+    "とうむぎ",
+    "とおい",
+    "とおか",
+    "とおく",
+    "とおす",
+    "とおる",
+    "とかい",
+    "とかす",
+    "ときおり",
+    "ときどき",
+    "とくい",
+    "とくしゅう",
+    "とくてん",
+    "とくに",
+    "とくべつ",
+    "とけい",
+    "とける",
+    "とこや",
+    "とさか",
+    "としょかん",
+    "とそう",
+    "とたん",
+    "とちゅう",
+    "とっきゅう",
+    "とっくん",
+    "とつぜん",
+    "とつにゅう",
+    "とどける",
+    "ととのえる",
+    "とない",
+    "となえる",
+    "となり",
+    "とのさま",
+    "とばす",
+    "どぶがわ",
+    "とほう",
+    "とまる",
+    "とめる",
+    "ともだち",
+    "ともる",
+    "どようび",
+    "とらえる",
+    "とんかつ",
+    "どんぶり",
+    "ないかく",
+    "ないこう",
+    "ないしょ",
+    "ないす",
+    "ないせん",
+    "ないそう",
+    "なおす",
+    "ながい",
+    "なくす",
+    "なげる",
+    "なこうど",
+    "なさけ",
+    "なたでここ",
+    "なっとう",
+    "なつやすみ",
+    "ななおし",
+    "なにごと",
+    "なにもの",
+    "なにわ",
+    "なのか",
+    "なふだ",
+    "なまいき",
+    "なまえ",
+    "なまみ",
+    "なみだ",
+    "なめらか",
+    "なめる",
+    "なやむ",
+    "ならう",
+    "ならび",
+    "ならぶ",
+    "なれる",
+    "なわとび",
+    "なわばり",
+    "にあう",
+    "にいがた",
+    "にうけ",
+    "におい",
+    "にかい",
+    "にがて",
+    "にきび",
+    "にくしみ",
+    "にくまん",
+    "にげる",
+    "にさんかたんそ",
+    "にしき",
+    "にせもの",
+    "にちじょう",
+    "にちようび",
+    "にっか",
+    "にっき",
+    "にっけい",
+    "にっこう",
+    "にっさん",
+    "にっしょく",
+    "にっすう",
+    "にっせき",
+    "にってい",
+    "になう",
+    "にほん",
+    "にまめ",
+    "にもつ",
+    "にやり",
+    "にゅういん",
+    "にりんしゃ",
+    "にわとり",
+    "にんい",
+    "にんか",
+    "にんき",
+    "にんげん",
+    "にんしき",
+    "にんずう",
+    "にんそう",
+    "にんたい",
+    "にんち",
+    "にんてい",
+    "にんにく",
+    "にんぷ",
+    "にんまり",
+    "にんむ",
+    "にんめい",
+    "にんよう",
+    "ぬいくぎ",
+    "ぬかす",
+    "ぬぐいとる",
+    "ぬぐう",
+    "ぬくもり",
+    "ぬすむ",
+    "ぬまえび",
+    "ぬめり",
+    "ぬらす",
+    "ぬんちゃく",
+    "ねあげ",
+    "ねいき",
+    "ねいる",
+    "ねいろ",
+    "ねぐせ",
+    "ねくたい",
+    "ねくら",
+    "ねこぜ",
+    "ねこむ",
+    "ねさげ",
+    "ねすごす",
+    "ねそべる",
+    "ねだん",
+    "ねつい",
+    "ねっしん",
+    "ねつぞう",
+    "ねったいぎょ",
+    "ねぶそく",
+    "ねふだ",
+    "ねぼう",
+    "ねほりはほり",
+    "ねまき",
+    "ねまわし",
+    "ねみみ",
+    "ねむい",
+    "ねむたい",
+    "ねもと",
+    "ねらう",
+    "ねわざ",
+    "ねんいり",
+    "ねんおし",
+    "ねんかん",
+    "ねんきん",
+    "ねんぐ",
+    "ねんざ",
+    "ねんし",
+    "ねんちゃく",
+    "ねんど",
+    "ねんぴ",
+    "ねんぶつ",
+    "ねんまつ",
+    "ねんりょう",
+    "ねんれい",
+    "のいず",
+    "のおづま",
+    "のがす",
+    "のきなみ",
+    "のこぎり",
+    "のこす",
+    "のこる",
+    "のせる",
+    "のぞく",
+    "のぞむ",
+    "のたまう",
+    "のちほど",
+    "のっく",
+    "のばす",
+    "のはら",
+    "のべる",
+    "のぼる",
+    "のみもの",
+    "のやま",
+    "のらいぬ",
+    "のらねこ",
+    "のりもの",
+    "のりゆき",
+    "のれん",
+    "のんき",
+    "ばあい",
+    "はあく",
+    "ばあさん",
+    "ばいか",
+    "ばいく",
+    "はいけん",
+    "はいご",
+    "はいしん",
+    "はいすい",
+    "はいせん",
+    "はいそう",
+    "はいち",
+    "ばいばい",
+    "はいれつ",
+    "はえる",
+    "はおる",
+    "はかい",
+    "ばかり",
+    "はかる",
+    "はくしゅ",
+    "はけん",
+    "はこぶ",
+    "はさみ",
+    "はさん",
+    "はしご",
+    "ばしょ",
+    "はしる",
+    "はせる",
+    "ぱそこん",
+    "はそん",
+    "はたん",
+    "はちみつ",
+    "はつおん",
+    "はっかく",
+    "はづき",
+    "はっきり",
+    "はっくつ",
+    "はっけん",
+    "はっこう",
+    "はっさん",
+    "はっしん",
+    "はったつ",
+    "はっちゅう",
+    "はってん",
+    "はっぴょう",
+    "はっぽう",
+    "はなす",
+    "はなび",
+    "はにかむ",
+    "はぶらし",
+    "はみがき",
+    "はむかう",
+    "はめつ",
+    "はやい",
+    "はやし",
+    "はらう",
+    "はろうぃん",
+    "はわい",
+    "はんい",
+    "はんえい",
+    "はんおん",
+    "はんかく",
+    "はんきょう",
+    "ばんぐみ",
+    "はんこ",
+    "はんしゃ",
+    "はんすう",
+    "はんだん",
+    "ぱんち",
+    "ぱんつ",
+    "はんてい",
+    "はんとし",
+    "はんのう",
+    "はんぱ",
+    "はんぶん",
+    "はんぺん",
+    "はんぼうき",
+    "はんめい",
+    "はんらん",
+    "はんろん",
+    "ひいき",
+    "ひうん",
+    "ひえる",
+    "ひかく",
+    "ひかり",
+    "ひかる",
+    "ひかん",
+    "ひくい",
+    "ひけつ",
+    "ひこうき",
+    "ひこく",
+    "ひさい",
+    "ひさしぶり",
+    "ひさん",
+    "びじゅつかん",
+    "ひしょ",
+    "ひそか",
+    "ひそむ",
+    "ひたむき",
+    "ひだり",
+    "ひたる",
+    "ひつぎ",
+    "ひっこし",
+    "ひっし",
+    "ひつじゅひん",
+    "ひっす",
+    "ひつぜん",
+    "ぴったり",
+    "ぴっちり",
+    "ひつよう",
+    "ひてい",
+    "ひとごみ",
+    "ひなまつり",
+    "ひなん",
+    "ひねる",
+    "ひはん",
+    "ひびく",
+    "ひひょう",
+    "ひほう",
+    "ひまわり",
+    "ひまん",
+    "ひみつ",
+    "ひめい",
+    "ひめじし",
+    "ひやけ",
+    "ひやす",
+    "ひよう",
+    "びょうき",
+    "ひらがな",
+    "ひらく",
+    "ひりつ",
+    "ひりょう",
+    "ひるま",
+    "ひるやすみ",
+    "ひれい",
+    "ひろい",
+    "ひろう",
+    "ひろき",
+    "ひろゆき",
+    "ひんかく",
+    "ひんけつ",
+    "ひんこん",
+    "ひんしゅ",
+    "ひんそう",
+    "ぴんち",
+    "ひんぱん",
+    "びんぼう",
+    "ふあん",
+    "ふいうち",
+    "ふうけい",
+    "ふうせん",
+    "ぷうたろう",
+    "ふうとう",
+    "ふうふ",
+    "ふえる",
+    "ふおん",
+    "ふかい",
+    "ふきん",
+    "ふくざつ",
+    "ふくぶくろ",
+    "ふこう",
+    "ふさい",
+    "ふしぎ",
+    "ふじみ",
+    "ふすま",
+    "ふせい",
+    "ふせぐ",
+    "ふそく",
+    "ぶたにく",
+    "ふたん",
+    "ふちょう",
+    "ふつう",
+    "ふつか",
+    "ふっかつ",
+    "ふっき",
+    "ふっこく",
+    "ぶどう",
+    "ふとる",
+    "ふとん",
+    "ふのう",
+    "ふはい",
+    "ふひょう",
+    "ふへん",
+    "ふまん",
+    "ふみん",
+    "ふめつ",
+    "ふめん",
+    "ふよう",
+    "ふりこ",
+    "ふりる",
+    "ふるい",
+    "ふんいき",
+    "ぶんがく",
+    "ぶんぐ",
+    "ふんしつ",
+    "ぶんせき",
+    "ふんそう",
+    "ぶんぽう",
+    "へいあん",
+    "へいおん",
+    "へいがい",
+    "へいき",
+    "へいげん",
+    "へいこう",
+    "へいさ",
+    "へいしゃ",
+    "へいせつ",
+    "へいそ",
+    "へいたく",
+    "へいてん",
+    "へいねつ",
+    "へいわ",
+    "へきが",
+    "へこむ",
+    "べにいろ",
+    "べにしょうが",
+    "へらす",
+    "へんかん",
+    "べんきょう",
+    "べんごし",
+    "へんさい",
+    "へんたい",
+    "べんり",
+    "ほあん",
+    "ほいく",
+    "ぼうぎょ",
+    "ほうこく",
+    "ほうそう",
+    "ほうほう",
+    "ほうもん",
+    "ほうりつ",
+    "ほえる",
+    "ほおん",
+    "ほかん",
+    "ほきょう",
+    "ぼきん",
+    "ほくろ",
+    "ほけつ",
+    "ほけん",
+    "ほこう",
+    "ほこる",
+    "ほしい",
+    "ほしつ",
+    "ほしゅ",
+    "ほしょう",
+    "ほせい",
+    "ほそい",
+    "ほそく",
+    "ほたて",
+    "ほたる",
+    "ぽちぶくろ",
+    "ほっきょく",
+    "ほっさ",
+    "ほったん",
+    "ほとんど",
+    "ほめる",
+    "ほんい",
+    "ほんき",
+    "ほんけ",
+    "ほんしつ",
+    "ほんやく",
+    "まいにち",
+    "まかい",
+    "まかせる",
+    "まがる",
+    "まける",
+    "まこと",
+    "まさつ",
+    "まじめ",
+    "ますく",
+    "まぜる",
+    "まつり",
+    "まとめ",
+    "まなぶ",
+    "まぬけ",
+    "まねく",
+    "まほう",
+    "まもる",
+    "まゆげ",
+    "まよう",
+    "まろやか",
+    "まわす",
+    "まわり",
+    "まわる",
+    "まんが",
+    "まんきつ",
+    "まんぞく",
+    "まんなか",
+    "みいら",
+    "みうち",
+    "みえる",
+    "みがく",
+    "みかた",
+    "みかん",
+    "みけん",
+    "みこん",
+    "みじかい",
+    "みすい",
+    "みすえる",
+    "みせる",
+    "みっか",
+    "みつかる",
+    "みつける",
+    "みてい",
+    "みとめる",
+    "みなと",
+    "みなみかさい",
+    "みねらる",
+    "みのう",
+    "みのがす",
+    "みほん",
+    "みもと",
+    "みやげ",
+    "みらい",
+    "みりょく",
+    "みわく",
+    "みんか",
+    "みんぞく",
+    "むいか",
+    "むえき",
+    "むえん",
+    "むかい",
+    "むかう",
+    "むかえ",
+    "むかし",
+    "むぎちゃ",
+    "むける",
+    "むげん",
+    "むさぼる",
+    "むしあつい",
+    "むしば",
+    "むじゅん",
+    "むしろ",
+    "むすう",
+    "むすこ",
+    "むすぶ",
+    "むすめ",
+    "むせる",
+    "むせん",
+    "むちゅう",
+    "むなしい",
+    "むのう",
+    "むやみ",
+    "むよう",
+    "むらさき",
+    "むりょう",
+    "むろん",
+    "めいあん",
+    "めいうん",
+    "めいえん",
+    "めいかく",
+    "めいきょく",
+    "めいさい",
+    "めいし",
+    "めいそう",
+    "めいぶつ",
+    "めいれい",
+    "めいわく",
+    "めぐまれる",
+    "めざす",
+    "めした",
+    "めずらしい",
+    "めだつ",
+    "めまい",
+    "めやす",
+    "めんきょ",
+    "めんせき",
+    "めんどう",
+    "もうしあげる",
+    "もうどうけん",
+    "もえる",
+    "もくし",
+    "もくてき",
+    "もくようび",
+    "もちろん",
+    "もどる",
+    "もらう",
+    "もんく",
+    "もんだい",
+    "やおや",
+    "やける",
+    "やさい",
+    "やさしい",
+    "やすい",
+    "やすたろう",
+    "やすみ",
+    "やせる",
+    "やそう",
+    "やたい",
+    "やちん",
+    "やっと",
+    "やっぱり",
+    "やぶる",
+    "やめる",
+    "ややこしい",
+    "やよい",
+    "やわらかい",
+    "ゆうき",
+    "ゆうびんきょく",
+    "ゆうべ",
+    "ゆうめい",
+    "ゆけつ",
+    "ゆしゅつ",
+    "ゆせん",
+    "ゆそう",
+    "ゆたか",
+    "ゆちゃく",
+    "ゆでる",
+    "ゆにゅう",
+    "ゆびわ",
+    "ゆらい",
+    "ゆれる",
+    "ようい",
+    "ようか",
+    "ようきゅう",
+    "ようじ",
+    "ようす",
+    "ようちえん",
+    "よかぜ",
+    "よかん",
+    "よきん",
+    "よくせい",
+    "よくぼう",
+    "よけい",
+    "よごれる",
+    "よさん",
+    "よしゅう",
+    "よそう",
+    "よそく",
+    "よっか",
+    "よてい",
+    "よどがわく",
+    "よねつ",
+    "よやく",
+    "よゆう",
+    "よろこぶ",
+    "よろしい",
+    "らいう",
+    "らくがき",
+    "らくご",
+    "らくさつ",
+    "らくだ",
+    "らしんばん",
+    "らせん",
+    "らぞく",
+    "らたい",
+    "らっか",
+    "られつ",
+    "りえき",
+    "りかい",
+    "りきさく",
+    "りきせつ",
+    "りくぐん",
+    "りくつ",
+    "りけん",
+    "りこう",
+    "りせい",
+    "りそう",
+    "りそく",
+    "りてん",
+    "りねん",
+    "りゆう",
+    "りゅうがく",
+    "りよう",
+    "りょうり",
+    "りょかん",
+    "りょくちゃ",
+    "りょこう",
+    "りりく",
+    "りれき",
+    "りろん",
+    "りんご",
+    "るいけい",
+    "るいさい",
+    "るいじ",
+    "るいせき",
+    "るすばん",
+    "るりがわら",
+    "れいかん",
+    "れいぎ",
+    "れいせい",
+    "れいぞうこ",
+    "れいとう",
+    "れいぼう",
+    "れきし",
+    "れきだい",
+    "れんあい",
+    "れんけい",
+    "れんこん",
+    "れんさい",
+    "れんしゅう",
+    "れんぞく",
+    "れんらく",
+    "ろうか",
+    "ろうご",
+    "ろうじん",
+    "ろうそく",
+    "ろくが",
+    "ろこつ",
+    "ろじうら",
+    "ろしゅつ",
+    "ろせん",
+    "ろてん",
+    "ろめん",
+    "ろれつ",
+    "ろんぎ",
+    "ろんぱ",
+    "ろんぶん",
+    "ろんり",
+    "わかす",
+    "わかめ",
+    "わかやま",
+    "わかれる",
+    "わしつ",
+    "わじまし",
+    "わすれもの",
+    "わらう",
+    "われる",
+}
+var rwords_japanese = map[string]int16{
+    "あいこくしん": 0,
+    "あいさつ": 1,
+    "あいだ": 2,
+    "あおぞら": 3,
+    "あかちゃん": 4,
+    "あきる": 5,
+    "あけがた": 6,
+    "あける": 7,
+    "あこがれる": 8,
+    "あさい": 9,
+    "あさひ": 10,
+    "あしあと": 11,
+    "あじわう": 12,
+    "あずかる": 13,
+    "あずき": 14,
+    "あそぶ": 15,
+    "あたえる": 16,
+    "あたためる": 17,
+    "あたりまえ": 18,
+    "あたる": 19,
+    "あつい": 20,
+    "あつかう": 21,
+    "あっしゅく": 22,
+    "あつまり": 23,
+    "あつめる": 24,
+    "あてな": 25,
+    "あてはまる": 26,
+    "あひる": 27,
+    "あぶら": 28,
+    "あぶる": 29,
+    "あふれる": 30,
+    "あまい": 31,
+    "あまど": 32,
+    "あまやかす": 33,
+    "あまり": 34,
+    "あみもの": 35,
+    "あめりか": 36,
+    "あやまる": 37,
+    "あゆむ": 38,
+    "あらいぐま": 39,
+    "あらし": 40,
+    "あらすじ": 41,
+    "あらためる": 42,
+    "あらゆる": 43,
+    "あらわす": 44,
+    "ありがとう": 45,
+    "あわせる": 46,
+    "あわてる": 47,
+    "あんい": 48,
+    "あんがい": 49,
+    "あんこ": 50,
+    "あんぜん": 51,
+    "あんてい": 52,
+    "あんない": 53,
+    "あんまり": 54,
+    "いいだす": 55,
+    "いおん": 56,
+    "いがい": 57,
+    "いがく": 58,
+    "いきおい": 59,
+    "いきなり": 60,
+    "いきもの": 61,
+    "いきる": 62,
+    "いくじ": 63,
+    "いくぶん": 64,
+    "いけばな": 65,
+    "いけん": 66,
+    "いこう": 67,
+    "いこく": 68,
+    "いこつ": 69,
+    "いさましい": 70,
+    "いさん": 71,
+    "いしき": 72,
+    "いじゅう": 73,
+    "いじょう": 74,
+    "いじわる": 75,
+    "いずみ": 76,
+    "いずれ": 77,
+    "いせい": 78,
+    "いせえび": 79,
+    "いせかい": 80,
+    "いせき": 81,
+    "いぜん": 82,
+    "いそうろう": 83,
+    "いそがしい": 84,
+    "いだい": 85,
+    "いだく": 86,
+    "いたずら": 87,
+    "いたみ": 88,
+    "いたりあ": 89,
+    "いちおう": 90,
+    "いちじ": 91,
+    "いちど": 92,
+    "いちば": 93,
+    "いちぶ": 94,
+    "いちりゅう": 95,
+    "いつか": 96,
+    "いっしゅん": 97,
+    "いっせい": 98,
+    "いっそう": 99,
+    "いったん": 100,
+    "いっち": 101,
+    "いってい": 102,
+    "いっぽう": 103,
+    "いてざ": 104,
+    "いてん": 105,
+    "いどう": 106,
+    "いとこ": 107,
+    "いない": 108,
+    "いなか": 109,
+    "いねむり": 110,
+    "いのち": 111,
+    "いのる": 112,
+    "いはつ": 113,
+    "いばる": 114,
+    "いはん": 115,
+    "いびき": 116,
+    "いひん": 117,
+    "いふく": 118,
+    "いへん": 119,
+    "いほう": 120,
+    "いみん": 121,
+    "いもうと": 122,
+    "いもたれ": 123,
+    "いもり": 124,
+    "いやがる": 125,
+    "いやす": 126,
+    "いよかん": 127,
+    "いよく": 128,
+    "いらい": 129,
+    "いらすと": 130,
+    "いりぐち": 131,
+    "いりょう": 132,
+    "いれい": 133,
+    "いれもの": 134,
+    "いれる": 135,
+    "いろえんぴつ": 136,
+    "いわい": 137,
+    "いわう": 138,
+    "いわかん": 139,
+    "いわば": 140,
+    "いわゆる": 141,
+    "いんげんまめ": 142,
+    "いんさつ": 143,
+    "いんしょう": 144,
+    "いんよう": 145,
+    "うえき": 146,
+    "うえる": 147,
+    "うおざ": 148,
+    "うがい": 149,
+    "うかぶ": 150,
+    "うかべる": 151,
+    "うきわ": 152,
+    "うくらいな": 153,
+    "うくれれ": 154,
+    "うけたまわる": 155,
+    "うけつけ": 156,
+    "うけとる": 157,
+    "うけもつ": 158,
+    "うける": 159,
+    "うごかす": 160,
+    "うごく": 161,
+    "うこん": 162,
+    "うさぎ": 163,
+    "うしなう": 164,
+    "うしろがみ": 165,
+    "うすい": 166,
+    "うすぎ": 167,
+    "うすぐらい": 168,
+    "うすめる": 169,
+    "うせつ": 170,
+    "うちあわせ": 171,
+    "うちがわ": 172,
+    "うちき": 173,
+    "うちゅう": 174,
+    "うっかり": 175,
+    "うつくしい": 176,
+    "うったえる": 177,
+    "うつる": 178,
+    "うどん": 179,
+    "うなぎ": 180,
+    "うなじ": 181,
+    "うなずく": 182,
+    "うなる": 183,
+    "うねる": 184,
+    "うのう": 185,
+    "うぶげ": 186,
+    "うぶごえ": 187,
+    "うまれる": 188,
+    "うめる": 189,
+    "うもう": 190,
+    "うやまう": 191,
+    "うよく": 192,
+    "うらがえす": 193,
+    "うらぐち": 194,
+    "うらない": 195,
+    "うりあげ": 196,
+    "うりきれ": 197,
+    "うるさい": 198,
+    "うれしい": 199,
+    "うれゆき": 200,
+    "うれる": 201,
+    "うろこ": 202,
+    "うわき": 203,
+    "うわさ": 204,
+    "うんこう": 205,
+    "うんちん": 206,
+    "うんてん": 207,
+    "うんどう": 208,
+    "えいえん": 209,
+    "えいが": 210,
+    "えいきょう": 211,
+    "えいご": 212,
+    "えいせい": 213,
+    "えいぶん": 214,
+    "えいよう": 215,
+    "えいわ": 216,
+    "えおり": 217,
+    "えがお": 218,
+    "えがく": 219,
+    "えきたい": 220,
+    "えくせる": 221,
+    "えしゃく": 222,
+    "えすて": 223,
+    "えつらん": 224,
+    "えのぐ": 225,
+    "えほうまき": 226,
+    "えほん": 227,
+    "えまき": 228,
+    "えもじ": 229,
+    "えもの": 230,
+    "えらい": 231,
+    "えらぶ": 232,
+    "えりあ": 233,
+    "えんえん": 234,
+    "えんかい": 235,
+    "えんぎ": 236,
+    "えんげき": 237,
+    "えんしゅう": 238,
+    "えんぜつ": 239,
+    "えんそく": 240,
+    "えんちょう": 241,
+    "えんとつ": 242,
+    "おいかける": 243,
+    "おいこす": 244,
+    "おいしい": 245,
+    "おいつく": 246,
+    "おうえん": 247,
+    "おうさま": 248,
+    "おうじ": 249,
+    "おうせつ": 250,
+    "おうたい": 251,
+    "おうふく": 252,
+    "おうべい": 253,
+    "おうよう": 254,
+    "おえる": 255,
+    "おおい": 256,
+    "おおう": 257,
+    "おおどおり": 258,
+    "おおや": 259,
+    "おおよそ": 260,
+    "おかえり": 261,
+    "おかず": 262,
+    "おがむ": 263,
+    "おかわり": 264,
+    "おぎなう": 265,
+    "おきる": 266,
+    "おくさま": 267,
+    "おくじょう": 268,
+    "おくりがな": 269,
+    "おくる": 270,
+    "おくれる": 271,
+    "おこす": 272,
+    "おこなう": 273,
+    "おこる": 274,
+    "おさえる": 275,
+    "おさない": 276,
+    "おさめる": 277,
+    "おしいれ": 278,
+    "おしえる": 279,
+    "おじぎ": 280,
+    "おじさん": 281,
+    "おしゃれ": 282,
+    "おそらく": 283,
+    "おそわる": 284,
+    "おたがい": 285,
+    "おたく": 286,
+    "おだやか": 287,
+    "おちつく": 288,
+    "おっと": 289,
+    "おつり": 290,
+    "おでかけ": 291,
+    "おとしもの": 292,
+    "おとなしい": 293,
+    "おどり": 294,
+    "おどろかす": 295,
+    "おばさん": 296,
+    "おまいり": 297,
+    "おめでとう": 298,
+    "おもいで": 299,
+    "おもう": 300,
+    "おもたい": 301,
+    "おもちゃ": 302,
+    "おやつ": 303,
+    "おやゆび": 304,
+    "およぼす": 305,
+    "おらんだ": 306,
+    "おろす": 307,
+    "おんがく": 308,
+    "おんけい": 309,
+    "おんしゃ": 310,
+    "おんせん": 311,
+    "おんだん": 312,
+    "おんちゅう": 313,
+    "おんどけい": 314,
+    "かあつ": 315,
+    "かいが": 316,
+    "がいき": 317,
+    "がいけん": 318,
+    "がいこう": 319,
+    "かいさつ": 320,
+    "かいしゃ": 321,
+    "かいすいよく": 322,
+    "かいぜん": 323,
+    "かいぞうど": 324,
+    "かいつう": 325,
+    "かいてん": 326,
+    "かいとう": 327,
+    "かいふく": 328,
+    "がいへき": 329,
+    "かいほう": 330,
+    "かいよう": 331,
+    "がいらい": 332,
+    "かいわ": 333,
+    "かえる": 334,
+    "かおり": 335,
+    "かかえる": 336,
+    "かがく": 337,
+    "かがし": 338,
+    "かがみ": 339,
+    "かくご": 340,
+    "かくとく": 341,
+    "かざる": 342,
+    "がぞう": 343,
+    "かたい": 344,
+    "かたち": 345,
+    "がちょう": 346,
+    "がっきゅう": 347,
+    "がっこう": 348,
+    "がっさん": 349,
+    "がっしょう": 350,
+    "かなざわし": 351,
+    "かのう": 352,
+    "がはく": 353,
+    "かぶか": 354,
+    "かほう": 355,
+    "かほご": 356,
+    "かまう": 357,
+    "かまぼこ": 358,
+    "かめれおん": 359,
+    "かゆい": 360,
+    "かようび": 361,
+    "からい": 362,
+    "かるい": 363,
+    "かろう": 364,
+    "かわく": 365,
+    "かわら": 366,
+    "がんか": 367,
+    "かんけい": 368,
+    "かんこう": 369,
+    "かんしゃ": 370,
+    "かんそう": 371,
+    "かんたん": 372,
+    "かんち": 373,
+    "がんばる": 374,
+    "きあい": 375,
+    "きあつ": 376,
+    "きいろ": 377,
+    "ぎいん": 378,
+    "きうい": 379,
+    "きうん": 380,
+    "きえる": 381,
+    "きおう": 382,
+    "きおく": 383,
+    "きおち": 384,
+    "きおん": 385,
+    "きかい": 386,
+    "きかく": 387,
+    "きかんしゃ": 388,
+    "ききて": 389,
+    "きくばり": 390,
+    "きくらげ": 391,
+    "きけんせい": 392,
+    "きこう": 393,
+    "きこえる": 394,
+    "きこく": 395,
+    "きさい": 396,
+    "きさく": 397,
+    "きさま": 398,
+    "きさらぎ": 399,
+    "ぎじかがく": 400,
+    "ぎしき": 401,
+    "ぎじたいけん": 402,
+    "ぎじにってい": 403,
+    "ぎじゅつしゃ": 404,
+    "きすう": 405,
+    "きせい": 406,
+    "きせき": 407,
+    "きせつ": 408,
+    "きそう": 409,
+    "きぞく": 410,
+    "きぞん": 411,
+    "きたえる": 412,
+    "きちょう": 413,
+    "きつえん": 414,
+    "ぎっちり": 415,
+    "きつつき": 416,
+    "きつね": 417,
+    "きてい": 418,
+    "きどう": 419,
+    "きどく": 420,
+    "きない": 421,
+    "きなが": 422,
+    "きなこ": 423,
+    "きぬごし": 424,
+    "きねん": 425,
+    "きのう": 426,
+    "きのした": 427,
+    "きはく": 428,
+    "きびしい": 429,
+    "きひん": 430,
+    "きふく": 431,
+    "きぶん": 432,
+    "きぼう": 433,
+    "きほん": 434,
+    "きまる": 435,
+    "きみつ": 436,
+    "きむずかしい": 437,
+    "きめる": 438,
+    "きもだめし": 439,
+    "きもち": 440,
+    "きもの": 441,
+    "きゃく": 442,
+    "きやく": 443,
+    "ぎゅうにく": 444,
+    "きよう": 445,
+    "きょうりゅう": 446,
+    "きらい": 447,
+    "きらく": 448,
+    "きりん": 449,
+    "きれい": 450,
+    "きれつ": 451,
+    "きろく": 452,
+    "ぎろん": 453,
+    "きわめる": 454,
+    "ぎんいろ": 455,
+    "きんかくじ": 456,
+    "きんじょ": 457,
+    "きんようび": 458,
+    "ぐあい": 459,
+    "くいず": 460,
+    "くうかん": 461,
+    "くうき": 462,
+    "くうぐん": 463,
+    "くうこう": 464,
+    "ぐうせい": 465,
+    "くうそう": 466,
+    "ぐうたら": 467,
+    "くうふく": 468,
+    "くうぼ": 469,
+    "くかん": 470,
+    "くきょう": 471,
+    "くげん": 472,
+    "ぐこう": 473,
+    "くさい": 474,
+    "くさき": 475,
+    "くさばな": 476,
+    "くさる": 477,
+    "くしゃみ": 478,
+    "くしょう": 479,
+    "くすのき": 480,
+    "くすりゆび": 481,
+    "くせげ": 482,
+    "くせん": 483,
+    "ぐたいてき": 484,
+    "くださる": 485,
+    "くたびれる": 486,
+    "くちこみ": 487,
+    "くちさき": 488,
+    "くつした": 489,
+    "ぐっすり": 490,
+    "くつろぐ": 491,
+    "くとうてん": 492,
+    "くどく": 493,
+    "くなん": 494,
+    "くねくね": 495,
+    "くのう": 496,
+    "くふう": 497,
+    "くみあわせ": 498,
+    "くみたてる": 499,
+    "くめる": 500,
+    "くやくしょ": 501,
+    "くらす": 502,
+    "くらべる": 503,
+    "くるま": 504,
+    "くれる": 505,
+    "くろう": 506,
+    "くわしい": 507,
+    "ぐんかん": 508,
+    "ぐんしょく": 509,
+    "ぐんたい": 510,
+    "ぐんて": 511,
+    "けあな": 512,
+    "けいかく": 513,
+    "けいけん": 514,
+    "けいこ": 515,
+    "けいさつ": 516,
+    "げいじゅつ": 517,
+    "けいたい": 518,
+    "げいのうじん": 519,
+    "けいれき": 520,
+    "けいろ": 521,
+    "けおとす": 522,
+    "けおりもの": 523,
+    "げきか": 524,
+    "げきげん": 525,
+    "げきだん": 526,
+    "げきちん": 527,
+    "げきとつ": 528,
+    "げきは": 529,
+    "げきやく": 530,
+    "げこう": 531,
+    "げこくじょう": 532,
+    "げざい": 533,
+    "けさき": 534,
+    "げざん": 535,
+    "けしき": 536,
+    "けしごむ": 537,
+    "けしょう": 538,
+    "げすと": 539,
+    "けたば": 540,
+    "けちゃっぷ": 541,
+    "けちらす": 542,
+    "けつあつ": 543,
+    "けつい": 544,
+    "けつえき": 545,
+    "けっこん": 546,
+    "けつじょ": 547,
+    "けっせき": 548,
+    "けってい": 549,
+    "けつまつ": 550,
+    "げつようび": 551,
+    "げつれい": 552,
+    "けつろん": 553,
+    "げどく": 554,
+    "けとばす": 555,
+    "けとる": 556,
+    "けなげ": 557,
+    "けなす": 558,
+    "けなみ": 559,
+    "けぬき": 560,
+    "げねつ": 561,
+    "けねん": 562,
+    "けはい": 563,
+    "げひん": 564,
+    "けぶかい": 565,
+    "げぼく": 566,
+    "けまり": 567,
+    "けみかる": 568,
+    "けむし": 569,
+    "けむり": 570,
+    "けもの": 571,
+    "けらい": 572,
+    "けろけろ": 573,
+    "けわしい": 574,
+    "けんい": 575,
+    "けんえつ": 576,
+    "けんお": 577,
+    "けんか": 578,
+    "げんき": 579,
+    "けんげん": 580,
+    "けんこう": 581,
+    "けんさく": 582,
+    "けんしゅう": 583,
+    "けんすう": 584,
+    "げんそう": 585,
+    "けんちく": 586,
+    "けんてい": 587,
+    "けんとう": 588,
+    "けんない": 589,
+    "けんにん": 590,
+    "げんぶつ": 591,
+    "けんま": 592,
+    "けんみん": 593,
+    "けんめい": 594,
+    "けんらん": 595,
+    "けんり": 596,
+    "こあくま": 597,
+    "こいぬ": 598,
+    "こいびと": 599,
+    "ごうい": 600,
+    "こうえん": 601,
+    "こうおん": 602,
+    "こうかん": 603,
+    "ごうきゅう": 604,
+    "ごうけい": 605,
+    "こうこう": 606,
+    "こうさい": 607,
+    "こうじ": 608,
+    "こうすい": 609,
+    "ごうせい": 610,
+    "こうそく": 611,
+    "こうたい": 612,
+    "こうちゃ": 613,
+    "こうつう": 614,
+    "こうてい": 615,
+    "こうどう": 616,
+    "こうない": 617,
+    "こうはい": 618,
+    "ごうほう": 619,
+    "ごうまん": 620,
+    "こうもく": 621,
+    "こうりつ": 622,
+    "こえる": 623,
+    "こおり": 624,
+    "ごかい": 625,
+    "ごがつ": 626,
+    "ごかん": 627,
+    "こくご": 628,
+    "こくさい": 629,
+    "こくとう": 630,
+    "こくない": 631,
+    "こくはく": 632,
+    "こぐま": 633,
+    "こけい": 634,
+    "こける": 635,
+    "ここのか": 636,
+    "こころ": 637,
+    "こさめ": 638,
+    "こしつ": 639,
+    "こすう": 640,
+    "こせい": 641,
+    "こせき": 642,
+    "こぜん": 643,
+    "こそだて": 644,
+    "こたい": 645,
+    "こたえる": 646,
+    "こたつ": 647,
+    "こちょう": 648,
+    "こっか": 649,
+    "こつこつ": 650,
+    "こつばん": 651,
+    "こつぶ": 652,
+    "こてい": 653,
+    "こてん": 654,
+    "ことがら": 655,
+    "ことし": 656,
+    "ことば": 657,
+    "ことり": 658,
+    "こなごな": 659,
+    "こねこね": 660,
+    "このまま": 661,
+    "このみ": 662,
+    "このよ": 663,
+    "ごはん": 664,
+    "こひつじ": 665,
+    "こふう": 666,
+    "こふん": 667,
+    "こぼれる": 668,
+    "ごまあぶら": 669,
+    "こまかい": 670,
+    "ごますり": 671,
+    "こまつな": 672,
+    "こまる": 673,
+    "こむぎこ": 674,
+    "こもじ": 675,
+    "こもち": 676,
+    "こもの": 677,
+    "こもん": 678,
+    "こやく": 679,
+    "こやま": 680,
+    "こゆう": 681,
+    "こゆび": 682,
+    "こよい": 683,
+    "こよう": 684,
+    "こりる": 685,
+    "これくしょん": 686,
+    "ころっけ": 687,
+    "こわもて": 688,
+    "こわれる": 689,
+    "こんいん": 690,
+    "こんかい": 691,
+    "こんき": 692,
+    "こんしゅう": 693,
+    "こんすい": 694,
+    "こんだて": 695,
+    "こんとん": 696,
+    "こんなん": 697,
+    "こんびに": 698,
+    "こんぽん": 699,
+    "こんまけ": 700,
+    "こんや": 701,
+    "こんれい": 702,
+    "こんわく": 703,
+    "ざいえき": 704,
+    "さいかい": 705,
+    "さいきん": 706,
+    "ざいげん": 707,
+    "ざいこ": 708,
+    "さいしょ": 709,
+    "さいせい": 710,
+    "ざいたく": 711,
+    "ざいちゅう": 712,
+    "さいてき": 713,
+    "ざいりょう": 714,
+    "さうな": 715,
+    "さかいし": 716,
+    "さがす": 717,
+    "さかな": 718,
+    "さかみち": 719,
+    "さがる": 720,
+    "さぎょう": 721,
+    "さくし": 722,
+    "さくひん": 723,
+    "さくら": 724,
+    "さこく": 725,
+    "さこつ": 726,
+    "さずかる": 727,
+    "ざせき": 728,
+    "さたん": 729,
+    "さつえい": 730,
+    "ざつおん": 731,
+    "ざっか": 732,
+    "ざつがく": 733,
+    "さっきょく": 734,
+    "ざっし": 735,
+    "さつじん": 736,
+    "ざっそう": 737,
+    "さつたば": 738,
+    "さつまいも": 739,
+    "さてい": 740,
+    "さといも": 741,
+    "さとう": 742,
+    "さとおや": 743,
+    "さとし": 744,
+    "さとる": 745,
+    "さのう": 746,
+    "さばく": 747,
+    "さびしい": 748,
+    "さべつ": 749,
+    "さほう": 750,
+    "さほど": 751,
+    "さます": 752,
+    "さみしい": 753,
+    "さみだれ": 754,
+    "さむけ": 755,
+    "さめる": 756,
+    "さやえんどう": 757,
+    "さゆう": 758,
+    "さよう": 759,
+    "さよく": 760,
+    "さらだ": 761,
+    "ざるそば": 762,
+    "さわやか": 763,
+    "さわる": 764,
+    "さんいん": 765,
+    "さんか": 766,
+    "さんきゃく": 767,
+    "さんこう": 768,
+    "さんさい": 769,
+    "ざんしょ": 770,
+    "さんすう": 771,
+    "さんせい": 772,
+    "さんそ": 773,
+    "さんち": 774,
+    "さんま": 775,
+    "さんみ": 776,
+    "さんらん": 777,
+    "しあい": 778,
+    "しあげ": 779,
+    "しあさって": 780,
+    "しあわせ": 781,
+    "しいく": 782,
+    "しいん": 783,
+    "しうち": 784,
+    "しえい": 785,
+    "しおけ": 786,
+    "しかい": 787,
+    "しかく": 788,
+    "じかん": 789,
+    "しごと": 790,
+    "しすう": 791,
+    "じだい": 792,
+    "したうけ": 793,
+    "したぎ": 794,
+    "したて": 795,
+    "したみ": 796,
+    "しちょう": 797,
+    "しちりん": 798,
+    "しっかり": 799,
+    "しつじ": 800,
+    "しつもん": 801,
+    "してい": 802,
+    "してき": 803,
+    "してつ": 804,
+    "じてん": 805,
+    "じどう": 806,
+    "しなぎれ": 807,
+    "しなもの": 808,
+    "しなん": 809,
+    "しねま": 810,
+    "しねん": 811,
+    "しのぐ": 812,
+    "しのぶ": 813,
+    "しはい": 814,
+    "しばかり": 815,
+    "しはつ": 816,
+    "しはらい": 817,
+    "しはん": 818,
+    "しひょう": 819,
+    "しふく": 820,
+    "じぶん": 821,
+    "しへい": 822,
+    "しほう": 823,
+    "しほん": 824,
+    "しまう": 825,
+    "しまる": 826,
+    "しみん": 827,
+    "しむける": 828,
+    "じむしょ": 829,
+    "しめい": 830,
+    "しめる": 831,
+    "しもん": 832,
+    "しゃいん": 833,
+    "しゃうん": 834,
+    "しゃおん": 835,
+    "じゃがいも": 836,
+    "しやくしょ": 837,
+    "しゃくほう": 838,
+    "しゃけん": 839,
+    "しゃこ": 840,
+    "しゃざい": 841,
+    "しゃしん": 842,
+    "しゃせん": 843,
+    "しゃそう": 844,
+    "しゃたい": 845,
+    "しゃちょう": 846,
+    "しゃっきん": 847,
+    "じゃま": 848,
+    "しゃりん": 849,
+    "しゃれい": 850,
+    "じゆう": 851,
+    "じゅうしょ": 852,
+    "しゅくはく": 853,
+    "じゅしん": 854,
+    "しゅっせき": 855,
+    "しゅみ": 856,
+    "しゅらば": 857,
+    "じゅんばん": 858,
+    "しょうかい": 859,
+    "しょくたく": 860,
+    "しょっけん": 861,
+    "しょどう": 862,
+    "しょもつ": 863,
+    "しらせる": 864,
+    "しらべる": 865,
+    "しんか": 866,
+    "しんこう": 867,
+    "じんじゃ": 868,
+    "しんせいじ": 869,
+    "しんちく": 870,
+    "しんりん": 871,
+    "すあげ": 872,
+    "すあし": 873,
+    "すあな": 874,
+    "ずあん": 875,
+    "すいえい": 876,
+    "すいか": 877,
+    "すいとう": 878,
+    "ずいぶん": 879,
+    "すいようび": 880,
+    "すうがく": 881,
+    "すうじつ": 882,
+    "すうせん": 883,
+    "すおどり": 884,
+    "すきま": 885,
+    "すくう": 886,
+    "すくない": 887,
+    "すける": 888,
+    "すごい": 889,
+    "すこし": 890,
+    "ずさん": 891,
+    "すずしい": 892,
+    "すすむ": 893,
+    "すすめる": 894,
+    "すっかり": 895,
+    "ずっしり": 896,
+    "ずっと": 897,
+    "すてき": 898,
+    "すてる": 899,
+    "すねる": 900,
+    "すのこ": 901,
+    "すはだ": 902,
+    "すばらしい": 903,
+    "ずひょう": 904,
+    "ずぶぬれ": 905,
+    "すぶり": 906,
+    "すふれ": 907,
+    "すべて": 908,
+    "すべる": 909,
+    "ずほう": 910,
+    "すぼん": 911,
+    "すまい": 912,
+    "すめし": 913,
+    "すもう": 914,
+    "すやき": 915,
+    "すらすら": 916,
+    "するめ": 917,
+    "すれちがう": 918,
+    "すろっと": 919,
+    "すわる": 920,
+    "すんぜん": 921,
+    "すんぽう": 922,
+    "せあぶら": 923,
+    "せいかつ": 924,
+    "せいげん": 925,
+    "せいじ": 926,
+    "せいよう": 927,
+    "せおう": 928,
+    "せかいかん": 929,
+    "せきにん": 930,
+    "せきむ": 931,
+    "せきゆ": 932,
+    "せきらんうん": 933,
+    "せけん": 934,
+    "せこう": 935,
+    "せすじ": 936,
+    "せたい": 937,
+    "せたけ": 938,
+    "せっかく": 939,
+    "せっきゃく": 940,
+    "ぜっく": 941,
+    "せっけん": 942,
+    "せっこつ": 943,
+    "せっさたくま": 944,
+    "せつぞく": 945,
+    "せつだん": 946,
+    "せつでん": 947,
+    "せっぱん": 948,
+    "せつび": 949,
+    "せつぶん": 950,
+    "せつめい": 951,
+    "せつりつ": 952,
+    "せなか": 953,
+    "せのび": 954,
+    "せはば": 955,
+    "せびろ": 956,
+    "せぼね": 957,
+    "せまい": 958,
+    "せまる": 959,
+    "せめる": 960,
+    "せもたれ": 961,
+    "せりふ": 962,
+    "ぜんあく": 963,
+    "せんい": 964,
+    "せんえい": 965,
+    "せんか": 966,
+    "せんきょ": 967,
+    "せんく": 968,
+    "せんげん": 969,
+    "ぜんご": 970,
+    "せんさい": 971,
+    "せんしゅ": 972,
+    "せんすい": 973,
+    "せんせい": 974,
+    "せんぞ": 975,
+    "せんたく": 976,
+    "せんちょう": 977,
+    "せんてい": 978,
+    "せんとう": 979,
+    "せんぬき": 980,
+    "せんねん": 981,
+    "せんぱい": 982,
+    "ぜんぶ": 983,
+    "ぜんぽう": 984,
+    "せんむ": 985,
+    "せんめんじょ": 986,
+    "せんもん": 987,
+    "せんやく": 988,
+    "せんゆう": 989,
+    "せんよう": 990,
+    "ぜんら": 991,
+    "ぜんりゃく": 992,
+    "せんれい": 993,
+    "せんろ": 994,
+    "そあく": 995,
+    "そいとげる": 996,
+    "そいね": 997,
+    "そうがんきょう": 998,
+    "そうき": 999,
+    "そうご": 1000,
+    "そうしん": 1001,
+    "そうだん": 1002,
+    "そうなん": 1003,
+    "そうび": 1004,
+    "そうめん": 1005,
+    "そうり": 1006,
+    "そえもの": 1007,
+    "そえん": 1008,
+    "そがい": 1009,
+    "そげき": 1010,
+    "そこう": 1011,
+    "そこそこ": 1012,
+    "そざい": 1013,
+    "そしな": 1014,
+    "そせい": 1015,
+    "そせん": 1016,
+    "そそぐ": 1017,
+    "そだてる": 1018,
+    "そつう": 1019,
+    "そつえん": 1020,
+    "そっかん": 1021,
+    "そつぎょう": 1022,
+    "そっけつ": 1023,
+    "そっこう": 1024,
+    "そっせん": 1025,
+    "そっと": 1026,
+    "そとがわ": 1027,
+    "そとづら": 1028,
+    "そなえる": 1029,
+    "そなた": 1030,
+    "そふぼ": 1031,
+    "そぼく": 1032,
+    "そぼろ": 1033,
+    "そまつ": 1034,
+    "そまる": 1035,
+    "そむく": 1036,
+    "そむりえ": 1037,
+    "そめる": 1038,
+    "そもそも": 1039,
+    "そよかぜ": 1040,
+    "そらまめ": 1041,
+    "そろう": 1042,
+    "そんかい": 1043,
+    "そんけい": 1044,
+    "そんざい": 1045,
+    "そんしつ": 1046,
+    "そんぞく": 1047,
+    "そんちょう": 1048,
+    "ぞんび": 1049,
+    "ぞんぶん": 1050,
+    "そんみん": 1051,
+    "たあい": 1052,
+    "たいいん": 1053,
+    "たいうん": 1054,
+    "たいえき": 1055,
+    "たいおう": 1056,
+    "だいがく": 1057,
+    "たいき": 1058,
+    "たいぐう": 1059,
+    "たいけん": 1060,
+    "たいこ": 1061,
+    "たいざい": 1062,
+    "だいじょうぶ": 1063,
+    "だいすき": 1064,
+    "たいせつ": 1065,
+    "たいそう": 1066,
+    "だいたい": 1067,
+    "たいちょう": 1068,
+    "たいてい": 1069,
+    "だいどころ": 1070,
+    "たいない": 1071,
+    "たいねつ": 1072,
+    "たいのう": 1073,
+    "たいはん": 1074,
+    "だいひょう": 1075,
+    "たいふう": 1076,
+    "たいへん": 1077,
+    "たいほ": 1078,
+    "たいまつばな": 1079,
+    "たいみんぐ": 1080,
+    "たいむ": 1081,
+    "たいめん": 1082,
+    "たいやき": 1083,
+    "たいよう": 1084,
+    "たいら": 1085,
+    "たいりょく": 1086,
+    "たいる": 1087,
+    "たいわん": 1088,
+    "たうえ": 1089,
+    "たえる": 1090,
+    "たおす": 1091,
+    "たおる": 1092,
+    "たおれる": 1093,
+    "たかい": 1094,
+    "たかね": 1095,
+    "たきび": 1096,
+    "たくさん": 1097,
+    "たこく": 1098,
+    "たこやき": 1099,
+    "たさい": 1100,
+    "たしざん": 1101,
+    "だじゃれ": 1102,
+    "たすける": 1103,
+    "たずさわる": 1104,
+    "たそがれ": 1105,
+    "たたかう": 1106,
+    "たたく": 1107,
+    "ただしい": 1108,
+    "たたみ": 1109,
+    "たちばな": 1110,
+    "だっかい": 1111,
+    "だっきゃく": 1112,
+    "だっこ": 1113,
+    "だっしゅつ": 1114,
+    "だったい": 1115,
+    "たてる": 1116,
+    "たとえる": 1117,
+    "たなばた": 1118,
+    "たにん": 1119,
+    "たぬき": 1120,
+    "たのしみ": 1121,
+    "たはつ": 1122,
+    "たぶん": 1123,
+    "たべる": 1124,
+    "たぼう": 1125,
+    "たまご": 1126,
+    "たまる": 1127,
+    "だむる": 1128,
+    "ためいき": 1129,
+    "ためす": 1130,
+    "ためる": 1131,
+    "たもつ": 1132,
+    "たやすい": 1133,
+    "たよる": 1134,
+    "たらす": 1135,
+    "たりきほんがん": 1136,
+    "たりょう": 1137,
+    "たりる": 1138,
+    "たると": 1139,
+    "たれる": 1140,
+    "たれんと": 1141,
+    "たろっと": 1142,
+    "たわむれる": 1143,
+    "だんあつ": 1144,
+    "たんい": 1145,
+    "たんおん": 1146,
+    "たんか": 1147,
+    "たんき": 1148,
+    "たんけん": 1149,
+    "たんご": 1150,
+    "たんさん": 1151,
+    "たんじょうび": 1152,
+    "だんせい": 1153,
+    "たんそく": 1154,
+    "たんたい": 1155,
+    "だんち": 1156,
+    "たんてい": 1157,
+    "たんとう": 1158,
+    "だんな": 1159,
+    "たんにん": 1160,
+    "だんねつ": 1161,
+    "たんのう": 1162,
+    "たんぴん": 1163,
+    "だんぼう": 1164,
+    "たんまつ": 1165,
+    "たんめい": 1166,
+    "だんれつ": 1167,
+    "だんろ": 1168,
+    "だんわ": 1169,
+    "ちあい": 1170,
+    "ちあん": 1171,
+    "ちいき": 1172,
+    "ちいさい": 1173,
+    "ちえん": 1174,
+    "ちかい": 1175,
+    "ちから": 1176,
+    "ちきゅう": 1177,
+    "ちきん": 1178,
+    "ちけいず": 1179,
+    "ちけん": 1180,
+    "ちこく": 1181,
+    "ちさい": 1182,
+    "ちしき": 1183,
+    "ちしりょう": 1184,
+    "ちせい": 1185,
+    "ちそう": 1186,
+    "ちたい": 1187,
+    "ちたん": 1188,
+    "ちちおや": 1189,
+    "ちつじょ": 1190,
+    "ちてき": 1191,
+    "ちてん": 1192,
+    "ちぬき": 1193,
+    "ちぬり": 1194,
+    "ちのう": 1195,
+    "ちひょう": 1196,
+    "ちへいせん": 1197,
+    "ちほう": 1198,
+    "ちまた": 1199,
+    "ちみつ": 1200,
+    "ちみどろ": 1201,
+    "ちめいど": 1202,
+    "ちゃんこなべ": 1203,
+    "ちゅうい": 1204,
+    "ちゆりょく": 1205,
+    "ちょうし": 1206,
+    "ちょさくけん": 1207,
+    "ちらし": 1208,
+    "ちらみ": 1209,
+    "ちりがみ": 1210,
+    "ちりょう": 1211,
+    "ちるど": 1212,
+    "ちわわ": 1213,
+    "ちんたい": 1214,
+    "ちんもく": 1215,
+    "ついか": 1216,
+    "ついたち": 1217,
+    "つうか": 1218,
+    "つうじょう": 1219,
+    "つうはん": 1220,
+    "つうわ": 1221,
+    "つかう": 1222,
+    "つかれる": 1223,
+    "つくね": 1224,
+    "つくる": 1225,
+    "つけね": 1226,
+    "つける": 1227,
+    "つごう": 1228,
+    "つたえる": 1229,
+    "つづく": 1230,
+    "つつじ": 1231,
+    "つつむ": 1232,
+    "つとめる": 1233,
+    "つながる": 1234,
+    "つなみ": 1235,
+    "つねづね": 1236,
+    "つのる": 1237,
+    "つぶす": 1238,
+    "つまらない": 1239,
+    "つまる": 1240,
+    "つみき": 1241,
+    "つめたい": 1242,
+    "つもり": 1243,
+    "つもる": 1244,
+    "つよい": 1245,
+    "つるぼ": 1246,
+    "つるみく": 1247,
+    "つわもの": 1248,
+    "つわり": 1249,
+    "てあし": 1250,
+    "てあて": 1251,
+    "てあみ": 1252,
+    "ていおん": 1253,
+    "ていか": 1254,
+    "ていき": 1255,
+    "ていけい": 1256,
+    "ていこく": 1257,
+    "ていさつ": 1258,
+    "ていし": 1259,
+    "ていせい": 1260,
+    "ていたい": 1261,
+    "ていど": 1262,
+    "ていねい": 1263,
+    "ていひょう": 1264,
+    "ていへん": 1265,
+    "ていぼう": 1266,
+    "てうち": 1267,
+    "ておくれ": 1268,
+    "てきとう": 1269,
+    "てくび": 1270,
+    "でこぼこ": 1271,
+    "てさぎょう": 1272,
+    "てさげ": 1273,
+    "てすり": 1274,
+    "てそう": 1275,
+    "てちがい": 1276,
+    "てちょう": 1277,
+    "てつがく": 1278,
+    "てつづき": 1279,
+    "でっぱ": 1280,
+    "てつぼう": 1281,
+    "てつや": 1282,
+    "でぬかえ": 1283,
+    "てぬき": 1284,
+    "てぬぐい": 1285,
+    "てのひら": 1286,
+    "てはい": 1287,
+    "てぶくろ": 1288,
+    "てふだ": 1289,
+    "てほどき": 1290,
+    "てほん": 1291,
+    "てまえ": 1292,
+    "てまきずし": 1293,
+    "てみじか": 1294,
+    "てみやげ": 1295,
+    "てらす": 1296,
+    "てれび": 1297,
+    "てわけ": 1298,
+    "てわたし": 1299,
+    "でんあつ": 1300,
+    "てんいん": 1301,
+    "てんかい": 1302,
+    "てんき": 1303,
+    "てんぐ": 1304,
+    "てんけん": 1305,
+    "てんごく": 1306,
+    "てんさい": 1307,
+    "てんし": 1308,
+    "てんすう": 1309,
+    "でんち": 1310,
+    "てんてき": 1311,
+    "てんとう": 1312,
+    "てんない": 1313,
+    "てんぷら": 1314,
+    "てんぼうだい": 1315,
+    "てんめつ": 1316,
+    "てんらんかい": 1317,
+    "でんりょく": 1318,
+    "でんわ": 1319,
+    "どあい": 1320,
+    "といれ": 1321,
+    "どうかん": 1322,
+    "とうきゅう": 1323,
+    "どうぐ": 1324,
+    "とうし": 1325,
+    "とうむぎ": 1326,
+    "とおい": 1327,
+    "とおか": 1328,
+    "とおく": 1329,
+    "とおす": 1330,
+    "とおる": 1331,
+    "とかい": 1332,
+    "とかす": 1333,
+    "ときおり": 1334,
+    "ときどき": 1335,
+    "とくい": 1336,
+    "とくしゅう": 1337,
+    "とくてん": 1338,
+    "とくに": 1339,
+    "とくべつ": 1340,
+    "とけい": 1341,
+    "とける": 1342,
+    "とこや": 1343,
+    "とさか": 1344,
+    "としょかん": 1345,
+    "とそう": 1346,
+    "とたん": 1347,
+    "とちゅう": 1348,
+    "とっきゅう": 1349,
+    "とっくん": 1350,
+    "とつぜん": 1351,
+    "とつにゅう": 1352,
+    "とどける": 1353,
+    "ととのえる": 1354,
+    "とない": 1355,
+    "となえる": 1356,
+    "となり": 1357,
+    "とのさま": 1358,
+    "とばす": 1359,
+    "どぶがわ": 1360,
+    "とほう": 1361,
+    "とまる": 1362,
+    "とめる": 1363,
+    "ともだち": 1364,
+    "ともる": 1365,
+    "どようび": 1366,
+    "とらえる": 1367,
+    "とんかつ": 1368,
+    "どんぶり": 1369,
+    "ないかく": 1370,
+    "ないこう": 1371,
+    "ないしょ": 1372,
+    "ないす": 1373,
+    "ないせん": 1374,
+    "ないそう": 1375,
+    "なおす": 1376,
+    "ながい": 1377,
+    "なくす": 1378,
+    "なげる": 1379,
+    "なこうど": 1380,
+    "なさけ": 1381,
+    "なたでここ": 1382,
+    "なっとう": 1383,
+    "なつやすみ": 1384,
+    "ななおし": 1385,
+    "なにごと": 1386,
+    "なにもの": 1387,
+    "なにわ": 1388,
+    "なのか": 1389,
+    "なふだ": 1390,
+    "なまいき": 1391,
+    "なまえ": 1392,
+    "なまみ": 1393,
+    "なみだ": 1394,
+    "なめらか": 1395,
+    "なめる": 1396,
+    "なやむ": 1397,
+    "ならう": 1398,
+    "ならび": 1399,
+    "ならぶ": 1400,
+    "なれる": 1401,
+    "なわとび": 1402,
+    "なわばり": 1403,
+    "にあう": 1404,
+    "にいがた": 1405,
+    "にうけ": 1406,
+    "におい": 1407,
+    "にかい": 1408,
+    "にがて": 1409,
+    "にきび": 1410,
+    "にくしみ": 1411,
+    "にくまん": 1412,
+    "にげる": 1413,
+    "にさんかたんそ": 1414,
+    "にしき": 1415,
+    "にせもの": 1416,
+    "にちじょう": 1417,
+    "にちようび": 1418,
+    "にっか": 1419,
+    "にっき": 1420,
+    "にっけい": 1421,
+    "にっこう": 1422,
+    "にっさん": 1423,
+    "にっしょく": 1424,
+    "にっすう": 1425,
+    "にっせき": 1426,
+    "にってい": 1427,
+    "になう": 1428,
+    "にほん": 1429,
+    "にまめ": 1430,
+    "にもつ": 1431,
+    "にやり": 1432,
+    "にゅういん": 1433,
+    "にりんしゃ": 1434,
+    "にわとり": 1435,
+    "にんい": 1436,
+    "にんか": 1437,
+    "にんき": 1438,
+    "にんげん": 1439,
+    "にんしき": 1440,
+    "にんずう": 1441,
+    "にんそう": 1442,
+    "にんたい": 1443,
+    "にんち": 1444,
+    "にんてい": 1445,
+    "にんにく": 1446,
+    "にんぷ": 1447,
+    "にんまり": 1448,
+    "にんむ": 1449,
+    "にんめい": 1450,
+    "にんよう": 1451,
+    "ぬいくぎ": 1452,
+    "ぬかす": 1453,
+    "ぬぐいとる": 1454,
+    "ぬぐう": 1455,
+    "ぬくもり": 1456,
+    "ぬすむ": 1457,
+    "ぬまえび": 1458,
+    "ぬめり": 1459,
+    "ぬらす": 1460,
+    "ぬんちゃく": 1461,
+    "ねあげ": 1462,
+    "ねいき": 1463,
+    "ねいる": 1464,
+    "ねいろ": 1465,
+    "ねぐせ": 1466,
+    "ねくたい": 1467,
+    "ねくら": 1468,
+    "ねこぜ": 1469,
+    "ねこむ": 1470,
+    "ねさげ": 1471,
+    "ねすごす": 1472,
+    "ねそべる": 1473,
+    "ねだん": 1474,
+    "ねつい": 1475,
+    "ねっしん": 1476,
+    "ねつぞう": 1477,
+    "ねったいぎょ": 1478,
+    "ねぶそく": 1479,
+    "ねふだ": 1480,
+    "ねぼう": 1481,
+    "ねほりはほり": 1482,
+    "ねまき": 1483,
+    "ねまわし": 1484,
+    "ねみみ": 1485,
+    "ねむい": 1486,
+    "ねむたい": 1487,
+    "ねもと": 1488,
+    "ねらう": 1489,
+    "ねわざ": 1490,
+    "ねんいり": 1491,
+    "ねんおし": 1492,
+    "ねんかん": 1493,
+    "ねんきん": 1494,
+    "ねんぐ": 1495,
+    "ねんざ": 1496,
+    "ねんし": 1497,
+    "ねんちゃく": 1498,
+    "ねんど": 1499,
+    "ねんぴ": 1500,
+    "ねんぶつ": 1501,
+    "ねんまつ": 1502,
+    "ねんりょう": 1503,
+    "ねんれい": 1504,
+    "のいず": 1505,
+    "のおづま": 1506,
+    "のがす": 1507,
+    "のきなみ": 1508,
+    "のこぎり": 1509,
+    "のこす": 1510,
+    "のこる": 1511,
+    "のせる": 1512,
+    "のぞく": 1513,
+    "のぞむ": 1514,
+    "のたまう": 1515,
+    "のちほど": 1516,
+    "のっく": 1517,
+    "のばす": 1518,
+    "のはら": 1519,
+    "のべる": 1520,
+    "のぼる": 1521,
+    "のみもの": 1522,
+    "のやま": 1523,
+    "のらいぬ": 1524,
+    "のらねこ": 1525,
+    "のりもの": 1526,
+    "のりゆき": 1527,
+    "のれん": 1528,
+    "のんき": 1529,
+    "ばあい": 1530,
+    "はあく": 1531,
+    "ばあさん": 1532,
+    "ばいか": 1533,
+    "ばいく": 1534,
+    "はいけん": 1535,
+    "はいご": 1536,
+    "はいしん": 1537,
+    "はいすい": 1538,
+    "はいせん": 1539,
+    "はいそう": 1540,
+    "はいち": 1541,
+    "ばいばい": 1542,
+    "はいれつ": 1543,
+    "はえる": 1544,
+    "はおる": 1545,
+    "はかい": 1546,
+    "ばかり": 1547,
+    "はかる": 1548,
+    "はくしゅ": 1549,
+    "はけん": 1550,
+    "はこぶ": 1551,
+    "はさみ": 1552,
+    "はさん": 1553,
+    "はしご": 1554,
+    "ばしょ": 1555,
+    "はしる": 1556,
+    "はせる": 1557,
+    "ぱそこん": 1558,
+    "はそん": 1559,
+    "はたん": 1560,
+    "はちみつ": 1561,
+    "はつおん": 1562,
+    "はっかく": 1563,
+    "はづき": 1564,
+    "はっきり": 1565,
+    "はっくつ": 1566,
+    "はっけん": 1567,
+    "はっこう": 1568,
+    "はっさん": 1569,
+    "はっしん": 1570,
+    "はったつ": 1571,
+    "はっちゅう": 1572,
+    "はってん": 1573,
+    "はっぴょう": 1574,
+    "はっぽう": 1575,
+    "はなす": 1576,
+    "はなび": 1577,
+    "はにかむ": 1578,
+    "はぶらし": 1579,
+    "はみがき": 1580,
+    "はむかう": 1581,
+    "はめつ": 1582,
+    "はやい": 1583,
+    "はやし": 1584,
+    "はらう": 1585,
+    "はろうぃん": 1586,
+    "はわい": 1587,
+    "はんい": 1588,
+    "はんえい": 1589,
+    "はんおん": 1590,
+    "はんかく": 1591,
+    "はんきょう": 1592,
+    "ばんぐみ": 1593,
+    "はんこ": 1594,
+    "はんしゃ": 1595,
+    "はんすう": 1596,
+    "はんだん": 1597,
+    "ぱんち": 1598,
+    "ぱんつ": 1599,
+    "はんてい": 1600,
+    "はんとし": 1601,
+    "はんのう": 1602,
+    "はんぱ": 1603,
+    "はんぶん": 1604,
+    "はんぺん": 1605,
+    "はんぼうき": 1606,
+    "はんめい": 1607,
+    "はんらん": 1608,
+    "はんろん": 1609,
+    "ひいき": 1610,
+    "ひうん": 1611,
+    "ひえる": 1612,
+    "ひかく": 1613,
+    "ひかり": 1614,
+    "ひかる": 1615,
+    "ひかん": 1616,
+    "ひくい": 1617,
+    "ひけつ": 1618,
+    "ひこうき": 1619,
+    "ひこく": 1620,
+    "ひさい": 1621,
+    "ひさしぶり": 1622,
+    "ひさん": 1623,
+    "びじゅつかん": 1624,
+    "ひしょ": 1625,
+    "ひそか": 1626,
+    "ひそむ": 1627,
+    "ひたむき": 1628,
+    "ひだり": 1629,
+    "ひたる": 1630,
+    "ひつぎ": 1631,
+    "ひっこし": 1632,
+    "ひっし": 1633,
+    "ひつじゅひん": 1634,
+    "ひっす": 1635,
+    "ひつぜん": 1636,
+    "ぴったり": 1637,
+    "ぴっちり": 1638,
+    "ひつよう": 1639,
+    "ひてい": 1640,
+    "ひとごみ": 1641,
+    "ひなまつり": 1642,
+    "ひなん": 1643,
+    "ひねる": 1644,
+    "ひはん": 1645,
+    "ひびく": 1646,
+    "ひひょう": 1647,
+    "ひほう": 1648,
+    "ひまわり": 1649,
+    "ひまん": 1650,
+    "ひみつ": 1651,
+    "ひめい": 1652,
+    "ひめじし": 1653,
+    "ひやけ": 1654,
+    "ひやす": 1655,
+    "ひよう": 1656,
+    "びょうき": 1657,
+    "ひらがな": 1658,
+    "ひらく": 1659,
+    "ひりつ": 1660,
+    "ひりょう": 1661,
+    "ひるま": 1662,
+    "ひるやすみ": 1663,
+    "ひれい": 1664,
+    "ひろい": 1665,
+    "ひろう": 1666,
+    "ひろき": 1667,
+    "ひろゆき": 1668,
+    "ひんかく": 1669,
+    "ひんけつ": 1670,
+    "ひんこん": 1671,
+    "ひんしゅ": 1672,
+    "ひんそう": 1673,
+    "ぴんち": 1674,
+    "ひんぱん": 1675,
+    "びんぼう": 1676,
+    "ふあん": 1677,
+    "ふいうち": 1678,
+    "ふうけい": 1679,
+    "ふうせん": 1680,
+    "ぷうたろう": 1681,
+    "ふうとう": 1682,
+    "ふうふ": 1683,
+    "ふえる": 1684,
+    "ふおん": 1685,
+    "ふかい": 1686,
+    "ふきん": 1687,
+    "ふくざつ": 1688,
+    "ふくぶくろ": 1689,
+    "ふこう": 1690,
+    "ふさい": 1691,
+    "ふしぎ": 1692,
+    "ふじみ": 1693,
+    "ふすま": 1694,
+    "ふせい": 1695,
+    "ふせぐ": 1696,
+    "ふそく": 1697,
+    "ぶたにく": 1698,
+    "ふたん": 1699,
+    "ふちょう": 1700,
+    "ふつう": 1701,
+    "ふつか": 1702,
+    "ふっかつ": 1703,
+    "ふっき": 1704,
+    "ふっこく": 1705,
+    "ぶどう": 1706,
+    "ふとる": 1707,
+    "ふとん": 1708,
+    "ふのう": 1709,
+    "ふはい": 1710,
+    "ふひょう": 1711,
+    "ふへん": 1712,
+    "ふまん": 1713,
+    "ふみん": 1714,
+    "ふめつ": 1715,
+    "ふめん": 1716,
+    "ふよう": 1717,
+    "ふりこ": 1718,
+    "ふりる": 1719,
+    "ふるい": 1720,
+    "ふんいき": 1721,
+    "ぶんがく": 1722,
+    "ぶんぐ": 1723,
+    "ふんしつ": 1724,
+    "ぶんせき": 1725,
+    "ふんそう": 1726,
+    "ぶんぽう": 1727,
+    "へいあん": 1728,
+    "へいおん": 1729,
+    "へいがい": 1730,
+    "へいき": 1731,
+    "へいげん": 1732,
+    "へいこう": 1733,
+    "へいさ": 1734,
+    "へいしゃ": 1735,
+    "へいせつ": 1736,
+    "へいそ": 1737,
+    "へいたく": 1738,
+    "へいてん": 1739,
+    "へいねつ": 1740,
+    "へいわ": 1741,
+    "へきが": 1742,
+    "へこむ": 1743,
+    "べにいろ": 1744,
+    "べにしょうが": 1745,
+    "へらす": 1746,
+    "へんかん": 1747,
+    "べんきょう": 1748,
+    "べんごし": 1749,
+    "へんさい": 1750,
+    "へんたい": 1751,
+    "べんり": 1752,
+    "ほあん": 1753,
+    "ほいく": 1754,
+    "ぼうぎょ": 1755,
+    "ほうこく": 1756,
+    "ほうそう": 1757,
+    "ほうほう": 1758,
+    "ほうもん": 1759,
+    "ほうりつ": 1760,
+    "ほえる": 1761,
+    "ほおん": 1762,
+    "ほかん": 1763,
+    "ほきょう": 1764,
+    "ぼきん": 1765,
+    "ほくろ": 1766,
+    "ほけつ": 1767,
+    "ほけん": 1768,
+    "ほこう": 1769,
+    "ほこる": 1770,
+    "ほしい": 1771,
+    "ほしつ": 1772,
+    "ほしゅ": 1773,
+    "ほしょう": 1774,
+    "ほせい": 1775,
+    "ほそい": 1776,
+    "ほそく": 1777,
+    "ほたて": 1778,
+    "ほたる": 1779,
+    "ぽちぶくろ": 1780,
+    "ほっきょく": 1781,
+    "ほっさ": 1782,
+    "ほったん": 1783,
+    "ほとんど": 1784,
+    "ほめる": 1785,
+    "ほんい": 1786,
+    "ほんき": 1787,
+    "ほんけ": 1788,
+    "ほんしつ": 1789,
+    "ほんやく": 1790,
+    "まいにち": 1791,
+    "まかい": 1792,
+    "まかせる": 1793,
+    "まがる": 1794,
+    "まける": 1795,
+    "まこと": 1796,
+    "まさつ": 1797,
+    "まじめ": 1798,
+    "ますく": 1799,
+    "まぜる": 1800,
+    "まつり": 1801,
+    "まとめ": 1802,
+    "まなぶ": 1803,
+    "まぬけ": 1804,
+    "まねく": 1805,
+    "まほう": 1806,
+    "まもる": 1807,
+    "まゆげ": 1808,
+    "まよう": 1809,
+    "まろやか": 1810,
+    "まわす": 1811,
+    "まわり": 1812,
+    "まわる": 1813,
+    "まんが": 1814,
+    "まんきつ": 1815,
+    "まんぞく": 1816,
+    "まんなか": 1817,
+    "みいら": 1818,
+    "みうち": 1819,
+    "みえる": 1820,
+    "みがく": 1821,
+    "みかた": 1822,
+    "みかん": 1823,
+    "みけん": 1824,
+    "みこん": 1825,
+    "みじかい": 1826,
+    "みすい": 1827,
+    "みすえる": 1828,
+    "みせる": 1829,
+    "みっか": 1830,
+    "みつかる": 1831,
+    "みつける": 1832,
+    "みてい": 1833,
+    "みとめる": 1834,
+    "みなと": 1835,
+    "みなみかさい": 1836,
+    "みねらる": 1837,
+    "みのう": 1838,
+    "みのがす": 1839,
+    "みほん": 1840,
+    "みもと": 1841,
+    "みやげ": 1842,
+    "みらい": 1843,
+    "みりょく": 1844,
+    "みわく": 1845,
+    "みんか": 1846,
+    "みんぞく": 1847,
+    "むいか": 1848,
+    "むえき": 1849,
+    "むえん": 1850,
+    "むかい": 1851,
+    "むかう": 1852,
+    "むかえ": 1853,
+    "むかし": 1854,
+    "むぎちゃ": 1855,
+    "むける": 1856,
+    "むげん": 1857,
+    "むさぼる": 1858,
+    "むしあつい": 1859,
+    "むしば": 1860,
+    "むじゅん": 1861,
+    "むしろ": 1862,
+    "むすう": 1863,
+    "むすこ": 1864,
+    "むすぶ": 1865,
+    "むすめ": 1866,
+    "むせる": 1867,
+    "むせん": 1868,
+    "むちゅう": 1869,
+    "むなしい": 1870,
+    "むのう": 1871,
+    "むやみ": 1872,
+    "むよう": 1873,
+    "むらさき": 1874,
+    "むりょう": 1875,
+    "むろん": 1876,
+    "めいあん": 1877,
+    "めいうん": 1878,
+    "めいえん": 1879,
+    "めいかく": 1880,
+    "めいきょく": 1881,
+    "めいさい": 1882,
+    "めいし": 1883,
+    "めいそう": 1884,
+    "めいぶつ": 1885,
+    "めいれい": 1886,
+    "めいわく": 1887,
+    "めぐまれる": 1888,
+    "めざす": 1889,
+    "めした": 1890,
+    "めずらしい": 1891,
+    "めだつ": 1892,
+    "めまい": 1893,
+    "めやす": 1894,
+    "めんきょ": 1895,
+    "めんせき": 1896,
+    "めんどう": 1897,
+    "もうしあげる": 1898,
+    "もうどうけん": 1899,
+    "もえる": 1900,
+    "もくし": 1901,
+    "もくてき": 1902,
+    "もくようび": 1903,
+    "もちろん": 1904,
+    "もどる": 1905,
+    "もらう": 1906,
+    "もんく": 1907,
+    "もんだい": 1908,
+    "やおや": 1909,
+    "やける": 1910,
+    "やさい": 1911,
+    "やさしい": 1912,
+    "やすい": 1913,
+    "やすたろう": 1914,
+    "やすみ": 1915,
+    "やせる": 1916,
+    "やそう": 1917,
+    "やたい": 1918,
+    "やちん": 1919,
+    "やっと": 1920,
+    "やっぱり": 1921,
+    "やぶる": 1922,
+    "やめる": 1923,
+    "ややこしい": 1924,
+    "やよい": 1925,
+    "やわらかい": 1926,
+    "ゆうき": 1927,
+    "ゆうびんきょく": 1928,
+    "ゆうべ": 1929,
+    "ゆうめい": 1930,
+    "ゆけつ": 1931,
+    "ゆしゅつ": 1932,
+    "ゆせん": 1933,
+    "ゆそう": 1934,
+    "ゆたか": 1935,
+    "ゆちゃく": 1936,
+    "ゆでる": 1937,
+    "ゆにゅう": 1938,
+    "ゆびわ": 1939,
+    "ゆらい": 1940,
+    "ゆれる": 1941,
+    "ようい": 1942,
+    "ようか": 1943,
+    "ようきゅう": 1944,
+    "ようじ": 1945,
+    "ようす": 1946,
+    "ようちえん": 1947,
+    "よかぜ": 1948,
+    "よかん": 1949,
+    "よきん": 1950,
+    "よくせい": 1951,
+    "よくぼう": 1952,
+    "よけい": 1953,
+    "よごれる": 1954,
+    "よさん": 1955,
+    "よしゅう": 1956,
+    "よそう": 1957,
+    "よそく": 1958,
+    "よっか": 1959,
+    "よてい": 1960,
+    "よどがわく": 1961,
+    "よねつ": 1962,
+    "よやく": 1963,
+    "よゆう": 1964,
+    "よろこぶ": 1965,
+    "よろしい": 1966,
+    "らいう": 1967,
+    "らくがき": 1968,
+    "らくご": 1969,
+    "らくさつ": 1970,
+    "らくだ": 1971,
+    "らしんばん": 1972,
+    "らせん": 1973,
+    "らぞく": 1974,
+    "らたい": 1975,
+    "らっか": 1976,
+    "られつ": 1977,
+    "りえき": 1978,
+    "りかい": 1979,
+    "りきさく": 1980,
+    "りきせつ": 1981,
+    "りくぐん": 1982,
+    "りくつ": 1983,
+    "りけん": 1984,
+    "りこう": 1985,
+    "りせい": 1986,
+    "りそう": 1987,
+    "りそく": 1988,
+    "りてん": 1989,
+    "りねん": 1990,
+    "りゆう": 1991,
+    "りゅうがく": 1992,
+    "りよう": 1993,
+    "りょうり": 1994,
+    "りょかん": 1995,
+    "りょくちゃ": 1996,
+    "りょこう": 1997,
+    "りりく": 1998,
+    "りれき": 1999,
+    "りろん": 2000,
+    "りんご": 2001,
+    "るいけい": 2002,
+    "るいさい": 2003,
+    "るいじ": 2004,
+    "るいせき": 2005,
+    "るすばん": 2006,
+    "るりがわら": 2007,
+    "れいかん": 2008,
+    "れいぎ": 2009,
+    "れいせい": 2010,
+    "れいぞうこ": 2011,
+    "れいとう": 2012,
+    "れいぼう": 2013,
+    "れきし": 2014,
+    "れきだい": 2015,
+    "れんあい": 2016,
+    "れんけい": 2017,
+    "れんこん": 2018,
+    "れんさい": 2019,
+    "れんしゅう": 2020,
+    "れんぞく": 2021,
+    "れんらく": 2022,
+    "ろうか": 2023,
+    "ろうご": 2024,
+    "ろうじん": 2025,
+    "ろうそく": 2026,
+    "ろくが": 2027,
+    "ろこつ": 2028,
+    "ろじうら": 2029,
+    "ろしゅつ": 2030,
+    "ろせん": 2031,
+    "ろてん": 2032,
+    "ろめん": 2033,
+    "ろれつ": 2034,
+    "ろんぎ": 2035,
+    "ろんぱ": 2036,
+    "ろんぶん": 2037,
+    "ろんり": 2038,
+    "わかす": 2039,
+    "わかめ": 2040,
+    "わかやま": 2041,
+    "わかれる": 2042,
+    "わしつ": 2043,
+    "わじまし": 2044,
+    "わすれもの": 2045,
+    "わらう": 2046,
+    "われる": 2047,
+}
+var words_spanish = [2048]string{
+    "ábaco",
+    "abdomen",
+    "abeja",
+    "abierto",
+    "abogado",
+    "abono",
+    "aborto",
+    "abrazo",
+    "abrir",
+    "abuelo",
+    "abuso",
+    "acabar",
+    "academia",
+    "acceso",
+    "acción",
+    "aceite",
+    "acelga",
+    "acento",
+    "aceptar",
+    "ácido",
+    "aclarar",
+    "acné",
+    "acoger",
+    "acoso",
+    "activo",
+    "acto",
+    "actriz",
+    "actuar",
+    "acudir",
+    "acuerdo",
+    "acusar",
+    "adicto",
+    "admitir",
+    "adoptar",
+    "adorno",
+    "aduana",
+    "adulto",
+    "aéreo",
+    "afectar",
+    "afición",
+    "afinar",
+    "afirmar",
+    "ágil",
+    "agitar",
+    "agonía",
+    "agosto",
+    "agotar",
+    "agregar",
+    "agrio",
+    "agua",
+    "agudo",
+    "águila",
+    "aguja",
+    "ahogo",
+    "ahorro",
+    "aire",
+    "aislar",
+    "ajedrez",
+    "ajeno",
+    "ajuste",
+    "alacrán",
+    "alambre",
+    "alarma",
+    "alba",
+    "álbum",
+    "alcalde",
+    "aldea",
+    "alegre",
+    "alejar",
+    "alerta",
+    "aleta",
+    "alfiler",
+    "alga",
+    "algodón",
+    "aliado",
+    "aliento",
+    "alivio",
+    "alma",
+    "almeja",
+    "almíbar",
+    "altar",
+    "alteza",
+    "altivo",
+    "alto",
+    "altura",
+    "alumno",
+    "alzar",
+    "amable",
+    "amante",
+    "amapola",
+    "amargo",
+    "amasar",
+    "ámbar",
+    "ámbito",
+    "ameno",
+    "amigo",
+    "amistad",
+    "amor",
+    "amparo",
+    "amplio",
+    "ancho",
+    "anciano",
+    "ancla",
+    "andar",
+    "andén",
+    "anemia",
+    "ángulo",
+    "anillo",
+    "ánimo",
+    "anís",
+    "anotar",
+    "antena",
+    "antiguo",
+    "antojo",
+    "anual",
+    "anular",
+    "anuncio",
+    "añadir",
+    "añejo",
+    "año",
+    "apagar",
+    "aparato",
+    "apetito",
+    "apio",
+    "aplicar",
+    "apodo",
+    "aporte",
+    "apoyo",
+    "aprender",
+    "aprobar",
+    "apuesta",
+    "apuro",
+    "arado",
+    "araña",
+    "arar",
+    "árbitro",
+    "árbol",
+    "arbusto",
+    "archivo",
+    "arco",
+    "arder",
+    "ardilla",
+    "arduo",
+    "área",
+    "árido",
+    "aries",
+    "armonía",
+    "arnés",
+    "aroma",
+    "arpa",
+    "arpón",
+    "arreglo",
+    "arroz",
+    "arruga",
+    "arte",
+    "artista",
+    "asa",
+    "asado",
+    "asalto",
+    "ascenso",
+    "asegurar",
+    "aseo",
+    "asesor",
+    "asiento",
+    "asilo",
+    "asistir",
+    "asno",
+    "asombro",
+    "áspero",
+    "astilla",
+    "astro",
+    "astuto",
+    "asumir",
+    "asunto",
+    "atajo",
+    "ataque",
+    "atar",
+    "atento",
+    "ateo",
+    "ático",
+    "atleta",
+    "átomo",
+    "atraer",
+    "atroz",
+    "atún",
+    "audaz",
+    "audio",
+    "auge",
+    "aula",
+    "aumento",
+    "ausente",
+    "autor",
+    "aval",
+    "avance",
+    "avaro",
+    "ave",
+    "avellana",
+    "avena",
+    "avestruz",
+    "avión",
+    "aviso",
+    "ayer",
+    "ayuda",
+    "ayuno",
+    "azafrán",
+    "azar",
+    "azote",
+    "azúcar",
+    "azufre",
+    "azul",
+    "baba",
+    "babor",
+    "bache",
+    "bahía",
+    "baile",
+    "bajar",
+    "balanza",
+    "balcón",
+    "balde",
+    "bambú",
+    "banco",
+    "banda",
+    "baño",
+    "barba",
+    "barco",
+    "barniz",
+    "barro",
+    "báscula",
+    "bastón",
+    "basura",
+    "batalla",
+    "batería",
+    "batir",
+    "batuta",
+    "baúl",
+    "bazar",
+    "bebé",
+    "bebida",
+    "bello",
+    "besar",
+    "beso",
+    "bestia",
+    "bicho",
+    "bien",
+    "bingo",
+    "blanco",
+    "bloque",
+    "blusa",
+    "boa",
+    "bobina",
+    "bobo",
+    "boca",
+    "bocina",
+    "boda",
+    "bodega",
+    "boina",
+    "bola",
+    "bolero",
+    "bolsa",
+    "bomba",
+    "bondad",
+    "bonito",
+    "bono",
+    "bonsái",
+    "borde",
+    "borrar",
+    "bosque",
+    "bote",
+    "botín",
+    "bóveda",
+    "bozal",
+    "bravo",
+    "brazo",
+    "brecha",
+    "breve",
+    "brillo",
+    "brinco",
+    "brisa",
+    "broca",
+    "broma",
+    "bronce",
+    "brote",
+    "bruja",
+    "brusco",
+    "bruto",
+    "buceo",
+    "bucle",
+    "bueno",
+    "buey",
+    "bufanda",
+    "bufón",
+    "búho",
+    "buitre",
+    "bulto",
+    "burbuja",
+    "burla",
+    "burro",
+    "buscar",
+    "butaca",
+    "buzón",
+    "caballo",
+    "cabeza",
+    "cabina",
+    "cabra",
+    "cacao",
+    "cadáver",
+    "cadena",
+    "caer",
+    "café",
+    "caída",
+    "caimán",
+    "caja",
+    "cajón",
+    "cal",
+    "calamar",
+    "calcio",
+    "caldo",
+    "calidad",
+    "calle",
+    "calma",
+    "calor",
+    "calvo",
+    "cama",
+    "cambio",
+    "camello",
+    "camino",
+    "campo",
+    "cáncer",
+    "candil",
+    "canela",
+    "canguro",
+    "canica",
+    "canto",
+    "caña",
+    "cañón",
+    "caoba",
+    "caos",
+    "capaz",
+    "capitán",
+    "capote",
+    "captar",
+    "capucha",
+    "cara",
+    "carbón",
+    "cárcel",
+    "careta",
+    "carga",
+    "cariño",
+    "carne",
+    "carpeta",
+    "carro",
+    "carta",
+    "casa",
+    "casco",
+    "casero",
+    "caspa",
+    "castor",
+    "catorce",
+    "catre",
+    "caudal",
+    "causa",
+    "cazo",
+    "cebolla",
+    "ceder",
+    "cedro",
+    "celda",
+    "célebre",
+    "celoso",
+    "célula",
+    "cemento",
+    "ceniza",
+    "centro",
+    "cerca",
+    "cerdo",
+    "cereza",
+    "cero",
+    "cerrar",
+    "certeza",
+    "césped",
+    "cetro",
+    "chacal",
+    "chaleco",
+    "champú",
+    "chancla",
+    "chapa",
+    "charla",
+    "chico",
+    "chiste",
+    "chivo",
+    "choque",
+    "choza",
+    "chuleta",
+    "chupar",
+    "ciclón",
+    "ciego",
+    "cielo",
+    "cien",
+    "cierto",
+    "cifra",
+    "cigarro",
+    "cima",
+    "cinco",
+    "cine",
+    "cinta",
+    "ciprés",
+    "circo",
+    "ciruela",
+    "cisne",
+    "cita",
+    "ciudad",
+    "clamor",
+    "clan",
+    "claro",
+    "clase",
+    "clave",
+    "cliente",
+    "clima",
+    "clínica",
+    "cobre",
+    "cocción",
+    "cochino",
+    "cocina",
+    "coco",
+    "código",
+    "codo",
+    "cofre",
+    "coger",
+    "cohete",
+    "cojín",
+    "cojo",
+    "cola",
+    "colcha",
+    "colegio",
+    "colgar",
+    "colina",
+    "collar",
+    "colmo",
+    "columna",
+    "combate",
+    "comer",
+    "comida",
+    "cómodo",
+    "compra",
+    "conde",
+    "conejo",
+    "conga",
+    "conocer",
+    "consejo",
+    "contar",
+    "copa",
+    "copia",
+    "corazón",
+    "corbata",
+    "corcho",
+    "cordón",
+    "corona",
+    "correr",
+    "coser",
+    "cosmos",
+    "costa",
+    "cráneo",
+    "cráter",
+    "crear",
+    "crecer",
+    "creído",
+    "crema",
+    "cría",
+    "crimen",
+    "cripta",
+    "crisis",
+    "cromo",
+    "crónica",
+    "croqueta",
+    "crudo",
+    "cruz",
+    "cuadro",
+    "cuarto",
+    "cuatro",
+    "cubo",
+    "cubrir",
+    "cuchara",
+    "cuello",
+    "cuento",
+    "cuerda",
+    "cuesta",
+    "cueva",
+    "cuidar",
+    "culebra",
+    "culpa",
+    "culto",
+    "cumbre",
+    "cumplir",
+    "cuna",
+    "cuneta",
+    "cuota",
+    "cupón",
+    "cúpula",
+    "curar",
+    "curioso",
+    "curso",
+    "curva",
+    "cutis",
+    "dama",
+    "danza",
+    "dar",
+    "dardo",
+    "dátil",
+    "deber",
+    "débil",
+    "década",
+    "decir",
+    "dedo",
+    "defensa",
+    "definir",
+    "dejar",
+    "delfín",
+    "delgado",
+    "delito",
+    "demora",
+    "denso",
+    "dental",
+    "deporte",
+    "derecho",
+    "derrota",
+    "desayuno",
+    "deseo",
+    "desfile",
+    "desnudo",
+    "destino",
+    "desvío",
+    "detalle",
+    "detener",
+    "deuda",
+    "día",
+    "diablo",
+    "diadema",
+    "diamante",
+    "diana",
+    "diario",
+    "dibujo",
+    "dictar",
+    "diente",
+    "dieta",
+    "diez",
+    "difícil",
+    "digno",
+    "dilema",
+    "diluir",
+    "dinero",
+    "directo",
+    "dirigir",
+    "disco",
+    "diseño",
+    "disfraz",
+    "diva",
+    "divino",
+    "doble",
+    "doce",
+    "dolor",
+    "domingo",
+    "don",
+    "donar",
+    "dorado",
+    "dormir",
+    "dorso",
+    "dos",
+    "dosis",
+    "dragón",
+    "droga",
+    "ducha",
+    "duda",
+    "duelo",
+    "dueño",
+    "dulce",
+    "dúo",
+    "duque",
+    "durar",
+    "dureza",
+    "duro",
+    "ébano",
+    "ebrio",
+    "echar",
+    "eco",
+    "ecuador",
+    "edad",
+    "edición",
+    "edificio",
+    "editor",
+    "educar",
+    "efecto",
+    "eficaz",
+    "eje",
+    "ejemplo",
+    "elefante",
+    "elegir",
+    "elemento",
+    "elevar",
+    "elipse",
+    "élite",
+    "elixir",
+    "elogio",
+    "eludir",
+    "embudo",
+    "emitir",
+    "emoción",
+    "empate",
+    "empeño",
+    "empleo",
+    "empresa",
+    "enano",
+    "encargo",
+    "enchufe",
+    "encía",
+    "enemigo",
+    "enero",
+    "enfado",
+    "enfermo",
+    "engaño",
+    "enigma",
+    "enlace",
+    "enorme",
+    "enredo",
+    "ensayo",
+    "enseñar",
+    "entero",
+    "entrar",
+    "envase",
+    "envío",
+    "época",
+    "equipo",
+    "erizo",
+    "escala",
+    "escena",
+    "escolar",
+    "escribir",
+    "escudo",
+    "esencia",
+    "esfera",
+    "esfuerzo",
+    "espada",
+    "espejo",
+    "espía",
+    "esposa",
+    "espuma",
+    "esquí",
+    "estar",
+    "este",
+    "estilo",
+    "estufa",
+    "etapa",
+    "eterno",
+    "ética",
+    "etnia",
+    "evadir",
+    "evaluar",
+    "evento",
+    "evitar",
+    "exacto",
+    "examen",
+    "exceso",
+    "excusa",
+    "exento",
+    "exigir",
+    "exilio",
+    "existir",
+    "éxito",
+    "experto",
+    "explicar",
+    "exponer",
+    "extremo",
+    "fábrica",
+    "fábula",
+    "fachada",
+    "fácil",
+    "factor",
+    "faena",
+    "faja",
+    "falda",
+    "fallo",
+    "falso",
+    "faltar",
+    "fama",
+    "familia",
+    "famoso",
+    "faraón",
+    "farmacia",
+    "farol",
+    "farsa",
+    "fase",
+    "fatiga",
+    "fauna",
+    "favor",
+    "fax",
+    "febrero",
+    "fecha",
+    "feliz",
+    "feo",
+    "feria",
+    "feroz",
+    "fértil",
+    "fervor",
+    "festín",
+    "fiable",
+    "fianza",
+    "fiar",
+    "fibra",
+    "ficción",
+    "ficha",
+    "fideo",
+    "fiebre",
+    "fiel",
+    "fiera",
+    "fiesta",
+    "figura",
+    "fijar",
+    "fijo",
+    "fila",
+    "filete",
+    "filial",
+    "filtro",
+    "fin",
+    "finca",
+    "fingir",
+    "finito",
+    "firma",
+    "flaco",
+    "flauta",
+    "flecha",
+    "flor",
+    "flota",
+    "fluir",
+    "flujo",
+    "flúor",
+    "fobia",
+    "foca",
+    "fogata",
+    "fogón",
+    "folio",
+    "folleto",
+    "fondo",
+    "forma",
+    "forro",
+    "fortuna",
+    "forzar",
+    "fosa",
+    "foto",
+    "fracaso",
+    "frágil",
+    "franja",
+    "frase",
+    "fraude",
+    "freír",
+    "freno",
+    "fresa",
+    "frío",
+    "frito",
+    "fruta",
+    "fuego",
+    "fuente",
+    "fuerza",
+    "fuga",
+    "fumar",
+    "función",
+    "funda",
+    "furgón",
+    "furia",
+    "fusil",
+    "fútbol",
+    "futuro",
+    "gacela",
+    "gafas",
+    "gaita",
+    "gajo",
+    "gala",
+    "galería",
+    "gallo",
+    "gamba",
+    "ganar",
+    "gancho",
+    "ganga",
+    "ganso",
+    "garaje",
+    "garza",
+    "gasolina",
+    "gastar",
+    "gato",
+    "gavilán",
+    "gemelo",
+    "gemir",
+    "gen",
+    "género",
+    "genio",
+    "gente",
+    "geranio",
+    "gerente",
+    "germen",
+    "gesto",
+    "gigante",
+    "gimnasio",
+    "girar",
+    "giro",
+    "glaciar",
+    "globo",
+    "gloria",
+    "gol",
+    "golfo",
+    "goloso",
+    "golpe",
+    "goma",
+    "gordo",
+    "gorila",
+    "gorra",
+    "gota",
+    "goteo",
+    "gozar",
+    "grada",
+    "gráfico",
+    "grano",
+    "grasa",
+    "gratis",
+    "grave",
+    "grieta",
+    "grillo",
+    "gripe",
+    "gris",
+    "grito",
+    "grosor",
+    "grúa",
+    "grueso",
+    "grumo",
+    "grupo",
+    "guante",
+    "guapo",
+    "guardia",
+    "guerra",
+    "guía",
+    "guiño",
+    "guion",
+    "guiso",
+    "guitarra",
+    "gusano",
+    "gustar",
+    "haber",
+    "hábil",
+    "hablar",
+    "hacer",
+    "hacha",
+    "hada",
+    "hallar",
+    "hamaca",
+    "harina",
+    "haz",
+    "hazaña",
+    "hebilla",
+    "hebra",
+    "hecho",
+    "helado",
+    "helio",
+    "hembra",
+    "herir",
+    "hermano",
+    "héroe",
+    "hervir",
+    "hielo",
+    "hierro",
+    "hígado",
+    "higiene",
+    "hijo",
+    "himno",
+    "historia",
+    "hocico",
+    "hogar",
+    "hoguera",
+    "hoja",
+    "hombre",
+    "hongo",
+    "honor",
+    "honra",
+    "hora",
+    "hormiga",
+    "horno",
+    "hostil",
+    "hoyo",
+    "hueco",
+    "huelga",
+    "huerta",
+    "hueso",
+    "huevo",
+    "huida",
+    "huir",
+    "humano",
+    "húmedo",
+    "humilde",
+    "humo",
+    "hundir",
+    "huracán",
+    "hurto",
+    "icono",
+    "ideal",
+    "idioma",
+    "ídolo",
+    "iglesia",
+    "iglú",
+    "igual",
+    "ilegal",
+    "ilusión",
+    "imagen",
+    "imán",
+    "imitar",
+    "impar",
+    "imperio",
+    "imponer",
+    "impulso",
+    "incapaz",
+    "índice",
+    "inerte",
+    "infiel",
+    "informe",
+    "ingenio",
+    "inicio",
+    "inmenso",
+    "inmune",
+    "innato",
+    "insecto",
+    "instante",
+    "interés",
+    "íntimo",
+    "intuir",
+    "inútil",
+    "invierno",
+    "ira",
+    "iris",
+    "ironía",
+    "isla",
+    "islote",
+    "jabalí",
+    "jabón",
+    "jamón",
+    "jarabe",
+    "jardín",
+    "jarra",
+    "jaula",
+    "jazmín",
+    "jefe",
+    "jeringa",
+    "jinete",
+    "jornada",
+    "joroba",
+    "joven",
+    "joya",
+    "juerga",
+    "jueves",
+    "juez",
+    "jugador",
+    "jugo",
+    "juguete",
+    "juicio",
+    "junco",
+    "jungla",
+    "junio",
+    "juntar",
+    "júpiter",
+    "jurar",
+    "justo",
+    "juvenil",
+    "juzgar",
+    "kilo",
+    "koala",
+    "labio",
+    "lacio",
+    "lacra",
+    "lado",
+    "ladrón",
+    "lagarto",
+    "lágrima",
+    "laguna",
+    "laico",
+    "lamer",
+    "lámina",
+    "lámpara",
+    "lana",
+    "lancha",
+    "langosta",
+    "lanza",
+    "lápiz",
+    "largo",
+    "larva",
+    "lástima",
+    "lata",
+    "látex",
+    "latir",
+    "laurel",
+    "lavar",
+    "lazo",
+    "leal",
+    "lección",
+    "leche",
+    "lector",
+    "leer",
+    "legión",
+    "legumbre",
+    "lejano",
+    "lengua",
+    "lento",
+    "leña",
+    "león",
+    "leopardo",
+    "lesión",
+    "letal",
+    "letra",
+    "leve",
+    "leyenda",
+    "libertad",
+    "libro",
+    "licor",
+    "líder",
+    "lidiar",
+    "lienzo",
+    "liga",
+    "ligero",
+    "lima",
+    "límite",
+    "limón",
+    "limpio",
+    "lince",
+    "lindo",
+    "línea",
+    "lingote",
+    "lino",
+    "linterna",
+    "líquido",
+    "liso",
+    "lista",
+    "litera",
+    "litio",
+    "litro",
+    "llaga",
+    "llama",
+    "llanto",
+    "llave",
+    "llegar",
+    "llenar",
+    "llevar",
+    "llorar",
+    "llover",
+    "lluvia",
+    "lobo",
+    "loción",
+    "loco",
+    "locura",
+    "lógica",
+    "logro",
+    "lombriz",
+    "lomo",
+    "lonja",
+    "lote",
+    "lucha",
+    "lucir",
+    "lugar",
+    "lujo",
+    "luna",
+    "lunes",
+    "lupa",
+    "lustro",
+    "luto",
+    "luz",
+    "maceta",
+    "macho",
+    "madera",
+    "madre",
+    "maduro",
+    "maestro",
+    "mafia",
+    "magia",
+    "mago",
+    "maíz",
+    "maldad",
+    "maleta",
+    "malla",
+    "malo",
+    "mamá",
+    "mambo",
+    "mamut",
+    "manco",
+    "mando",
+    "manejar",
+    "manga",
+    "maniquí",
+    "manjar",
+    "mano",
+    "manso",
+    "manta",
+    "mañana",
+    "mapa",
+    "máquina",
+    "mar",
+    "marco",
+    "marea",
+    "marfil",
+    "margen",
+    "marido",
+    "mármol",
+    "marrón",
+    "martes",
+    "marzo",
+    "masa",
+    "máscara",
+    "masivo",
+    "matar",
+    "materia",
+    "matiz",
+    "matriz",
+    "máximo",
+    "mayor",
+    "mazorca",
+    "mecha",
+    "medalla",
+    "medio",
+    "médula",
+    "mejilla",
+    "mejor",
+    "melena",
+    "melón",
+    "memoria",
+    "menor",
+    "mensaje",
+    "mente",
+    "menú",
+    "mercado",
+    "merengue",
+    "mérito",
+    "mes",
+    "mesón",
+    "meta",
+    "meter",
+    "método",
+    "metro",
+    "mezcla",
+    "miedo",
+    "miel",
+    "miembro",
+    "miga",
+    "mil",
+    "milagro",
+    "militar",
+    "millón",
+    "mimo",
+    "mina",
+    "minero",
+    "mínimo",
+    "minuto",
+    "miope",
+    "mirar",
+    "misa",
+    "miseria",
+    "misil",
+    "mismo",
+    "mitad",
+    "mito",
+    "mochila",
+    "moción",
+    "moda",
+    "modelo",
+    "moho",
+    "mojar",
+    "molde",
+    "moler",
+    "molino",
+    "momento",
+    "momia",
+    "monarca",
+    "moneda",
+    "monja",
+    "monto",
+    "moño",
+    "morada",
+    "morder",
+    "moreno",
+    "morir",
+    "morro",
+    "morsa",
+    "mortal",
+    "mosca",
+    "mostrar",
+    "motivo",
+    "mover",
+    "móvil",
+    "mozo",
+    "mucho",
+    "mudar",
+    "mueble",
+    "muela",
+    "muerte",
+    "muestra",
+    "mugre",
+    "mujer",
+    "mula",
+    "muleta",
+    "multa",
+    "mundo",
+    "muñeca",
+    "mural",
+    "muro",
+    "músculo",
+    "museo",
+    "musgo",
+    "música",
+    "muslo",
+    "nácar",
+    "nación",
+    "nadar",
+    "naipe",
+    "naranja",
+    "nariz",
+    "narrar",
+    "nasal",
+    "natal",
+    "nativo",
+    "natural",
+    "náusea",
+    "naval",
+    "nave",
+    "navidad",
+    "necio",
+    "néctar",
+    "negar",
+    "negocio",
+    "negro",
+    "neón",
+    "nervio",
+    "neto",
+    "neutro",
+    "nevar",
+    "nevera",
+    "nicho",
+    "nido",
+    "niebla",
+    "nieto",
+    "niñez",
+    "niño",
+    "nítido",
+    "nivel",
+    "nobleza",
+    "noche",
+    "nómina",
+    "noria",
+    "norma",
+    "norte",
+    "nota",
+    "noticia",
+    "novato",
+    "novela",
+    "novio",
+    "nube",
+    "nuca",
+    "núcleo",
+    "nudillo",
+    "nudo",
+    "nuera",
+    "nueve",
+    "nuez",
+    "nulo",
+    "número",
+    "nutria",
+    "oasis",
+    "obeso",
+    "obispo",
+    "objeto",
+    "obra",
+    "obrero",
+    "observar",
+    "obtener",
+    "obvio",
+    "oca",
+    "ocaso",
+    "océano",
+    "ochenta",
+    "ocho",
+    "ocio",
+    "ocre",
+    "octavo",
+    "octubre",
+    "oculto",
+    "ocupar",
+    "ocurrir",
+    "odiar",
+    "odio",
+    "odisea",
+    "oeste",
+    "ofensa",
+    "oferta",
+    "oficio",
+    "ofrecer",
+    "ogro",
+    "oído",
+    "oír",
+    "ojo",
+    "ola",
+    "oleada",
+    "olfato",
+    "olivo",
+    "olla",
+    "olmo",
+    "olor",
+    "olvido",
+    "ombligo",
+    "onda",
+    "onza",
+    "opaco",
+    "opción",
+    "ópera",
+    "opinar",
+    "oponer",
+    "optar",
+    "óptica",
+    "opuesto",
+    "oración",
+    "orador",
+    "oral",
+    "órbita",
+    "orca",
+    "orden",
+    "oreja",
+    "órgano",
+    "orgía",
+    "orgullo",
+    "oriente",
+    "origen",
+    "orilla",
+    "oro",
+    "orquesta",
+    "oruga",
+    "osadía",
+    "oscuro",
+    "osezno",
+    "oso",
+    "ostra",
+    "otoño",
+    "otro",
+    "oveja",
+    "óvulo",
+    "óxido",
+    "oxígeno",
+    "oyente",
+    "ozono",
+    "pacto",
+    "padre",
+    "paella",
+    "página",
+    "pago",
+    "país",
+    "pájaro",
+    "palabra",
+    "palco",
+    "paleta",
+    "pálido",
+    "palma",
+    "paloma",
+    "palpar",
+    "pan",
+    "panal",
+    "pánico",
+    "pantera",
+    "pañuelo",
+    "papá",
+    "papel",
+    "papilla",
+    "paquete",
+    "parar",
+    "parcela",
+    "pared",
+    "parir",
+    "paro",
+    "párpado",
+    "parque",
+    "párrafo",
+    "parte",
+    "pasar",
+    "paseo",
+    "pasión",
+    "paso",
+    "pasta",
+    "pata",
+    "patio",
+    "patria",
+    "pausa",
+    "pauta",
+    "pavo",
+    "payaso",
+    "peatón",
+    "pecado",
+    "pecera",
+    "pecho",
+    "pedal",
+    "pedir",
+    "pegar",
+    "peine",
+    "pelar",
+    "peldaño",
+    "pelea",
+    "peligro",
+    "pellejo",
+    "pelo",
+    "peluca",
+    "pena",
+    "pensar",
+    "peñón",
+    "peón",
+    "peor",
+    "pepino",
+    "pequeño",
+    "pera",
+    "percha",
+    "perder",
+    "pereza",
+    "perfil",
+    "perico",
+    "perla",
+    "permiso",
+    "perro",
+    "persona",
+    "pesa",
+    "pesca",
+    "pésimo",
+    "pestaña",
+    "pétalo",
+    "petróleo",
+    "pez",
+    "pezuña",
+    "picar",
+    "pichón",
+    "pie",
+    "piedra",
+    "pierna",
+    "pieza",
+    "pijama",
+    "pilar",
+    "piloto",
+    "pimienta",
+    "pino",
+    "pintor",
+    "pinza",
+    "piña",
+    "piojo",
+    "pipa",
+    "pirata",
+    "pisar",
+    "piscina",
+    "piso",
+    "pista",
+    "pitón",
+    "pizca",
+    "placa",
+    "plan",
+    "plata",
+    "playa",
+    "plaza",
+    "pleito",
+    "pleno",
+    "plomo",
+    "pluma",
+    "plural",
+    "pobre",
+    "poco",
+    "poder",
+    "podio",
+    "poema",
+    "poesía",
+    "poeta",
+    "polen",
+    "policía",
+    "pollo",
+    "polvo",
+    "pomada",
+    "pomelo",
+    "pomo",
+    "pompa",
+    "poner",
+    "porción",
+    "portal",
+    "posada",
+    "poseer",
+    "posible",
+    "poste",
+    "potencia",
+    "potro",
+    "pozo",
+    "prado",
+    "precoz",
+    "pregunta",
+    "premio",
+    "prensa",
+    "preso",
+    "previo",
+    "primo",
+    "príncipe",
+    "prisión",
+    "privar",
+    "proa",
+    "probar",
+    "proceso",
+    "producto",
+    "proeza",
+    "profesor",
+    "programa",
+    "prole",
+    "promesa",
+    "pronto",
+    "propio",
+    "próximo",
+    "prueba",
+    "público",
+    "puchero",
+    "pudor",
+    "pueblo",
+    "puerta",
+    "puesto",
+    "pulga",
+    "pulir",
+    "pulmón",
+    "pulpo",
+    "pulso",
+    "puma",
+    "punto",
+    "puñal",
+    "puño",
+    "pupa",
+    "pupila",
+    "puré",
+    "quedar",
+    "queja",
+    "quemar",
+    "querer",
+    "queso",
+    "quieto",
+    "química",
+    "quince",
+    "quitar",
+    "rábano",
+    "rabia",
+    "rabo",
+    "ración",
+    "radical",
+    "raíz",
+    "rama",
+    "rampa",
+    "rancho",
+    "rango",
+    "rapaz",
+    "rápido",
+    "rapto",
+    "rasgo",
+    "raspa",
+    "rato",
+    "rayo",
+    "raza",
+    "razón",
+    "reacción",
+    "realidad",
+    "rebaño",
+    "rebote",
+    "recaer",
+    "receta",
+    "rechazo",
+    "recoger",
+    "recreo",
+    "recto",
+    "recurso",
+    "red",
+    "redondo",
+    "reducir",
+    "reflejo",
+    "reforma",
+    "refrán",
+    "refugio",
+    "regalo",
+    "regir",
+    "regla",
+    "regreso",
+    "rehén",
+    "reino",
+    "reír",
+    "reja",
+    "relato",
+    "relevo",
+    "relieve",
+    "relleno",
+    "reloj",
+    "remar",
+    "remedio",
+    "remo",
+    "rencor",
+    "rendir",
+    "renta",
+    "reparto",
+    "repetir",
+    "reposo",
+    "reptil",
+    "res",
+    "rescate",
+    "resina",
+    "respeto",
+    "resto",
+    "resumen",
+    "retiro",
+    "retorno",
+    "retrato",
+    "reunir",
+    "revés",
+    "revista",
+    "rey",
+    "rezar",
+    "rico",
+    "riego",
+    "rienda",
+    "riesgo",
+    "rifa",
+    "rígido",
+    "rigor",
+    "rincón",
+    "riñón",
+    "río",
+    "riqueza",
+    "risa",
+    "ritmo",
+    "rito",
+    "rizo",
+    "roble",
+    "roce",
+    "rociar",
+    "rodar",
+    "rodeo",
+    "rodilla",
+    "roer",
+    "rojizo",
+    "rojo",
+    "romero",
+    "romper",
+    "ron",
+    "ronco",
+    "ronda",
+    "ropa",
+    "ropero",
+    "rosa",
+    "rosca",
+    "rostro",
+    "rotar",
+    "rubí",
+    "rubor",
+    "rudo",
+    "rueda",
+    "rugir",
+    "ruido",
+    "ruina",
+    "ruleta",
+    "rulo",
+    "rumbo",
+    "rumor",
+    "ruptura",
+    "ruta",
+    "rutina",
+    "sábado",
+    "saber",
+    "sabio",
+    "sable",
+    "sacar",
+    "sagaz",
+    "sagrado",
+    "sala",
+    "saldo",
+    "salero",
+    "salir",
+    "salmón",
+    "salón",
+    "salsa",
+    "salto",
+    "salud",
+    "salvar",
+    "samba",
+    "sanción",
+    "sandía",
+    "sanear",
+    "sangre",
+    "sanidad",
+    "sano",
+    "santo",
+    "sapo",
+    "saque",
+    "sardina",
+    "sartén",
+    "sastre",
+    "satán",
+    "sauna",
+    "saxofón",
+    "sección",
+    "seco",
+    "secreto",
+    "secta",
+    "sed",
+    "seguir",
+    "seis",
+    "sello",
+    "selva",
+    "semana",
+    "semilla",
+    "senda",
+    "sensor",
+    "señal",
+    "señor",
+    "separar",
+    "sepia",
+    "sequía",
+    "ser",
+    "serie",
+    "sermón",
+    "servir",
+    "sesenta",
+    "sesión",
+    "seta",
+    "setenta",
+    "severo",
+    "sexo",
+    "sexto",
+    "sidra",
+    "siesta",
+    "siete",
+    "siglo",
+    "signo",
+    "sílaba",
+    "silbar",
+    "silencio",
+    "silla",
+    "símbolo",
+    "simio",
+    "sirena",
+    "sistema",
+    "sitio",
+    "situar",
+    "sobre",
+    "socio",
+    "sodio",
+    "sol",
+    "solapa",
+    "soldado",
+    "soledad",
+    "sólido",
+    "soltar",
+    "solución",
+    "sombra",
+    "sondeo",
+    "sonido",
+    "sonoro",
+    "sonrisa",
+    "sopa",
+    "soplar",
+    "soporte",
+    "sordo",
+    "sorpresa",
+    "sorteo",
+    "sostén",
+    "sótano",
+    "suave",
+    "subir",
+    "suceso",
+    "sudor",
+    "suegra",
+    "suelo",
+    "sueño",
+    "suerte",
+    "sufrir",
+    "sujeto",
+    "sultán",
+    "sumar",
+    "superar",
+    "suplir",
+    "suponer",
+    "supremo",
+    "sur",
+    "surco",
+    "sureño",
+    "surgir",
+    "susto",
+    "sutil",
+    "tabaco",
+    "tabique",
+    "tabla",
+    "tabú",
+    "taco",
+    "tacto",
+    "tajo",
+    "talar",
+    "talco",
+    "talento",
+    "talla",
+    "talón",
+    "tamaño",
+    "tambor",
+    "tango",
+    "tanque",
+    "tapa",
+    "tapete",
+    "tapia",
+    "tapón",
+    "taquilla",
+    "tarde",
+    "tarea",
+    "tarifa",
+    "tarjeta",
+    "tarot",
+    "tarro",
+    "tarta",
+    "tatuaje",
+    "tauro",
+    "taza",
+    "tazón",
+    "teatro",
+    "techo",
+    "tecla",
+    "técnica",
+    "tejado",
+    "tejer",
+    "tejido",
+    "tela",
+    "teléfono",
+    "tema",
+    "temor",
+    "templo",
+    "tenaz",
+    "tender",
+    "tener",
+    "tenis",
+    "tenso",
+    "teoría",
+    "terapia",
+    "terco",
+    "término",
+    "ternura",
+    "terror",
+    "tesis",
+    "tesoro",
+    "testigo",
+    "tetera",
+    "texto",
+    "tez",
+    "tibio",
+    "tiburón",
+    "tiempo",
+    "tienda",
+    "tierra",
+    "tieso",
+    "tigre",
+    "tijera",
+    "tilde",
+    "timbre",
+    "tímido",
+    "timo",
+    "tinta",
+    "tío",
+    "típico",
+    "tipo",
+    "tira",
+    "tirón",
+    "titán",
+    "títere",
+    "título",
+    "tiza",
+    "toalla",
+    "tobillo",
+    "tocar",
+    "tocino",
+    "todo",
+    "toga",
+    "toldo",
+    "tomar",
+    "tono",
+    "tonto",
+    "topar",
+    "tope",
+    "toque",
+    "tórax",
+    "torero",
+    "tormenta",
+    "torneo",
+    "toro",
+    "torpedo",
+    "torre",
+    "torso",
+    "tortuga",
+    "tos",
+    "tosco",
+    "toser",
+    "tóxico",
+    "trabajo",
+    "tractor",
+    "traer",
+    "tráfico",
+    "trago",
+    "traje",
+    "tramo",
+    "trance",
+    "trato",
+    "trauma",
+    "trazar",
+    "trébol",
+    "tregua",
+    "treinta",
+    "tren",
+    "trepar",
+    "tres",
+    "tribu",
+    "trigo",
+    "tripa",
+    "triste",
+    "triunfo",
+    "trofeo",
+    "trompa",
+    "tronco",
+    "tropa",
+    "trote",
+    "trozo",
+    "truco",
+    "trueno",
+    "trufa",
+    "tubería",
+    "tubo",
+    "tuerto",
+    "tumba",
+    "tumor",
+    "túnel",
+    "túnica",
+    "turbina",
+    "turismo",
+    "turno",
+    "tutor",
+    "ubicar",
+    "úlcera",
+    "umbral",
+    "unidad",
+    "unir",
+    "universo",
+    "uno",
+    "untar",
+    "uña",
+    "urbano",
+    "urbe",
+    "urgente",
+    "urna",
+    "usar",
+    "usuario",
+    "útil",
+    "utopía",
+    "uva",
+    "vaca",
+    "vacío",
+    "vacuna",
+    "vagar",
+    "vago",
+    "vaina",
+    "vajilla",
+    "vale",
+    "válido",
+    "valle",
+    "valor",
+    "válvula",
+    "vampiro",
+    "vara",
+    "variar",
+    "varón",
+    "vaso",
+    "vecino",
+    "vector",
+    "vehículo",
+    "veinte",
+    "vejez",
+    "vela",
+    "velero",
+    "veloz",
+    "vena",
+    "vencer",
+    "venda",
+    "veneno",
+    "vengar",
+    "venir",
+    "venta",
+    "venus",
+    "ver",
+    "verano",
+    "verbo",
+    "verde",
+    "vereda",
+    "verja",
+    "verso",
+    "verter",
+    "vía",
+    "viaje",
+    "vibrar",
+    "vicio",
+    "víctima",
+    "vida",
+    "vídeo",
+    "vidrio",
+    "viejo",
+    "viernes",
+    "vigor",
+    "vil",
+    "villa",
+    "vinagre",
+    "vino",
+    "viñedo",
+    "violín",
+    "viral",
+    "virgo",
+    "virtud",
+    "visor",
+    "víspera",
+    "vista",
+    "vitamina",
+    "viudo",
+    "vivaz",
+    "vivero",
+    "vivir",
+    "vivo",
+    "volcán",
+    "volumen",
+    "volver",
+    "voraz",
+    "votar",
+    "voto",
+    "voz",
+    "vuelo",
+    "vulgar",
+    "yacer",
+    "yate",
+    "yegua",
+    "yema",
+    "yerno",
+    "yeso",
+    "yodo",
+    "yoga",
+    "yogur",
+    "zafiro",
+    "zanja",
+    "zapato",
+    "zarza",
+    "zona",
+    "zorro",
+    "zumo",
+    "zurdo",
+}
+var rwords_spanish = map[string]int16{
+    "ábaco": 0,
+    "abdomen": 1,
+    "abeja": 2,
+    "abierto": 3,
+    "abogado": 4,
+    "abono": 5,
+    "aborto": 6,
+    "abrazo": 7,
+    "abrir": 8,
+    "abuelo": 9,
+    "abuso": 10,
+    "acabar": 11,
+    "academia": 12,
+    "acceso": 13,
+    "acción": 14,
+    "aceite": 15,
+    "acelga": 16,
+    "acento": 17,
+    "aceptar": 18,
+    "ácido": 19,
+    "aclarar": 20,
+    "acné": 21,
+    "acoger": 22,
+    "acoso": 23,
+    "activo": 24,
+    "acto": 25,
+    "actriz": 26,
+    "actuar": 27,
+    "acudir": 28,
+    "acuerdo": 29,
+    "acusar": 30,
+    "adicto": 31,
+    "admitir": 32,
+    "adoptar": 33,
+    "adorno": 34,
+    "aduana": 35,
+    "adulto": 36,
+    "aéreo": 37,
+    "afectar": 38,
+    "afición": 39,
+    "afinar": 40,
+    "afirmar": 41,
+    "ágil": 42,
+    "agitar": 43,
+    "agonía": 44,
+    "agosto": 45,
+    "agotar": 46,
+    "agregar": 47,
+    "agrio": 48,
+    "agua": 49,
+    "agudo": 50,
+    "águila": 51,
+    "aguja": 52,
+    "ahogo": 53,
+    "ahorro": 54,
+    "aire": 55,
+    "aislar": 56,
+    "ajedrez": 57,
+    "ajeno": 58,
+    "ajuste": 59,
+    "alacrán": 60,
+    "alambre": 61,
+    "alarma": 62,
+    "alba": 63,
+    "álbum": 64,
+    "alcalde": 65,
+    "aldea": 66,
+    "alegre": 67,
+    "alejar": 68,
+    "alerta": 69,
+    "aleta": 70,
+    "alfiler": 71,
+    "alga": 72,
+    "algodón": 73,
+    "aliado": 74,
+    "aliento": 75,
+    "alivio": 76,
+    "alma": 77,
+    "almeja": 78,
+    "almíbar": 79,
+    "altar": 80,
+    "alteza": 81,
+    "altivo": 82,
+    "alto": 83,
+    "altura": 84,
+    "alumno": 85,
+    "alzar": 86,
+    "amable": 87,
+    "amante": 88,
+    "amapola": 89,
+    "amargo": 90,
+    "amasar": 91,
+    "ámbar": 92,
+    "ámbito": 93,
+    "ameno": 94,
+    "amigo": 95,
+    "amistad": 96,
+    "amor": 97,
+    "amparo": 98,
+    "amplio": 99,
+    "ancho": 100,
+    "anciano": 101,
+    "ancla": 102,
+    "andar": 103,
+    "andén": 104,
+    "anemia": 105,
+    "ángulo": 106,
+    "anillo": 107,
+    "ánimo": 108,
+    "anís": 109,
+    "anotar": 110,
+    "antena": 111,
+    "antiguo": 112,
+    "antojo": 113,
+    "anual": 114,
+    "anular": 115,
+    "anuncio": 116,
+    "añadir": 117,
+    "añejo": 118,
+    "año": 119,
+    "apagar": 120,
+    "aparato": 121,
+    "apetito": 122,
+    "apio": 123,
+    "aplicar": 124,
+    "apodo": 125,
+    "aporte": 126,
+    "apoyo": 127,
+    "aprender": 128,
+    "aprobar": 129,
+    "apuesta": 130,
+    "apuro": 131,
+    "arado": 132,
+    "araña": 133,
+    "arar": 134,
+    "árbitro": 135,
+    "árbol": 136,
+    "arbusto": 137,
+    "archivo": 138,
+    "arco": 139,
+    "arder": 140,
+    "ardilla": 141,
+    "arduo": 142,
+    "área": 143,
+    "árido": 144,
+    "aries": 145,
+    "armonía": 146,
+    "arnés": 147,
+    "aroma": 148,
+    "arpa": 149,
+    "arpón": 150,
+    "arreglo": 151,
+    "arroz": 152,
+    "arruga": 153,
+    "arte": 154,
+    "artista": 155,
+    "asa": 156,
+    "asado": 157,
+    "asalto": 158,
+    "ascenso": 159,
+    "asegurar": 160,
+    "aseo": 161,
+    "asesor": 162,
+    "asiento": 163,
+    "asilo": 164,
+    "asistir": 165,
+    "asno": 166,
+    "asombro": 167,
+    "áspero": 168,
+    "astilla": 169,
+    "astro": 170,
+    "astuto": 171,
+    "asumir": 172,
+    "asunto": 173,
+    "atajo": 174,
+    "ataque": 175,
+    "atar": 176,
+    "atento": 177,
+    "ateo": 178,
+    "ático": 179,
+    "atleta": 180,
+    "átomo": 181,
+    "atraer": 182,
+    "atroz": 183,
+    "atún": 184,
+    "audaz": 185,
+    "audio": 186,
+    "auge": 187,
+    "aula": 188,
+    "aumento": 189,
+    "ausente": 190,
+    "autor": 191,
+    "aval": 192,
+    "avance": 193,
+    "avaro": 194,
+    "ave": 195,
+    "avellana": 196,
+    "avena": 197,
+    "avestruz": 198,
+    "avión": 199,
+    "aviso": 200,
+    "ayer": 201,
+    "ayuda": 202,
+    "ayuno": 203,
+    "azafrán": 204,
+    "azar": 205,
+    "azote": 206,
+    "azúcar": 207,
+    "azufre": 208,
+    "azul": 209,
+    "baba": 210,
+    "babor": 211,
+    "bache": 212,
+    "bahía": 213,
+    "baile": 214,
+    "bajar": 215,
+    "balanza": 216,
+    "balcón": 217,
+    "balde": 218,
+    "bambú": 219,
+    "banco": 220,
+    "banda": 221,
+    "baño": 222,
+    "barba": 223,
+    "barco": 224,
+    "barniz": 225,
+    "barro": 226,
+    "báscula": 227,
+    "bastón": 228,
+    "basura": 229,
+    "batalla": 230,
+    "batería": 231,
+    "batir": 232,
+    "batuta": 233,
+    "baúl": 234,
+    "bazar": 235,
+    "bebé": 236,
+    "bebida": 237,
+    "bello": 238,
+    "besar": 239,
+    "beso": 240,
+    "bestia": 241,
+    "bicho": 242,
+    "bien": 243,
+    "bingo": 244,
+    "blanco": 245,
+    "bloque": 246,
+    "blusa": 247,
+    "boa": 248,
+    "bobina": 249,
+    "bobo": 250,
+    "boca": 251,
+    "bocina": 252,
+    "boda": 253,
+    "bodega": 254,
+    "boina": 255,
+    "bola": 256,
+    "bolero": 257,
+    "bolsa": 258,
+    "bomba": 259,
+    "bondad": 260,
+    "bonito": 261,
+    "bono": 262,
+    "bonsái": 263,
+    "borde": 264,
+    "borrar": 265,
+    "bosque": 266,
+    "bote": 267,
+    "botín": 268,
+    "bóveda": 269,
+    "bozal": 270,
+    "bravo": 271,
+    "brazo": 272,
+    "brecha": 273,
+    "breve": 274,
+    "brillo": 275,
+    "brinco": 276,
+    "brisa": 277,
+    "broca": 278,
+    "broma": 279,
+    "bronce": 280,
+    "brote": 281,
+    "bruja": 282,
+    "brusco": 283,
+    "bruto": 284,
+    "buceo": 285,
+    "bucle": 286,
+    "bueno": 287,
+    "buey": 288,
+    "bufanda": 289,
+    "bufón": 290,
+    "búho": 291,
+    "buitre": 292,
+    "bulto": 293,
+    "burbuja": 294,
+    "burla": 295,
+    "burro": 296,
+    "buscar": 297,
+    "butaca": 298,
+    "buzón": 299,
+    "caballo": 300,
+    "cabeza": 301,
+    "cabina": 302,
+    "cabra": 303,
+    "cacao": 304,
+    "cadáver": 305,
+    "cadena": 306,
+    "caer": 307,
+    "café": 308,
+    "caída": 309,
+    "caimán": 310,
+    "caja": 311,
+    "cajón": 312,
+    "cal": 313,
+    "calamar": 314,
+    "calcio": 315,
+    "caldo": 316,
+    "calidad": 317,
+    "calle": 318,
+    "calma": 319,
+    "calor": 320,
+    "calvo": 321,
+    "cama": 322,
+    "cambio": 323,
+    "camello": 324,
+    "camino": 325,
+    "campo": 326,
+    "cáncer": 327,
+    "candil": 328,
+    "canela": 329,
+    "canguro": 330,
+    "canica": 331,
+    "canto": 332,
+    "caña": 333,
+    "cañón": 334,
+    "caoba": 335,
+    "caos": 336,
+    "capaz": 337,
+    "capitán": 338,
+    "capote": 339,
+    "captar": 340,
+    "capucha": 341,
+    "cara": 342,
+    "carbón": 343,
+    "cárcel": 344,
+    "careta": 345,
+    "carga": 346,
+    "cariño": 347,
+    "carne": 348,
+    "carpeta": 349,
+    "carro": 350,
+    "carta": 351,
+    "casa": 352,
+    "casco": 353,
+    "casero": 354,
+    "caspa": 355,
+    "castor": 356,
+    "catorce": 357,
+    "catre": 358,
+    "caudal": 359,
+    "causa": 360,
+    "cazo": 361,
+    "cebolla": 362,
+    "ceder": 363,
+    "cedro": 364,
+    "celda": 365,
+    "célebre": 366,
+    "celoso": 367,
+    "célula": 368,
+    "cemento": 369,
+    "ceniza": 370,
+    "centro": 371,
+    "cerca": 372,
+    "cerdo": 373,
+    "cereza": 374,
+    "cero": 375,
+    "cerrar": 376,
+    "certeza": 377,
+    "césped": 378,
+    "cetro": 379,
+    "chacal": 380,
+    "chaleco": 381,
+    "champú": 382,
+    "chancla": 383,
+    "chapa": 384,
+    "charla": 385,
+    "chico": 386,
+    "chiste": 387,
+    "chivo": 388,
+    "choque": 389,
+    "choza": 390,
+    "chuleta": 391,
+    "chupar": 392,
+    "ciclón": 393,
+    "ciego": 394,
+    "cielo": 395,
+    "cien": 396,
+    "cierto": 397,
+    "cifra": 398,
+    "cigarro": 399,
+    "cima": 400,
+    "cinco": 401,
+    "cine": 402,
+    "cinta": 403,
+    "ciprés": 404,
+    "circo": 405,
+    "ciruela": 406,
+    "cisne": 407,
+    "cita": 408,
+    "ciudad": 409,
+    "clamor": 410,
+    "clan": 411,
+    "claro": 412,
+    "clase": 413,
+    "clave": 414,
+    "cliente": 415,
+    "clima": 416,
+    "clínica": 417,
+    "cobre": 418,
+    "cocción": 419,
+    "cochino": 420,
+    "cocina": 421,
+    "coco": 422,
+    "código": 423,
+    "codo": 424,
+    "cofre": 425,
+    "coger": 426,
+    "cohete": 427,
+    "cojín": 428,
+    "cojo": 429,
+    "cola": 430,
+    "colcha": 431,
+    "colegio": 432,
+    "colgar": 433,
+    "colina": 434,
+    "collar": 435,
+    "colmo": 436,
+    "columna": 437,
+    "combate": 438,
+    "comer": 439,
+    "comida": 440,
+    "cómodo": 441,
+    "compra": 442,
+    "conde": 443,
+    "conejo": 444,
+    "conga": 445,
+    "conocer": 446,
+    "consejo": 447,
+    "contar": 448,
+    "copa": 449,
+    "copia": 450,
+    "corazón": 451,
+    "corbata": 452,
+    "corcho": 453,
+    "cordón": 454,
+    "corona": 455,
+    "correr": 456,
+    "coser": 457,
+    "cosmos": 458,
+    "costa": 459,
+    "cráneo": 460,
+    "cráter": 461,
+    "crear": 462,
+    "crecer": 463,
+    "creído": 464,
+    "crema": 465,
+    "cría": 466,
+    "crimen": 467,
+    "cripta": 468,
+    "crisis": 469,
+    "cromo": 470,
+    "crónica": 471,
+    "croqueta": 472,
+    "crudo": 473,
+    "cruz": 474,
+    "cuadro": 475,
+    "cuarto": 476,
+    "cuatro": 477,
+    "cubo": 478,
+    "cubrir": 479,
+    "cuchara": 480,
+    "cuello": 481,
+    "cuento": 482,
+    "cuerda": 483,
+    "cuesta": 484,
+    "cueva": 485,
+    "cuidar": 486,
+    "culebra": 487,
+    "culpa": 488,
+    "culto": 489,
+    "cumbre": 490,
+    "cumplir": 491,
+    "cuna": 492,
+    "cuneta": 493,
+    "cuota": 494,
+    "cupón": 495,
+    "cúpula": 496,
+    "curar": 497,
+    "curioso": 498,
+    "curso": 499,
+    "curva": 500,
+    "cutis": 501,
+    "dama": 502,
+    "danza": 503,
+    "dar": 504,
+    "dardo": 505,
+    "dátil": 506,
+    "deber": 507,
+    "débil": 508,
+    "década": 509,
+    "decir": 510,
+    "dedo": 511,
+    "defensa": 512,
+    "definir": 513,
+    "dejar": 514,
+    "delfín": 515,
+    "delgado": 516,
+    "delito": 517,
+    "demora": 518,
+    "denso": 519,
+    "dental": 520,
+    "deporte": 521,
+    "derecho": 522,
+    "derrota": 523,
+    "desayuno": 524,
+    "deseo": 525,
+    "desfile": 526,
+    "desnudo": 527,
+    "destino": 528,
+    "desvío": 529,
+    "detalle": 530,
+    "detener": 531,
+    "deuda": 532,
+    "día": 533,
+    "diablo": 534,
+    "diadema": 535,
+    "diamante": 536,
+    "diana": 537,
+    "diario": 538,
+    "dibujo": 539,
+    "dictar": 540,
+    "diente": 541,
+    "dieta": 542,
+    "diez": 543,
+    "difícil": 544,
+    "digno": 545,
+    "dilema": 546,
+    "diluir": 547,
+    "dinero": 548,
+    "directo": 549,
+    "dirigir": 550,
+    "disco": 551,
+    "diseño": 552,
+    "disfraz": 553,
+    "diva": 554,
+    "divino": 555,
+    "doble": 556,
+    "doce": 557,
+    "dolor": 558,
+    "domingo": 559,
+    "don": 560,
+    "donar": 561,
+    "dorado": 562,
+    "dormir": 563,
+    "dorso": 564,
+    "dos": 565,
+    "dosis": 566,
+    "dragón": 567,
+    "droga": 568,
+    "ducha": 569,
+    "duda": 570,
+    "duelo": 571,
+    "dueño": 572,
+    "dulce": 573,
+    "dúo": 574,
+    "duque": 575,
+    "durar": 576,
+    "dureza": 577,
+    "duro": 578,
+    "ébano": 579,
+    "ebrio": 580,
+    "echar": 581,
+    "eco": 582,
+    "ecuador": 583,
+    "edad": 584,
+    "edición": 585,
+    "edificio": 586,
+    "editor": 587,
+    "educar": 588,
+    "efecto": 589,
+    "eficaz": 590,
+    "eje": 591,
+    "ejemplo": 592,
+    "elefante": 593,
+    "elegir": 594,
+    "elemento": 595,
+    "elevar": 596,
+    "elipse": 597,
+    "élite": 598,
+    "elixir": 599,
+    "elogio": 600,
+    "eludir": 601,
+    "embudo": 602,
+    "emitir": 603,
+    "emoción": 604,
+    "empate": 605,
+    "empeño": 606,
+    "empleo": 607,
+    "empresa": 608,
+    "enano": 609,
+    "encargo": 610,
+    "enchufe": 611,
+    "encía": 612,
+    "enemigo": 613,
+    "enero": 614,
+    "enfado": 615,
+    "enfermo": 616,
+    "engaño": 617,
+    "enigma": 618,
+    "enlace": 619,
+    "enorme": 620,
+    "enredo": 621,
+    "ensayo": 622,
+    "enseñar": 623,
+    "entero": 624,
+    "entrar": 625,
+    "envase": 626,
+    "envío": 627,
+    "época": 628,
+    "equipo": 629,
+    "erizo": 630,
+    "escala": 631,
+    "escena": 632,
+    "escolar": 633,
+    "escribir": 634,
+    "escudo": 635,
+    "esencia": 636,
+    "esfera": 637,
+    "esfuerzo": 638,
+    "espada": 639,
+    "espejo": 640,
+    "espía": 641,
+    "esposa": 642,
+    "espuma": 643,
+    "esquí": 644,
+    "estar": 645,
+    "este": 646,
+    "estilo": 647,
+    "estufa": 648,
+    "etapa": 649,
+    "eterno": 650,
+    "ética": 651,
+    "etnia": 652,
+    "evadir": 653,
+    "evaluar": 654,
+    "evento": 655,
+    "evitar": 656,
+    "exacto": 657,
+    "examen": 658,
+    "exceso": 659,
+    "excusa": 660,
+    "exento": 661,
+    "exigir": 662,
+    "exilio": 663,
+    "existir": 664,
+    "éxito": 665,
+    "experto": 666,
+    "explicar": 667,
+    "exponer": 668,
+    "extremo": 669,
+    "fábrica": 670,
+    "fábula": 671,
+    "fachada": 672,
+    "fácil": 673,
+    "factor": 674,
+    "faena": 675,
+    "faja": 676,
+    "falda": 677,
+    "fallo": 678,
+    "falso": 679,
+    "faltar": 680,
+    "fama": 681,
+    "familia": 682,
+    "famoso": 683,
+    "faraón": 684,
+    "farmacia": 685,
+    "farol": 686,
+    "farsa": 687,
+    "fase": 688,
+    "fatiga": 689,
+    "fauna": 690,
+    "favor": 691,
+    "fax": 692,
+    "febrero": 693,
+    "fecha": 694,
+    "feliz": 695,
+    "feo": 696,
+    "feria": 697,
+    "feroz": 698,
+    "fértil": 699,
+    "fervor": 700,
+    "festín": 701,
+    "fiable": 702,
+    "fianza": 703,
+    "fiar": 704,
+    "fibra": 705,
+    "ficción": 706,
+    "ficha": 707,
+    "fideo": 708,
+    "fiebre": 709,
+    "fiel": 710,
+    "fiera": 711,
+    "fiesta": 712,
+    "figura": 713,
+    "fijar": 714,
+    "fijo": 715,
+    "fila": 716,
+    "filete": 717,
+    "filial": 718,
+    "filtro": 719,
+    "fin": 720,
+    "finca": 721,
+    "fingir": 722,
+    "finito": 723,
+    "firma": 724,
+    "flaco": 725,
+    "flauta": 726,
+    "flecha": 727,
+    "flor": 728,
+    "flota": 729,
+    "fluir": 730,
+    "flujo": 731,
+    "flúor": 732,
+    "fobia": 733,
+    "foca": 734,
+    "fogata": 735,
+    "fogón": 736,
+    "folio": 737,
+    "folleto": 738,
+    "fondo": 739,
+    "forma": 740,
+    "forro": 741,
+    "fortuna": 742,
+    "forzar": 743,
+    "fosa": 744,
+    "foto": 745,
+    "fracaso": 746,
+    "frágil": 747,
+    "franja": 748,
+    "frase": 749,
+    "fraude": 750,
+    "freír": 751,
+    "freno": 752,
+    "fresa": 753,
+    "frío": 754,
+    "frito": 755,
+    "fruta": 756,
+    "fuego": 757,
+    "fuente": 758,
+    "fuerza": 759,
+    "fuga": 760,
+    "fumar": 761,
+    "función": 762,
+    "funda": 763,
+    "furgón": 764,
+    "furia": 765,
+    "fusil": 766,
+    "fútbol": 767,
+    "futuro": 768,
+    "gacela": 769,
+    "gafas": 770,
+    "gaita": 771,
+    "gajo": 772,
+    "gala": 773,
+    "galería": 774,
+    "gallo": 775,
+    "gamba": 776,
+    "ganar": 777,
+    "gancho": 778,
+    "ganga": 779,
+    "ganso": 780,
+    "garaje": 781,
+    "garza": 782,
+    "gasolina": 783,
+    "gastar": 784,
+    "gato": 785,
+    "gavilán": 786,
+    "gemelo": 787,
+    "gemir": 788,
+    "gen": 789,
+    "género": 790,
+    "genio": 791,
+    "gente": 792,
+    "geranio": 793,
+    "gerente": 794,
+    "germen": 795,
+    "gesto": 796,
+    "gigante": 797,
+    "gimnasio": 798,
+    "girar": 799,
+    "giro": 800,
+    "glaciar": 801,
+    "globo": 802,
+    "gloria": 803,
+    "gol": 804,
+    "golfo": 805,
+    "goloso": 806,
+    "golpe": 807,
+    "goma": 808,
+    "gordo": 809,
+    "gorila": 810,
+    "gorra": 811,
+    "gota": 812,
+    "goteo": 813,
+    "gozar": 814,
+    "grada": 815,
+    "gráfico": 816,
+    "grano": 817,
+    "grasa": 818,
+    "gratis": 819,
+    "grave": 820,
+    "grieta": 821,
+    "grillo": 822,
+    "gripe": 823,
+    "gris": 824,
+    "grito": 825,
+    "grosor": 826,
+    "grúa": 827,
+    "grueso": 828,
+    "grumo": 829,
+    "grupo": 830,
+    "guante": 831,
+    "guapo": 832,
+    "guardia": 833,
+    "guerra": 834,
+    "guía": 835,
+    "guiño": 836,
+    "guion": 837,
+    "guiso": 838,
+    "guitarra": 839,
+    "gusano": 840,
+    "gustar": 841,
+    "haber": 842,
+    "hábil": 843,
+    "hablar": 844,
+    "hacer": 845,
+    "hacha": 846,
+    "hada": 847,
+    "hallar": 848,
+    "hamaca": 849,
+    "harina": 850,
+    "haz": 851,
+    "hazaña": 852,
+    "hebilla": 853,
+    "hebra": 854,
+    "hecho": 855,
+    "helado": 856,
+    "helio": 857,
+    "hembra": 858,
+    "herir": 859,
+    "hermano": 860,
+    "héroe": 861,
+    "hervir": 862,
+    "hielo": 863,
+    "hierro": 864,
+    "hígado": 865,
+    "higiene": 866,
+    "hijo": 867,
+    "himno": 868,
+    "historia": 869,
+    "hocico": 870,
+    "hogar": 871,
+    "hoguera": 872,
+    "hoja": 873,
+    "hombre": 874,
+    "hongo": 875,
+    "honor": 876,
+    "honra": 877,
+    "hora": 878,
+    "hormiga": 879,
+    "horno": 880,
+    "hostil": 881,
+    "hoyo": 882,
+    "hueco": 883,
+    "huelga": 884,
+    "huerta": 885,
+    "hueso": 886,
+    "huevo": 887,
+    "huida": 888,
+    "huir": 889,
+    "humano": 890,
+    "húmedo": 891,
+    "humilde": 892,
+    "humo": 893,
+    "hundir": 894,
+    "huracán": 895,
+    "hurto": 896,
+    "icono": 897,
+    "ideal": 898,
+    "idioma": 899,
+    "ídolo": 900,
+    "iglesia": 901,
+    "iglú": 902,
+    "igual": 903,
+    "ilegal": 904,
+    "ilusión": 905,
+    "imagen": 906,
+    "imán": 907,
+    "imitar": 908,
+    "impar": 909,
+    "imperio": 910,
+    "imponer": 911,
+    "impulso": 912,
+    "incapaz": 913,
+    "índice": 914,
+    "inerte": 915,
+    "infiel": 916,
+    "informe": 917,
+    "ingenio": 918,
+    "inicio": 919,
+    "inmenso": 920,
+    "inmune": 921,
+    "innato": 922,
+    "insecto": 923,
+    "instante": 924,
+    "interés": 925,
+    "íntimo": 926,
+    "intuir": 927,
+    "inútil": 928,
+    "invierno": 929,
+    "ira": 930,
+    "iris": 931,
+    "ironía": 932,
+    "isla": 933,
+    "islote": 934,
+    "jabalí": 935,
+    "jabón": 936,
+    "jamón": 937,
+    "jarabe": 938,
+    "jardín": 939,
+    "jarra": 940,
+    "jaula": 941,
+    "jazmín": 942,
+    "jefe": 943,
+    "jeringa": 944,
+    "jinete": 945,
+    "jornada": 946,
+    "joroba": 947,
+    "joven": 948,
+    "joya": 949,
+    "juerga": 950,
+    "jueves": 951,
+    "juez": 952,
+    "jugador": 953,
+    "jugo": 954,
+    "juguete": 955,
+    "juicio": 956,
+    "junco": 957,
+    "jungla": 958,
+    "junio": 959,
+    "juntar": 960,
+    "júpiter": 961,
+    "jurar": 962,
+    "justo": 963,
+    "juvenil": 964,
+    "juzgar": 965,
+    "kilo": 966,
+    "koala": 967,
+    "labio": 968,
+    "lacio": 969,
+    "lacra": 970,
+    "lado": 971,
+    "ladrón": 972,
+    "lagarto": 973,
+    "lágrima": 974,
+    "laguna": 975,
+    "laico": 976,
+    "lamer": 977,
+    "lámina": 978,
+    "lámpara": 979,
+    "lana": 980,
+    "lancha": 981,
+    "langosta": 982,
+    "lanza": 983,
+    "lápiz": 984,
+    "largo": 985,
+    "larva": 986,
+    "lástima": 987,
+    "lata": 988,
+    "látex": 989,
+    "latir": 990,
+    "laurel": 991,
+    "lavar": 992,
+    "lazo": 993,
+    "leal": 994,
+    "lección": 995,
+    "leche": 996,
+    "lector": 997,
+    "leer": 998,
+    "legión": 999,
+    "legumbre": 1000,
+    "lejano": 1001,
+    "lengua": 1002,
+    "lento": 1003,
+    "leña": 1004,
+    "león": 1005,
+    "leopardo": 1006,
+    "lesión": 1007,
+    "letal": 1008,
+    "letra": 1009,
+    "leve": 1010,
+    "leyenda": 1011,
+    "libertad": 1012,
+    "libro": 1013,
+    "licor": 1014,
+    "líder": 1015,
+    "lidiar": 1016,
+    "lienzo": 1017,
+    "liga": 1018,
+    "ligero": 1019,
+    "lima": 1020,
+    "límite": 1021,
+    "limón": 1022,
+    "limpio": 1023,
+    "lince": 1024,
+    "lindo": 1025,
+    "línea": 1026,
+    "lingote": 1027,
+    "lino": 1028,
+    "linterna": 1029,
+    "líquido": 1030,
+    "liso": 1031,
+    "lista": 1032,
+    "litera": 1033,
+    "litio": 1034,
+    "litro": 1035,
+    "llaga": 1036,
+    "llama": 1037,
+    "llanto": 1038,
+    "llave": 1039,
+    "llegar": 1040,
+    "llenar": 1041,
+    "llevar": 1042,
+    "llorar": 1043,
+    "llover": 1044,
+    "lluvia": 1045,
+    "lobo": 1046,
+    "loción": 1047,
+    "loco": 1048,
+    "locura": 1049,
+    "lógica": 1050,
+    "logro": 1051,
+    "lombriz": 1052,
+    "lomo": 1053,
+    "lonja": 1054,
+    "lote": 1055,
+    "lucha": 1056,
+    "lucir": 1057,
+    "lugar": 1058,
+    "lujo": 1059,
+    "luna": 1060,
+    "lunes": 1061,
+    "lupa": 1062,
+    "lustro": 1063,
+    "luto": 1064,
+    "luz": 1065,
+    "maceta": 1066,
+    "macho": 1067,
+    "madera": 1068,
+    "madre": 1069,
+    "maduro": 1070,
+    "maestro": 1071,
+    "mafia": 1072,
+    "magia": 1073,
+    "mago": 1074,
+    "maíz": 1075,
+    "maldad": 1076,
+    "maleta": 1077,
+    "malla": 1078,
+    "malo": 1079,
+    "mamá": 1080,
+    "mambo": 1081,
+    "mamut": 1082,
+    "manco": 1083,
+    "mando": 1084,
+    "manejar": 1085,
+    "manga": 1086,
+    "maniquí": 1087,
+    "manjar": 1088,
+    "mano": 1089,
+    "manso": 1090,
+    "manta": 1091,
+    "mañana": 1092,
+    "mapa": 1093,
+    "máquina": 1094,
+    "mar": 1095,
+    "marco": 1096,
+    "marea": 1097,
+    "marfil": 1098,
+    "margen": 1099,
+    "marido": 1100,
+    "mármol": 1101,
+    "marrón": 1102,
+    "martes": 1103,
+    "marzo": 1104,
+    "masa": 1105,
+    "máscara": 1106,
+    "masivo": 1107,
+    "matar": 1108,
+    "materia": 1109,
+    "matiz": 1110,
+    "matriz": 1111,
+    "máximo": 1112,
+    "mayor": 1113,
+    "mazorca": 1114,
+    "mecha": 1115,
+    "medalla": 1116,
+    "medio": 1117,
+    "médula": 1118,
+    "mejilla": 1119,
+    "mejor": 1120,
+    "melena": 1121,
+    "melón": 1122,
+    "memoria": 1123,
+    "menor": 1124,
+    "mensaje": 1125,
+    "mente": 1126,
+    "menú": 1127,
+    "mercado": 1128,
+    "merengue": 1129,
+    "mérito": 1130,
+    "mes": 1131,
+    "mesón": 1132,
+    "meta": 1133,
+    "meter": 1134,
+    "método": 1135,
+    "metro": 1136,
+    "mezcla": 1137,
+    "miedo": 1138,
+    "miel": 1139,
+    "miembro": 1140,
+    "miga": 1141,
+    "mil": 1142,
+    "milagro": 1143,
+    "militar": 1144,
+    "millón": 1145,
+    "mimo": 1146,
+    "mina": 1147,
+    "minero": 1148,
+    "mínimo": 1149,
+    "minuto": 1150,
+    "miope": 1151,
+    "mirar": 1152,
+    "misa": 1153,
+    "miseria": 1154,
+    "misil": 1155,
+    "mismo": 1156,
+    "mitad": 1157,
+    "mito": 1158,
+    "mochila": 1159,
+    "moción": 1160,
+    "moda": 1161,
+    "modelo": 1162,
+    "moho": 1163,
+    "mojar": 1164,
+    "molde": 1165,
+    "moler": 1166,
+    "molino": 1167,
+    "momento": 1168,
+    "momia": 1169,
+    "monarca": 1170,
+    "moneda": 1171,
+    "monja": 1172,
+    "monto": 1173,
+    "moño": 1174,
+    "morada": 1175,
+    "morder": 1176,
+    "moreno": 1177,
+    "morir": 1178,
+    "morro": 1179,
+    "morsa": 1180,
+    "mortal": 1181,
+    "mosca": 1182,
+    "mostrar": 1183,
+    "motivo": 1184,
+    "mover": 1185,
+    "móvil": 1186,
+    "mozo": 1187,
+    "mucho": 1188,
+    "mudar": 1189,
+    "mueble": 1190,
+    "muela": 1191,
+    "muerte": 1192,
+    "muestra": 1193,
+    "mugre": 1194,
+    "mujer": 1195,
+    "mula": 1196,
+    "muleta": 1197,
+    "multa": 1198,
+    "mundo": 1199,
+    "muñeca": 1200,
+    "mural": 1201,
+    "muro": 1202,
+    "músculo": 1203,
+    "museo": 1204,
+    "musgo": 1205,
+    "música": 1206,
+    "muslo": 1207,
+    "nácar": 1208,
+    "nación": 1209,
+    "nadar": 1210,
+    "naipe": 1211,
+    "naranja": 1212,
+    "nariz": 1213,
+    "narrar": 1214,
+    "nasal": 1215,
+    "natal": 1216,
+    "nativo": 1217,
+    "natural": 1218,
+    "náusea": 1219,
+    "naval": 1220,
+    "nave": 1221,
+    "navidad": 1222,
+    "necio": 1223,
+    "néctar": 1224,
+    "negar": 1225,
+    "negocio": 1226,
+    "negro": 1227,
+    "neón": 1228,
+    "nervio": 1229,
+    "neto": 1230,
+    "neutro": 1231,
+    "nevar": 1232,
+    "nevera": 1233,
+    "nicho": 1234,
+    "nido": 1235,
+    "niebla": 1236,
+    "nieto": 1237,
+    "niñez": 1238,
+    "niño": 1239,
+    "nítido": 1240,
+    "nivel": 1241,
+    "nobleza": 1242,
+    "noche": 1243,
+    "nómina": 1244,
+    "noria": 1245,
+    "norma": 1246,
+    "norte": 1247,
+    "nota": 1248,
+    "noticia": 1249,
+    "novato": 1250,
+    "novela": 1251,
+    "novio": 1252,
+    "nube": 1253,
+    "nuca": 1254,
+    "núcleo": 1255,
+    "nudillo": 1256,
+    "nudo": 1257,
+    "nuera": 1258,
+    "nueve": 1259,
+    "nuez": 1260,
+    "nulo": 1261,
+    "número": 1262,
+    "nutria": 1263,
+    "oasis": 1264,
+    "obeso": 1265,
+    "obispo": 1266,
+    "objeto": 1267,
+    "obra": 1268,
+    "obrero": 1269,
+    "observar": 1270,
+    "obtener": 1271,
+    "obvio": 1272,
+    "oca": 1273,
+    "ocaso": 1274,
+    "océano": 1275,
+    "ochenta": 1276,
+    "ocho": 1277,
+    "ocio": 1278,
+    "ocre": 1279,
+    "octavo": 1280,
+    "octubre": 1281,
+    "oculto": 1282,
+    "ocupar": 1283,
+    "ocurrir": 1284,
+    "odiar": 1285,
+    "odio": 1286,
+    "odisea": 1287,
+    "oeste": 1288,
+    "ofensa": 1289,
+    "oferta": 1290,
+    "oficio": 1291,
+    "ofrecer": 1292,
+    "ogro": 1293,
+    "oído": 1294,
+    "oír": 1295,
+    "ojo": 1296,
+    "ola": 1297,
+    "oleada": 1298,
+    "olfato": 1299,
+    "olivo": 1300,
+    "olla": 1301,
+    "olmo": 1302,
+    "olor": 1303,
+    "olvido": 1304,
+    "ombligo": 1305,
+    "onda": 1306,
+    "onza": 1307,
+    "opaco": 1308,
+    "opción": 1309,
+    "ópera": 1310,
+    "opinar": 1311,
+    "oponer": 1312,
+    "optar": 1313,
+    "óptica": 1314,
+    "opuesto": 1315,
+    "oración": 1316,
+    "orador": 1317,
+    "oral": 1318,
+    "órbita": 1319,
+    "orca": 1320,
+    "orden": 1321,
+    "oreja": 1322,
+    "órgano": 1323,
+    "orgía": 1324,
+    "orgullo": 1325,
+    "oriente": 1326,
+    "origen": 1327,
+    "orilla": 1328,
+    "oro": 1329,
+    "orquesta": 1330,
+    "oruga": 1331,
+    "osadía": 1332,
+    "oscuro": 1333,
+    "osezno": 1334,
+    "oso": 1335,
+    "ostra": 1336,
+    "otoño": 1337,
+    "otro": 1338,
+    "oveja": 1339,
+    "óvulo": 1340,
+    "óxido": 1341,
+    "oxígeno": 1342,
+    "oyente": 1343,
+    "ozono": 1344,
+    "pacto": 1345,
+    "padre": 1346,
+    "paella": 1347,
+    "página": 1348,
+    "pago": 1349,
+    "país": 1350,
+    "pájaro": 1351,
+    "palabra": 1352,
+    "palco": 1353,
+    "paleta": 1354,
+    "pálido": 1355,
+    "palma": 1356,
+    "paloma": 1357,
+    "palpar": 1358,
+    "pan": 1359,
+    "panal": 1360,
+    "pánico": 1361,
+    "pantera": 1362,
+    "pañuelo": 1363,
+    "papá": 1364,
+    "papel": 1365,
+    "papilla": 1366,
+    "paquete": 1367,
+    "parar": 1368,
+    "parcela": 1369,
+    "pared": 1370,
+    "parir": 1371,
+    "paro": 1372,
+    "párpado": 1373,
+    "parque": 1374,
+    "párrafo": 1375,
+    "parte": 1376,
+    "pasar": 1377,
+    "paseo": 1378,
+    "pasión": 1379,
+    "paso": 1380,
+    "pasta": 1381,
+    "pata": 1382,
+    "patio": 1383,
+    "patria": 1384,
+    "pausa": 1385,
+    "pauta": 1386,
+    "pavo": 1387,
+    "payaso": 1388,
+    "peatón": 1389,
+    "pecado": 1390,
+    "pecera": 1391,
+    "pecho": 1392,
+    "pedal": 1393,
+    "pedir": 1394,
+    "pegar": 1395,
+    "peine": 1396,
+    "pelar": 1397,
+    "peldaño": 1398,
+    "pelea": 1399,
+    "peligro": 1400,
+    "pellejo": 1401,
+    "pelo": 1402,
+    "peluca": 1403,
+    "pena": 1404,
+    "pensar": 1405,
+    "peñón": 1406,
+    "peón": 1407,
+    "peor": 1408,
+    "pepino": 1409,
+    "pequeño": 1410,
+    "pera": 1411,
+    "percha": 1412,
+    "perder": 1413,
+    "pereza": 1414,
+    "perfil": 1415,
+    "perico": 1416,
+    "perla": 1417,
+    "permiso": 1418,
+    "perro": 1419,
+    "persona": 1420,
+    "pesa": 1421,
+    "pesca": 1422,
+    "pésimo": 1423,
+    "pestaña": 1424,
+    "pétalo": 1425,
+    "petróleo": 1426,
+    "pez": 1427,
+    "pezuña": 1428,
+    "picar": 1429,
+    "pichón": 1430,
+    "pie": 1431,
+    "piedra": 1432,
+    "pierna": 1433,
+    "pieza": 1434,
+    "pijama": 1435,
+    "pilar": 1436,
+    "piloto": 1437,
+    "pimienta": 1438,
+    "pino": 1439,
+    "pintor": 1440,
+    "pinza": 1441,
+    "piña": 1442,
+    "piojo": 1443,
+    "pipa": 1444,
+    "pirata": 1445,
+    "pisar": 1446,
+    "piscina": 1447,
+    "piso": 1448,
+    "pista": 1449,
+    "pitón": 1450,
+    "pizca": 1451,
+    "placa": 1452,
+    "plan": 1453,
+    "plata": 1454,
+    "playa": 1455,
+    "plaza": 1456,
+    "pleito": 1457,
+    "pleno": 1458,
+    "plomo": 1459,
+    "pluma": 1460,
+    "plural": 1461,
+    "pobre": 1462,
+    "poco": 1463,
+    "poder": 1464,
+    "podio": 1465,
+    "poema": 1466,
+    "poesía": 1467,
+    "poeta": 1468,
+    "polen": 1469,
+    "policía": 1470,
+    "pollo": 1471,
+    "polvo": 1472,
+    "pomada": 1473,
+    "pomelo": 1474,
+    "pomo": 1475,
+    "pompa": 1476,
+    "poner": 1477,
+    "porción": 1478,
+    "portal": 1479,
+    "posada": 1480,
+    "poseer": 1481,
+    "posible": 1482,
+    "poste": 1483,
+    "potencia": 1484,
+    "potro": 1485,
+    "pozo": 1486,
+    "prado": 1487,
+    "precoz": 1488,
+    "pregunta": 1489,
+    "premio": 1490,
+    "prensa": 1491,
+    "preso": 1492,
+    "previo": 1493,
+    "primo": 1494,
+    "príncipe": 1495,
+    "prisión": 1496,
+    "privar": 1497,
+    "proa": 1498,
+    "probar": 1499,
+    "proceso": 1500,
+    "producto": 1501,
+    "proeza": 1502,
+    "profesor": 1503,
+    "programa": 1504,
+    "prole": 1505,
+    "promesa": 1506,
+    "pronto": 1507,
+    "propio": 1508,
+    "próximo": 1509,
+    "prueba": 1510,
+    "público": 1511,
+    "puchero": 1512,
+    "pudor": 1513,
+    "pueblo": 1514,
+    "puerta": 1515,
+    "puesto": 1516,
+    "pulga": 1517,
+    "pulir": 1518,
+    "pulmón": 1519,
+    "pulpo": 1520,
+    "pulso": 1521,
+    "puma": 1522,
+    "punto": 1523,
+    "puñal": 1524,
+    "puño": 1525,
+    "pupa": 1526,
+    "pupila": 1527,
+    "puré": 1528,
+    "quedar": 1529,
+    "queja": 1530,
+    "quemar": 1531,
+    "querer": 1532,
+    "queso": 1533,
+    "quieto": 1534,
+    "química": 1535,
+    "quince": 1536,
+    "quitar": 1537,
+    "rábano": 1538,
+    "rabia": 1539,
+    "rabo": 1540,
+    "ración": 1541,
+    "radical": 1542,
+    "raíz": 1543,
+    "rama": 1544,
+    "rampa": 1545,
+    "rancho": 1546,
+    "rango": 1547,
+    "rapaz": 1548,
+    "rápido": 1549,
+    "rapto": 1550,
+    "rasgo": 1551,
+    "raspa": 1552,
+    "rato": 1553,
+    "rayo": 1554,
+    "raza": 1555,
+    "razón": 1556,
+    "reacción": 1557,
+    "realidad": 1558,
+    "rebaño": 1559,
+    "rebote": 1560,
+    "recaer": 1561,
+    "receta": 1562,
+    "rechazo": 1563,
+    "recoger": 1564,
+    "recreo": 1565,
+    "recto": 1566,
+    "recurso": 1567,
+    "red": 1568,
+    "redondo": 1569,
+    "reducir": 1570,
+    "reflejo": 1571,
+    "reforma": 1572,
+    "refrán": 1573,
+    "refugio": 1574,
+    "regalo": 1575,
+    "regir": 1576,
+    "regla": 1577,
+    "regreso": 1578,
+    "rehén": 1579,
+    "reino": 1580,
+    "reír": 1581,
+    "reja": 1582,
+    "relato": 1583,
+    "relevo": 1584,
+    "relieve": 1585,
+    "relleno": 1586,
+    "reloj": 1587,
+    "remar": 1588,
+    "remedio": 1589,
+    "remo": 1590,
+    "rencor": 1591,
+    "rendir": 1592,
+    "renta": 1593,
+    "reparto": 1594,
+    "repetir": 1595,
+    "reposo": 1596,
+    "reptil": 1597,
+    "res": 1598,
+    "rescate": 1599,
+    "resina": 1600,
+    "respeto": 1601,
+    "resto": 1602,
+    "resumen": 1603,
+    "retiro": 1604,
+    "retorno": 1605,
+    "retrato": 1606,
+    "reunir": 1607,
+    "revés": 1608,
+    "revista": 1609,
+    "rey": 1610,
+    "rezar": 1611,
+    "rico": 1612,
+    "riego": 1613,
+    "rienda": 1614,
+    "riesgo": 1615,
+    "rifa": 1616,
+    "rígido": 1617,
+    "rigor": 1618,
+    "rincón": 1619,
+    "riñón": 1620,
+    "río": 1621,
+    "riqueza": 1622,
+    "risa": 1623,
+    "ritmo": 1624,
+    "rito": 1625,
+    "rizo": 1626,
+    "roble": 1627,
+    "roce": 1628,
+    "rociar": 1629,
+    "rodar": 1630,
+    "rodeo": 1631,
+    "rodilla": 1632,
+    "roer": 1633,
+    "rojizo": 1634,
+    "rojo": 1635,
+    "romero": 1636,
+    "romper": 1637,
+    "ron": 1638,
+    "ronco": 1639,
+    "ronda": 1640,
+    "ropa": 1641,
+    "ropero": 1642,
+    "rosa": 1643,
+    "rosca": 1644,
+    "rostro": 1645,
+    "rotar": 1646,
+    "rubí": 1647,
+    "rubor": 1648,
+    "rudo": 1649,
+    "rueda": 1650,
+    "rugir": 1651,
+    "ruido": 1652,
+    "ruina": 1653,
+    "ruleta": 1654,
+    "rulo": 1655,
+    "rumbo": 1656,
+    "rumor": 1657,
+    "ruptura": 1658,
+    "ruta": 1659,
+    "rutina": 1660,
+    "sábado": 1661,
+    "saber": 1662,
+    "sabio": 1663,
+    "sable": 1664,
+    "sacar": 1665,
+    "sagaz": 1666,
+    "sagrado": 1667,
+    "sala": 1668,
+    "saldo": 1669,
+    "salero": 1670,
+    "salir": 1671,
+    "salmón": 1672,
+    "salón": 1673,
+    "salsa": 1674,
+    "salto": 1675,
+    "salud": 1676,
+    "salvar": 1677,
+    "samba": 1678,
+    "sanción": 1679,
+    "sandía": 1680,
+    "sanear": 1681,
+    "sangre": 1682,
+    "sanidad": 1683,
+    "sano": 1684,
+    "santo": 1685,
+    "sapo": 1686,
+    "saque": 1687,
+    "sardina": 1688,
+    "sartén": 1689,
+    "sastre": 1690,
+    "satán": 1691,
+    "sauna": 1692,
+    "saxofón": 1693,
+    "sección": 1694,
+    "seco": 1695,
+    "secreto": 1696,
+    "secta": 1697,
+    "sed": 1698,
+    "seguir": 1699,
+    "seis": 1700,
+    "sello": 1701,
+    "selva": 1702,
+    "semana": 1703,
+    "semilla": 1704,
+    "senda": 1705,
+    "sensor": 1706,
+    "señal": 1707,
+    "señor": 1708,
+    "separar": 1709,
+    "sepia": 1710,
+    "sequía": 1711,
+    "ser": 1712,
+    "serie": 1713,
+    "sermón": 1714,
+    "servir": 1715,
+    "sesenta": 1716,
+    "sesión": 1717,
+    "seta": 1718,
+    "setenta": 1719,
+    "severo": 1720,
+    "sexo": 1721,
+    "sexto": 1722,
+    "sidra": 1723,
+    "siesta": 1724,
+    "siete": 1725,
+    "siglo": 1726,
+    "signo": 1727,
+    "sílaba": 1728,
+    "silbar": 1729,
+    "silencio": 1730,
+    "silla": 1731,
+    "símbolo": 1732,
+    "simio": 1733,
+    "sirena": 1734,
+    "sistema": 1735,
+    "sitio": 1736,
+    "situar": 1737,
+    "sobre": 1738,
+    "socio": 1739,
+    "sodio": 1740,
+    "sol": 1741,
+    "solapa": 1742,
+    "soldado": 1743,
+    "soledad": 1744,
+    "sólido": 1745,
+    "soltar": 1746,
+    "solución": 1747,
+    "sombra": 1748,
+    "sondeo": 1749,
+    "sonido": 1750,
+    "sonoro": 1751,
+    "sonrisa": 1752,
+    "sopa": 1753,
+    "soplar": 1754,
+    "soporte": 1755,
+    "sordo": 1756,
+    "sorpresa": 1757,
+    "sorteo": 1758,
+    "sostén": 1759,
+    "sótano": 1760,
+    "suave": 1761,
+    "subir": 1762,
+    "suceso": 1763,
+    "sudor": 1764,
+    "suegra": 1765,
+    "suelo": 1766,
+    "sueño": 1767,
+    "suerte": 1768,
+    "sufrir": 1769,
+    "sujeto": 1770,
+    "sultán": 1771,
+    "sumar": 1772,
+    "superar": 1773,
+    "suplir": 1774,
+    "suponer": 1775,
+    "supremo": 1776,
+    "sur": 1777,
+    "surco": 1778,
+    "sureño": 1779,
+    "surgir": 1780,
+    "susto": 1781,
+    "sutil": 1782,
+    "tabaco": 1783,
+    "tabique": 1784,
+    "tabla": 1785,
+    "tabú": 1786,
+    "taco": 1787,
+    "tacto": 1788,
+    "tajo": 1789,
+    "talar": 1790,
+    "talco": 1791,
+    "talento": 1792,
+    "talla": 1793,
+    "talón": 1794,
+    "tamaño": 1795,
+    "tambor": 1796,
+    "tango": 1797,
+    "tanque": 1798,
+    "tapa": 1799,
+    "tapete": 1800,
+    "tapia": 1801,
+    "tapón": 1802,
+    "taquilla": 1803,
+    "tarde": 1804,
+    "tarea": 1805,
+    "tarifa": 1806,
+    "tarjeta": 1807,
+    "tarot": 1808,
+    "tarro": 1809,
+    "tarta": 1810,
+    "tatuaje": 1811,
+    "tauro": 1812,
+    "taza": 1813,
+    "tazón": 1814,
+    "teatro": 1815,
+    "techo": 1816,
+    "tecla": 1817,
+    "técnica": 1818,
+    "tejado": 1819,
+    "tejer": 1820,
+    "tejido": 1821,
+    "tela": 1822,
+    "teléfono": 1823,
+    "tema": 1824,
+    "temor": 1825,
+    "templo": 1826,
+    "tenaz": 1827,
+    "tender": 1828,
+    "tener": 1829,
+    "tenis": 1830,
+    "tenso": 1831,
+    "teoría": 1832,
+    "terapia": 1833,
+    "terco": 1834,
+    "término": 1835,
+    "ternura": 1836,
+    "terror": 1837,
+    "tesis": 1838,
+    "tesoro": 1839,
+    "testigo": 1840,
+    "tetera": 1841,
+    "texto": 1842,
+    "tez": 1843,
+    "tibio": 1844,
+    "tiburón": 1845,
+    "tiempo": 1846,
+    "tienda": 1847,
+    "tierra": 1848,
+    "tieso": 1849,
+    "tigre": 1850,
+    "tijera": 1851,
+    "tilde": 1852,
+    "timbre": 1853,
+    "tímido": 1854,
+    "timo": 1855,
+    "tinta": 1856,
+    "tío": 1857,
+    "típico": 1858,
+    "tipo": 1859,
+    "tira": 1860,
+    "tirón": 1861,
+    "titán": 1862,
+    "títere": 1863,
+    "título": 1864,
+    "tiza": 1865,
+    "toalla": 1866,
+    "tobillo": 1867,
+    "tocar": 1868,
+    "tocino": 1869,
+    "todo": 1870,
+    "toga": 1871,
+    "toldo": 1872,
+    "tomar": 1873,
+    "tono": 1874,
+    "tonto": 1875,
+    "topar": 1876,
+    "tope": 1877,
+    "toque": 1878,
+    "tórax": 1879,
+    "torero": 1880,
+    "tormenta": 1881,
+    "torneo": 1882,
+    "toro": 1883,
+    "torpedo": 1884,
+    "torre": 1885,
+    "torso": 1886,
+    "tortuga": 1887,
+    "tos": 1888,
+    "tosco": 1889,
+    "toser": 1890,
+    "tóxico": 1891,
+    "trabajo": 1892,
+    "tractor": 1893,
+    "traer": 1894,
+    "tráfico": 1895,
+    "trago": 1896,
+    "traje": 1897,
+    "tramo": 1898,
+    "trance": 1899,
+    "trato": 1900,
+    "trauma": 1901,
+    "trazar": 1902,
+    "trébol": 1903,
+    "tregua": 1904,
+    "treinta": 1905,
+    "tren": 1906,
+    "trepar": 1907,
+    "tres": 1908,
+    "tribu": 1909,
+    "trigo": 1910,
+    "tripa": 1911,
+    "triste": 1912,
+    "triunfo": 1913,
+    "trofeo": 1914,
+    "trompa": 1915,
+    "tronco": 1916,
+    "tropa": 1917,
+    "trote": 1918,
+    "trozo": 1919,
+    "truco": 1920,
+    "trueno": 1921,
+    "trufa": 1922,
+    "tubería": 1923,
+    "tubo": 1924,
+    "tuerto": 1925,
+    "tumba": 1926,
+    "tumor": 1927,
+    "túnel": 1928,
+    "túnica": 1929,
+    "turbina": 1930,
+    "turismo": 1931,
+    "turno": 1932,
+    "tutor": 1933,
+    "ubicar": 1934,
+    "úlcera": 1935,
+    "umbral": 1936,
+    "unidad": 1937,
+    "unir": 1938,
+    "universo": 1939,
+    "uno": 1940,
+    "untar": 1941,
+    "uña": 1942,
+    "urbano": 1943,
+    "urbe": 1944,
+    "urgente": 1945,
+    "urna": 1946,
+    "usar": 1947,
+    "usuario": 1948,
+    "útil": 1949,
+    "utopía": 1950,
+    "uva": 1951,
+    "vaca": 1952,
+    "vacío": 1953,
+    "vacuna": 1954,
+    "vagar": 1955,
+    "vago": 1956,
+    "vaina": 1957,
+    "vajilla": 1958,
+    "vale": 1959,
+    "válido": 1960,
+    "valle": 1961,
+    "valor": 1962,
+    "válvula": 1963,
+    "vampiro": 1964,
+    "vara": 1965,
+    "variar": 1966,
+    "varón": 1967,
+    "vaso": 1968,
+    "vecino": 1969,
+    "vector": 1970,
+    "vehículo": 1971,
+    "veinte": 1972,
+    "vejez": 1973,
+    "vela": 1974,
+    "velero": 1975,
+    "veloz": 1976,
+    "vena": 1977,
+    "vencer": 1978,
+    "venda": 1979,
+    "veneno": 1980,
+    "vengar": 1981,
+    "venir": 1982,
+    "venta": 1983,
+    "venus": 1984,
+    "ver": 1985,
+    "verano": 1986,
+    "verbo": 1987,
+    "verde": 1988,
+    "vereda": 1989,
+    "verja": 1990,
+    "verso": 1991,
+    "verter": 1992,
+    "vía": 1993,
+    "viaje": 1994,
+    "vibrar": 1995,
+    "vicio": 1996,
+    "víctima": 1997,
+    "vida": 1998,
+    "vídeo": 1999,
+    "vidrio": 2000,
+    "viejo": 2001,
+    "viernes": 2002,
+    "vigor": 2003,
+    "vil": 2004,
+    "villa": 2005,
+    "vinagre": 2006,
+    "vino": 2007,
+    "viñedo": 2008,
+    "violín": 2009,
+    "viral": 2010,
+    "virgo": 2011,
+    "virtud": 2012,
+    "visor": 2013,
+    "víspera": 2014,
+    "vista": 2015,
+    "vitamina": 2016,
+    "viudo": 2017,
+    "vivaz": 2018,
+    "vivero": 2019,
+    "vivir": 2020,
+    "vivo": 2021,
+    "volcán": 2022,
+    "volumen": 2023,
+    "volver": 2024,
+    "voraz": 2025,
+    "votar": 2026,
+    "voto": 2027,
+    "voz": 2028,
+    "vuelo": 2029,
+    "vulgar": 2030,
+    "yacer": 2031,
+    "yate": 2032,
+    "yegua": 2033,
+    "yema": 2034,
+    "yerno": 2035,
+    "yeso": 2036,
+    "yodo": 2037,
+    "yoga": 2038,
+    "yogur": 2039,
+    "zafiro": 2040,
+    "zanja": 2041,
+    "zapato": 2042,
+    "zarza": 2043,
+    "zona": 2044,
+    "zorro": 2045,
+    "zumo": 2046,
+    "zurdo": 2047,
+}
 func init() {
+    allWords["chinese_simplified"] = &wordsDesc{ words: words_chinese_simplified, rwords: rwords_chinese_simplified, lang: "chinese_simplified" }
     allWords["english"] = &wordsDesc{ words: words_english, rwords: rwords_english, lang: "english" }
+    allWords["french"] = &wordsDesc{ words: words_french, rwords: rwords_french, lang: "french" }
+    allWords["japanese"] = &wordsDesc{ words: words_japanese, rwords: rwords_japanese, lang: "japanese" }
+    allWords["spanish"] = &wordsDesc{ words: words_spanish, rwords: rwords_spanish, lang: "spanish" }
 }
@@ -0,0 +1,203 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor
+
+import (
+	"sort"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/txscript"
+
+	"github.com/pkt-cash/pktd/pktwallet/wallet/internal/txsizes"
+)
+
+// bnbMaxTries is the maximum number of branches which SelectBranchAndBound
+// will visit before giving up.
+const bnbMaxTries = 100000
+
+// knapsackIterations is the number of random subsets which SelectKnapsack
+// tries in each pass.
+const knapsackIterations = 1000
+
+// InputVirtualSize returns the worst case virtual size of an input which
+// spends an output with the given pkScript, using the same classification as
+// NewUnsignedTransaction.
+func InputVirtualSize(pkScript []byte) int {
+	witness := (txsizes.RedeemP2WPKHInputWitnessWeight + 3) / 4
+	switch {
+	case txscript.IsPayToScriptHash(pkScript):
+		return txsizes.RedeemNestedP2WPKHInputSize + witness
+	case txscript.IsPayToWitnessPubKeyHash(pkScript):
+		return txsizes.RedeemP2WPKHInputSize + witness
+	default:
+		return txsizes.RedeemP2PKHInputSize
+	}
+}
+
+// SelectBranchAndBound searches for a subset of values whose sum is at least
+// target but less than target+window, so that the transaction can be made
+// without a change output.  The values should be effective values, that is
+// the value of each coin less the fee for spending it.  Of the subsets which
+// are found, the one with the least excess is returned as a list of indexes
+// into values.  If no such subset is found then nil is returned.
+func SelectBranchAndBound(values []btcutil.Amount, target, window btcutil.Amount) []int {
+	order := make([]int, 0, len(values))
+	var available btcutil.Amount
+	for i, v := range values {
+		if v > 0 {
+			order = append(order, i)
+			available += v
+		}
+	}
+	if available < target {
+		return nil
+	}
+	// Visiting the biggest coins first finds solutions sooner and prunes
+	// branches which overshoot earlier.
+	sort.SliceStable(order, func(a, b int) bool {
+		return values[order[a]] > values[order[b]]
+	})
+
+	selected := make([]bool, len(order))
+	var best []int
+	bestExcess := window
+	tries := 0
+
+	var search func(depth int, current, remaining btcutil.Amount)
+	search = func(depth int, current, remaining btcutil.Amount) {
+		if tries >= bnbMaxTries || bestExcess == 0 {
+			return
+		}
+		tries++
+		if current+remaining < target || current-target >= bestExcess {
+			return
+		}
+		if current >= target {
+			bestExcess = current - target
+			best = best[:0]
+			for i, sel := range selected {
+				if sel {
+					best = append(best, order[i])
+				}
+			}
+			return
+		}
+		if depth == len(order) {
+			return
+		}
+		v := values[order[depth]]
+		selected[depth] = true
+		search(depth+1, current+v, remaining-v)
+		selected[depth] = false
+		search(depth+1, current, remaining-v)
+	}
+	search(0, 0, available)
+
+	if best == nil {
+		return nil
+	}
+	sort.Ints(best)
+	return best
+}
+
+// approximateBestSubset randomly includes and excludes values in order to
+// find the subset whose sum is closest to, without going under, target.
+func approximateBestSubset(values []btcutil.Amount, order []int,
+	total, target btcutil.Amount) ([]bool, btcutil.Amount) {
+	best := make([]bool, len(order))
+	for i := range best {
+		best[i] = true
+	}
+	bestValue := total
+	included := make([]bool, len(order))
+	for rep := 0; rep < knapsackIterations && bestValue != target; rep++ {
+		for i := range included {
+			included[i] = false
+		}
+		var sum btcutil.Amount
+		reached := false
+		for pass := 0; pass < 2 && !reached; pass++ {
+			for i, idx := range order {
+				// The first pass includes coins at random, the second pass
+				// includes every coin which was left out by the first.
+				if pass == 0 && cprng.Int31n(2) == 0 {
+					continue
+				} else if pass == 1 && included[i] {
+					continue
+				}
+				sum += values[idx]
+				included[i] = true
+				if sum >= target {
+					reached = true
+					if sum < bestValue {
+						bestValue = sum
+						copy(best, included)
+					}
+					sum -= values[idx]
+					included[i] = false
+				}
+			}
+		}
+	}
+	return best, bestValue
+}
+
+// SelectKnapsack chooses a subset of values which sums to at least target,
+// preferring an exact match, then a subset which leaves at least minChange,
+// and otherwise the smallest single value which is large enough.  This is
+// used when SelectBranchAndBound is unable to find a changeless solution.
+// The result is a list of indexes into values, or nil if the values do not
+// sum to target.
+func SelectKnapsack(values []btcutil.Amount, target, minChange btcutil.Amount) []int {
+	lowestLarger := -1
+	var smaller []int
+	var totalLower btcutil.Amount
+	for i, v := range values {
+		if v <= 0 {
+			continue
+		}
+		if v == target {
+			return []int{i}
+		}
+		if v < target+minChange {
+			smaller = append(smaller, i)
+			totalLower += v
+		} else if lowestLarger < 0 || v < values[lowestLarger] {
+			lowestLarger = i
+		}
+	}
+	if totalLower == target {
+		return smaller
+	}
+	if totalLower < target {
+		if lowestLarger < 0 {
+			return nil
+		}
+		return []int{lowestLarger}
+	}
+
+	sort.SliceStable(smaller, func(a, b int) bool {
+		return values[smaller[a]] > values[smaller[b]]
+	})
+	best, bestValue := approximateBestSubset(values, smaller, totalLower, target)
+	if bestValue != target && totalLower >= target+minChange {
+		best, bestValue = approximateBestSubset(values, smaller, totalLower, target+minChange)
+	}
+
+	// A single larger coin is better than a subset which leaves too little
+	// change, or a subset which spends more.
+	if lowestLarger >= 0 && ((bestValue != target && bestValue < target+minChange) ||
+		values[lowestLarger] <= bestValue) {
+		return []int{lowestLarger}
+	}
+	var out []int
+	for i, sel := range best {
+		if sel {
+			out = append(out, smaller[i])
+		}
+	}
+	sort.Ints(out)
+	return out
+}
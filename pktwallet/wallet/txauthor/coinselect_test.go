@@ -0,0 +1,105 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txauthor_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcutil"
+	. "github.com/pkt-cash/pktd/pktwallet/wallet/txauthor"
+)
+
+func sumOf(values []btcutil.Amount, idx []int) btcutil.Amount {
+	var sum btcutil.Amount
+	for _, i := range idx {
+		sum += values[i]
+	}
+	return sum
+}
+
+func TestSelectBranchAndBound(t *testing.T) {
+	values := []btcutil.Amount{1e8, 2e8, 3e8, 4e8, 5e8}
+	tests := []struct {
+		target btcutil.Amount
+		window btcutil.Amount
+		want   []int
+	}{
+		// exact single coin
+		{target: 3e8, window: 1000, want: []int{2}},
+		// exact combination
+		{target: 10e8, window: 1000, want: []int{0, 3, 4}},
+		// within the window, the least excess wins
+		{target: 6e8 - 500, window: 1000, want: []int{0, 4}},
+		// no combination lands inside the window
+		{target: 1e8 + 1, window: 1000, want: nil},
+		// not enough funds
+		{target: 16e8, window: 1000, want: nil},
+	}
+	for i, test := range tests {
+		got := SelectBranchAndBound(values, test.target, test.window)
+		if test.want == nil {
+			if got != nil {
+				t.Errorf("%d: expected no solution, got %v", i, got)
+			}
+			continue
+		}
+		if s := sumOf(values, got); s < test.target || s >= test.target+test.window {
+			t.Errorf("%d: sum %v out of range", i, s)
+		}
+		if sumOf(values, got) != sumOf(values, test.want) {
+			t.Errorf("%d: got %v want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestSelectBranchAndBoundIgnoresNegative(t *testing.T) {
+	// Coins which cost more to spend than they are worth are never chosen.
+	values := []btcutil.Amount{-100, 5000, -1, 7000}
+	got := SelectBranchAndBound(values, 12000, 10)
+	if !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestSelectKnapsack(t *testing.T) {
+	const minChange = 1e6
+	tests := []struct {
+		name   string
+		values []btcutil.Amount
+		target btcutil.Amount
+		want   []int
+	}{
+		{"exact match", []btcutil.Amount{5e7, 1e8, 2e8}, 1e8, []int{1}},
+		{"all smaller sum exactly", []btcutil.Amount{3e7, 7e7, 5e8}, 1e8, []int{0, 1}},
+		{"smaller insufficient", []btcutil.Amount{1e7, 2e7, 5e8, 3e8}, 1e8, []int{3}},
+		{"insufficient", []btcutil.Amount{1e7, 2e7}, 1e8, nil},
+		// 6e7+4e7 is exact, better than the single large coin
+		{"subset", []btcutil.Amount{6e7, 9e8, 4e7, 3e7}, 1e8, []int{0, 2}},
+	}
+	for _, test := range tests {
+		got := SelectKnapsack(test.values, test.target, minChange)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v want %v", test.name, got, test.want)
+		}
+	}
+
+	// When there is no exact match, the result covers the target and
+	// leaves at least minChange, or is a single coin.
+	values := []btcutil.Amount{11e6, 23e6, 37e6, 41e6, 53e6, 67e6}
+	for target := btcutil.Amount(5e6); target < 230e6; target += 7e6 {
+		got := SelectKnapsack(values, target, minChange)
+		if got == nil {
+			t.Fatalf("target %v: no solution", target)
+		}
+		s := sumOf(values, got)
+		if s < target {
+			t.Fatalf("target %v: sum %v is not enough", target, s)
+		}
+		if s != target && s < target+minChange && len(got) != 1 {
+			t.Fatalf("target %v: sum %v leaves too little change", target, s)
+		}
+	}
+}
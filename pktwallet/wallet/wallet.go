@@ -123,6 +123,9 @@ type Wallet struct {
 
 	rescanJLock sync.Mutex
 	rescanJ     *rescanJob
+
+	foldJLock sync.Mutex
+	foldJ     *foldJob
 }
 
 type rescanJob struct {
@@ -510,6 +513,9 @@ type (
 		InputMinHeight  int
 		InputComparator utils.Comparator
 		MaxInputs       int
+		InputOutpoints  *[]wire.OutPoint
+		CoinSelection   CoinSelection
+		AvoidMixing     bool
 	}
 	createTxRequest struct {
 		req  CreateTxReq
@@ -2171,7 +2177,18 @@ func Create(db walletdb.DB, pubPass, privPass []byte, seedInput []byte,
 		// If we don't know the bday, put it before all of this began
 		birthday = time.Unix(1231006505, 0)
 	}
+	return create(db, pubPass, privPass, legacySeed, seedx, birthday, params)
+}
+
+// CreateFromBip39 creates a new wallet using a root seed derived from a BIP39
+// mnemonic, the birthday of the seed is used as the wallet birthday.
+func CreateFromBip39(db walletdb.DB, pubPass, privPass []byte,
+	seed *seedwords.Bip39Seed, params *chaincfg.Params) er.R {
+	return create(db, pubPass, privPass, seed.Bytes(), nil, seed.Birthday(), params)
+}
 
+func create(db walletdb.DB, pubPass, privPass []byte, legacySeed []byte,
+	seedx *seedwords.Seed, birthday time.Time, params *chaincfg.Params) er.R {
 	return walletdb.Update(db, func(tx walletdb.ReadWriteTx) er.R {
 		addrmgrNs, err := tx.CreateTopLevelBucket(waddrmgrNamespaceKey)
 		if err != nil {
@@ -2788,6 +2805,10 @@ func (w *Wallet) walletInit() {
 		err.AddMessage("Unable to synchronize wallet to chain")
 		panic(err.String())
 	}
+
+	if err := w.loadFoldJob(); err != nil {
+		log.Warnf("Unable to resume fold job [%s]", err.String())
+	}
 }
 
 func (w *Wallet) goMainLoop() {
@@ -2800,6 +2821,7 @@ func (w *Wallet) goMainLoop() {
 	w.walletInit()
 	for {
 		w.rescan()
+		w.fold()
 		w.checkBlock()
 		time.Sleep(time.Duration(500) * time.Millisecond)
 	}
@@ -107,10 +107,32 @@ func convertLegacyKeystore(legacyKeyStore *keystore.Store, w *wallet.Wallet) er.
 }
 
 type WalletSetupCfg struct {
-	Passphrase       *string `json:"passphrase"`
-	PublicPassphrase *string `json:"viewpassphrase"`
-	Seed             *string `json:"seed"`
-	SeedPassphrase   *string `json:"seedpassphrase"`
+	Passphrase       *string  `json:"passphrase"`
+	PublicPassphrase *string  `json:"viewpassphrase"`
+	Seed             *string  `json:"seed"`
+	SeedPassphrase   *string  `json:"seedpassphrase"`
+	SeedShares       []string `json:"seedshares"`
+	Bip39Mnemonic    *string  `json:"bip39mnemonic"`
+	Bip39Passphrase  *string  `json:"bip39passphrase"`
+	Birthday         *string  `json:"birthday"`
+}
+
+// seedFromShares decodes and combines the shares of a split seed.
+func seedFromShares(words []string) (*seedwords.SeedEnc, er.R) {
+	shares := make([]*seedwords.Share, 0, len(words))
+	defer func() {
+		for _, sh := range shares {
+			sh.Zero()
+		}
+	}()
+	for i, w := range words {
+		sh, err := seedwords.ShareFromWords(w)
+		if err != nil {
+			return nil, er.Errorf("Share number %d: %s", i+1, err.Message())
+		}
+		shares = append(shares, sh)
+	}
+	return seedwords.CombineShares(shares)
 }
 
 // createWallet prompts the user for information needed to generate a new wallet
@@ -149,6 +171,7 @@ func createWallet(cfg *config) er.R {
 	pubPass := []byte(wallet.InsecurePubPassphrase)
 	var seedInput []byte
 	var seed *seedwords.Seed
+	var bip39Seed *seedwords.Bip39Seed
 	setupCfg := WalletSetupCfg{}
 	if (fi.Mode() & os.ModeCharDevice) != 0 {
 		tty = true
@@ -163,12 +186,45 @@ func createWallet(cfg *config) er.R {
 		if setupCfg.PublicPassphrase != nil {
 			pubPass = []byte(*setupCfg.PublicPassphrase)
 		}
-		if setupCfg.Seed != nil {
-			if decoded, err := hex.DecodeString(*setupCfg.Seed); err == nil {
-				zero.Bytes(decoded)
-				seedInput = []byte(*setupCfg.Seed)
-			} else {
-				seedEnc, err := seedwords.SeedFromWords(*setupCfg.Seed)
+		if setupCfg.Bip39Mnemonic != nil {
+			if setupCfg.Seed != nil || len(setupCfg.SeedShares) > 0 {
+				return er.New("Only one of seed, seedshares and bip39mnemonic may be specified")
+			}
+			if setupCfg.Birthday == nil {
+				return er.New("A birthday (YYYY-MM-DD) is required when " +
+					"importing a BIP39 mnemonic")
+			}
+			bday, err := seedwords.ParseBirthday(*setupCfg.Birthday)
+			if err != nil {
+				return err
+			}
+			var bs []byte
+			if setupCfg.Bip39Passphrase != nil {
+				bs = []byte(*setupCfg.Bip39Passphrase)
+			}
+			s, err := seedwords.Bip39SeedFromMnemonic(*setupCfg.Bip39Mnemonic, bs, bday)
+			if err != nil {
+				return err
+			}
+			bip39Seed = s
+		} else if setupCfg.Seed != nil || len(setupCfg.SeedShares) > 0 {
+			if setupCfg.Seed != nil && len(setupCfg.SeedShares) > 0 {
+				return er.New("Only one of seed and seedshares may be specified")
+			}
+			if setupCfg.Seed != nil {
+				if decoded, err := hex.DecodeString(*setupCfg.Seed); err == nil {
+					zero.Bytes(decoded)
+					seedInput = []byte(*setupCfg.Seed)
+				}
+			}
+			if seedInput == nil {
+				var seedEnc *seedwords.SeedEnc
+				var err er.R
+				if setupCfg.Seed != nil {
+					seedEnc, err = seedwords.SeedFromWords(*setupCfg.Seed)
+				} else {
+					seedEnc, err = seedFromShares(setupCfg.SeedShares)
+				}
 				if err != nil {
 					return err
 				}
@@ -267,18 +323,26 @@ func createWallet(cfg *config) er.R {
 	// automatically generated value the user has already confirmed or a
 	// value the user has entered which has already been validated.
 	if tty {
-		si, sd, err := prompt.Seed(reader, privPass)
+		si, sd, bs, err := prompt.Seed(reader, privPass)
 		if err != nil {
 			return err
 		}
 		seedInput = si
 		seed = sd
+		bip39Seed = bs
 	}
 
 	if tty {
 		fmt.Println("Creating the wallet...")
 	}
-	w, werr := loader.CreateNewWallet(pubPass, privPass, seedInput, seed)
+	var w *wallet.Wallet
+	var werr er.R
+	if bip39Seed != nil {
+		w, werr = loader.CreateNewWalletFromBip39(pubPass, privPass, bip39Seed)
+		bip39Seed.Zero()
+	} else {
+		w, werr = loader.CreateNewWallet(pubPass, privPass, seedInput, seed)
+	}
 	if werr != nil {
 		return werr
 	}
@@ -294,7 +358,7 @@ func createWallet(cfg *config) er.R {
 			fmt.Printf(`{"seed":"%s"}`+"\n", words)
 		}
 		seedEnc.Zero()
-	} else {
+	} else if seedInput != nil {
 		fmt.Printf(`{"seed":"%s"}`+"\n", seedInput)
 	}
 	if seed != nil {
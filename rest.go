@@ -0,0 +1,487 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+const (
+	// restPrefix is the path which the REST interface is served under.
+	restPrefix = "/rest/"
+
+	// restMaxHeaders is the maximum number of headers which can be
+	// requested from the headers endpoint at one time.
+	restMaxHeaders = 2000
+
+	// restMaxOutpoints is the maximum number of outpoints which can be
+	// queried by the getutxos endpoint at one time.
+	restMaxOutpoints = 15
+)
+
+// restFormat is the encoding of a REST reply, it is selected by the extension
+// of the last element of the path.
+type restFormat int
+
+const (
+	restJSON restFormat = iota
+	restHex
+	restBinary
+)
+
+var restFormats = map[string]restFormat{
+	"json": restJSON,
+	"hex":  restHex,
+	"bin":  restBinary,
+}
+
+// restHandler handles one REST endpoint.  The args are the path elements
+// following the name of the endpoint, with the format extension removed.
+type restHandler struct {
+	handler func(s *rpcServer, args []string, format restFormat) (interface{}, []byte, er.R)
+
+	// jsonOnly is set for endpoints which have no serialized form.
+	jsonOnly bool
+}
+
+// restHandlers maps the name of each REST endpoint to its handler.  Every
+// endpoint is read-only and serves only public chain data, there is no
+// authentication for the REST interface.
+var restHandlers map[string]restHandler
+
+func init() {
+	restHandlers = map[string]restHandler{
+		"block":             {handler: handleRestBlock},
+		"headers":           {handler: handleRestHeaders},
+		"tx":                {handler: handleRestTx},
+		"blockhashbyheight": {handler: handleRestBlockHashByHeight},
+		"chaininfo":         {handler: handleRestChainInfo, jsonOnly: true},
+		"mempool":           {handler: handleRestMempool, jsonOnly: true},
+		"getutxos":          {handler: handleRestGetUtxos},
+	}
+}
+
+// parseRestPath splits the path of a REST request into the name of the
+// endpoint, its arguments and the requested format.
+func parseRestPath(path string) (string, []string, restFormat, er.R) {
+	path = strings.TrimPrefix(path, restPrefix)
+	dot := strings.LastIndex(path, ".")
+	if dot < 0 || dot < strings.LastIndex(path, "/") {
+		return "", nil, 0, er.New("missing format extension, " +
+			"use one of .json, .hex or .bin")
+	}
+	format, ok := restFormats[path[dot+1:]]
+	if !ok {
+		return "", nil, 0, er.Errorf("unknown format [%s], "+
+			"use one of .json, .hex or .bin", path[dot+1:])
+	}
+	elems := strings.Split(path[:dot], "/")
+	for _, e := range elems {
+		if e == "" {
+			return "", nil, 0, er.New("empty path element")
+		}
+	}
+	return elems[0], elems[1:], format, nil
+}
+
+// restStatus returns the HTTP status code which corresponds to an error
+// returned by an RPC handler.
+func restStatus(err er.R) int {
+	switch {
+	case btcjson.ErrRPCBlockNotFound.Is(err),
+		btcjson.ErrRPCNoTxInfo.Is(err),
+		btcjson.ErrBlockHeightOutOfRange.Is(err):
+		return http.StatusNotFound
+	case btcjson.ErrRPCInternal.Is(err):
+		return http.StatusInternalServerError
+	}
+	return http.StatusBadRequest
+}
+
+// restRead serves one REST request.
+func (s *rpcServer) restRead(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	name, args, format, err := parseRestPath(r.URL.Path)
+	if err != nil {
+		http.Error(w, err.Message(), http.StatusBadRequest)
+		return
+	}
+	rh, ok := restHandlers[name]
+	if !ok {
+		http.Error(w, "Unknown REST endpoint", http.StatusNotFound)
+		return
+	}
+	if rh.jsonOnly && format != restJSON {
+		http.Error(w, "This endpoint is only available as .json",
+			http.StatusNotFound)
+		return
+	}
+
+	result, bin, err := rh.handler(s, args, format)
+	if err != nil {
+		msg := err.Message()
+		if btcjson.ErrRPCInternal.Is(err) {
+			rpcsLog.Errorf("REST request for [%s] failed: %s", r.URL.Path,
+				err.String())
+			msg = "Internal error"
+		}
+		http.Error(w, msg, restStatus(err))
+		return
+	}
+
+	var reply []byte
+	switch format {
+	case restJSON:
+		var errr error
+		if reply, errr = jsoniter.Marshal(result); errr != nil {
+			rpcsLog.Errorf("Failed to marshal REST reply for [%s]: %v",
+				r.URL.Path, errr)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		reply = append(reply, '\n')
+	case restHex:
+		w.Header().Set("Content-Type", "text/plain")
+		reply = []byte(hex.EncodeToString(bin) + "\n")
+	case restBinary:
+		w.Header().Set("Content-Type", "application/octet-stream")
+		reply = bin
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(reply)))
+	if _, errr := w.Write(reply); errr != nil {
+		rpcsLog.Debugf("Failed to write REST reply: %v", errr)
+	}
+}
+
+// restArgCount returns an error unless there are exactly n arguments.
+func restArgCount(args []string, n int) er.R {
+	if len(args) != n {
+		return btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			"Wrong number of path elements", nil)
+	}
+	return nil
+}
+
+// restHexResult decodes the hex string which an RPC handler returned when it
+// was called without the verbose flag.
+func restHexResult(result interface{}) ([]byte, er.R) {
+	b, errr := hex.DecodeString(result.(string))
+	if errr != nil {
+		return nil, internalRPCError(er.E(errr), "Failed to decode result")
+	}
+	return b, nil
+}
+
+// handleRestBlock implements /rest/block/<hash>.
+func handleRestBlock(s *rpcServer, args []string, format restFormat) (interface{}, []byte, er.R) {
+	if err := restArgCount(args, 1); err != nil {
+		return nil, nil, err
+	}
+	verbose := format == restJSON
+	result, err := handleGetBlock(s, &btcjson.GetBlockCmd{
+		Hash:    args[0],
+		Verbose: &verbose,
+	}, nil)
+	if err != nil || verbose {
+		return result, nil, err
+	}
+	bin, err := restHexResult(result)
+	return nil, bin, err
+}
+
+// handleRestHeaders implements /rest/headers/<count>/<hash>, it returns up to
+// count headers of the main chain starting with the block with the hash.
+func handleRestHeaders(s *rpcServer, args []string, format restFormat) (interface{}, []byte, er.R) {
+	if err := restArgCount(args, 2); err != nil {
+		return nil, nil, err
+	}
+	count, errr := strconv.Atoi(args[0])
+	if errr != nil || count < 1 || count > restMaxHeaders {
+		return nil, nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			"Header count must be between 1 and "+strconv.Itoa(restMaxHeaders), nil)
+	}
+	hash, err := chainhash.NewHashFromStr(args[1])
+	if err != nil {
+		return nil, nil, rpcDecodeHexError(args[1])
+	}
+	height, err := s.cfg.Chain.BlockHeightByHash(hash)
+	if err != nil {
+		return nil, nil, btcjson.NewRPCError(btcjson.ErrRPCBlockNotFound,
+			"Block not found", nil)
+	}
+
+	verbose := format == restJSON
+	var results []interface{}
+	var bin []byte
+	best := s.cfg.Chain.BestSnapshot().Height
+	for i := 0; i < count && height <= best; i++ {
+		result, err := handleGetBlockHeader(s, &btcjson.GetBlockHeaderCmd{
+			Hash:    hash.String(),
+			Verbose: &verbose,
+		}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if verbose {
+			results = append(results, result)
+		} else {
+			b, err := restHexResult(result)
+			if err != nil {
+				return nil, nil, err
+			}
+			bin = append(bin, b...)
+		}
+		height++
+		if height > best {
+			break
+		}
+		if hash, err = s.cfg.Chain.BlockHashByHeight(height); err != nil {
+			// The chain was reorganized while the headers were being
+			// fetched, return what we have.
+			break
+		}
+	}
+	return results, bin, nil
+}
+
+// handleRestTx implements /rest/tx/<txid>, transactions which are not in the
+// mempool can only be found if the transaction index is enabled.
+func handleRestTx(s *rpcServer, args []string, format restFormat) (interface{}, []byte, er.R) {
+	if err := restArgCount(args, 1); err != nil {
+		return nil, nil, err
+	}
+	verbose := format == restJSON
+	result, err := handleGetRawTransaction(s, &btcjson.GetRawTransactionCmd{
+		Txid:    args[0],
+		Verbose: &verbose,
+	}, nil)
+	if err != nil || verbose {
+		return result, nil, err
+	}
+	bin, err := restHexResult(result)
+	return nil, bin, err
+}
+
+// handleRestBlockHashByHeight implements /rest/blockhashbyheight/<height>.
+func handleRestBlockHashByHeight(s *rpcServer, args []string, format restFormat) (interface{}, []byte, er.R) {
+	if err := restArgCount(args, 1); err != nil {
+		return nil, nil, err
+	}
+	height, errr := strconv.ParseInt(args[0], 10, 32)
+	if errr != nil || height < 0 {
+		return nil, nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			"Invalid block height", nil)
+	}
+	result, err := handleGetBlockHash(s, &btcjson.GetBlockHashCmd{Index: height}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if format == restJSON {
+		return struct {
+			BlockHash string `json:"blockhash"`
+		}{result.(string)}, nil, nil
+	}
+	hash, err := chainhash.NewHashFromStr(result.(string))
+	if err != nil {
+		return nil, nil, internalRPCError(err, "Failed to decode block hash")
+	}
+	return nil, hash[:], nil
+}
+
+// handleRestChainInfo implements /rest/chaininfo.
+func handleRestChainInfo(s *rpcServer, args []string, format restFormat) (interface{}, []byte, er.R) {
+	if err := restArgCount(args, 0); err != nil {
+		return nil, nil, err
+	}
+	result, err := handleGetBlockChainInfo(s, &btcjson.GetBlockChainInfoCmd{}, nil)
+	return result, nil, err
+}
+
+// handleRestMempool implements /rest/mempool/info.
+func handleRestMempool(s *rpcServer, args []string, format restFormat) (interface{}, []byte, er.R) {
+	if err := restArgCount(args, 1); err != nil {
+		return nil, nil, err
+	}
+	if args[0] != "info" {
+		return nil, nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			"Unknown mempool endpoint", nil)
+	}
+	result, err := handleGetMempoolInfo(s, &btcjson.GetMempoolInfoCmd{}, nil)
+	return result, nil, err
+}
+
+// restUtxo is an unspent output in the reply to getutxos.
+type restUtxo struct {
+	Height     int32   `json:"height"`
+	ValueCoins float64 `json:"value"`
+	Svalue     string  `json:"svalue"`
+	Address    string  `json:"address"`
+	value      int64
+	pkScript   []byte
+}
+
+// restGetUtxosResult is the reply to getutxos.
+type restGetUtxosResult struct {
+	ChainHeight  int32      `json:"chainHeight"`
+	ChainTipHash string     `json:"chaintipHash"`
+	Bitmap       string     `json:"bitmap"`
+	Utxos        []restUtxo `json:"utxos"`
+}
+
+// parseRestOutpoint parses an outpoint in the form <txid>-<index>.
+func parseRestOutpoint(s string) (*wire.OutPoint, er.R) {
+	dash := strings.LastIndex(s, "-")
+	if dash < 0 {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			"Outpoints must be in the form <txid>-<index>", nil)
+	}
+	hash, err := chainhash.NewHashFromStr(s[:dash])
+	if err != nil {
+		return nil, rpcDecodeHexError(s[:dash])
+	}
+	index, errr := strconv.ParseUint(s[dash+1:], 10, 32)
+	if errr != nil {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			"Invalid outpoint index", nil)
+	}
+	return wire.NewOutPoint(hash, uint32(index)), nil
+}
+
+// handleRestGetUtxos implements /rest/getutxos[/checkmempool]/<txid>-<n>/...,
+// it reports which of the outpoints are unspent along with the outputs.  If
+// checkmempool is given then outputs created by transactions in the mempool
+// are included and outputs spent by them are excluded.
+//
+// The serialized form is the chain height (uint32), the hash of the chain
+// tip, a varint length prefixed bitmap with one bit per outpoint which is set
+// if it is unspent, then a varint count of the unspent outputs, each being
+// its height (uint32), value (int64) and varint length prefixed script.
+func handleRestGetUtxos(s *rpcServer, args []string, format restFormat) (interface{}, []byte, er.R) {
+	checkMempool := false
+	if len(args) > 0 && args[0] == "checkmempool" {
+		checkMempool = true
+		args = args[1:]
+	}
+	if len(args) == 0 || len(args) > restMaxOutpoints {
+		return nil, nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			"Between 1 and "+strconv.Itoa(restMaxOutpoints)+
+				" outpoints must be given", nil)
+	}
+	outpoints := make([]*wire.OutPoint, 0, len(args))
+	for _, arg := range args {
+		op, err := parseRestOutpoint(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		outpoints = append(outpoints, op)
+	}
+
+	best := s.cfg.Chain.BestSnapshot()
+	res := restGetUtxosResult{
+		ChainHeight:  best.Height,
+		ChainTipHash: best.Hash.String(),
+		Utxos:        []restUtxo{},
+	}
+	bitmap := make([]byte, (len(outpoints)+7)/8)
+	var bits strings.Builder
+	for i, op := range outpoints {
+		utxo, err := s.restFetchUtxo(op, checkMempool)
+		if err != nil {
+			return nil, nil, err
+		}
+		if utxo == nil {
+			bits.WriteByte('0')
+			continue
+		}
+		bits.WriteByte('1')
+		bitmap[i/8] |= 1 << uint(i%8)
+		res.Utxos = append(res.Utxos, *utxo)
+	}
+	res.Bitmap = bits.String()
+	if format == restJSON {
+		return res, nil, nil
+	}
+
+	var buf bytes.Buffer
+	var u32 [4]byte
+	var u64 [8]byte
+	binary.LittleEndian.PutUint32(u32[:], uint32(res.ChainHeight))
+	buf.Write(u32[:])
+	buf.Write(best.Hash[:])
+	if err := wire.WriteVarBytes(&buf, 0, bitmap); err != nil {
+		return nil, nil, internalRPCError(err, "Failed to serialize utxos")
+	}
+	if err := wire.WriteVarInt(&buf, 0, uint64(len(res.Utxos))); err != nil {
+		return nil, nil, internalRPCError(err, "Failed to serialize utxos")
+	}
+	for _, u := range res.Utxos {
+		binary.LittleEndian.PutUint32(u32[:], uint32(u.Height))
+		buf.Write(u32[:])
+		binary.LittleEndian.PutUint64(u64[:], uint64(u.value))
+		buf.Write(u64[:])
+		if err := wire.WriteVarBytes(&buf, 0, u.pkScript); err != nil {
+			return nil, nil, internalRPCError(err, "Failed to serialize utxos")
+		}
+	}
+	return nil, buf.Bytes(), nil
+}
+
+// restFetchUtxo returns the unspent output at the outpoint, or nil if it is
+// spent or does not exist.  Outputs of mempool transactions have a height of
+// 0x7fffffff, the same as the reference client.
+func (s *rpcServer) restFetchUtxo(op *wire.OutPoint, checkMempool bool) (*restUtxo, er.R) {
+	var value int64
+	var pkScript []byte
+	height := int32(0x7fffffff)
+	if checkMempool && s.cfg.TxMemPool.CheckSpend(*op) != nil {
+		return nil, nil
+	}
+	if tx, err := s.cfg.TxMemPool.FetchTransaction(&op.Hash); checkMempool && err == nil {
+		if op.Index >= uint32(len(tx.MsgTx().TxOut)) {
+			return nil, nil
+		}
+		txOut := tx.MsgTx().TxOut[op.Index]
+		value = txOut.Value
+		pkScript = txOut.PkScript
+	} else {
+		entry, err := s.cfg.Chain.FetchUtxoEntry(*op)
+		if err != nil {
+			return nil, internalRPCError(err, "Failed to fetch utxo")
+		}
+		if entry == nil || entry.IsSpent() {
+			return nil, nil
+		}
+		value = entry.Amount()
+		pkScript = entry.PkScript()
+		height = entry.BlockHeight()
+	}
+
+	return &restUtxo{
+		Height:     height,
+		ValueCoins: btcutil.Amount(value).ToBTC(),
+		Svalue:     strconv.FormatInt(value, 10),
+		Address:    txscript.PkScriptToAddress(pkScript, s.cfg.ChainParams).EncodeAddress(),
+		value:      value,
+		pkScript:   pkScript,
+	}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseRestPath ensures that REST paths are split into the endpoint, its
+// arguments and the format, and that malformed paths are rejected.
+func TestParseRestPath(t *testing.T) {
+	tests := []struct {
+		path   string
+		name   string
+		args   []string
+		format restFormat
+		bad    bool
+	}{
+		{path: "/rest/chaininfo.json", name: "chaininfo", args: []string{}},
+		{path: "/rest/mempool/info.json", name: "mempool", args: []string{"info"}},
+		{
+			path:   "/rest/headers/5/0000000000000000000000000000000000000000000000000000000000000000.hex",
+			name:   "headers",
+			args:   []string{"5", "0000000000000000000000000000000000000000000000000000000000000000"},
+			format: restHex,
+		},
+		{path: "/rest/blockhashbyheight/10.bin", name: "blockhashbyheight",
+			args: []string{"10"}, format: restBinary},
+		{
+			path: "/rest/getutxos/checkmempool/aa-0/bb-1.json",
+			name: "getutxos",
+			args: []string{"checkmempool", "aa-0", "bb-1"},
+		},
+		{path: "/rest/chaininfo", bad: true},
+		{path: "/rest/chaininfo.xml", bad: true},
+		{path: "/rest/block.json/abc", bad: true},
+		{path: "/rest/block//abc.json", bad: true},
+		{path: "/rest/.json", bad: true},
+	}
+
+	for _, test := range tests {
+		name, args, format, err := parseRestPath(test.path)
+		if test.bad {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.path, err)
+			continue
+		}
+		if name != test.name || format != test.format ||
+			!reflect.DeepEqual(args, test.args) {
+
+			t.Errorf("%s: got %s %v %d, expected %s %v %d", test.path,
+				name, args, format, test.name, test.args, test.format)
+		}
+	}
+}
+
+// TestParseRestOutpoint ensures that getutxos outpoints are parsed.
+func TestParseRestOutpoint(t *testing.T) {
+	txid := "4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b"
+	op, err := parseRestOutpoint(txid + "-3")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if op.Hash.String() != txid || op.Index != 3 {
+		t.Errorf("got %v", op)
+	}
+	for _, bad := range []string{txid, txid + "-x", "zz-1", txid + "--1"} {
+		if _, err := parseRestOutpoint(bad); err == nil {
+			t.Errorf("%s: expected an error", bad)
+		}
+	}
+}
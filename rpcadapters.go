@@ -5,7 +5,9 @@
 package main
 
 import (
+	"net"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkt-cash/pktd/btcutil/er"
 
@@ -42,7 +44,7 @@ func (p *rpcPeer) ToPeer() *peer.Peer {
 // This function is safe for concurrent access and is part of the rpcserverPeer
 // interface implementation.
 func (p *rpcPeer) IsTxRelayDisabled() bool {
-	return (*serverPeer)(p).disableRelayTx
+	return (*serverPeer)(p).relayTxDisabled()
 }
 
 // BanScore returns the current integer value that represents how close the peer
@@ -63,6 +65,14 @@ func (p *rpcPeer) FeeFilter() int64 {
 	return atomic.LoadInt64(&(*serverPeer)(p).feeFilter)
 }
 
+// ConnectionType returns the type of the connection to the peer.
+//
+// This function is safe for concurrent access and is part of the rpcserverPeer
+// interface implementation.
+func (p *rpcPeer) ConnectionType() string {
+	return (*serverPeer)(p).connectionType()
+}
+
 // rpcConnManager provides a connection manager for use with the RPC server and
 // implements the rpcserverConnManager interface.
 type rpcConnManager struct {
@@ -149,6 +159,60 @@ func (cm *rpcConnManager) DisconnectByAddr(addr string) er.R {
 	return <-replyChan
 }
 
+// BanSubnet bans the provided subnet until the provided time and disconnects
+// the peers within it.  It returns false when the subnet is already banned.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) BanSubnet(ipNet *net.IPNet, until time.Time,
+	reason string) (bool, er.R) {
+
+	replyChan := make(chan banReply)
+	cm.server.query <- banSubnetMsg{
+		ipNet:  ipNet,
+		until:  until,
+		reason: reason,
+		reply:  replyChan,
+	}
+	reply := <-replyChan
+	return reply.changed, reply.err
+}
+
+// UnbanSubnet lifts the ban of the provided subnet.  It returns false when the
+// subnet is not banned.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) UnbanSubnet(ipNet *net.IPNet) (bool, er.R) {
+	replyChan := make(chan banReply)
+	cm.server.query <- unbanSubnetMsg{
+		ipNet: ipNet,
+		reply: replyChan,
+	}
+	reply := <-replyChan
+	return reply.changed, reply.err
+}
+
+// BannedSubnets returns the bans which have not yet expired.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) BannedSubnets() []*banEntry {
+	replyChan := make(chan []*banEntry)
+	cm.server.query <- listBannedMsg{reply: replyChan}
+	return <-replyChan
+}
+
+// ClearBanned lifts all bans.
+//
+// This function is safe for concurrent access and is part of the
+// rpcserverConnManager interface implementation.
+func (cm *rpcConnManager) ClearBanned() er.R {
+	replyChan := make(chan er.R)
+	cm.server.query <- clearBannedMsg{reply: replyChan}
+	return <-replyChan
+}
+
 // ConnectedCount returns the number of currently connected peers.
 //
 // This function is safe for concurrent access and is part of the
@@ -220,9 +284,9 @@ func (cm *rpcConnManager) AddRebroadcastInventory(iv *wire.InvVect, data interfa
 }
 
 // RelayTransactions generates and relays inventory vectors for all of the
-// passed transactions to all connected peers.
+// passed transactions to all connected peers after a random delay.
 func (cm *rpcConnManager) RelayTransactions(txns []*mempool.TxDesc) {
-	cm.server.relayTransactions(txns)
+	cm.server.relayLocalTransactions(txns)
 }
 
 // rpcSyncMgr provides a block manager for use with the RPC server and
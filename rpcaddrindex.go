@@ -0,0 +1,202 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/pkt-cash/pktd/blockchain/indexers"
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// addrIndexOrError returns the address index, or an error if it is not
+// enabled.
+func (s *rpcServer) addrIndexOrError() (*indexers.AddrIndex, er.R) {
+	if s.cfg.AddrIndex == nil {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCMisc,
+			"Address index must be enabled (--addrindex)", nil)
+	}
+	return s.cfg.AddrIndex, nil
+}
+
+// decodeAddresses decodes the addresses of the address index commands.
+func (s *rpcServer) decodeAddresses(addresses []string) ([]btcutil.Address, er.R) {
+	if len(addresses) == 0 {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"No addresses given", nil)
+	}
+	addrs := make([]btcutil.Address, 0, len(addresses))
+	for _, address := range addresses {
+		addr, err := btcutil.DecodeAddress(address, s.cfg.ChainParams)
+		if err != nil {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidAddressOrKey,
+				"Invalid address or key: "+address, err)
+		}
+		if !indexers.IsSupportedAddress(addr) {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidAddressOrKey,
+				"Address type is not supported by the address index: "+
+					address, nil)
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// addrIndexQueryError converts an error from querying the address index to
+// an RPC error.
+func addrIndexQueryError(err er.R) er.R {
+	if indexers.ErrAddrIndexNotUpgraded.Is(err) {
+		return btcjson.NewRPCError(btcjson.ErrRPCMisc,
+			"Address index has not been upgraded, restart to upgrade it", err)
+	}
+	return internalRPCError(err, "Failed to query the address index")
+}
+
+// handleGetAddressBalance implements the getaddressbalance command.
+func handleGetAddressBalance(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.GetAddressBalanceCmd)
+	addrIndex, err := s.addrIndexOrError()
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := s.decodeAddresses(c.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &btcjson.GetAddressBalanceResult{}
+	for _, addr := range addrs {
+		balance, received, err := addrIndex.BalanceForAddress(addr)
+		if err != nil {
+			return nil, addrIndexQueryError(err)
+		}
+		result.Balance += balance
+		result.Received += received
+	}
+	return result, nil
+}
+
+// handleGetAddressDeltas implements the getaddressdeltas command.
+func handleGetAddressDeltas(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.GetAddressDeltasCmd)
+	addrIndex, err := s.addrIndexOrError()
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := s.decodeAddresses(c.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	start := int32(0)
+	end := s.cfg.Chain.BestSnapshot().Height
+	if c.Start != nil {
+		start = *c.Start
+	}
+	if c.End != nil {
+		end = *c.End
+	}
+	if start < 0 || end < start {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Start must be positive and not greater than end", nil)
+	}
+
+	result := make([]btcjson.AddressDeltaResult, 0)
+	for i, addr := range addrs {
+		deltas, err := addrIndex.DeltasForAddress(addr, start, end)
+		if err != nil {
+			return nil, addrIndexQueryError(err)
+		}
+		for _, d := range deltas {
+			result = append(result, btcjson.AddressDeltaResult{
+				Address:    c.Addresses[i],
+				TxID:       d.TxHash.String(),
+				Index:      d.Index,
+				Satoshis:   d.Amount,
+				Height:     d.Height,
+				BlockIndex: d.BlockIndex,
+			})
+		}
+	}
+
+	// Interleave the deltas of the addresses in the order of the chain.
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Height != result[j].Height {
+			return result[i].Height < result[j].Height
+		}
+		return result[i].BlockIndex < result[j].BlockIndex
+	})
+	return result, nil
+}
+
+// handleGetAddressMempool implements the getaddressmempool command.
+func handleGetAddressMempool(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.GetAddressMempoolCmd)
+	addrIndex, err := s.addrIndexOrError()
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := s.decodeAddresses(c.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]btcjson.AddressMempoolResult, 0)
+	for i, addr := range addrs {
+		for _, d := range addrIndex.UnconfirmedDeltasForAddress(addr) {
+			r := btcjson.AddressMempoolResult{
+				Address:  c.Addresses[i],
+				TxID:     d.TxHash.String(),
+				Index:    d.Index,
+				Satoshis: d.Amount,
+			}
+			if d.Spending {
+				prevOut := d.PrevOut.Index
+				r.PrevTxID = d.PrevOut.Hash.String()
+				r.PrevOut = &prevOut
+			}
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+// handleGetAddressUtxos implements the getaddressutxos command.
+func handleGetAddressUtxos(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.GetAddressUtxosCmd)
+	addrIndex, err := s.addrIndexOrError()
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := s.decodeAddresses(c.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]btcjson.AddressUtxoResult, 0)
+	for i, addr := range addrs {
+		utxos, err := addrIndex.UtxosForAddress(addr)
+		if err != nil {
+			return nil, addrIndexQueryError(err)
+		}
+		for _, u := range utxos {
+			result = append(result, btcjson.AddressUtxoResult{
+				Address:     c.Addresses[i],
+				TxID:        u.OutPoint.Hash.String(),
+				OutputIndex: u.OutPoint.Index,
+				Script:      hex.EncodeToString(u.PkScript),
+				Satoshis:    u.Amount,
+				Height:      u.Height,
+			})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Height < result[j].Height
+	})
+	return result, nil
+}
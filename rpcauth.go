@@ -0,0 +1,190 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// rpcMethodGroups are named sets of methods which can be given in the
+// allow-list of an --rpcauth credential as @name.
+var rpcMethodGroups = map[string]map[string]struct{}{
+	// The methods which are available to the --rpclimituser.
+	"limited": rpcLimited,
+
+	// The methods which are needed by a block template service.
+	"mining": {
+		"checkpcann":        {},
+		"getbestblockhash":  {},
+		"getblockchaininfo": {},
+		"getblockcount":     {},
+		"getblockhash":      {},
+		"getblockheader":    {},
+		"getblocktemplate":  {},
+		"getcurrentnet":     {},
+		"getdifficulty":     {},
+		"getinfo":           {},
+		"getmininginfo":     {},
+		"getnetworkhashps":  {},
+		"getnetworkinfo":    {},
+		"getrawmempool":     {},
+		"help":              {},
+		"submitblock":       {},
+	},
+}
+
+// rpcUser is a user who is authenticated to the RPC server.
+type rpcUser struct {
+	name string
+
+	// salt and hash are the credential of a user which was configured
+	// using --rpcauth, hash is HMAC-SHA256(salt, password).
+	salt string
+	hash []byte
+
+	// methods is the set of methods which the user may call, if it is nil
+	// then the user is an admin who may call any method.
+	methods map[string]struct{}
+}
+
+// unknownRPCUser is the user whose credential is checked when the username
+// isn't one of the --rpcauth users, so that the check takes as long as for a
+// known user.  No password matches its hash.
+var unknownRPCUser = rpcUser{hash: make([]byte, sha256.Size+1)}
+
+// allowed returns true if the user may call the method.
+func (u *rpcUser) allowed(method string) bool {
+	if u.methods == nil {
+		return true
+	}
+	_, ok := u.methods[method]
+	return ok
+}
+
+// checkPass returns true if the password matches the --rpcauth credential of
+// the user.
+func (u *rpcUser) checkPass(pass string) bool {
+	mac := hmac.New(sha256.New, []byte(u.salt))
+	mac.Write([]byte(pass))
+	return hmac.Equal(mac.Sum(nil), u.hash)
+}
+
+// notAuthorizedError is the error which is returned when a user calls a
+// method which is not in their allow-list.
+func (u *rpcUser) notAuthorizedError(method string) er.R {
+	return btcjson.NewRPCError(
+		btcjson.ErrRPCInvalidParams,
+		"user ["+u.name+"] is not authorized for method ["+method+"]",
+		nil,
+	)
+}
+
+// rpcMethodExists returns true if the method is handled by the RPC server,
+// either over HTTP or websockets.
+func rpcMethodExists(method string) bool {
+	if _, ok := rpcHandlers[method]; ok {
+		return true
+	}
+	if _, ok := rpcAskWallet[method]; ok {
+		return true
+	}
+	_, ok := wsHandlers[method]
+	return ok
+}
+
+// parseRPCAuth parses an --rpcauth credential which has the form
+// user:salt$hmac[:method,@group,...] where hmac is the hex encoded
+// HMAC-SHA256 of the password, keyed with the salt.  If no methods are given
+// then the user is an admin.
+func parseRPCAuth(auth string) (*rpcUser, er.R) {
+	parts := strings.Split(auth, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, er.Errorf("rpcauth [%s] must have the form "+
+			"user:salt$hmac[:method,@group,...]", auth)
+	}
+	u := &rpcUser{name: parts[0]}
+	if u.name == "" {
+		return nil, er.Errorf("rpcauth [%s] has an empty user name", auth)
+	}
+	dollar := strings.Index(parts[1], "$")
+	if dollar < 1 {
+		return nil, er.Errorf("rpcauth for user [%s] must have the "+
+			"form salt$hmac", u.name)
+	}
+	u.salt = parts[1][:dollar]
+	hash, errr := hex.DecodeString(parts[1][dollar+1:])
+	if errr != nil || len(hash) != sha256.Size {
+		return nil, er.Errorf("rpcauth for user [%s] has an invalid hmac, "+
+			"it must be %d hex encoded bytes", u.name, sha256.Size)
+	}
+	u.hash = hash
+	if len(parts) == 2 {
+		return u, nil
+	}
+
+	u.methods = make(map[string]struct{})
+	for _, m := range strings.Split(parts[2], ",") {
+		m = strings.TrimSpace(m)
+		if strings.HasPrefix(m, "@") {
+			group, ok := rpcMethodGroups[m[1:]]
+			if !ok {
+				return nil, er.Errorf("rpcauth for user [%s] has unknown "+
+					"method group [%s], known groups are %s", u.name, m,
+					strings.Join(rpcMethodGroupNames(), ", "))
+			}
+			for method := range group {
+				u.methods[method] = struct{}{}
+			}
+			continue
+		}
+		if !rpcMethodExists(m) {
+			return nil, er.Errorf("rpcauth for user [%s] has unknown "+
+				"method [%s]", u.name, m)
+		}
+		u.methods[m] = struct{}{}
+	}
+	return u, nil
+}
+
+// rpcMethodGroupNames returns the names of the method groups, sorted.
+func rpcMethodGroupNames() []string {
+	names := make([]string, 0, len(rpcMethodGroups))
+	for name := range rpcMethodGroups {
+		names = append(names, "@"+name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseRPCAuths parses all of the --rpcauth credentials, user names must be
+// unique and must not be the same as the --rpcuser or --rpclimituser.
+func parseRPCAuths(auths []string, reserved ...string) (map[string]*rpcUser, er.R) {
+	users := make(map[string]*rpcUser, len(auths))
+	for _, auth := range auths {
+		u, err := parseRPCAuth(auth)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := users[u.name]; ok {
+			return nil, er.Errorf("rpcauth user [%s] is specified more "+
+				"than once", u.name)
+		}
+		for _, r := range reserved {
+			if r == u.name {
+				return nil, er.Errorf("rpcauth user [%s] is the same as "+
+					"the rpcuser or rpclimituser", u.name)
+			}
+		}
+		users[u.name] = u
+	}
+	return users, nil
+}
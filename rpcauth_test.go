@@ -0,0 +1,158 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcjson"
+)
+
+// rpcAuthHash returns the hmac part of an --rpcauth credential.
+func rpcAuthHash(salt, pass string) string {
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(pass))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// TestParseRPCAuth ensures that --rpcauth credentials are parsed along with
+// their allow-lists and that malformed credentials are rejected.
+func TestParseRPCAuth(t *testing.T) {
+	hash := rpcAuthHash("salt", "pass")
+	tests := []struct {
+		auth    string
+		admin   bool
+		allowed []string
+		denied  []string
+		bad     bool
+	}{
+		{auth: "ops:salt$" + hash, admin: true, allowed: []string{"stop"}},
+		{
+			auth:    "explorer:salt$" + hash + ":getblock,getrawtransaction",
+			allowed: []string{"getblock", "getrawtransaction"},
+			denied:  []string{"stop", "getblocktemplate"},
+		},
+		{
+			auth:    "pool:salt$" + hash + ":@mining,getpeerinfo",
+			allowed: []string{"getblocktemplate", "submitblock", "getpeerinfo"},
+			denied:  []string{"stop", "addnode"},
+		},
+		{
+			auth:    "limited:salt$" + hash + ":@limited",
+			allowed: []string{"getblock", "notifyblocks"},
+			denied:  []string{"stop"},
+		},
+		{auth: "nohash", bad: true},
+		{auth: ":salt$" + hash, bad: true},
+		{auth: "user:salt" + hash, bad: true},
+		{auth: "user:$" + hash, bad: true},
+		{auth: "user:salt$abcd", bad: true},
+		{auth: "user:salt$" + hash + ":nosuchmethod", bad: true},
+		{auth: "user:salt$" + hash + ":@nosuchgroup", bad: true},
+		{auth: "user:salt$" + hash + ":getblock:extra", bad: true},
+	}
+
+	for _, test := range tests {
+		u, err := parseRPCAuth(test.auth)
+		if test.bad {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.auth)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.auth, err)
+			continue
+		}
+		if !u.checkPass("pass") || u.checkPass("wrong") {
+			t.Errorf("%s: password check failed", test.auth)
+		}
+		if (u.methods == nil) != test.admin {
+			t.Errorf("%s: expected admin %v", test.auth, test.admin)
+		}
+		for _, m := range test.allowed {
+			if !u.allowed(m) {
+				t.Errorf("%s: expected %s to be allowed", test.auth, m)
+			}
+		}
+		for _, m := range test.denied {
+			if u.allowed(m) {
+				t.Errorf("%s: expected %s to be denied", test.auth, m)
+			}
+		}
+	}
+
+	if _, err := parseRPCAuths([]string{
+		"a:salt$" + hash, "a:salt$" + hash,
+	}); err == nil {
+		t.Errorf("expected an error for a duplicate user")
+	}
+	if _, err := parseRPCAuths([]string{"admin:salt$" + hash}, "admin"); err == nil {
+		t.Errorf("expected an error for a user with the same name as rpcuser")
+	}
+}
+
+// TestRPCMethodGroups ensures that every method in a method group exists.
+func TestRPCMethodGroups(t *testing.T) {
+	for name, group := range rpcMethodGroups {
+		for method := range group {
+			if !rpcMethodExists(method) {
+				t.Errorf("group @%s has unknown method %s", name, method)
+			}
+		}
+	}
+}
+
+// TestCheckAuth ensures that users are resolved from the basic auth header
+// and that each user may only call the methods in their allow-list.
+func TestCheckAuth(t *testing.T) {
+	users, err := parseRPCAuths([]string{
+		"explorer:s1$" + rpcAuthHash("s1", "explorerpass") + ":getblockcount",
+	})
+	if err != nil {
+		t.Fatalf("parseRPCAuths: %v", err)
+	}
+	s := &rpcServer{authUsers: users}
+	s.authsha = sha256.Sum256([]byte("Basic " +
+		base64.StdEncoding.EncodeToString([]byte("admin:adminpass"))))
+	s.adminUser = &rpcUser{name: "admin"}
+
+	tests := []struct {
+		user, pass string
+		ok         bool
+	}{
+		{"admin", "adminpass", true},
+		{"admin", "wrong", false},
+		{"explorer", "explorerpass", true},
+		{"explorer", "adminpass", false},
+		{"nobody", "explorerpass", false},
+	}
+	for _, test := range tests {
+		r, _ := http.NewRequest("POST", "/", nil)
+		r.SetBasicAuth(test.user, test.pass)
+		ok, user, err := s.checkAuth(r, true)
+		if ok != test.ok || (err == nil) != test.ok {
+			t.Errorf("%s/%s: expected ok %v, got %v %v", test.user, test.pass,
+				test.ok, ok, err)
+			continue
+		}
+		if ok && user.name != test.user {
+			t.Errorf("%s: resolved to user %s", test.user, user.name)
+		}
+	}
+
+	// The explorer may not call stop, the request is refused before it
+	// reaches the handler.
+	cmd := &parsedRPCCmd{id: 1, method: "stop", cmd: &btcjson.StopCmd{}}
+	_, jsonErr := s.standardCmdResult(cmd, users["explorer"], nil)
+	if jsonErr == nil || !btcjson.ErrRPCInvalidParams.Is(jsonErr) {
+		t.Errorf("expected stop to be refused, got %v", jsonErr)
+	}
+}
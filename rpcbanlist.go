@@ -0,0 +1,125 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"time"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// manualBanReason is the reason recorded for bans added with setban.
+const manualBanReason = "manually added"
+
+// banExpiry returns the time until which a ban requested with setban lasts.
+// A ban time of zero or less uses the configured ban duration.
+func banExpiry(now time.Time, banTime int64, absolute bool) time.Time {
+	switch {
+	case banTime <= 0:
+		return now.Add(cfg.BanDuration)
+	case absolute:
+		return time.Unix(banTime, 0)
+	default:
+		return now.Add(time.Duration(banTime) * time.Second)
+	}
+}
+
+// handleSetBan implements the setban command.
+func handleSetBan(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.SetBanCmd)
+	ipNet, err := parseSubnet(c.Subnet)
+	if err != nil {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCClientInvalidIPOrSubnet,
+			"Error: Invalid IP/Subnet", err)
+	}
+
+	switch c.SubCmd {
+	case btcjson.SBAdd:
+		var banTime int64
+		if c.BanTime != nil {
+			banTime = *c.BanTime
+		}
+		now := time.Now()
+		until := banExpiry(now, banTime, c.Absolute != nil && *c.Absolute)
+		if !until.After(now) {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"Error: Ban time is in the past", nil)
+		}
+		banned, err := s.cfg.ConnMgr.BanSubnet(ipNet, until, manualBanReason)
+		if err != nil {
+			return nil, internalRPCError(err, "Unable to store the ban")
+		}
+		if !banned {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCClientNodeAlreadyAdded,
+				"Error: IP/Subnet already banned", nil)
+		}
+
+	case btcjson.SBRemove:
+		unbanned, err := s.cfg.ConnMgr.UnbanSubnet(ipNet)
+		if err != nil {
+			return nil, internalRPCError(err, "Unable to remove the ban")
+		}
+		if !unbanned {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCClientInvalidIPOrSubnet,
+				"Error: Unban failed. Requested address/subnet was not "+
+					"previously banned.", nil)
+		}
+
+	default:
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"invalid subcommand for setban", nil)
+	}
+	return nil, nil
+}
+
+// handleListBanned implements the listbanned command.
+func handleListBanned(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	bans := s.cfg.ConnMgr.BannedSubnets()
+	result := make([]btcjson.ListBannedResult, 0, len(bans))
+	for _, ban := range bans {
+		result = append(result, btcjson.ListBannedResult{
+			Address:     ban.ipNet.String(),
+			BannedUntil: ban.until.Unix(),
+			BanCreated:  ban.created.Unix(),
+			BanReason:   ban.reason,
+		})
+	}
+	return result, nil
+}
+
+// handleClearBanned implements the clearbanned command.
+func handleClearBanned(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	if err := s.cfg.ConnMgr.ClearBanned(); err != nil {
+		return nil, internalRPCError(err, "Unable to clear the bans")
+	}
+	return nil, nil
+}
+
+// handleDisconnectNode implements the disconnectnode command.
+func handleDisconnectNode(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.DisconnectNodeCmd)
+	hasAddress := c.Address != nil && *c.Address != ""
+
+	var err er.R
+	switch {
+	case hasAddress && c.NodeID != nil:
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Only one of address and nodeid should be provided.", nil)
+	case hasAddress:
+		addr := normalizeAddress(*c.Address, s.cfg.ChainParams.DefaultPort)
+		err = s.cfg.ConnMgr.DisconnectByAddr(addr)
+	case c.NodeID != nil:
+		err = s.cfg.ConnMgr.DisconnectByID(*c.NodeID)
+	default:
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Either an address or a nodeid must be provided.", nil)
+	}
+	if err != nil {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCClientNodeNotConnected,
+			"Node not found in connected nodes", err)
+	}
+	return nil, nil
+}
@@ -57,6 +57,15 @@ the Receive method on the returned instance will either return the result
 immediately if it has already arrived, or block until it has.  This is useful
 since it provides the caller with greater control over concurrency.
 
+Batch Mode
+
+A client created with NewBatch queues the commands which are issued through
+the asynchronous API instead of sending them.  Calling Send posts all of the
+queued commands to the server as a single JSON-RPC batch, after which the
+Receive method of each future returns the result of it's command.  Batch mode
+requires HTTP POST mode and the server limits the number of commands in one
+batch, for pktd this is the rpcmaxbatch setting.
+
 Notifications
 
 The first important part of notifications is to realize that they will only
@@ -84,6 +84,11 @@ var (
 	// configured to run in HTTP POST mode.
 	ErrWebsocketsRequired = Err.CodeWithDetail("ErrWebsocketsRequired",
 		"a websocket connection is required to use this feature")
+
+	// ErrNotBatchClient is an error to describe the condition of calling
+	// Send on a client which was not created using NewBatch.
+	ErrNotBatchClient = Err.CodeWithDetail("ErrNotBatchClient",
+		"client is not configured for batch requests")
 )
 
 const (
@@ -164,6 +169,11 @@ type Client struct {
 	ntfnStateLock sync.Mutex
 	ntfnState     *notificationState
 
+	// Batch mode, commands are queued in batchList until Send is called.
+	batch     bool
+	batchLock sync.Mutex
+	batchList *list.List
+
 	// Networking infrastructure.
 	sendChan        chan []byte
 	sendPostChan    chan *sendPostDetails
@@ -299,6 +309,13 @@ type (
 		Result jsoniter.RawMessage `json:"result"`
 		Error  *btcjson.RPCErr     `json:"error"`
 	}
+
+	// rawBatchResponse is a partially-unmarshaled element of the reply to
+	// a JSON-RPC batch, the ID is used to match it with it's request.
+	rawBatchResponse struct {
+		ID *uint64 `json:"id"`
+		rawResponse
+	}
 )
 
 // response is the raw bytes of a JSON-RPC result, or the error if the response
@@ -822,31 +839,142 @@ func receiveFuture(f chan *response) ([]byte, er.R) {
 // however, the underlying HTTP client might coalesce multiple commands
 // depending on several factors including the remote server configuration.
 func (c *Client) sendPost(jReq *jsonRequest) {
+	httpReq, err := c.newPostRequest(jReq.marshaledJSON)
+	if err != nil {
+		jReq.responseChan <- &response{result: nil, err: err}
+		return
+	}
+
+	log.Tracef("Sending command [%s] with id %d", jReq.method, jReq.id)
+	c.sendPostRequest(httpReq, jReq)
+}
+
+// newPostRequest creates an HTTP POST request to the configured RPC server
+// with the passed body.  The connection is kept alive so it can be reused by
+// later requests.
+func (c *Client) newPostRequest(body []byte) (*http.Request, er.R) {
 	// Generate a request to the configured RPC server.
 	protocol := "http"
 	if !c.config.DisableTLS {
 		protocol = "https"
 	}
 	url := protocol + "://" + c.config.Host
-	bodyReader := bytes.NewReader(jReq.marshaledJSON)
-	httpReq, err := http.NewRequest("POST", url, bodyReader)
-	if err != nil {
-		jReq.responseChan <- &response{result: nil, err: er.E(err)}
-		return
+	httpReq, errr := http.NewRequest("POST", url, bytes.NewReader(body))
+	if errr != nil {
+		return nil, er.E(errr)
 	}
-	httpReq.Close = true
 	httpReq.Header.Set("Content-Type", "application/json")
 
 	// Configure basic access authorization.
-	user, pass, errr := c.config.getAuth()
-	if errr != nil {
-		jReq.responseChan <- &response{result: nil, err: errr}
-		return
+	user, pass, err := c.config.getAuth()
+	if err != nil {
+		return nil, err
 	}
 	httpReq.SetBasicAuth(user, pass)
+	return httpReq, nil
+}
 
-	log.Tracef("Sending command [%s] with id %d", jReq.method, jReq.id)
-	c.sendPostRequest(httpReq, jReq)
+// addBatchRequest queues the passed request to be sent by the next call to
+// Send.
+func (c *Client) addBatchRequest(jReq *jsonRequest) {
+	c.batchLock.Lock()
+	defer c.batchLock.Unlock()
+	c.batchList.PushBack(jReq)
+}
+
+// Send sends every command which has been issued through a client created by
+// NewBatch to the server in a single JSON-RPC batch, then delivers each reply
+// to the future which was returned when the command was issued.  If the batch
+// cannot be sent or the reply cannot be understood then the error is returned
+// and it is also delivered to every future of the batch.
+func (c *Client) Send() er.R {
+	if !c.batch {
+		return ErrNotBatchClient.Default()
+	}
+	c.batchLock.Lock()
+	pending := make([]*jsonRequest, 0, c.batchList.Len())
+	for e := c.batchList.Front(); e != nil; e = e.Next() {
+		pending = append(pending, e.Value.(*jsonRequest))
+	}
+	c.batchList.Init()
+	c.batchLock.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	fail := func(err er.R) er.R {
+		for _, jReq := range pending {
+			jReq.responseChan <- &response{err: err}
+		}
+		return err
+	}
+
+	select {
+	case <-c.shutdown:
+		return fail(ErrClientShutdown.Default())
+	default:
+	}
+
+	var body bytes.Buffer
+	body.WriteByte('[')
+	for i, jReq := range pending {
+		if i > 0 {
+			body.WriteByte(',')
+		}
+		body.Write(jReq.marshaledJSON)
+	}
+	body.WriteByte(']')
+	httpReq, err := c.newPostRequest(body.Bytes())
+	if err != nil {
+		return fail(err)
+	}
+
+	log.Tracef("Sending batch of [%d] commands", len(pending))
+	httpResponse, errr := c.httpClient.Do(httpReq)
+	if errr != nil {
+		return fail(er.E(errr))
+	}
+	respBytes, errr := ioutil.ReadAll(httpResponse.Body)
+	httpResponse.Body.Close()
+	if errr != nil {
+		return fail(er.Errorf("error reading json reply: %v", errr))
+	}
+
+	var resps []rawBatchResponse
+	if errr := jsoniter.Unmarshal(respBytes, &resps); errr != nil {
+		// The server replies with a single response when the batch as
+		// a whole is rejected, for example because it is too large.
+		var resp rawResponse
+		if errr := jsoniter.Unmarshal(respBytes, &resp); errr == nil && resp.Error != nil {
+			_, err := resp.result()
+			return fail(err)
+		}
+		return fail(er.Errorf("status code: %d, response: %q",
+			httpResponse.StatusCode, string(respBytes)))
+	}
+
+	byID := make(map[uint64]*jsonRequest, len(pending))
+	for _, jReq := range pending {
+		byID[jReq.id] = jReq
+	}
+	for _, resp := range resps {
+		if resp.ID == nil {
+			continue
+		}
+		jReq, ok := byID[*resp.ID]
+		if !ok {
+			continue
+		}
+		delete(byID, *resp.ID)
+		res, err := resp.result()
+		jReq.responseChan <- &response{result: res, err: err}
+	}
+	for _, jReq := range byID {
+		jReq.responseChan <- &response{
+			err: er.Errorf("no reply to command [%s] in batch", jReq.method),
+		}
+	}
+	return nil
 }
 
 // sendRequest sends the passed json request to the associated server using the
@@ -857,6 +985,10 @@ func (c *Client) sendRequest(jReq *jsonRequest) {
 	// the client running in HTTP POST mode or not.  When running in HTTP
 	// POST mode, the command is issued via an HTTP client.  Otherwise,
 	// the command is issued via the asynchronous websocket channels.
+	if c.batch {
+		c.addBatchRequest(jReq)
+		return
+	}
 	if c.config.HTTPPostMode {
 		c.sendPost(jReq)
 		return
@@ -1278,6 +1410,7 @@ func New(config *ConnConfig, ntfnHandlers *NotificationHandlers) (*Client, er.R)
 		httpClient:      httpClient,
 		requestMap:      make(map[uint64]*list.Element),
 		requestList:     list.New(),
+		batchList:       list.New(),
 		ntfnHandlers:    ntfnHandlers,
 		ntfnState:       newNotificationState(),
 		sendChan:        make(chan []byte, sendBufferSize),
@@ -1301,6 +1434,23 @@ func New(config *ConnConfig, ntfnHandlers *NotificationHandlers) (*Client, er.R)
 	return client, nil
 }
 
+// NewBatch creates a new RPC client which queues the commands issued through
+// it rather than sending them, the futures which are returned are resolved
+// once Send is called to send all of the queued commands to the server as a
+// single JSON-RPC batch.  The server limits the number of commands in a batch
+// to it's rpcmaxbatch setting.  Batch mode requires HTTP POST mode.
+func NewBatch(config *ConnConfig) (*Client, er.R) {
+	if !config.HTTPPostMode {
+		return nil, er.New("batch mode requires HTTP POST mode")
+	}
+	client, err := New(config, nil)
+	if err != nil {
+		return nil, err
+	}
+	client.batch = true
+	return client, nil
+}
+
 // Connect establishes the initial websocket connection.  This is necessary when
 // a client was created after setting the DisableConnectOnNew field of the
 // Config struct.
@@ -94,3 +94,125 @@ func (c *Client) GetPeerInfoAsync() FutureGetPeerInfoResult {
 func (c *Client) GetPeerInfo() ([]btcjson.GetPeerInfoResult, er.R) {
 	return c.GetPeerInfoAsync().Receive()
 }
+
+// FutureSetBanResult is a future promise to deliver the result of a
+// SetBanAsync RPC invocation (or an applicable error).
+type FutureSetBanResult chan *response
+
+// Receive waits for the response promised by the future and returns an error if
+// any occurred when performing the specified command.
+func (r FutureSetBanResult) Receive() er.R {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// SetBanAsync returns an instance of a type that can be used to get the result
+// of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See SetBan for the blocking version and more details.
+func (c *Client) SetBanAsync(subnet string, command btcjson.SetBanSubCmd,
+	banTime *int64, absolute *bool) FutureSetBanResult {
+
+	cmd := btcjson.NewSetBanCmd(subnet, command, banTime, absolute)
+	return c.sendCmd(cmd)
+}
+
+// SetBan adds or removes the ban of the passed IP address or subnet.  The ban
+// time is a number of seconds, or a unix timestamp when absolute is set, and
+// the default ban duration of the server is used when it is nil or zero.
+func (c *Client) SetBan(subnet string, command btcjson.SetBanSubCmd,
+	banTime *int64, absolute *bool) er.R {
+
+	return c.SetBanAsync(subnet, command, banTime, absolute).Receive()
+}
+
+// FutureListBannedResult is a future promise to deliver the result of a
+// ListBannedAsync RPC invocation (or an applicable error).
+type FutureListBannedResult chan *response
+
+// Receive waits for the response promised by the future and returns the
+// banned IP addresses and subnets.
+func (r FutureListBannedResult) Receive() ([]btcjson.ListBannedResult, er.R) {
+	res, err := receiveFuture(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal result as an array of listbanned result objects.
+	var banned []btcjson.ListBannedResult
+	err = er.E(jsoniter.Unmarshal(res, &banned))
+	if err != nil {
+		return nil, err
+	}
+
+	return banned, nil
+}
+
+// ListBannedAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See ListBanned for the blocking version and more details.
+func (c *Client) ListBannedAsync() FutureListBannedResult {
+	cmd := btcjson.NewListBannedCmd()
+	return c.sendCmd(cmd)
+}
+
+// ListBanned returns the banned IP addresses and subnets.
+func (c *Client) ListBanned() ([]btcjson.ListBannedResult, er.R) {
+	return c.ListBannedAsync().Receive()
+}
+
+// FutureClearBannedResult is a future promise to deliver the result of a
+// ClearBannedAsync RPC invocation (or an applicable error).
+type FutureClearBannedResult chan *response
+
+// Receive waits for the response promised by the future and returns an error if
+// any occurred when performing the specified command.
+func (r FutureClearBannedResult) Receive() er.R {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// ClearBannedAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See ClearBanned for the blocking version and more details.
+func (c *Client) ClearBannedAsync() FutureClearBannedResult {
+	cmd := btcjson.NewClearBannedCmd()
+	return c.sendCmd(cmd)
+}
+
+// ClearBanned lifts all bans.
+func (c *Client) ClearBanned() er.R {
+	return c.ClearBannedAsync().Receive()
+}
+
+// FutureDisconnectNodeResult is a future promise to deliver the result of a
+// DisconnectNodeAsync RPC invocation (or an applicable error).
+type FutureDisconnectNodeResult chan *response
+
+// Receive waits for the response promised by the future and returns an error if
+// any occurred when performing the specified command.
+func (r FutureDisconnectNodeResult) Receive() er.R {
+	_, err := receiveFuture(r)
+	return err
+}
+
+// DisconnectNodeAsync returns an instance of a type that can be used to get the
+// result of the RPC at some future time by invoking the Receive function on the
+// returned instance.
+//
+// See DisconnectNode for the blocking version and more details.
+func (c *Client) DisconnectNodeAsync(address *string, nodeID *int32) FutureDisconnectNodeResult {
+	cmd := btcjson.NewDisconnectNodeCmd(address, nodeID)
+	return c.sendCmd(cmd)
+}
+
+// DisconnectNode disconnects the peer with the passed address, or with the
+// passed node id when the address is nil or empty.
+func (c *Client) DisconnectNode(address *string, nodeID *int32) er.R {
+	return c.DisconnectNodeAsync(address, nodeID).Receive()
+}
@@ -20,6 +20,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
@@ -33,6 +34,7 @@ import (
 	"github.com/pkt-cash/pktd/blockchain"
 	"github.com/pkt-cash/pktd/blockchain/indexers"
 	"github.com/pkt-cash/pktd/blockchain/packetcrypt"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/announce"
 	"github.com/pkt-cash/pktd/blockchain/packetcrypt/difficulty"
 	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcjson"
@@ -49,6 +51,7 @@ import (
 	"github.com/pkt-cash/pktd/pktconfig/version"
 	"github.com/pkt-cash/pktd/txscript"
 	"github.com/pkt-cash/pktd/txscript/scriptbuilder"
+	"github.com/pkt-cash/pktd/txscript/txscripterr"
 	"github.com/pkt-cash/pktd/wire"
 	"github.com/pkt-cash/pktd/wire/constants"
 	"github.com/pkt-cash/pktd/wire/protocol"
@@ -69,6 +72,11 @@ const (
 	// is closed.
 	rpcAuthTimeoutSeconds = 10
 
+	// rpcIdleTimeoutSeconds is the number of seconds which a kept alive
+	// connection to the RPC server may remain idle between requests before
+	// it is closed.
+	rpcIdleTimeoutSeconds = 120
+
 	// gbtNonceRange is two 32-bit big-endian hexadecimal integers which
 	// represent the valid ranges of nonces returned by the getblocktemplate
 	// RPC.
@@ -119,15 +127,22 @@ var rpcHandlers map[string]commandHandler
 
 var rpcHandlersBeforeInit = map[string]commandHandler{
 	"addnode":                handleAddNode,
+	"clearbanned":            handleClearBanned,
 	"configureminingpayouts": handleConfigureMiningPayouts,
 	"createrawtransaction":   handleCreateRawTransaction,
 	"debuglevel":             handleDebugLevel,
+	"debugscript":            handleDebugScript,
 	"decoderawtransaction":   handleDecodeRawTransaction,
 	"decodescript":           handleDecodeScript,
+	"disconnectnode":         handleDisconnectNode,
 	"estimatefee":            handleEstimateFee,
 	"estimatesmartfee":       handleEstimateSmartFee,
 	"generate":               handleGenerate,
 	"getaddednodeinfo":       handleGetAddedNodeInfo,
+	"getaddressbalance":      handleGetAddressBalance,
+	"getaddressdeltas":       handleGetAddressDeltas,
+	"getaddressmempool":      handleGetAddressMempool,
+	"getaddressutxos":        handleGetAddressUtxos,
 	"getbestblock":           handleGetBestBlock,
 	"getbestblockhash":       handleGetBestBlockHash,
 	"getblock":               handleGetBlock,
@@ -135,7 +150,9 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"getblockcount":          handleGetBlockCount,
 	"getblockhash":           handleGetBlockHash,
 	"getblockheader":         handleGetBlockHeader,
+	"getblockstats":          handleGetBlockStats,
 	"getblocktemplate":       handleGetBlockTemplate,
+	"getchaintxstats":        handleGetChainTxStats,
 	"getcfilter":             handleGetCFilter,
 	"getcfilterheader":       handleGetCFilterHeader,
 	"getconnectioncount":     handleGetConnectionCount,
@@ -153,18 +170,25 @@ var rpcHandlersBeforeInit = map[string]commandHandler{
 	"getnetworkinfo":         handleGetNetworkInfo,
 	"getnetworksteward":      handleGetNetworkSteward,
 	"getpeerinfo":            handleGetPeerInfo,
+	"getpcannpool":           handleGetPcAnnPool,
 	"getrawmempool":          handleGetRawMempool,
 	"getrawblocktemplate":    handleGetRawBlockTemplate,
 	"checkpcshare":           handleCheckPcShare,
 	"checkpcann":             handleCheckPcAnn,
+	"checkpcanns":            handleCheckPcAnns,
 	"getrawtransaction":      handleGetRawTransaction,
+	"getspentinfo":           handleGetSpentInfo,
 	"gettxout":               handleGetTxOut,
 	"help":                   handleHelp,
+	"listbanned":             handleListBanned,
 	"node":                   handleNode,
 	"ping":                   handlePing,
+	"prioritisetransaction":  handlePrioritiseTransaction,
 	"echo":                   handleEcho,
+	"savemempool":            handleSaveMempool,
 	"searchrawtransactions":  handleSearchRawTransactions,
 	"sendrawtransaction":     handleSendRawTransaction,
+	"setban":                 handleSetBan,
 	"setgenerate":            handleSetGenerate,
 	"stop":                   handleStop,
 	"submitblock":            handleSubmitBlock,
@@ -238,14 +262,21 @@ var rpcLimited = map[string]struct{}{
 	// HTTP/S-only commands
 	"createrawtransaction":  {},
 	"decoderawtransaction":  {},
+	"debugscript":           {},
 	"decodescript":          {},
 	"estimatefee":           {},
+	"getaddressbalance":     {},
+	"getaddressdeltas":      {},
+	"getaddressmempool":     {},
+	"getaddressutxos":       {},
 	"getbestblock":          {},
 	"getbestblockhash":      {},
 	"getblock":              {},
 	"getblockcount":         {},
 	"getblockhash":          {},
 	"getblockheader":        {},
+	"getblockstats":         {},
+	"getchaintxstats":       {},
 	"getcfilter":            {},
 	"getcfilterheader":      {},
 	"getcurrentnet":         {},
@@ -254,8 +285,10 @@ var rpcLimited = map[string]struct{}{
 	"getinfo":               {},
 	"getnettotals":          {},
 	"getnetworkhashps":      {},
+	"getpcannpool":          {},
 	"getrawmempool":         {},
 	"getrawtransaction":     {},
+	"getspentinfo":          {},
 	"gettxout":              {},
 	"searchrawtransactions": {},
 	"sendrawtransaction":    {},
@@ -624,6 +657,200 @@ func handleDebugLevel(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 	return "Done.", nil
 }
 
+// debugScriptPrevOut returns the output which is spent by the input being
+// debugged.  It is taken from the command if a script is given, otherwise it
+// is looked up in the utxo set, then the mempool and the transaction index.
+func debugScriptPrevOut(s *rpcServer, mtx *wire.MsgTx, c *btcjson.DebugScriptCmd) (*wire.TxOut, er.R) {
+	if c.PkScript != nil && *c.PkScript != "" {
+		pkScript, errr := hex.DecodeString(*c.PkScript)
+		if errr != nil {
+			return nil, rpcDecodeHexError(*c.PkScript)
+		}
+		var value btcutil.Amount
+		if c.Amount != nil {
+			var err er.R
+			value, err = btcutil.NewAmount(*c.Amount)
+			if err != nil {
+				return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+					"Invalid amount", err)
+			}
+		}
+		return wire.NewTxOut(int64(value), pkScript), nil
+	}
+
+	op := mtx.TxIn[c.Input].PreviousOutPoint
+	entry, err := s.cfg.Chain.FetchUtxoEntry(op)
+	if err == nil && entry != nil && !entry.IsSpent() {
+		return wire.NewTxOut(entry.Amount(), entry.PkScript()), nil
+	}
+
+	// The output has already been spent or it was created by a transaction
+	// which is in the mempool.
+	originOutputs, err := fetchInputTxos(s, &wire.MsgTx{
+		TxIn: []*wire.TxIn{mtx.TxIn[c.Input]},
+	})
+	if err != nil {
+		return nil, err
+	}
+	txOut := originOutputs[op]
+	return &txOut, nil
+}
+
+// scriptErrorCode returns the name of the script error code, such as
+// ErrEqualVerify, or an empty string if the error is not a script error.
+func scriptErrorCode(err er.R) string {
+	if code := txscripterr.Err.Decode(err); code != nil {
+		return code.Detail
+	}
+	return ""
+}
+
+// traceScript runs the script engine one step at a time and records the
+// state of the engine after each step.  The error is the reason the script
+// failed, or nil if it succeeded.
+func traceScript(vm *txscript.Engine) ([]btcjson.DebugScriptStep, er.R) {
+	hexStack := func(stack [][]byte) []string {
+		out := make([]string, len(stack))
+		for i, item := range stack {
+			out[i] = hex.EncodeToString(item)
+		}
+		return out
+	}
+	condNames := map[int]string{
+		txscript.OpCondTrue:  "true",
+		txscript.OpCondFalse: "false",
+		txscript.OpCondSkip:  "skip",
+	}
+
+	var steps []btcjson.DebugScriptStep
+	for i := 0; ; i++ {
+		pc, err := vm.DisasmPC()
+		if err != nil {
+			// There is nothing left to execute.
+			break
+		}
+		cond := vm.CondStack()
+		step := btcjson.DebugScriptStep{
+			Step:     i,
+			Opcode:   pc,
+			Executed: len(cond) == 0 || cond[len(cond)-1] == txscript.OpCondTrue,
+		}
+		if parts := strings.SplitN(pc, ": ", 2); len(parts) == 2 {
+			step.PC, step.Opcode = parts[0], parts[1]
+		}
+
+		done, err := vm.Step()
+		step.Stack = hexStack(vm.GetStack())
+		step.AltStack = hexStack(vm.GetAltStack())
+		for _, c := range vm.CondStack() {
+			step.Branch = append(step.Branch, condNames[c])
+		}
+		if err != nil {
+			step.Error = err.Message()
+			step.ErrorCode = scriptErrorCode(err)
+			return append(steps, step), err
+		}
+		steps = append(steps, step)
+		if done {
+			break
+		}
+	}
+	return steps, vm.CheckErrorCondition(true)
+}
+
+// debugScriptText formats the reply to the debugscript command for reading in
+// a terminal.
+func debugScriptText(res *btcjson.DebugScriptResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Input %d of %s spending %s output %s\n", res.Input,
+		res.TxID, res.Type, res.PkScript)
+	for _, step := range res.Steps {
+		skipped := ""
+		if !step.Executed {
+			skipped = " (not executed)"
+		}
+		fmt.Fprintf(&b, "%4d %s %s%s\n", step.Step, step.PC, step.Opcode, skipped)
+		fmt.Fprintf(&b, "     stack:    [%s]\n", strings.Join(step.Stack, " "))
+		if len(step.AltStack) > 0 {
+			fmt.Fprintf(&b, "     altstack: [%s]\n", strings.Join(step.AltStack, " "))
+		}
+		if len(step.Branch) > 0 {
+			fmt.Fprintf(&b, "     branch:   [%s]\n", strings.Join(step.Branch, " "))
+		}
+	}
+	if res.Valid {
+		b.WriteString("Script is valid\n")
+	} else {
+		fmt.Fprintf(&b, "Script failed: %s\n", res.Error)
+	}
+	return b.String()
+}
+
+// handleDebugScript handles debugscript commands.  It executes the scripts of
+// one input of a transaction one opcode at a time and returns the state of the
+// script engine after each opcode, along with the error which caused the
+// scripts to fail, if any.
+func handleDebugScript(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.DebugScriptCmd)
+
+	// Deserialize the transaction.
+	hexStr := c.HexTx
+	if len(hexStr)%2 != 0 {
+		hexStr = "0" + hexStr
+	}
+	serializedTx, errr := hex.DecodeString(hexStr)
+	if errr != nil {
+		return nil, rpcDecodeHexError(hexStr)
+	}
+	var mtx wire.MsgTx
+	if err := mtx.Deserialize(bytes.NewReader(serializedTx)); err != nil {
+		return nil, btcjson.NewRPCError(
+			btcjson.ErrRPCDeserialization, "TX decode failed", err)
+	}
+	if blockchain.IsCoinBaseTx(&mtx) {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			"Coinbase transactions have no scripts to debug", nil)
+	}
+	if int(c.Input) >= len(mtx.TxIn) {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter,
+			fmt.Sprintf("Transaction has no input %d", c.Input), nil)
+	}
+
+	prevOut, err := debugScriptPrevOut(s, &mtx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &btcjson.DebugScriptResult{
+		TxID:     mtx.TxHash().String(),
+		Input:    c.Input,
+		PkScript: hex.EncodeToString(prevOut.PkScript),
+		Type:     txscript.GetScriptClass(prevOut.PkScript).String(),
+		Svalue:   strconv.FormatInt(prevOut.Value, 10),
+		Witness:  len(mtx.TxIn[c.Input].Witness) > 0,
+		Steps:    []btcjson.DebugScriptStep{},
+	}
+	vm, err := txscript.NewEngine(prevOut.PkScript, &mtx, int(c.Input),
+		txscript.StandardVerifyFlags, nil, txscript.NewTxSigHashes(&mtx),
+		prevOut.Value)
+	if err == nil {
+		var steps []btcjson.DebugScriptStep
+		steps, err = traceScript(vm)
+		res.Steps = append(res.Steps, steps...)
+	}
+	if err != nil {
+		res.Error = err.Message()
+		res.ErrorCode = scriptErrorCode(err)
+	} else {
+		res.Valid = true
+	}
+
+	if c.Text != nil && *c.Text {
+		return debugScriptText(res), nil
+	}
+	return res, nil
+}
+
 // witnessToHex formats the passed witness stack as a slice of hex-encoded
 // strings to be used in a JSON response.
 func witnessToHex(witness wire.TxWitness) []string {
@@ -1344,6 +1571,9 @@ func handleGetBlockChainInfo(s *rpcServer, cmd interface{}, closeChan <-chan str
 		case chaincfg.DeploymentSegwit:
 			forkName = "segwit"
 
+		case chaincfg.DeploymentTaproot:
+			forkName = "taproot"
+
 		default:
 			return nil, btcjson.NewRPCError(
 				btcjson.ErrRPCInternal,
@@ -2228,7 +2458,8 @@ func handleCheckPcShare(s *rpcServer, cmd interface{}, closeChan <-chan struct{}
 	}
 
 	// Check #3, does it hash?
-	blockOk, err := packetcrypt.ValidatePcBlock(mb, c.Height, c.ShareTarget, parentHashes[:])
+	blockOk, err := packetcrypt.ValidatePcBlock(mb, c.Height, c.ShareTarget, parentHashes[:],
+		s.cfg.AnnCache)
 	if err != nil {
 		return nil, err
 	}
@@ -2238,26 +2469,28 @@ func handleCheckPcShare(s *rpcServer, cmd interface{}, closeChan <-chan struct{}
 	return "OK", nil
 }
 
-func handleCheckPcAnn(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
-	cx := cmd.(*btcjson.CheckPcAnnCmd)
-	annBytes, errr := hex.DecodeString(cx.AnnHex)
+// maxCheckPcAnns is the maximum number of announcements which may be checked
+// with a single checkpcanns command.
+const maxCheckPcAnns = 1000
+
+// checkPcAnn decodes and checks an announcement against the provided parent
+// block hash or, when it is nil, the hash of the block at the parent block
+// height of the announcement in the main chain.
+func checkPcAnn(s *rpcServer, annHex string, parentHash *chainhash.Hash,
+	version int) (*chainhash.Hash, er.R) {
+
+	annBytes, errr := hex.DecodeString(annHex)
 	if errr != nil {
-		return nil, rpcDecodeHexError(cx.AnnHex)
+		return nil, rpcDecodeHexError(annHex)
 	}
 	annBuf := bytes.NewBuffer(annBytes)
 	ann := wire.PacketCryptAnn{}
 	if err := ann.BtcDecode(annBuf, 0, 0); err != nil {
 		return nil, err
 	}
-	var parentHash *chainhash.Hash
-	var err er.R
-	if cx.ParentHash != nil {
-		parentHash, err = chainhash.NewHashFromStr(*cx.ParentHash)
-		if err != nil {
-			return nil, err
-		}
-	} else {
+	if parentHash == nil {
 		height := ann.GetParentBlockHeight()
+		var err er.R
 		parentHash, err = s.cfg.Chain.BlockHashByHeight(int32(height))
 		if err != nil {
 			return nil, btcjson.NewRPCError(
@@ -2267,18 +2500,72 @@ func handleCheckPcAnn(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 			)
 		}
 	}
+	return s.cfg.AnnCache.CheckAnn(&ann, parentHash, version)
+}
+
+// pcAnnVersion returns the PacketCrypt version to check announcements with.
+func pcAnnVersion(pcVersion *int) int {
 	// This is a default assumption, it should be updated when new versions are released.
 	version := 1
-	if cx.PcVersion != nil {
-		version = *cx.PcVersion
+	if pcVersion != nil {
+		version = *pcVersion
+	}
+	return version
+}
+
+func handleCheckPcAnn(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	cx := cmd.(*btcjson.CheckPcAnnCmd)
+	var parentHash *chainhash.Hash
+	if cx.ParentHash != nil {
+		var err er.R
+		parentHash, err = chainhash.NewHashFromStr(*cx.ParentHash)
+		if err != nil {
+			return nil, err
+		}
 	}
-	workHash, err := packetcrypt.ValidatePcAnn(&ann, parentHash, version)
+	workHash, err := checkPcAnn(s, cx.AnnHex, parentHash, pcAnnVersion(cx.PcVersion))
 	if err != nil {
 		return nil, err
 	}
 	return &btcjson.CheckPcAnnResult{WorkHash: workHash.String()}, nil
 }
 
+// handleCheckPcAnns implements the checkpcanns command.  The announcements
+// are checked in parallel, one goroutine per CPU.
+func handleCheckPcAnns(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.CheckPcAnnsCmd)
+	if len(c.AnnHexes) > maxCheckPcAnns {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			fmt.Sprintf("At most %d announcements may be checked at once",
+				maxCheckPcAnns), nil)
+	}
+	version := pcAnnVersion(c.PcVersion)
+
+	results := make([]btcjson.CheckPcAnnsResult, len(c.AnnHexes))
+	indexes := make(chan int, len(c.AnnHexes))
+	for i := range c.AnnHexes {
+		indexes <- i
+	}
+	close(indexes)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				workHash, err := checkPcAnn(s, c.AnnHexes[i], nil, version)
+				if err != nil {
+					results[i].Error = err.Message()
+					continue
+				}
+				results[i].WorkHash = workHash.String()
+			}
+		}()
+	}
+	wg.Wait()
+	return results, nil
+}
+
 // handleGetBlockTemplateProposal is a helper for handleGetBlockTemplate which
 // deals with block proposals.
 //
@@ -2522,16 +2809,19 @@ func handleGetInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (in
 
 // handleGetMempoolInfo implements the getmempoolinfo command.
 func handleGetMempoolInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
-	mempoolTxns := s.cfg.TxMemPool.TxDescs()
-
-	var numBytes int64
-	for _, txD := range mempoolTxns {
-		numBytes += int64(txD.Tx.MsgTx().SerializeSize())
+	// The mempool minimum fee is never below the minimum relay fee, as
+	// transactions paying less are not relayed.
+	minFee := s.cfg.TxMemPool.MinFee()
+	if minFee < cfg.minRelayTxFee {
+		minFee = cfg.minRelayTxFee
 	}
 
 	ret := &btcjson.GetMempoolInfoResult{
-		Size:  int64(len(mempoolTxns)),
-		Bytes: numBytes,
+		Size:          int64(s.cfg.TxMemPool.Count()),
+		Bytes:         s.cfg.TxMemPool.Size(),
+		MaxMempool:    cfg.MaxMempool * 1000000,
+		MempoolMinFee: minFee.ToBTC(),
+		MinRelayTxFee: cfg.minRelayTxFee.ToBTC(),
 	}
 
 	return ret, nil
@@ -2774,6 +3064,7 @@ func handleGetPeerInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 			BanScore:       int32(p.BanScore()),
 			FeeFilter:      p.FeeFilter(),
 			SyncNode:       statsSnap.ID == syncPeerID,
+			ConnectionType: p.ConnectionType(),
 		}
 		if p.ToPeer().LastPingNonce() != 0 {
 			wait := float64(time.Since(statsSnap.LastPingTime).Nanoseconds())
@@ -2785,6 +3076,31 @@ func handleGetPeerInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{})
 	return infos, nil
 }
 
+// handleGetPcAnnPool implements the getpcannpool command.
+func handleGetPcAnnPool(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.GetPcAnnPoolCmd)
+	if s.cfg.PcAnnPool == nil {
+		return nil, btcjson.ErrRPCMisc.New(
+			"PacketCrypt announcement relay must be enabled with "+
+				"--pcannrelay for this command", nil)
+	}
+	count := 0
+	if c.Count != nil {
+		count = *c.Count
+	}
+
+	anns := s.cfg.PcAnnPool.bestAnns(count)
+	annHexes := make([]string, len(anns))
+	for i, ann := range anns {
+		var buf bytes.Buffer
+		if err := ann.BtcEncode(&buf, 0, 0); err != nil {
+			return nil, err
+		}
+		annHexes[i] = hex.EncodeToString(buf.Bytes())
+	}
+	return annHexes, nil
+}
+
 // handleGetRawMempool implements the getrawmempool command.
 func handleGetRawMempool(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
 	c := cmd.(*btcjson.GetRawMempoolCmd)
@@ -2933,6 +3249,9 @@ func handleGetRawTransaction(s *rpcServer, cmd interface{}, closeChan <-chan str
 	if err := loadPrevOuts(s, mtx, s.cfg.ChainParams, rawTxn.Vin); err != nil {
 		return nil, err
 	}
+	if err := s.loadSpentTxIDs(txHash, rawTxn.Vout); err != nil {
+		return nil, err
+	}
 	return *rawTxn, nil
 }
 
@@ -3076,6 +3395,21 @@ func handlePing(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (inter
 	return nil, nil
 }
 
+// handlePrioritiseTransaction implements the prioritisetransaction command.
+func handlePrioritiseTransaction(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.PrioritiseTransactionCmd)
+	txHash, err := chainhash.NewHashFromStr(c.Txid)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Txid)
+	}
+	if c.PriorityDelta != 0 {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Priority delta is not supported, it must be 0", nil)
+	}
+	s.cfg.TxMemPool.PrioritiseTransaction(txHash, c.FeeDelta)
+	return true, nil
+}
+
 func handleEcho(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
 	c := cmd.(*btcjson.EchoCmd)
 	var out []string
@@ -3349,6 +3683,14 @@ func fetchMempoolTxnsForAddress(s *rpcServer, addr btcutil.Address, numToSkip, n
 	return mpTxns[numToSkip:rangeEnd], numToSkip
 }
 
+// handleSaveMempool implements the savemempool command.
+func handleSaveMempool(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	if err := s.cfg.SaveMempool(); err != nil {
+		return nil, btcjson.ErrRPCMisc.New("Unable to save mempool", err)
+	}
+	return nil, nil
+}
+
 // handleSearchRawTransactions implements the searchrawtransactions command.
 func handleSearchRawTransactions(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
 	// Respond with an error if the address index is not enabled.
@@ -3791,6 +4133,12 @@ func handleValidateAddress(s *rpcServer, cmd interface{}, closeChan <-chan struc
 
 	result.Address = addr.EncodeAddress()
 	result.IsValid = true
+	if wa, ok := addr.(btcutil.WitnessAddress); ok {
+		version := int32(wa.WitnessVersion())
+		result.IsWitness = true
+		result.WitnessVersion = &version
+		result.WitnessProgram = hex.EncodeToString(wa.WitnessProgram())
+	}
 
 	return result, nil
 }
@@ -3934,6 +4282,9 @@ type rpcServer struct {
 	cfg                    rpcserverConfig
 	authsha                [sha256.Size]byte
 	limitauthsha           [sha256.Size]byte
+	adminUser              *rpcUser
+	limitUser              *rpcUser
+	authUsers              map[string]*rpcUser
 	ntfnMgr                *wsNotificationManager
 	numClients             int32
 	statusLines            map[int]string
@@ -4079,6 +4430,47 @@ func (s *rpcServer) decrementClients() {
 	atomic.AddInt32(&s.numClients, -1)
 }
 
+// authenticate returns the RPC user with the username and password, or nil
+// if there is no such user.  Users configured with --rpcauth are checked
+// against their HMAC, the --rpcuser and --rpclimituser are checked against
+// the hash of their basic auth header.
+//
+// This check is time-constant.
+func (s *rpcServer) authenticate(username, pass string) *rpcUser {
+	// Every credential is checked whichever user the name belongs to, so
+	// that the time taken doesn't reveal which --rpcauth users exist.
+	authUser, isAuthUser := s.authUsers[username]
+	checked := authUser
+	if !isAuthUser {
+		checked = &unknownRPCUser
+	}
+	authOk := checked.checkPass(pass)
+
+	login := username + ":" + pass
+	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
+	authsha := sha256.Sum256([]byte(auth))
+	limitcmp := subtle.ConstantTimeCompare(authsha[:], s.limitauthsha[:])
+	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
+
+	switch {
+	case isAuthUser:
+		if authOk {
+			return authUser
+		}
+		return nil
+
+	// Check for limited auth first as in environments with limited users,
+	// those are probably expected to have a higher volume of calls
+	case limitcmp == 1 && s.limitUser != nil:
+		return s.limitUser
+
+	// Check for admin-level auth
+	case cmp == 1 && s.adminUser != nil:
+		return s.adminUser
+	}
+	return nil
+}
+
 // checkAuth checks the HTTP Basic authentication supplied by a wallet
 // or RPC client in the HTTP request r.  If the supplied authentication
 // does not match the username and password expected, a non-nil error is
@@ -4086,40 +4478,35 @@ func (s *rpcServer) decrementClients() {
 //
 // This check is time-constant.
 //
-// The first bool return value signifies auth success (true if successful) and
-// the second bool return value specifies whether the user can change the state
-// of the server (true) or whether the user is limited (false). The second is
-// always false if the first is.
-func (s *rpcServer) checkAuth(r *http.Request, require bool) (bool, bool, er.R) {
+// The bool return value signifies auth success (true if successful) and the
+// user is the one which was authenticated, it determines which methods may be
+// called.  The user is nil if the bool is false.
+func (s *rpcServer) checkAuth(r *http.Request, require bool) (bool, *rpcUser, er.R) {
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) <= 0 {
 		if require {
 			rpcsLog.Warnf("RPC authentication failure from %s",
 				r.RemoteAddr)
-			return false, false, er.New("auth failure")
+			return false, nil, er.New("auth failure")
 		}
 
-		return false, false, nil
-	}
-
-	authsha := sha256.Sum256([]byte(authhdr[0]))
-
-	// Check for limited auth first as in environments with limited users, those
-	// are probably expected to have a higher volume of calls
-	limitcmp := subtle.ConstantTimeCompare(authsha[:], s.limitauthsha[:])
-	if limitcmp == 1 {
-		return true, false, nil
-	}
-
-	// Check for admin-level auth
-	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
-	if cmp == 1 {
-		return true, true, nil
+		return false, nil, nil
 	}
 
-	// Request's auth doesn't match either user
-	rpcsLog.Warnf("RPC authentication failure from %s", r.RemoteAddr)
-	return false, false, er.New("auth failure")
+	username, pass, ok := r.BasicAuth()
+	if !ok {
+		rpcsLog.Warnf("RPC authentication failure from %s, malformed "+
+			"authorization header", r.RemoteAddr)
+		return false, nil, er.New("auth failure")
+	}
+	user := s.authenticate(username, pass)
+	if user == nil {
+		// Request's auth doesn't match any user
+		rpcsLog.Warnf("RPC authentication failure for user [%s] from %s",
+			username, r.RemoteAddr)
+		return false, nil, er.New("auth failure")
+	}
+	return true, user, nil
 }
 
 // parsedRPCCmd represents a JSON-RPC request object that has been parsed into
@@ -4135,8 +4522,14 @@ type parsedRPCCmd struct {
 // standardCmdResult checks that a parsed command is a standard Bitcoin JSON-RPC
 // command and runs the appropriate handler to reply to the command.  Any
 // commands which are not recognized or not implemented will return an error
-// suitable for use in replies.
-func (s *rpcServer) standardCmdResult(cmd *parsedRPCCmd, closeChan <-chan struct{}) (interface{}, er.R) {
+// suitable for use in replies, as will commands which the user is not allowed
+// to call.
+func (s *rpcServer) standardCmdResult(cmd *parsedRPCCmd, user *rpcUser, closeChan <-chan struct{}) (interface{}, er.R) {
+	if !user.allowed(cmd.method) {
+		rpcsLog.Warnf("RPC user [%s] is not authorized for method [%s]",
+			user.name, cmd.method)
+		return nil, user.notAuthorizedError(cmd.method)
+	}
 	handler, ok := rpcHandlers[cmd.method]
 	if ok {
 		goto handled
@@ -4201,23 +4594,12 @@ func createResponse(id, result interface{}, jsonErr er.R) (*btcjson.Response, er
 
 func (s *rpcServer) jsonRPCReq(
 	request *btcjson.Request,
-	closeChan chan struct{},
-	isAdmin bool,
+	closeChan <-chan struct{},
+	user *rpcUser,
 ) (*btcjson.Response, er.R) {
 	var jsonErr er.R
 	var result interface{}
 
-	// Check if the user is limited and set error if method unauthorized
-	if !isAdmin {
-		if _, ok := rpcLimited[request.Method]; !ok {
-			jsonErr = btcjson.NewRPCError(
-				btcjson.ErrRPCInvalidParams,
-				"limited user not authorized for this method",
-				nil,
-			)
-		}
-	}
-
 	ps := make([]string, 0, len(request.Params))
 	for _, par := range request.Params {
 		ps = append(ps, string(par))
@@ -4231,13 +4613,11 @@ func (s *rpcServer) jsonRPCReq(
 
 	// Attempt to parse the JSON-RPC request into a known concrete
 	// command.
-	if jsonErr == nil {
-		parsedCmd := parseCmd(request)
-		if parsedCmd.err != nil {
-			jsonErr = parsedCmd.err
-		} else {
-			result, jsonErr = s.standardCmdResult(parsedCmd, closeChan)
-		}
+	parsedCmd := parseCmd(request)
+	if parsedCmd.err != nil {
+		jsonErr = parsedCmd.err
+	} else {
+		result, jsonErr = s.standardCmdResult(parsedCmd, user, closeChan)
 	}
 
 	resp, err := createResponse(request.ID, result, jsonErr)
@@ -4252,8 +4632,126 @@ func (s *rpcServer) jsonRPCReq(
 	return resp, err
 }
 
+// rpcErrorResponse creates a response which carries only an error, if the id
+// is not valid then the response has a null id.
+func rpcErrorResponse(id interface{}, jsonErr er.R) *btcjson.Response {
+	if !btcjson.IsValidIDType(id) {
+		id = nil
+	}
+	return &btcjson.Response{
+		Error: btcjson.SerializeError(jsonErr),
+		ID:    &id,
+	}
+}
+
+// rpcBatch is the parsed body of an HTTP JSON-RPC request.  It is either a
+// single request object or a JSON-RPC 2.0 batch, which is an array of them.
+type rpcBatch struct {
+	isArray  bool
+	requests []*btcjson.Request
+
+	// errors holds the reply to each request which could not be parsed, it
+	// is indexed the same as requests, whose entry is then nil.
+	errors []er.R
+
+	// err is set if the body as a whole is invalid, in which case it is the
+	// only reply.
+	err er.R
+}
+
+// parseRPCBatch parses the body of an HTTP JSON-RPC request.  Each element of
+// a batch is parsed on it's own so that one bad element does not prevent the
+// others from being processed.  Batches may contain at most maxBatch
+// requests.
+func parseRPCBatch(body []byte, maxBatch int) *rpcBatch {
+	b := &rpcBatch{}
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) == 0 || body[0] != '[' {
+		var req btcjson.Request
+		if errr := jsoniter.Unmarshal(body, &req); errr != nil {
+			b.err = btcjson.NewRPCError(btcjson.ErrRPCParse,
+				"Failed to parse request", er.E(errr))
+			return b
+		}
+		b.requests = append(b.requests, &req)
+		b.errors = append(b.errors, nil)
+		return b
+	}
+
+	b.isArray = true
+	var elems []jsoniter.RawMessage
+	if errr := jsoniter.Unmarshal(body, &elems); errr != nil {
+		b.err = btcjson.NewRPCError(btcjson.ErrRPCParse,
+			"Failed to parse requests", er.E(errr))
+		return b
+	}
+	if len(elems) == 0 {
+		b.err = btcjson.NewRPCError(btcjson.ErrRPCInvalidRequest,
+			"Empty batch", nil)
+		return b
+	}
+	if len(elems) > maxBatch {
+		b.err = btcjson.NewRPCError(btcjson.ErrRPCInvalidRequest,
+			fmt.Sprintf("Batch of [%d] requests exceeds the limit of [%d], "+
+				"see rpcmaxbatch", len(elems), maxBatch), nil)
+		return b
+	}
+	for _, elem := range elems {
+		var req btcjson.Request
+		if errr := jsoniter.Unmarshal(elem, &req); errr != nil {
+			b.requests = append(b.requests, nil)
+			b.errors = append(b.errors, btcjson.NewRPCError(
+				btcjson.ErrRPCInvalidRequest, "Invalid request", er.E(errr)))
+			continue
+		}
+		b.requests = append(b.requests, &req)
+		b.errors = append(b.errors, nil)
+	}
+	return b
+}
+
+// needsLongPoll returns true if any request of the batch may wait for an
+// event, such as a getblocktemplate long poll, rather than replying
+// immediately.
+func (b *rpcBatch) needsLongPoll() bool {
+	for _, req := range b.requests {
+		if req != nil && req.Method == "getblocktemplate" {
+			return true
+		}
+	}
+	return false
+}
+
+// processBatch runs every request of the batch through jsonRPCReq and returns
+// the marshaled reply.
+func (s *rpcServer) processBatch(b *rpcBatch, closeChan <-chan struct{}, user *rpcUser) ([]byte, er.R) {
+	if b.err != nil {
+		out, errr := jsoniter.Marshal(rpcErrorResponse(nil, b.err))
+		return out, er.E(errr)
+	}
+	responses := make([]*btcjson.Response, 0, len(b.requests))
+	for i, req := range b.requests {
+		if req == nil {
+			responses = append(responses, rpcErrorResponse(nil, b.errors[i]))
+			continue
+		}
+		res, err := s.jsonRPCReq(req, closeChan, user)
+		if err != nil {
+			res = rpcErrorResponse(req.ID, btcjson.ErrRPCInternal.New(
+				"Failed to create response", err))
+		}
+		responses = append(responses, res)
+	}
+	if !b.isArray {
+		out, errr := jsoniter.Marshal(responses[0])
+		return out, er.E(errr)
+	}
+	out, errr := jsoniter.Marshal(responses)
+	return out, er.E(errr)
+}
+
 // jsonRPCRead handles reading and responding to RPC messages.
-func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin bool) {
+func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, user *rpcUser) {
 	if atomic.LoadInt32(&s.shutdown) != 0 {
 		return
 	}
@@ -4268,6 +4766,26 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 		return
 	}
 
+	batch := parseRPCBatch(body, cfg.RPCMaxBatch)
+	if !batch.needsLongPoll() {
+		// The connection is kept alive so that the client can make
+		// more requests without reconnecting.
+		msg, err := s.processBatch(batch, r.Context().Done(), user)
+		if err != nil {
+			rpcsLog.Error(err)
+			errCode := http.StatusInternalServerError
+			http.Error(w, strconv.Itoa(errCode)+" "+err.Message(), errCode)
+			return
+		}
+		// Terminate with newline to maintain compatibility with Bitcoin Core.
+		msg = append(msg, '\n')
+		w.Header().Set("Content-Length", strconv.Itoa(len(msg)))
+		if _, errr := w.Write(msg); errr != nil {
+			rpcsLog.Errorf("Failed to write marshaled reply: %v", errr)
+		}
+		return
+	}
+
 	// Unfortunately, the http server doesn't provide the ability to
 	// change the read deadline for the new connection and having one breaks
 	// long polling.  However, not having a read deadline on the initial
@@ -4303,73 +4821,17 @@ func (s *rpcServer) jsonRPCRead(w http.ResponseWriter, r *http.Request, isAdmin
 		}
 	}()
 
-	var requests []btcjson.Request
-	var req0 btcjson.Request
-	var jsonErr er.R
-	isArray := len(body) > 0 && body[0] == '['
-	if isArray {
-		if errr := jsoniter.Unmarshal(body, &requests); errr != nil {
-			jsonErr = btcjson.NewRPCError(
-				btcjson.ErrRPCParse,
-				"Failed to parse requests",
-				er.E(errr),
-			)
-		}
-	} else if errr := jsoniter.Unmarshal(body, &req0); errr != nil {
-		jsonErr = btcjson.NewRPCError(
-			btcjson.ErrRPCParse,
-			"Failed to parse request",
-			er.E(errr),
-		)
-	} else {
-		requests = append(requests, req0)
-	}
-
-	responses := make([]*btcjson.Response, 0, len(requests))
-	if jsonErr == nil {
-		for _, req := range requests {
-			res, jsonErr := s.jsonRPCReq(&req, closeChan, isAdmin)
-			if jsonErr != nil {
-				break
-			}
-			responses = append(responses, res)
-		}
-	}
-
-	var msg []byte
-	if jsonErr != nil {
-		resp, err := createResponse(requests[0].ID, nil, jsonErr)
-		if err != nil {
-			rpcsLog.Error(err)
-			return
-		}
-		out, errr := jsoniter.Marshal(&resp)
-		if errr != nil {
-			rpcsLog.Error(er.E(errr))
-			return
-		}
-		msg = out
-	} else if isArray {
-		out, errr := jsoniter.Marshal(&responses)
-		if errr != nil {
-			rpcsLog.Error(er.E(errr))
-			return
-		}
-		msg = out
-	} else {
-		var resp0 *btcjson.Response = responses[0]
-		out, errr := jsoniter.Marshal(&resp0)
-		if errr != nil {
-			rpcsLog.Error(er.E(errr))
-			return
-		}
-		msg = out
+	msg, err := s.processBatch(batch, closeChan, user)
+	if err != nil {
+		rpcsLog.Error(err)
+		return
 	}
 
+	w.Header().Set("Connection", "close")
 	w.Header().Add("Content-Length", strconv.FormatInt(int64(len(msg)+1), 10))
 
 	// Write the response.
-	err := s.writeHTTPResponseHeaders(r, w.Header(), http.StatusOK, buf)
+	err = s.writeHTTPResponseHeaders(r, w.Header(), http.StatusOK, buf)
 	if err != nil {
 		rpcsLog.Error(err)
 		return
@@ -4404,11 +4866,13 @@ func (s *rpcServer) Start() {
 		// Timeout connections which don't complete the initial
 		// handshake within the allowed timeframe.
 		ReadTimeout: time.Second * rpcAuthTimeoutSeconds,
+
+		// Keep idle connections open so that clients can make many
+		// requests without reconnecting each time.
+		IdleTimeout: time.Second * rpcIdleTimeoutSeconds,
 	}
 	rpcServeMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Connection", "close")
 		w.Header().Set("Content-Type", "application/json")
-		r.Close = true
 
 		// Limit the number of connections to max allowed.
 		if s.limitConnections(w, r.RemoteAddr) {
@@ -4418,19 +4882,19 @@ func (s *rpcServer) Start() {
 		// Keep track of the number of connected clients.
 		s.incrementClients()
 		defer s.decrementClients()
-		_, isAdmin, err := s.checkAuth(r, true)
+		_, user, err := s.checkAuth(r, true)
 		if err != nil {
 			jsonAuthFail(w)
 			return
 		}
 
 		// Read and respond to the request.
-		s.jsonRPCRead(w, r, isAdmin)
+		s.jsonRPCRead(w, r, user)
 	})
 
 	// Websocket endpoint.
 	rpcServeMux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
-		authenticated, isAdmin, err := s.checkAuth(r, false)
+		authenticated, user, err := s.checkAuth(r, false)
 		if err != nil {
 			jsonAuthFail(w)
 			return
@@ -4451,9 +4915,21 @@ func (s *rpcServer) Start() {
 			http.Error(w, "400 Bad Request.", http.StatusBadRequest)
 			return
 		}
-		s.WebsocketHandler(ws, r.RemoteAddr, authenticated, isAdmin)
+		s.WebsocketHandler(ws, r.RemoteAddr, authenticated, user)
 	})
 
+	// Unauthenticated read-only REST endpoint, only served if enabled.
+	if cfg.Rest {
+		rpcServeMux.HandleFunc(restPrefix, func(w http.ResponseWriter, r *http.Request) {
+			if s.limitConnections(w, r.RemoteAddr) {
+				return
+			}
+			s.incrementClients()
+			defer s.decrementClients()
+			s.restRead(w, r)
+		})
+	}
+
 	for _, listener := range s.cfg.Listeners {
 		s.wg.Add(1)
 		go func(listener net.Listener) {
@@ -4513,6 +4989,10 @@ type rpcserverPeer interface {
 	// FeeFilter returns the requested current minimum fee rate for which
 	// transactions should be announced.
 	FeeFilter() int64
+
+	// ConnectionType returns the type of the connection to the peer:
+	// inbound, manual, outbound-full-relay, block-relay-only or feeler.
+	ConnectionType() string
 }
 
 // rpcserverConnManager represents a connection manager for use with the RPC
@@ -4548,6 +5028,21 @@ type rpcserverConnManager interface {
 	// error.
 	DisconnectByAddr(addr string) er.R
 
+	// BanSubnet bans the provided subnet until the provided time and
+	// disconnects the peers within it.  It returns false when the subnet is
+	// already banned.
+	BanSubnet(ipNet *net.IPNet, until time.Time, reason string) (bool, er.R)
+
+	// UnbanSubnet lifts the ban of the provided subnet.  It returns false
+	// when the subnet is not banned.
+	UnbanSubnet(ipNet *net.IPNet) (bool, er.R)
+
+	// BannedSubnets returns the bans which have not yet expired.
+	BannedSubnets() []*banEntry
+
+	// ClearBanned lifts all bans.
+	ClearBanned() er.R
+
 	// ConnectedCount returns the number of currently connected peers.
 	ConnectedCount() int32
 
@@ -4572,7 +5067,9 @@ type rpcserverConnManager interface {
 	AddRebroadcastInventory(iv *wire.InvVect, data interface{})
 
 	// RelayTransactions generates and relays inventory vectors for all of
-	// the passed transactions to all connected peers.
+	// the passed transactions to all connected peers.  Since the
+	// transactions originate from this node, they are only announced after
+	// a random delay.
 	RelayTransactions(txns []*mempool.TxDesc)
 }
 
@@ -4646,12 +5143,25 @@ type rpcserverConfig struct {
 	// of to provide additional data when queried.
 	TxIndexOrNil *indexers.TxIndex
 	AddrIndex    *indexers.AddrIndex
+	SpentIndex   *indexers.SpentIndex
 	CfIndex      *indexers.CfIndex
 
 	// The fee estimator keeps track of how long transactions are left in
 	// the mempool before they are mined into blocks.
 	FeeEstimator *mempool.FeeEstimator
 
+	// AnnCache caches the PacketCrypt announcements found to be valid,
+	// which are checked again when they show up in blocks.
+	AnnCache *announce.Cache
+
+	// PcAnnPool holds the PacketCrypt announcements relayed by peers.  It
+	// is nil when announcement relay is disabled.
+	PcAnnPool *pcAnnPool
+
+	// SaveMempool saves the transactions of the memory pool so that they
+	// are loaded again on startup.
+	SaveMempool func() er.R
+
 	ServiceFlags protocol.ServiceFlag
 }
 
@@ -4669,12 +5179,19 @@ func newRPCServer(config *rpcserverConfig) (*rpcServer, er.R) {
 		login := cfg.RPCUser + ":" + cfg.RPCPass
 		auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 		rpc.authsha = sha256.Sum256([]byte(auth))
+		rpc.adminUser = &rpcUser{name: cfg.RPCUser}
 	}
 	if cfg.RPCLimitUser != "" && cfg.RPCLimitPass != "" {
 		login := cfg.RPCLimitUser + ":" + cfg.RPCLimitPass
 		auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
 		rpc.limitauthsha = sha256.Sum256([]byte(auth))
+		rpc.limitUser = &rpcUser{name: cfg.RPCLimitUser, methods: rpcLimited}
+	}
+	authUsers, err := parseRPCAuths(cfg.RPCAuth, cfg.RPCUser, cfg.RPCLimitUser)
+	if err != nil {
+		return nil, err
 	}
+	rpc.authUsers = authUsers
 	rpc.ntfnMgr = newWsNotificationManager(&rpc)
 	rpc.cfg.Chain.Subscribe(rpc.handleBlockchainNotification)
 
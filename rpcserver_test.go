@@ -0,0 +1,230 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// TestParseRPCBatch ensures that request bodies are split into batches
+// correctly and that batches which are invalid as a whole are rejected.
+func TestParseRPCBatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		isArray  bool
+		requests int
+		badElems int
+		err      *btcjson.RPCErr
+	}{
+		{
+			name:     "single request",
+			body:     `{"jsonrpc":"1.0","method":"getblockcount","params":[],"id":1}`,
+			requests: 1,
+		},
+		{
+			name:     "batch",
+			body:     ` [{"method":"getblockcount","id":1},{"method":"getbestblockhash","id":2}]`,
+			isArray:  true,
+			requests: 2,
+		},
+		{
+			name:     "batch with a bad element",
+			body:     `[{"method":"getblockcount","id":1},7]`,
+			isArray:  true,
+			requests: 2,
+			badElems: 1,
+		},
+		{
+			name: "parse error",
+			body: `{"method":`,
+			err:  &btcjson.RPCErr{Code: btcjson.ErrRPCParse.Number},
+		},
+		{
+			name:    "empty batch",
+			body:    `[]`,
+			isArray: true,
+			err:     &btcjson.RPCErr{Code: btcjson.ErrRPCInvalidRequest.Number},
+		},
+		{
+			name:    "batch too large",
+			body:    `[{"method":"a","id":1},{"method":"b","id":2},{"method":"c","id":3}]`,
+			isArray: true,
+			err:     &btcjson.RPCErr{Code: btcjson.ErrRPCInvalidRequest.Number},
+		},
+	}
+
+	for _, test := range tests {
+		b := parseRPCBatch([]byte(test.body), 2)
+		if test.err != nil {
+			if b.err == nil {
+				t.Errorf("%s: expected an error", test.name)
+				continue
+			}
+			if code := btcjson.SerializeError(b.err).Code; code != test.err.Code {
+				t.Errorf("%s: expected error code %d, got %d",
+					test.name, test.err.Code, code)
+			}
+			continue
+		}
+		if b.err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, b.err)
+			continue
+		}
+		if b.isArray != test.isArray {
+			t.Errorf("%s: expected isArray %v", test.name, test.isArray)
+		}
+		if len(b.requests) != test.requests {
+			t.Errorf("%s: expected %d requests, got %d",
+				test.name, test.requests, len(b.requests))
+		}
+		bad := 0
+		for i, req := range b.requests {
+			if req == nil {
+				bad++
+				if b.errors[i] == nil {
+					t.Errorf("%s: element %d has no request or error", test.name, i)
+				}
+			}
+		}
+		if bad != test.badElems {
+			t.Errorf("%s: expected %d bad elements, got %d",
+				test.name, test.badElems, bad)
+		}
+	}
+}
+
+// TestProcessBatch ensures that every element of a batch gets a reply with
+// the id of the request, including elements which fail.
+func TestProcessBatch(t *testing.T) {
+	s := &rpcServer{}
+	body := `[{"method":"nosuchmethod","id":1},5,{"method":"nosuchmethod","id":"x"}]`
+	msg, err := s.processBatch(parseRPCBatch([]byte(body), 10), nil, &rpcUser{name: "admin"})
+	if err != nil {
+		t.Fatalf("processBatch: %v", err)
+	}
+	var replies []struct {
+		ID    interface{}     `json:"id"`
+		Error *btcjson.RPCErr `json:"error"`
+	}
+	if errr := jsoniter.Unmarshal(msg, &replies); errr != nil {
+		t.Fatalf("unable to unmarshal reply %s: %v", msg, errr)
+	}
+	if len(replies) != 3 {
+		t.Fatalf("expected 3 replies, got %d", len(replies))
+	}
+	wantIDs := []interface{}{float64(1), nil, "x"}
+	wantCodes := []int{
+		btcjson.ErrRPCMethodNotFound.Number,
+		btcjson.ErrRPCInvalidRequest.Number,
+		btcjson.ErrRPCMethodNotFound.Number,
+	}
+	for i, r := range replies {
+		if r.ID != wantIDs[i] {
+			t.Errorf("reply %d: expected id %v, got %v", i, wantIDs[i], r.ID)
+		}
+		if r.Error == nil || r.Error.Code != wantCodes[i] {
+			t.Errorf("reply %d: expected error code %d, got %+v", i, wantCodes[i], r.Error)
+		}
+	}
+}
+
+// TestDebugScript ensures that debugscript traces legacy and witness v0
+// inputs and reports the error which made the scripts fail.
+func TestDebugScript(t *testing.T) {
+	witnessScript := []byte{opcode.OP_TRUE}
+	witnessHash := sha256.Sum256(witnessScript)
+	p2wsh := append([]byte{opcode.OP_0, opcode.OP_DATA_32}, witnessHash[:]...)
+
+	tests := []struct {
+		name      string
+		sigScript []byte
+		witness   wire.TxWitness
+		pkScript  []byte
+		steps     int
+		lastPC    string
+		errorCode string
+	}{
+		{
+			name:      "legacy fail",
+			sigScript: []byte{opcode.OP_1},
+			pkScript:  []byte{opcode.OP_2, opcode.OP_EQUALVERIFY},
+			steps:     3,
+			lastPC:    "01:0001",
+			errorCode: "ErrEqualVerify",
+		},
+		{
+			name:      "legacy success",
+			sigScript: []byte{opcode.OP_2},
+			pkScript:  []byte{opcode.OP_2, opcode.OP_EQUAL},
+			steps:     3,
+			lastPC:    "01:0001",
+		},
+		{
+			name:     "witness v0",
+			witness:  wire.TxWitness{witnessScript},
+			pkScript: p2wsh,
+			steps:    3,
+			lastPC:   "02:0000",
+		},
+	}
+
+	for _, test := range tests {
+		mtx := wire.NewMsgTx(constants.TxVersion)
+		mtx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{Index: 1},
+			SignatureScript:  test.sigScript,
+			Witness:          test.witness,
+		})
+		mtx.AddTxOut(wire.NewTxOut(1000, []byte{opcode.OP_TRUE}))
+		var buf bytes.Buffer
+		if err := mtx.Serialize(&buf); err != nil {
+			t.Fatalf("%s: serialize: %v", test.name, err)
+		}
+		pkScript := hex.EncodeToString(test.pkScript)
+		amount := 0.5
+		cmd := btcjson.NewDebugScriptCmd(hex.EncodeToString(buf.Bytes()), 0,
+			&pkScript, &amount, nil)
+		result, err := handleDebugScript(&rpcServer{}, cmd, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		res := result.(*btcjson.DebugScriptResult)
+		if len(res.Steps) != test.steps {
+			t.Errorf("%s: expected %d steps, got %d", test.name, test.steps,
+				len(res.Steps))
+			continue
+		}
+		if pc := res.Steps[len(res.Steps)-1].PC; pc != test.lastPC {
+			t.Errorf("%s: expected last pc %s, got %s", test.name, test.lastPC, pc)
+		}
+		if res.Valid != (test.errorCode == "") || res.ErrorCode != test.errorCode {
+			t.Errorf("%s: expected error code %q, got valid %v %q", test.name,
+				test.errorCode, res.Valid, res.ErrorCode)
+		}
+
+		text := true
+		cmd.Text = &text
+		result, err = handleDebugScript(&rpcServer{}, cmd, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if _, ok := result.(string); !ok {
+			t.Errorf("%s: expected text result, got %T", test.name, result)
+		}
+	}
+}
@@ -25,6 +25,18 @@ var helpDescsEnUS = map[string]string{
 	"checkpcann-annhex":         "The announcement body as hex",
 	"checkpcannresult-workhash": "The result hash from validating the announcement, this is used to assess difficulty",
 
+	// CheckPcAnnsCmd help.
+	"checkpcanns--synopsis":      "Validate a batch of PacketCrypt announcements in parallel, the parent block hash of each announcement is taken from the current chain",
+	"checkpcanns-annhexes":       "The announcement bodies as hex, at most 1000",
+	"checkpcanns-pcversion":      "The version of PacketCrypt to consider for the announcements",
+	"checkpcannsresult-workhash": "The result hash from validating the announcement, omitted when it is invalid",
+	"checkpcannsresult-error":    "The reason the announcement is invalid, omitted when it is valid",
+
+	// GetPcAnnPoolCmd help.
+	"getpcannpool--synopsis": "Returns the PacketCrypt announcements in the announcement pool which can be put in the next block, the ones with the most work first.",
+	"getpcannpool-count":     "The maximum number of announcements to return, all of them when omitted",
+	"getpcannpool--result0":  "Array of announcement bodies as hex",
+
 	// DebugLevelCmd help.
 	"debuglevel--synopsis": "Dynamically changes the debug logging level.\n" +
 		"The levelspec can either a debug level or of the form:\n" +
@@ -38,6 +50,41 @@ var helpDescsEnUS = map[string]string{
 	"debuglevel--result0":    "The string 'Done.'",
 	"debuglevel--result1":    "The list of subsystems",
 
+	// DebugScriptCmd help.
+	"debugscript--synopsis": "Executes the scripts of one input of a transaction one opcode at a time and returns the state of the script engine after each opcode.\n" +
+		"Legacy, pay-to-script-hash and witness v0 inputs are supported.",
+	"debugscript-hextx":       "Serialized, hex-encoded transaction",
+	"debugscript-input":       "The index of the input to debug",
+	"debugscript-pkscript":    "The hex-encoded script of the output which is spent by the input, if not given then it is looked up in the utxo set, the mempool and the transaction index",
+	"debugscript-amount":      "The value of the output which is spent by the input in coins, needed for witness inputs when pkscript is given",
+	"debugscript-text":        "Return the trace as text which is easy to read in a terminal",
+	"debugscript--condition0": "text=false",
+	"debugscript--condition1": "text=true",
+	"debugscript--result1":    "The trace as text",
+
+	// DebugScriptResult help.
+	"debugscriptresult-txid":      "The hash of the transaction",
+	"debugscriptresult-input":     "The index of the input which was debugged",
+	"debugscriptresult-pkscript":  "The hex-encoded script of the output which is spent by the input",
+	"debugscriptresult-type":      "The type of the output script (e.g. 'pubkeyhash')",
+	"debugscriptresult-svalue":    "The value of the output which is spent by the input in atomic units, string containing base 10 number",
+	"debugscriptresult-witness":   "Whether the input has witness data",
+	"debugscriptresult-steps":     "The state of the script engine after each opcode",
+	"debugscriptresult-valid":     "Whether the scripts executed successfully",
+	"debugscriptresult-error":     "The reason the scripts failed",
+	"debugscriptresult-errorcode": "The script error code of the failure (e.g. 'ErrEqualVerify')",
+
+	// DebugScriptStep help.
+	"debugscriptstep-step":      "The number of the step, starting from zero",
+	"debugscriptstep-pc":        "The index of the script and the offset of the opcode in it, script 0 is the signature script, 1 is the output script and 2 is the redeem or witness script",
+	"debugscriptstep-opcode":    "The opcode which was executed",
+	"debugscriptstep-executed":  "Whether the opcode was in an executing branch, opcodes in branches which are not taken only affect the branch state",
+	"debugscriptstep-stack":     "The hex-encoded items of the data stack after the opcode, the last item is the top",
+	"debugscriptstep-altstack":  "The hex-encoded items of the alternate stack after the opcode, the last item is the top",
+	"debugscriptstep-branch":    "The state of each nested IF after the opcode, innermost last, one of 'true', 'false' or 'skip'",
+	"debugscriptstep-error":     "The error caused by the opcode, only set on the step which failed",
+	"debugscriptstep-errorcode": "The script error code of the error (e.g. 'ErrEqualVerify')",
+
 	// AddNodeCmd help.
 	"addnode--synopsis": "Attempts to add or remove a persistent peer.",
 	"addnode-addr":      "IP address and port of the peer to operate on",
@@ -49,6 +96,31 @@ var helpDescsEnUS = map[string]string{
 	"node-target":        "Either the IP address and port of the peer to operate on, or a valid peer ID.",
 	"node-connectsubcmd": "'perm' to make the connected peer a permanent one, 'temp' to try a single connect to a peer",
 
+	// SetBanCmd help.
+	"setban--synopsis": "Bans an IP address or subnet, or lifts its ban.\n" +
+		"Bans are persisted in the chain database, peers within a banned subnet are disconnected.",
+	"setban-subnet":   "The IP address or subnet in CIDR notation (e.g. 192.168.0.0/24) to ban or unban",
+	"setban-subcmd":   "'add' to ban the subnet or 'remove' to lift its ban",
+	"setban-bantime":  "The number of seconds to ban for, or a unix timestamp if absolute is set; 0 uses the configured ban duration",
+	"setban-absolute": "Whether bantime is a unix timestamp rather than a number of seconds",
+
+	// ListBannedCmd help.
+	"listbanned--synopsis": "Returns the banned IP addresses and subnets.",
+
+	// ListBannedResult help.
+	"listbannedresult-address":      "The banned IP address or subnet",
+	"listbannedresult-banned_until": "The unix timestamp when the ban expires",
+	"listbannedresult-ban_created":  "The unix timestamp when the ban was added",
+	"listbannedresult-ban_reason":   "Why the subnet was banned, including the offending message type for misbehaving peers",
+
+	// ClearBannedCmd help.
+	"clearbanned--synopsis": "Lifts all bans.",
+
+	// DisconnectNodeCmd help.
+	"disconnectnode--synopsis": "Disconnects a peer by its address or, when the address is empty, by its node id.",
+	"disconnectnode-address":   "The IP address and port of the peer",
+	"disconnectnode-nodeid":    "The node id of the peer, as reported by getpeerinfo",
+
 	// TransactionInput help.
 	"transactioninput-txid": "The hash of the input transaction",
 	"transactioninput-vout": "The specific output of the input transaction to redeem",
@@ -101,6 +173,7 @@ var helpDescsEnUS = map[string]string{
 	"vout-n":            "The index of this transaction output",
 	"vout-address":      "The address paid to",
 	"vout-vote":         "A vote on network steward, if any exists",
+	"vout-spentTxId":    "The hash of the transaction spending this output (only with --spentindex and once spent in the main chain)",
 	"vout-scriptPubKey": "The public key script used to pay coins as a JSON object",
 
 	// TxRawDecodeResult help.
@@ -188,6 +261,52 @@ var helpDescsEnUS = map[string]string{
 	"getaddednodeinfo--condition1": "dns=true",
 	"getaddednodeinfo--result0":    "List of added peers",
 
+	// GetAddressBalanceCmd help.
+	"getaddressbalance--synopsis": "Returns the total balance of a set of addresses, it requires the address index (--addrindex).",
+	"getaddressbalance-addresses": "The addresses",
+
+	// GetAddressBalanceResult help.
+	"getaddressbalanceresult-balance":  "The confirmed balance of the addresses in atomic units",
+	"getaddressbalanceresult-received": "The total ever received by the addresses in atomic units",
+
+	// GetAddressDeltasCmd help.
+	"getaddressdeltas--synopsis": "Returns every change to the balance of a set of addresses in the order of the chain, it requires the address index (--addrindex).",
+	"getaddressdeltas-addresses": "The addresses",
+	"getaddressdeltas-start":     "The height of the first block, defaults to 0",
+	"getaddressdeltas-end":       "The height of the last block, defaults to the best block",
+
+	// AddressDeltaResult help.
+	"addressdeltaresult-address":    "The address whose balance changed",
+	"addressdeltaresult-txid":       "The hash of the transaction which changed the balance",
+	"addressdeltaresult-index":      "The index of the output, or of the input for spends",
+	"addressdeltaresult-satoshis":   "The change in atomic units, negative for spends",
+	"addressdeltaresult-height":     "The height of the block holding the transaction",
+	"addressdeltaresult-blockindex": "The position of the transaction in the block",
+
+	// GetAddressMempoolCmd help.
+	"getaddressmempool--synopsis": "Returns the changes to the balance of a set of addresses made by unconfirmed transactions, it requires the address index (--addrindex).",
+	"getaddressmempool-addresses": "The addresses",
+
+	// AddressMempoolResult help.
+	"addressmempoolresult-address":  "The address whose balance changes",
+	"addressmempoolresult-txid":     "The hash of the unconfirmed transaction",
+	"addressmempoolresult-index":    "The index of the output, or of the input for spends",
+	"addressmempoolresult-satoshis": "The change in atomic units, negative for spends",
+	"addressmempoolresult-prevtxid": "The hash of the transaction holding the spent output (only for spends)",
+	"addressmempoolresult-prevout":  "The index of the spent output (only for spends)",
+
+	// GetAddressUtxosCmd help.
+	"getaddressutxos--synopsis": "Returns the confirmed unspent outputs which pay to a set of addresses, it requires the address index (--addrindex).",
+	"getaddressutxos-addresses": "The addresses",
+
+	// AddressUtxoResult help.
+	"addressutxoresult-address":     "The address which the output pays to",
+	"addressutxoresult-txid":        "The hash of the transaction holding the output",
+	"addressutxoresult-outputIndex": "The index of the output",
+	"addressutxoresult-script":      "The hex-encoded public key script of the output",
+	"addressutxoresult-satoshis":    "The value of the output in atomic units",
+	"addressutxoresult-height":      "The height of the block holding the output",
+
 	// GetBestBlockResult help.
 	"getbestblockresult-hash":   "Hex-encoded bytes of the best block hash",
 	"getbestblockresult-height": "Height of the best block",
@@ -328,6 +447,63 @@ var helpDescsEnUS = map[string]string{
 	"getblockheaderverboseresult-previousblockhash": "The hash of the previous block",
 	"getblockheaderverboseresult-nextblockhash":     "The hash of the next block (only if there is one)",
 
+	// GetBlockStatsCmd help.
+	"getblockstats--synopsis":       "Returns statistics about the fees, sizes and outputs of a block in the main chain.",
+	"getblockstats-hashorheight":    "The hash or the height of the block",
+	"getblockstats-stats":           "Only return these statistics, all statistics are returned when omitted",
+	"getblockstats--condition0":     "stats omitted or empty",
+	"getblockstats--condition1":     "stats given",
+	"getblockstats--result1--desc":  "An object holding only the selected statistics, keyed by their names as in the full result",
+	"getblockstats--result1--key":   "statistic",
+	"getblockstats--result1--value": "The value of the statistic",
+
+	// GetBlockStatsResult help.
+	"getblockstatsresult-avgfee":               "Average fee of the transactions in the block, excluding the coinbase",
+	"getblockstatsresult-avgfeerate":           "Average fee rate in atomic units per virtual byte",
+	"getblockstatsresult-avgtxsize":            "Average transaction size in bytes",
+	"getblockstatsresult-blockhash":            "The hash of the block",
+	"getblockstatsresult-feerate_percentiles":  "Fee rates at the 10th, 25th, 50th, 75th and 90th percentiles of the weight of the block",
+	"getblockstatsresult-height":               "The height of the block",
+	"getblockstatsresult-ins":                  "Number of inputs, excluding the coinbase",
+	"getblockstatsresult-maxfee":               "Largest fee in the block",
+	"getblockstatsresult-maxfeerate":           "Largest fee rate in atomic units per virtual byte",
+	"getblockstatsresult-maxtxsize":            "Largest transaction size in bytes",
+	"getblockstatsresult-medianfee":            "Median fee in the block",
+	"getblockstatsresult-mediantxsize":         "Median transaction size in bytes",
+	"getblockstatsresult-minfee":               "Smallest fee in the block",
+	"getblockstatsresult-minfeerate":           "Smallest fee rate in atomic units per virtual byte",
+	"getblockstatsresult-mintxsize":            "Smallest transaction size in bytes",
+	"getblockstatsresult-outs":                 "Number of outputs, including the coinbase",
+	"getblockstatsresult-subsidy":              "The block subsidy in atomic units",
+	"getblockstatsresult-swtotal_size":         "Total size of the segwit transactions",
+	"getblockstatsresult-swtotal_weight":       "Total weight of the segwit transactions",
+	"getblockstatsresult-swtxs":                "Number of segwit transactions",
+	"getblockstatsresult-time":                 "The block time in seconds since 1 Jan 1970 GMT",
+	"getblockstatsresult-total_out":            "Total value of the outputs, excluding the coinbase",
+	"getblockstatsresult-total_size":           "Total size of the transactions, excluding the coinbase",
+	"getblockstatsresult-total_weight":         "Total weight of the transactions, excluding the coinbase",
+	"getblockstatsresult-totalfee":             "Total fees paid by the transactions",
+	"getblockstatsresult-txs":                  "Number of transactions, including the coinbase",
+	"getblockstatsresult-utxo_increase":        "Change in the number of unspent outputs",
+	"getblockstatsresult-networkstewardpayout": "The part of the subsidy paid to the network steward",
+	"getblockstatsresult-pcanncount":           "Number of PacketCrypt announcements committed by the block",
+	"getblockstatsresult-pcproofsize":          "Size of the PacketCrypt proof in bytes",
+
+	// GetChainTxStatsCmd help.
+	"getchaintxstats--synopsis": "Returns statistics about the rate of transactions in the chain.",
+	"getchaintxstats-nblocks":   "Size of the window in blocks, defaults to about one month",
+	"getchaintxstats-blockhash": "The hash of the last block of the window, defaults to the best block",
+
+	// GetChainTxStatsResult help.
+	"getchaintxstatsresult-time":                      "The time of the last block of the window",
+	"getchaintxstatsresult-txcount":                   "The total number of transactions in the chain (only when the window ends at the best block)",
+	"getchaintxstatsresult-window_final_block_hash":   "The hash of the last block of the window",
+	"getchaintxstatsresult-window_final_block_height": "The height of the last block of the window",
+	"getchaintxstatsresult-window_block_count":        "The size of the window in blocks",
+	"getchaintxstatsresult-window_tx_count":           "The number of transactions in the window",
+	"getchaintxstatsresult-window_interval":           "The time span of the window in seconds",
+	"getchaintxstatsresult-txrate":                    "The average number of transactions per second in the window",
+
 	// TemplateRequest help.
 	"templaterequest-mode":         "This is 'template', 'proposal', or omitted",
 	"templaterequest-capabilities": "List of capabilities",
@@ -459,8 +635,11 @@ var helpDescsEnUS = map[string]string{
 	"getmempoolinfo--synopsis": "Returns memory pool information",
 
 	// GetMempoolInfoResult help.
-	"getmempoolinforesult-bytes": "Size in bytes of the mempool",
-	"getmempoolinforesult-size":  "Number of transactions in the mempool",
+	"getmempoolinforesult-bytes":         "Sum of the virtual sizes of the transactions in the mempool",
+	"getmempoolinforesult-size":          "Number of transactions in the mempool",
+	"getmempoolinforesult-maxmempool":    "Maximum total virtual size in bytes of the transactions in the mempool, 0 when unlimited",
+	"getmempoolinforesult-mempoolminfee": "Minimum fee rate in BTC/kB for transactions to be accepted, raised when transactions are evicted from the full mempool",
+	"getmempoolinforesult-minrelaytxfee": "Minimum fee rate in BTC/kB for transactions to be relayed",
 
 	// GetMiningInfoResult help.
 	"getmininginforesult-blocks":             "Height of the latest best block",
@@ -534,6 +713,7 @@ var helpDescsEnUS = map[string]string{
 	"getpeerinforesult-banscore":       "The ban score",
 	"getpeerinforesult-feefilter":      "The requested minimum fee a transaction must have to be announced to the peer",
 	"getpeerinforesult-syncnode":       "Whether or not the peer is the sync peer",
+	"getpeerinforesult-connectiontype": "Type of the connection to the peer (inbound, manual, outbound-full-relay, block-relay-only or feeler)",
 
 	// GetPeerInfoCmd help.
 	"getpeerinfo--synopsis": "Returns data about each connected network peer as an array of json objects.",
@@ -560,6 +740,7 @@ var helpDescsEnUS = map[string]string{
 	// GetRawMempoolVerboseResult help.
 	"getrawmempoolverboseresult-size":             "Transaction size in bytes",
 	"getrawmempoolverboseresult-fee":              "Transaction fee in bitcoins",
+	"getrawmempoolverboseresult-modifiedfee":      "Transaction fee including the fee delta set with prioritisetransaction, in bitcoins",
 	"getrawmempoolverboseresult-time":             "Local time transaction entered pool in seconds since 1 Jan 1970 GMT",
 	"getrawmempoolverboseresult-height":           "Block height when transaction entered the pool",
 	"getrawmempoolverboseresult-startingpriority": "Priority when transaction entered the pool",
@@ -582,6 +763,17 @@ var helpDescsEnUS = map[string]string{
 	"getrawtransaction--condition1": "verbose=true",
 	"getrawtransaction--result0":    "Hex-encoded bytes of the serialized transaction",
 
+	// GetSpentInfoCmd help.
+	"getspentinfo--synopsis": "Returns the input which spends an output in the main chain, it requires the spent output index (--spentindex).",
+	"getspentinfo-txid":      "The hash of the transaction holding the output",
+	"getspentinfo-index":     "The index of the output",
+
+	// GetSpentInfoResult help.
+	"getspentinforesult-txid":     "The hash of the spending transaction",
+	"getspentinforesult-index":    "The index of the spending input",
+	"getspentinforesult-height":   "The height of the block holding the spending transaction",
+	"getspentinforesult-satoshis": "The value of the spent output in atomic units",
+
 	// GetTxOutResult help.
 	"gettxoutresult-bestblock":     "The block hash that contains the transaction output",
 	"gettxoutresult-confirmations": "The number of confirmations",
@@ -607,6 +799,13 @@ var helpDescsEnUS = map[string]string{
 	"help--result0":    "List of commands",
 	"help--result1":    "Help for specified command",
 
+	// PrioritiseTransactionCmd help.
+	"prioritisetransaction--synopsis":     "Changes the fee a transaction is considered to pay by the memory pool and when building block templates, without changing the fee it actually pays.",
+	"prioritisetransaction-txid":          "The hash of the transaction, which does not need to be in the memory pool yet",
+	"prioritisetransaction-prioritydelta": "Unsupported, must be 0",
+	"prioritisetransaction-feedelta":      "The amount in atomic units added to the fee of the transaction, which may be negative",
+	"prioritisetransaction--result0":      "Returns true",
+
 	// PingCmd help.
 	"ping--synopsis": "Queues a ping to be sent to each connected peer.\n" +
 		"Ping times are provided by getpeerinfo via the pingtime and pingwait fields.",
@@ -622,6 +821,9 @@ var helpDescsEnUS = map[string]string{
 	"echo-f":         "anything",
 	"echo-g":         "anything",
 
+	// SaveMempoolCmd help.
+	"savemempool--synopsis": "Saves the transactions of the memory pool to the mempool.dat file in the data directory, from which they are loaded on startup.",
+
 	// SearchRawTransactionsCmd help.
 	"searchrawtransactions--synopsis": "Returns raw data for transactions involving the passed address.\n" +
 		"Returned transactions are pulled from both the database, and transactions currently in the mempool.\n" +
@@ -666,8 +868,11 @@ var helpDescsEnUS = map[string]string{
 	"submitblock--result1":    "The reason the block was rejected",
 
 	// ValidateAddressResult help.
-	"validateaddresschainresult-isvalid": "Whether or not the address is valid",
-	"validateaddresschainresult-address": "The bitcoin address (only when isvalid is true)",
+	"validateaddresschainresult-isvalid":         "Whether or not the address is valid",
+	"validateaddresschainresult-address":         "The bitcoin address (only when isvalid is true)",
+	"validateaddresschainresult-iswitness":       "Whether the address pays to a segwit witness program",
+	"validateaddresschainresult-witness_version": "The witness version of the address (only when iswitness is true)",
+	"validateaddresschainresult-witness_program": "The hex encoded witness program of the address (only when iswitness is true)",
 
 	// ValidateAddressCmd help.
 	"validateaddress--synopsis": "Verify an address is valid.",
@@ -779,26 +984,36 @@ var helpDescsEnUS = map[string]string{
 // pointer to the type (or nil to indicate no return value).
 var rpcResultTypes = map[string][]interface{}{
 	"addnode":                nil,
+	"clearbanned":            nil,
 	"configureminingpayouts": nil,
 	"createrawtransaction":   {(*string)(nil)},
 	"checkpcann":             {(*btcjson.CheckPcAnnResult)(nil)},
+	"checkpcanns":            {(*[]btcjson.CheckPcAnnsResult)(nil)},
 	"debuglevel":             {(*string)(nil), (*string)(nil)},
+	"debugscript":            {(*btcjson.DebugScriptResult)(nil), (*string)(nil)},
 	"decoderawtransaction":   {(*btcjson.TxRawDecodeResult)(nil)},
 	"decodescript":           {(*btcjson.DecodeScriptResult)(nil)},
+	"disconnectnode":         nil,
 	"estimatefee":            {(*float64)(nil)},
 	"estimatesmartfee":       {(*btcjson.EstimateSmartFeeResult)(nil)},
 	"generate":               {(*[]string)(nil)},
 	"getaddednodeinfo":       {(*[]string)(nil), (*[]btcjson.GetAddedNodeInfoResult)(nil)},
+	"getaddressbalance":      {(*btcjson.GetAddressBalanceResult)(nil)},
+	"getaddressdeltas":       {(*[]btcjson.AddressDeltaResult)(nil)},
+	"getaddressmempool":      {(*[]btcjson.AddressMempoolResult)(nil)},
+	"getaddressutxos":        {(*[]btcjson.AddressUtxoResult)(nil)},
 	"getbestblock":           {(*btcjson.GetBestBlockResult)(nil)},
 	"getbestblockhash":       {(*string)(nil)},
 	"getblock":               {(*string)(nil), (*btcjson.GetBlockVerboseResult)(nil)},
 	"getblockcount":          {(*int64)(nil)},
 	"getblockhash":           {(*string)(nil)},
 	"getblockheader":         {(*string)(nil), (*btcjson.GetBlockHeaderVerboseResult)(nil)},
+	"getblockstats":          {(*btcjson.GetBlockStatsResult)(nil), (*map[string]interface{})(nil)},
 	"getblocktemplate":       {(*btcjson.GetBlockTemplateResult)(nil), (*string)(nil), nil},
 	"getblockchaininfo":      {(*btcjson.GetBlockChainInfoResult)(nil)},
 	"getcfilter":             {(*string)(nil)},
 	"getcfilterheader":       {(*string)(nil)},
+	"getchaintxstats":        {(*btcjson.GetChainTxStatsResult)(nil)},
 	"getconnectioncount":     {(*int32)(nil)},
 	"getcurrentnet":          {(*uint32)(nil)},
 	"getdifficulty":          {(*float64)(nil)},
@@ -816,15 +1031,21 @@ var rpcResultTypes = map[string][]interface{}{
 	"getpeerinfo":            {(*[]btcjson.GetPeerInfoResult)(nil)},
 	"getrawblocktemplate":    {(*string)(nil)},
 	"checkpcshare":           {(*string)(nil)},
+	"getpcannpool":           {(*[]string)(nil)},
 	"getrawmempool":          {(*[]string)(nil), (*btcjson.GetRawMempoolVerboseResult)(nil)},
 	"getrawtransaction":      {(*string)(nil), (*btcjson.TxRawResult)(nil)},
+	"getspentinfo":           {(*btcjson.GetSpentInfoResult)(nil)},
 	"gettxout":               {(*btcjson.GetTxOutResult)(nil)},
 	"node":                   nil,
 	"help":                   {(*string)(nil), (*string)(nil)},
+	"listbanned":             {(*[]btcjson.ListBannedResult)(nil)},
 	"ping":                   nil,
+	"prioritisetransaction":  {(*bool)(nil)},
 	"echo":                   {(*[]string)(nil)},
+	"savemempool":            nil,
 	"searchrawtransactions":  {(*string)(nil), (*[]btcjson.TxRawResult)(nil)},
 	"sendrawtransaction":     {(*string)(nil)},
+	"setban":                 nil,
 	"setgenerate":            nil,
 	"stop":                   {(*string)(nil)},
 	"submitblock":            {nil, (*string)(nil)},
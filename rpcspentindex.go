@@ -0,0 +1,67 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// handleGetSpentInfo implements the getspentinfo command.
+func handleGetSpentInfo(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.GetSpentInfoCmd)
+	if s.cfg.SpentIndex == nil {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCMisc,
+			"Spent output index must be enabled (--spentindex)", nil)
+	}
+
+	txHash, err := chainhash.NewHashFromStr(c.Txid)
+	if err != nil {
+		return nil, rpcDecodeHexError(c.Txid)
+	}
+	info, err := s.cfg.SpentIndex.SpentInfo(&wire.OutPoint{
+		Hash:  *txHash,
+		Index: c.Index,
+	})
+	if err != nil {
+		return nil, internalRPCError(err, "Failed to query the spent "+
+			"output index")
+	}
+	if info == nil {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidAddressOrKey,
+			"Unable to get spent info", nil)
+	}
+	return &btcjson.GetSpentInfoResult{
+		TxID:     info.TxHash.String(),
+		Index:    info.Index,
+		Height:   info.Height,
+		Satoshis: info.Amount,
+	}, nil
+}
+
+// loadSpentTxIDs sets the hash of the spending transaction on each output
+// of a transaction which is spent in the main chain.  It does nothing when
+// the spent output index is not enabled.
+func (s *rpcServer) loadSpentTxIDs(txHash *chainhash.Hash, vouts []btcjson.Vout) er.R {
+	if s.cfg.SpentIndex == nil {
+		return nil
+	}
+	for i := range vouts {
+		info, err := s.cfg.SpentIndex.SpentInfo(&wire.OutPoint{
+			Hash:  *txHash,
+			Index: vouts[i].N,
+		})
+		if err != nil {
+			return internalRPCError(err, "Failed to query the spent "+
+				"output index")
+		}
+		if info != nil {
+			vouts[i].SpentTxID = info.TxHash.String()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,412 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt"
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/chaincfg/globalcfg"
+	"github.com/pkt-cash/pktd/database"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// blockRegionReader reads a serialized block from the database a few bytes at
+// a time, so that parts of the block can be decoded without loading all of it.
+type blockRegionReader struct {
+	dbTx   database.Tx
+	hash   *chainhash.Hash
+	offset uint32
+}
+
+// Read reads the next len(p) bytes of the block.
+func (r *blockRegionReader) Read(p []byte) (int, error) {
+	b, err := r.dbTx.FetchBlockRegion(&database.BlockRegion{
+		Hash:   r.hash,
+		Offset: r.offset,
+		Len:    uint32(len(p)),
+	})
+	if err != nil {
+		return 0, er.Native(err)
+	}
+	n := copy(p, b)
+	r.offset += uint32(n)
+	return n, nil
+}
+
+// Seek moves the offset of the next read, only io.SeekCurrent is supported.
+func (r *blockRegionReader) Seek(offset int64, whence int) (int64, error) {
+	if whence != io.SeekCurrent {
+		return 0, er.Native(er.New("blockRegionReader: unsupported seek"))
+	}
+	r.offset += uint32(offset)
+	return int64(r.offset), nil
+}
+
+// readBlockTxCount returns the number of transactions of a serialized block
+// by reading only its header, the length of each entry of its PacketCrypt
+// proof if the chain uses them, and the transaction count.
+func readBlockTxCount(r io.ReadSeeker, packetCrypt bool) (uint64, er.R) {
+	if _, errr := r.Seek(wire.MaxBlockHeaderPayload, io.SeekCurrent); errr != nil {
+		return 0, er.E(errr)
+	}
+	// The PacketCrypt proof is a list of type, length and value entries
+	// which ends with an entry of type 0.
+	for packetCrypt {
+		t, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return 0, err
+		}
+		length, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return 0, err
+		}
+		if t == 0 {
+			break
+		}
+		if _, errr := r.Seek(int64(length), io.SeekCurrent); errr != nil {
+			return 0, er.E(errr)
+		}
+	}
+	return wire.ReadVarInt(r, 0)
+}
+
+// feeRatePercentiles are the percentiles of block weight at which
+// getblockstats reports the fee rate.
+var feeRatePercentiles = []float64{0.10, 0.25, 0.50, 0.75, 0.90}
+
+// txFeeRate is the fee rate of one transaction along with its weight, for
+// computing fee rate percentiles by weight.
+type txFeeRate struct {
+	feeRate int64
+	weight  int64
+}
+
+// percentilesByWeight returns the fee rate at each of feeRatePercentiles of
+// the total weight, counting from the lowest fee rate.
+func percentilesByWeight(rates []txFeeRate, totalWeight int64) []int64 {
+	out := make([]int64, len(feeRatePercentiles))
+	if len(rates) == 0 {
+		return out
+	}
+	sort.SliceStable(rates, func(i, j int) bool {
+		return rates[i].feeRate < rates[j].feeRate
+	})
+	next := 0
+	cumulative := int64(0)
+	for _, r := range rates {
+		cumulative += r.weight
+		for next < len(out) &&
+			float64(cumulative) >= float64(totalWeight)*feeRatePercentiles[next] {
+			out[next] = r.feeRate
+			next++
+		}
+	}
+	for ; next < len(out); next++ {
+		out[next] = rates[len(rates)-1].feeRate
+	}
+	return out
+}
+
+// median returns the median of the values, or the average of the two middle
+// values if there is an even number of them.
+func median(values []int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
+
+// computeBlockStats computes the statistics of a block from the block and
+// the outputs which it spends, in the order they appear in the spend
+// journal.
+func computeBlockStats(blk *btcutil.Block, stxos []blockchain.SpentTxOut,
+	params *chaincfg.Params) (*btcjson.GetBlockStatsResult, er.R) {
+
+	res := &btcjson.GetBlockStatsResult{
+		BlockHash: blk.Hash().String(),
+		Height:    int64(blk.Height()),
+		Time:      blk.MsgBlock().Header.Timestamp.Unix(),
+		Txs:       int64(len(blk.Transactions())),
+		Subsidy:   blockchain.CalcBlockSubsidy(blk.Height(), params),
+	}
+	if params.GlobalConf.HasNetworkSteward {
+		res.NetworkStewardPayout = blockchain.PktCalcNetworkStewardPayout(res.Subsidy)
+	}
+	if pcp := blk.MsgBlock().Pcp; pcp != nil {
+		commit := packetcrypt.ExtractCoinbaseCommit(blk.MsgBlock().Transactions[0])
+		res.PcAnnCount = commit.AnnCount()
+		res.PcProofSize = int64(pcp.SerializeSize())
+	}
+
+	var fees, sizes []int64
+	var rates []txFeeRate
+	spent := 0
+	utxos := int64(0)
+	for i, tx := range blk.Transactions() {
+		msgTx := tx.MsgTx()
+		res.Outs += int64(len(msgTx.TxOut))
+		for _, out := range msgTx.TxOut {
+			if !txscript.IsUnspendable(out.PkScript) {
+				utxos++
+			}
+		}
+		if i == 0 {
+			continue
+		}
+
+		in := int64(0)
+		for range msgTx.TxIn {
+			if spent >= len(stxos) {
+				return nil, er.New("spend journal is shorter than the " +
+					"inputs of the block")
+			}
+			in += stxos[spent].Amount
+			spent++
+		}
+		res.Ins += int64(len(msgTx.TxIn))
+		out := int64(0)
+		for _, txOut := range msgTx.TxOut {
+			out += txOut.Value
+		}
+		res.TotalOut += out
+
+		size := int64(msgTx.SerializeSize())
+		weight := blockchain.GetTransactionWeight(tx)
+		vsize := (weight + blockchain.WitnessScaleFactor - 1) /
+			blockchain.WitnessScaleFactor
+		fee := in - out
+		feeRate := fee / vsize
+
+		res.TotalFee += fee
+		res.TotalSize += size
+		res.TotalWeight += weight
+		if msgTx.HasWitness() {
+			res.SegWitTxs++
+			res.SegWitTotalSize += size
+			res.SegWitTotalWeight += weight
+		}
+		if len(fees) == 0 || fee < res.MinFee {
+			res.MinFee = fee
+		}
+		if fee > res.MaxFee {
+			res.MaxFee = fee
+		}
+		if len(fees) == 0 || feeRate < res.MinFeeRate {
+			res.MinFeeRate = feeRate
+		}
+		if feeRate > res.MaxFeeRate {
+			res.MaxFeeRate = feeRate
+		}
+		if len(sizes) == 0 || size < res.MinTxSize {
+			res.MinTxSize = size
+		}
+		if size > res.MaxTxSize {
+			res.MaxTxSize = size
+		}
+		fees = append(fees, fee)
+		sizes = append(sizes, size)
+		rates = append(rates, txFeeRate{feeRate: feeRate, weight: weight})
+	}
+	if spent != len(stxos) {
+		return nil, er.New("spend journal does not match the inputs of " +
+			"the block")
+	}
+
+	if n := int64(len(fees)); n > 0 {
+		res.AvgFee = res.TotalFee / n
+		res.AvgTxSize = res.TotalSize / n
+		vsize := (res.TotalWeight + blockchain.WitnessScaleFactor - 1) /
+			blockchain.WitnessScaleFactor
+		res.AvgFeeRate = res.TotalFee / vsize
+	}
+	res.MedianFee = median(fees)
+	res.MedianTxSize = median(sizes)
+	res.FeeRatePercentiles = percentilesByWeight(rates, res.TotalWeight)
+	res.UTXOIncrease = utxos - res.Ins
+	return res, nil
+}
+
+// selectStats returns only the named fields of the getblockstats result, it
+// fails if any of the names is not a known statistic.
+func selectStats(res *btcjson.GetBlockStatsResult, names []string) (map[string]interface{}, er.R) {
+	fields := make(map[string]interface{})
+	rv := reflect.ValueOf(res).Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		tag := strings.Split(rv.Type().Field(i).Tag.Get("json"), ",")[0]
+		fields[tag] = rv.Field(i).Interface()
+	}
+	out := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		v, ok := fields[name]
+		if !ok {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"Invalid selected statistic "+name, nil)
+		}
+		out[name] = v
+	}
+	return out, nil
+}
+
+// fetchBlockByHashOrHeight loads a main chain block given either its hash or
+// its height.
+func (s *rpcServer) fetchBlockByHashOrHeight(hashOrHeight string) (*btcutil.Block, er.R) {
+	if len(hashOrHeight) == chainhash.MaxHashStringSize {
+		hash, err := chainhash.NewHashFromStr(hashOrHeight)
+		if err != nil {
+			return nil, rpcDecodeHexError(hashOrHeight)
+		}
+		blk, err := s.cfg.Chain.BlockByHash(hash)
+		if err != nil {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCBlockNotFound,
+				"Block not found in the main chain", nil)
+		}
+		return blk, nil
+	}
+	height, errr := strconv.ParseInt(hashOrHeight, 10, 32)
+	if errr != nil || height < 0 {
+		return nil, btcjson.ErrRPCInvalidParameter.New(
+			"Argument must be a block hash or a height", nil)
+	}
+	blk, err := s.cfg.Chain.BlockByHeight(int32(height))
+	if err != nil {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCOutOfRange,
+			"Block height out of range", nil)
+	}
+	return blk, nil
+}
+
+// handleGetBlockStats implements the getblockstats command.
+func handleGetBlockStats(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.GetBlockStatsCmd)
+
+	blk, err := s.fetchBlockByHashOrHeight(string(c.HashOrHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	// The spend journal holds the outputs which are spent by the block,
+	// which gives the input values without needing the transaction index.
+	stxos, err := s.cfg.Chain.FetchSpendJournal(blk)
+	if err != nil {
+		return nil, internalRPCError(err, "Failed to load spend journal")
+	}
+	res, err := computeBlockStats(blk, stxos, s.cfg.ChainParams)
+	if err != nil {
+		return nil, internalRPCError(err, "Failed to compute block stats")
+	}
+	if c.Stats != nil && len(*c.Stats) > 0 {
+		return selectStats(res, *c.Stats)
+	}
+	return res, nil
+}
+
+// handleGetChainTxStats implements the getchaintxstats command.
+func handleGetChainTxStats(s *rpcServer, cmd interface{}, closeChan <-chan struct{}) (interface{}, er.R) {
+	c := cmd.(*btcjson.GetChainTxStatsCmd)
+	params := s.cfg.ChainParams
+	best := s.cfg.Chain.BestSnapshot()
+
+	finalHash := &best.Hash
+	finalHeight := best.Height
+	if c.BlockHash != nil {
+		hash, err := chainhash.NewHashFromStr(*c.BlockHash)
+		if err != nil {
+			return nil, rpcDecodeHexError(*c.BlockHash)
+		}
+		height, err := s.cfg.Chain.BlockHeightByHash(hash)
+		if err != nil {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCBlockNotFound,
+				"Block not found in the main chain", nil)
+		}
+		finalHash, finalHeight = hash, height
+	}
+
+	// The default window is about one month of blocks.
+	nBlocks := int32((30 * 24 * time.Hour) / params.TargetTimePerBlock)
+	if c.NBlocks != nil {
+		nBlocks = *c.NBlocks
+		if nBlocks < 0 || (nBlocks > 0 && nBlocks >= finalHeight) {
+			return nil, btcjson.ErrRPCInvalidParameter.New(
+				"Invalid block count: should be between 0 and the "+
+					"block's height - 1", nil)
+		}
+	}
+	if nBlocks >= finalHeight {
+		nBlocks = finalHeight - 1
+	}
+	if nBlocks < 0 {
+		nBlocks = 0
+	}
+
+	finalHeader, err := s.cfg.Chain.HeaderByHash(finalHash)
+	if err != nil {
+		return nil, internalRPCError(err, "Failed to load block header")
+	}
+	res := &btcjson.GetChainTxStatsResult{
+		Time:                   finalHeader.Timestamp.Unix(),
+		WindowFinalBlockHash:   finalHash.String(),
+		WindowFinalBlockHeight: finalHeight,
+		WindowBlockCount:       nBlocks,
+	}
+	if finalHeight == best.Height {
+		txCount := int64(best.TotalTxns)
+		res.TxCount = &txCount
+	}
+	if nBlocks == 0 {
+		return res, nil
+	}
+
+	startHeader, err := s.cfg.Chain.BlockHeaderByHeight(finalHeight - nBlocks)
+	if err != nil {
+		return nil, internalRPCError(err, "Failed to load block header")
+	}
+	res.WindowInterval = res.Time - startHeader.Timestamp.Unix()
+	for height := finalHeight - nBlocks + 1; height <= finalHeight; height++ {
+		select {
+		case <-closeChan:
+			return nil, ErrClientQuit.Default()
+		default:
+		}
+		hash, err := s.cfg.Chain.BlockHashByHeight(height)
+		if err != nil {
+			return nil, internalRPCError(err, "Failed to load block hash")
+		}
+		var txCount uint64
+		err = s.cfg.DB.View(func(dbTx database.Tx) er.R {
+			var err er.R
+			txCount, err = readBlockTxCount(&blockRegionReader{
+				dbTx: dbTx,
+				hash: hash,
+			}, globalcfg.GetProofOfWorkAlgorithm() == globalcfg.PowPacketCrypt)
+			return err
+		})
+		if err != nil {
+			return nil, internalRPCError(err, "Failed to load block")
+		}
+		res.WindowTxCount += int64(txCount)
+	}
+	if res.WindowInterval > 0 {
+		res.TxRate = float64(res.WindowTxCount) / float64(res.WindowInterval)
+	}
+	return res, nil
+}
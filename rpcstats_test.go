@@ -0,0 +1,176 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcjson"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// statsTestBlock returns a block with a coinbase and two transactions,
+// along with the spend journal of the outputs which they spend.
+func statsTestBlock() (*btcutil.Block, []blockchain.SpentTxOut) {
+	pkScript := []byte{opcode.OP_TRUE}
+
+	coinbase := wire.NewMsgTx(1)
+	coinbase.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: constants.MaxPrevOutIndex},
+		SignatureScript:  []byte{0x01, 0x0a, 0x00},
+	})
+	coinbase.AddTxOut(wire.NewTxOut(5000000000, pkScript))
+	coinbase.AddTxOut(wire.NewTxOut(0, []byte{opcode.OP_RETURN}))
+
+	// One input paying a fee of 1000.
+	tx1 := wire.NewMsgTx(1)
+	tx1.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 0}})
+	tx1.AddTxOut(wire.NewTxOut(9000, pkScript))
+
+	// Two inputs paying a fee of 5000.
+	tx2 := wire.NewMsgTx(1)
+	tx2.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 1}})
+	tx2.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 2}})
+	tx2.AddTxOut(wire.NewTxOut(10000, pkScript))
+	tx2.AddTxOut(wire.NewTxOut(5000, pkScript))
+
+	msgBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{coinbase, tx1, tx2},
+	}
+	blk := btcutil.NewBlock(msgBlock)
+	blk.SetHeight(10)
+
+	stxos := []blockchain.SpentTxOut{
+		{Amount: 10000, PkScript: pkScript, Height: 1},
+		{Amount: 12000, PkScript: pkScript, Height: 2},
+		{Amount: 8000, PkScript: pkScript, Height: 3},
+	}
+	return blk, stxos
+}
+
+// TestComputeBlockStats ensures that the block statistics exclude the
+// coinbase from the fee figures and use the spend journal for input values.
+func TestComputeBlockStats(t *testing.T) {
+	blk, stxos := statsTestBlock()
+	txs := blk.MsgBlock().Transactions
+	size1 := int64(txs[1].SerializeSize())
+	size2 := int64(txs[2].SerializeSize())
+
+	res, err := computeBlockStats(blk, stxos, &chaincfg.RegressionNetParams)
+	if err != nil {
+		t.Fatalf("computeBlockStats: %v", err)
+	}
+	checks := []struct {
+		name      string
+		got, want int64
+	}{
+		{"txs", res.Txs, 3},
+		{"ins", res.Ins, 3},
+		{"outs", res.Outs, 5},
+		{"totalfee", res.TotalFee, 6000},
+		{"minfee", res.MinFee, 1000},
+		{"maxfee", res.MaxFee, 5000},
+		{"avgfee", res.AvgFee, 3000},
+		{"medianfee", res.MedianFee, 3000},
+		{"total_out", res.TotalOut, 24000},
+		{"total_size", res.TotalSize, size1 + size2},
+		{"mintxsize", res.MinTxSize, size1},
+		{"maxtxsize", res.MaxTxSize, size2},
+		{"utxo_increase", res.UTXOIncrease, 1},
+		{"swtxs", res.SegWitTxs, 0},
+		{"minfeerate", res.MinFeeRate, 1000 / size1},
+		{"maxfeerate", res.MaxFeeRate, 5000 / size2},
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			t.Errorf("%s: got %d want %d", c.name, c.got, c.want)
+		}
+	}
+
+	// A spend journal which does not match the block is an error.
+	if _, err := computeBlockStats(blk, stxos[:2],
+		&chaincfg.RegressionNetParams); err == nil {
+		t.Errorf("computeBlockStats: expected failure on short journal")
+	}
+	if _, err := computeBlockStats(blk, append(stxos, stxos[0]),
+		&chaincfg.RegressionNetParams); err == nil {
+		t.Errorf("computeBlockStats: expected failure on long journal")
+	}
+}
+
+// TestPercentilesByWeight ensures that fee rate percentiles are taken over
+// the weight of the transactions rather than their count.
+func TestPercentilesByWeight(t *testing.T) {
+	rates := []txFeeRate{
+		{feeRate: 50, weight: 100},
+		{feeRate: 10, weight: 800},
+		{feeRate: 20, weight: 100},
+	}
+	got := percentilesByWeight(rates, 1000)
+	want := []int64{10, 10, 10, 10, 20}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("percentilesByWeight: got %v want %v", got, want)
+	}
+	if got := percentilesByWeight(nil, 0); !reflect.DeepEqual(got,
+		[]int64{0, 0, 0, 0, 0}) {
+		t.Errorf("percentilesByWeight(nil): got %v", got)
+	}
+}
+
+// TestSelectStats ensures that only the requested statistics are returned
+// and that unknown statistics are rejected.
+func TestSelectStats(t *testing.T) {
+	res := &btcjson.GetBlockStatsResult{Txs: 3, TotalFee: 6000}
+	got, err := selectStats(res, []string{"txs", "totalfee"})
+	if err != nil {
+		t.Fatalf("selectStats: %v", err)
+	}
+	want := map[string]interface{}{"txs": int64(3), "totalfee": int64(6000)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("selectStats: got %v want %v", got, want)
+	}
+	if _, err := selectStats(res, []string{"txs", "nosuchstat"}); !btcjson.ErrRPCInvalidParameter.Is(err) {
+		t.Errorf("selectStats: got %v want ErrRPCInvalidParameter", err)
+	}
+}
+
+// TestReadBlockTxCount ensures that the transaction count is read from
+// serialized blocks, with and without a PacketCrypt proof.
+func TestReadBlockTxCount(t *testing.T) {
+	blk, _ := statsTestBlock()
+	msgBlock := *blk.MsgBlock()
+
+	var buf bytes.Buffer
+	err := msgBlock.BtcEncode(&buf, 0, wire.WitnessEncoding|wire.NoPacketCryptEncoding)
+	if err != nil {
+		t.Fatalf("BtcEncode: %v", err)
+	}
+	count, err := readBlockTxCount(bytes.NewReader(buf.Bytes()), false)
+	if err != nil || count != 3 {
+		t.Fatalf("readBlockTxCount: got %d %v, want 3", count, err)
+	}
+
+	msgBlock.Pcp = &wire.PacketCryptProof{
+		AnnProof:     make([]byte, 100),
+		ContentProof: make([]byte, 64),
+		Version:      2,
+	}
+	buf.Reset()
+	err = msgBlock.BtcEncode(&buf, 0, wire.WitnessEncoding|wire.PacketCryptEncoding)
+	if err != nil {
+		t.Fatalf("BtcEncode: %v", err)
+	}
+	count, err = readBlockTxCount(bytes.NewReader(buf.Bytes()), true)
+	if err != nil || count != 3 {
+		t.Fatalf("readBlockTxCount: got %d %v, want 3", count, err)
+	}
+}
@@ -8,9 +8,6 @@ package main
 import (
 	"bytes"
 	"container/list"
-	"crypto/sha256"
-	"crypto/subtle"
-	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -85,7 +82,7 @@ var wsHandlersBeforeInit = map[string]wsCommandHandler{
 // server handler which runs each new connection in a new goroutine thereby
 // satisfying the requirement.
 func (s *rpcServer) WebsocketHandler(conn *websocket.Conn, remoteAddr string,
-	authenticated bool, isAdmin bool) {
+	authenticated bool, user *rpcUser) {
 	// Clear the read deadline that was set before the websocket hijacked
 	// the connection.
 	conn.SetReadDeadline(timeZeroVal)
@@ -103,7 +100,7 @@ func (s *rpcServer) WebsocketHandler(conn *websocket.Conn, remoteAddr string,
 	// Create a new websocket client to handle the new websocket connection
 	// and wait for it to shutdown.  Once it has shutdown (and hence
 	// disconnected), remove it and any notifications it registered for.
-	client, err := newWebsocketClient(s, conn, remoteAddr, authenticated, isAdmin)
+	client, err := newWebsocketClient(s, conn, remoteAddr, authenticated, user)
 	if err != nil {
 		rpcsLog.Errorf("Failed to serve client %s: %v", remoteAddr, err)
 		conn.Close()
@@ -1207,9 +1204,9 @@ type wsClient struct {
 	// and therefore is allowed to communicated over the websocket.
 	authenticated bool
 
-	// isAdmin specifies whether a client may change the state of the server;
-	// false means its access is only to the limited set of RPC calls.
-	isAdmin bool
+	// user is the RPC user which the client authenticated as, it is nil
+	// until the client is authenticated.
+	user *rpcUser
 
 	// sessionID is a random ID generated for each client when connected.
 	// These IDs may be queried by a client using the session RPC.  A change
@@ -1344,17 +1341,14 @@ out:
 			break out
 		case !c.authenticated:
 			// Check credentials.
-			login := authCmd.Username + ":" + authCmd.Passphrase
-			auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
-			authSha := sha256.Sum256([]byte(auth))
-			cmp := subtle.ConstantTimeCompare(authSha[:], c.server.authsha[:])
-			limitcmp := subtle.ConstantTimeCompare(authSha[:], c.server.limitauthsha[:])
-			if cmp != 1 && limitcmp != 1 {
-				rpcsLog.Warnf("Auth failure.")
+			user := c.server.authenticate(authCmd.Username, authCmd.Passphrase)
+			if user == nil {
+				rpcsLog.Warnf("Websocket authentication failure for user "+
+					"[%s] from %s", authCmd.Username, c.addr)
 				break out
 			}
 			c.authenticated = true
-			c.isAdmin = cmp == 1
+			c.user = user
 
 			// Marshal and send response.
 			reply, err := createMarshaledReply(cmd.id, nil, nil)
@@ -1367,25 +1361,20 @@ out:
 			continue
 		}
 
-		// Check if the client is using limited RPC credentials and
-		// error when not authorized to call this RPC.
-		if !c.isAdmin {
-			if _, ok := rpcLimited[request.Method]; !ok {
-				jsonErr := btcjson.NewRPCError(
-					btcjson.ErrRPCInvalidParams,
-					"limited user not authorized for this method",
-					nil,
-				)
-				// Marshal and send response.
-				reply, err := createMarshaledReply(request.ID, nil, jsonErr)
-				if err != nil {
-					rpcsLog.Errorf("Failed to marshal parse failure "+
-						"reply: %v", err)
-					continue
-				}
-				c.SendMessage(reply, nil)
+		// Check that the user is allowed to call this RPC, this covers
+		// the websocket extension commands which are not handled by
+		// standardCmdResult.
+		if !c.user.allowed(request.Method) {
+			jsonErr := c.user.notAuthorizedError(request.Method)
+			// Marshal and send response.
+			reply, err := createMarshaledReply(request.ID, nil, jsonErr)
+			if err != nil {
+				rpcsLog.Errorf("Failed to marshal parse failure "+
+					"reply: %v", err)
 				continue
 			}
+			c.SendMessage(reply, nil)
+			continue
 		}
 
 		// Asynchronously handle the request.  A semaphore is used to
@@ -1436,7 +1425,7 @@ func (c *wsClient) serviceRequest(r *parsedRPCCmd) {
 	if ok {
 		result, err = wsHandler(c, r.cmd)
 	} else {
-		result, err = c.server.standardCmdResult(r, nil)
+		result, err = c.server.standardCmdResult(r, c.user, nil)
 	}
 	reply, err := createMarshaledReply(r.id, result, err)
 	if err != nil {
@@ -1652,7 +1641,7 @@ func (c *wsClient) WaitForShutdown() {
 // incoming and outgoing messages in separate goroutines complete with queuing
 // and asynchrous handling for long-running operations.
 func newWebsocketClient(server *rpcServer, conn *websocket.Conn,
-	remoteAddr string, authenticated bool, isAdmin bool) (*wsClient, er.R) {
+	remoteAddr string, authenticated bool, user *rpcUser) (*wsClient, er.R) {
 	sessionID, err := wire.RandomUint64()
 	if err != nil {
 		return nil, err
@@ -1662,7 +1651,7 @@ func newWebsocketClient(server *rpcServer, conn *websocket.Conn,
 		conn:              conn,
 		addr:              remoteAddr,
 		authenticated:     authenticated,
-		isAdmin:           isAdmin,
+		user:              user,
 		sessionID:         sessionID,
 		server:            server,
 		addrRequests:      make(map[string]struct{}),
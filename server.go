@@ -25,6 +25,7 @@ import (
 	"github.com/pkt-cash/pktd/addrmgr"
 	"github.com/pkt-cash/pktd/blockchain"
 	"github.com/pkt-cash/pktd/blockchain/indexers"
+	"github.com/pkt-cash/pktd/blockchain/packetcrypt/announce"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/btcutil/bloom"
 	"github.com/pkt-cash/pktd/btcutil/er"
@@ -53,17 +54,36 @@ const (
 	// required to be supported by outbound peers.
 	defaultRequiredServices = protocol.SFNodeNetwork
 
-	// defaultTargetOutbound is the default number of outbound peers to
-	// target. We are normalizing the Bitcoin Core in allowing 16 here,
-	// For Bitcoin Core latest Bitcoin Core, 14 connections are used for
-	// full relaying and 2 are used for "block only" "fast" connections,
-	// although we don't yet make such a distinction.
+	// defaultTargetOutbound is the default number of full relay outbound
+	// peers to target. We are normalizing the Bitcoin Core in allowing 16
+	// outbound peers here, 14 connections are used for full relaying and
+	// defaultTargetBlockRelayOnly are used for block relay only.
 	defaultTargetOutbound = 14
 
+	// defaultTargetBlockRelayOnly is the default number of block relay only
+	// outbound peers to target.  These peers are not relayed transactions
+	// or addresses, which makes them harder to discover for an attacker
+	// trying to eclipse the node, and they are reconnected to after a
+	// restart as anchors.
+	defaultTargetBlockRelayOnly = 2
+
+	// feelerInterval is the interval at which feeler connections are made
+	// to test addresses which were never connected to before they are
+	// moved to the tried table of the address manager.
+	feelerInterval = time.Minute * 2
+
 	// connectionRetryInterval is the base amount of time to wait in between
 	// retries when connecting to persistent peers.  It is adjusted by the
 	// number of retries such that there is a retry backoff.
 	connectionRetryInterval = time.Second * 5
+
+	// feeFilterCheckInterval is the interval at which the minimum fee of
+	// the mempool is checked for changes to advertise to peers.
+	feeFilterCheckInterval = time.Minute
+
+	// maxLocalTxRelayDelay is the maximum random delay before transactions
+	// submitted through the RPC server are announced to peers.
+	maxLocalTxRelayDelay = time.Second * 10
 )
 
 // simpleAddr implements the net.Addr interface with two struct fields
@@ -89,10 +109,14 @@ func (a simpleAddr) Network() string {
 var _ net.Addr = simpleAddr{}
 
 // broadcastMsg provides the ability to house a bitcoin message to be broadcast
-// to all connected peers except specified excluded peers.
+// to all connected peers except specified excluded peers.  When services is
+// set, the message is only sent to peers advertising these services, and when
+// protocolVersion is set, only to peers which negotiated at least this version.
 type broadcastMsg struct {
-	message      wire.Message
-	excludePeers []*serverPeer
+	message         wire.Message
+	excludePeers    []*serverPeer
+	services        protocol.ServiceFlag
+	protocolVersion uint32
 }
 
 // broadcastInventoryAdd is a type used to declare that the InvVect it contains
@@ -110,6 +134,12 @@ type relayMsg struct {
 	data    interface{}
 }
 
+// banPeerMsg packages a peer to ban along with the reason it misbehaved.
+type banPeerMsg struct {
+	sp     *serverPeer
+	reason string
+}
+
 // updatePeerHeightsMsg is a message sent from the blockmanager to the server
 // after a new block has been accepted. The purpose of the message is to update
 // the heights of peers that were known to announce the block before we
@@ -128,7 +158,7 @@ type peerState struct {
 	inboundPeers    map[int32]*serverPeer
 	outboundPeers   map[int32]*serverPeer
 	persistentPeers map[int32]*serverPeer
-	banned          map[string]time.Time
+	banned          *banList
 	outboundGroups  map[string]int
 }
 
@@ -174,6 +204,7 @@ type server struct {
 	bytesSent     uint64 // Total bytes sent by all peers since start.
 	started       int32
 	shutdown      int32
+	mempoolLoaded int32
 	startupTime   int64
 
 	chainParams          *chaincfg.Params
@@ -181,6 +212,8 @@ type server struct {
 	connManager          *connmgr.ConnManager
 	sigCache             *txscript.SigCache
 	hashCache            *txscript.HashCache
+	annCache             *announce.Cache
+	pcAnnPool            *pcAnnPool
 	rpcServer            *rpcServer
 	syncManager          *netsync.SyncManager
 	chain                *blockchain.BlockChain
@@ -189,7 +222,7 @@ type server struct {
 	modifyRebroadcastInv chan interface{}
 	newPeers             chan *serverPeer
 	donePeers            chan *serverPeer
-	banPeers             chan *serverPeer
+	banPeers             chan banPeerMsg
 	query                chan interface{}
 	relayInv             chan relayMsg
 	broadcast            chan broadcastMsg
@@ -198,6 +231,7 @@ type server struct {
 	quit                 chan struct{}
 	nat                  NAT
 	db                   database.DB
+	banList              *banList
 	timeSource           blockchain.MedianTimeSource
 	services             protocol.ServiceFlag
 
@@ -205,9 +239,10 @@ type server struct {
 	// if the associated index is not enabled.  These fields are set during
 	// initial creation of the server and never changed afterwards, so they
 	// do not need to be protected for concurrent access.
-	txIndex   *indexers.TxIndex
-	addrIndex *indexers.AddrIndex
-	cfIndex   *indexers.CfIndex
+	txIndex    *indexers.TxIndex
+	addrIndex  *indexers.AddrIndex
+	spentIndex *indexers.SpentIndex
+	cfIndex    *indexers.CfIndex
 
 	// The fee estimator keeps track of how long transactions are left in
 	// the mempool before they are mined into blocks.
@@ -225,6 +260,16 @@ type server struct {
 	// agentWhitelist is a list of whitelisted user agent substrings, no
 	// whitelisting will be applied if the list is empty or nil.
 	agentWhitelist []string
+
+	// anchors holds the addresses of the block relay only peers of the
+	// previous run, which are reconnected to first.
+	anchors    []string
+	anchorsMtx sync.Mutex
+
+	// unrelayedLocalTxs holds the hashes of the transactions submitted
+	// through the RPC server which have not been announced to peers yet.
+	unrelayedLocalTxs    map[chainhash.Hash]struct{}
+	unrelayedLocalTxsMtx sync.Mutex
 }
 
 // serverPeer extends the peer to maintain state shared by the server and
@@ -301,14 +346,37 @@ func (sp *serverPeer) setDisableRelayTx(disable bool) {
 }
 
 // relayTxDisabled returns whether or not relaying of transactions for the given
-// peer is disabled.
+// peer is disabled, either by the peer or because it is not a full relay peer.
 // It is safe for concurrent access.
 func (sp *serverPeer) relayTxDisabled() bool {
 	sp.relayMtx.Lock()
 	isDisabled := sp.disableRelayTx
 	sp.relayMtx.Unlock()
 
-	return isDisabled
+	return isDisabled || sp.connClass() != connmgr.ConnFullRelay
+}
+
+// connClass returns the class of the connection to the peer.  Inbound and
+// persistent peers are full relay peers.
+func (sp *serverPeer) connClass() connmgr.ConnClass {
+	if sp.connReq == nil || sp.persistent {
+		return connmgr.ConnFullRelay
+	}
+	return sp.connReq.Class
+}
+
+// connectionType returns a description of the connection to the peer for the
+// getpeerinfo RPC.
+func (sp *serverPeer) connectionType() string {
+	switch {
+	case sp.Inbound():
+		return "inbound"
+	case sp.persistent:
+		return "manual"
+	case sp.connClass() == connmgr.ConnFullRelay:
+		return "outbound-full-relay"
+	}
+	return sp.connClass().String()
 }
 
 // pushAddrMsg sends an addr message to the connected peer using the provided
@@ -363,7 +431,7 @@ func (sp *serverPeer) addBanScore(persistent, transient uint32, reason string) b
 		if score > cfg.BanThreshold {
 			peerLog.Warnf("Misbehaving peer %s -- banning and disconnecting",
 				sp)
-			sp.server.BanPeer(sp)
+			sp.server.BanPeer(sp, reason)
 			sp.Disconnect()
 			return true
 		}
@@ -447,7 +515,33 @@ func (sp *serverPeer) OnVersion(_ *peer.Peer, msg *wire.MsgVersion) *wire.MsgRej
 // OnVerAck is invoked when a peer receives a verack bitcoin message and is used
 // to kick start communication with them.
 func (sp *serverPeer) OnVerAck(_ *peer.Peer, _ *wire.MsgVerAck) {
+	// Feeler connections are only made to learn that the address is
+	// reachable, so they are closed right after the handshake.
+	if sp.connClass() == connmgr.ConnFeeler {
+		srvrLog.Debugf("Feeler connection to %s succeeded", sp)
+		sp.server.addrManager.Good(sp.NA())
+		sp.Disconnect()
+		return
+	}
+
 	sp.server.AddPeer(sp)
+
+	// Let peers relaying PacketCrypt announcements know about those in the
+	// announcement pool.
+	if pool := sp.server.pcAnnPool; pool != nil &&
+		sp.Services()&protocol.SFNodePcAnn == protocol.SFNodePcAnn {
+
+		sp.queuePcAnnInv(pool.hashes())
+	}
+
+	// Let the peer know about the minimum fee of the mempool when it is
+	// full so it doesn't announce transactions which would be rejected.
+	if minFee := sp.server.txMemPool.MinFee(); minFee > 0 &&
+		sp.ProtocolVersion() >= protocol.FeeFilterVersion &&
+		sp.connClass() == connmgr.ConnFullRelay {
+
+		sp.QueueMessage(wire.NewMsgFeeFilter(int64(minFee)), nil)
+	}
 }
 
 // OnMemPool is invoked when a peer receives a mempool bitcoin message.
@@ -482,6 +576,12 @@ func (sp *serverPeer) OnMemPool(_ *peer.Peer, msg *wire.MsgMemPool) {
 	invMsg := wire.NewMsgInvSizeHint(uint(len(txDescs)))
 
 	for _, txDesc := range txDescs {
+		// Transactions submitted locally are not revealed before they
+		// have been announced.
+		if sp.server.isUnrelayedLocalTx(txDesc.Tx.Hash()) {
+			continue
+		}
+
 		// Either add all transactions when there is no bloom filter,
 		// or only the transactions that match the filter when there is
 		// one.
@@ -1108,6 +1208,109 @@ func (sp *serverPeer) OnFeeFilter(_ *peer.Peer, msg *wire.MsgFeeFilter) {
 	atomic.StoreInt64(&sp.feeFilter, msg.MinFee)
 }
 
+// queuePcAnnInv queues pcanninv messages announcing the PacketCrypt
+// announcements with the passed hashes to the peer.
+func (sp *serverPeer) queuePcAnnInv(hashes []*chainhash.Hash) {
+	inv := wire.NewMsgPcAnnInv()
+	for _, hash := range hashes {
+		if len(inv.AnnHashes) == wire.MaxPcAnnsPerMsg {
+			sp.QueueMessage(inv, nil)
+			inv = wire.NewMsgPcAnnInv()
+		}
+		inv.AddAnnHash(hash)
+	}
+	if len(inv.AnnHashes) > 0 {
+		sp.QueueMessage(inv, nil)
+	}
+}
+
+// OnPcAnnInv is invoked when a peer receives a pcanninv message and is used to
+// request the PacketCrypt announcements which are not in the announcement pool
+// yet.  It is ignored unless announcement relay is enabled.
+func (sp *serverPeer) OnPcAnnInv(_ *peer.Peer, msg *wire.MsgPcAnnInv) {
+	pool := sp.server.pcAnnPool
+	if pool == nil {
+		peerLog.Debugf("Ignoring pcanninv from %v -- announcement relay "+
+			"is disabled", sp)
+		return
+	}
+
+	// A decaying ban score increase is applied to prevent flooding the
+	// pool with requests, as is done for getpcanns.
+	numAnns := len(msg.AnnHashes)
+	if sp.addBanScore(0, uint32(numAnns)*99/wire.MaxPcAnnsPerMsg,
+		wire.CmdPcAnnInv) {
+
+		return
+	}
+
+	getAnns := wire.NewMsgGetPcAnns()
+	for _, hash := range msg.AnnHashes {
+		if pool.want(hash, sp.ID()) {
+			getAnns.AddAnnHash(hash)
+		}
+	}
+	if len(getAnns.AnnHashes) > 0 {
+		sp.QueueMessage(getAnns, nil)
+	}
+}
+
+// OnGetPcAnns is invoked when a peer receives a getpcanns message and is used
+// to send the requested PacketCrypt announcements which are in the
+// announcement pool.  Announcements which are not in the pool anymore are
+// skipped.
+func (sp *serverPeer) OnGetPcAnns(_ *peer.Peer, msg *wire.MsgGetPcAnns) {
+	pool := sp.server.pcAnnPool
+	if pool == nil {
+		peerLog.Debugf("Ignoring getpcanns from %v -- announcement relay "+
+			"is disabled", sp)
+		return
+	}
+
+	// A decaying ban score increase is applied to prevent exhausting
+	// resources with unusually large requests, as is done for getdata.
+	numAnns := len(msg.AnnHashes)
+	if sp.addBanScore(0, uint32(numAnns)*99/wire.MaxPcAnnsPerMsg,
+		wire.CmdGetPcAnns) {
+
+		return
+	}
+
+	for _, hash := range msg.AnnHashes {
+		if ann := pool.get(hash); ann != nil {
+			sp.QueueMessage(wire.NewMsgPcAnn(ann), nil)
+		}
+	}
+}
+
+// OnPcAnn is invoked when a peer receives a pcann message.  The announcement is
+// added to the announcement pool and relayed to the other peers when it is
+// new.  Peers sending unrequested or invalid announcements have their ban
+// score increased.
+func (sp *serverPeer) OnPcAnn(_ *peer.Peer, msg *wire.MsgPcAnn) {
+	pool := sp.server.pcAnnPool
+	if pool == nil {
+		peerLog.Debugf("Ignoring pcann from %v -- announcement relay "+
+			"is disabled", sp)
+		return
+	}
+
+	isNew, err := pool.add(&msg.Ann, sp.ID())
+	if err != nil {
+		if errUnrequestedPcAnn.Is(err) {
+			sp.addBanScore(0, 20, wire.CmdPcAnn)
+		} else if errInvalidPcAnn.Is(err) {
+			sp.addBanScore(0, 10, wire.CmdPcAnn)
+		}
+		peerLog.Debugf("Rejected announcement from %v: %v", sp, err)
+		return
+	}
+	if isNew {
+		hash := msg.Ann.Hash()
+		sp.server.RelayPcAnns([]*chainhash.Hash{&hash}, sp)
+	}
+}
+
 // OnFilterAdd is invoked when a peer receives a filteradd bitcoin
 // message and is used by remote peers to add data to an already loaded bloom
 // filter.  The peer will be disconnected if a filter is not loaded when this
@@ -1226,6 +1429,12 @@ func (sp *serverPeer) OnAddr(_ *peer.Peer, msg *wire.MsgAddr) {
 		return
 	}
 
+	// Ignore addresses from block relay only peers, they are not relayed
+	// addresses to keep them hidden.
+	if sp.connClass() != connmgr.ConnFullRelay {
+		return
+	}
+
 	// Ignore old style addresses which don't include a timestamp.
 	if sp.ProtocolVersion() < protocol.NetAddressTimeVersion {
 		return
@@ -1296,14 +1505,16 @@ func (sp *serverPeer) OnNotFound(p *peer.Peer, msg *wire.MsgNotFound) {
 	}
 	if numBlocks > 0 {
 		blockStr := pickNoun(uint64(numBlocks), "block", "blocks")
-		reason := fmt.Sprintf("%d %v not found", numBlocks, blockStr)
+		reason := fmt.Sprintf("%s: %d %v not found", wire.CmdNotFound,
+			numBlocks, blockStr)
 		if sp.addBanScore(10*numBlocks, 0, reason) {
 			return
 		}
 	}
 	if numTxns > 0 {
 		txStr := pickNoun(uint64(numTxns), "transaction", "transactions")
-		reason := fmt.Sprintf("%d %v not found", numBlocks, txStr)
+		reason := fmt.Sprintf("%s: %d %v not found", wire.CmdNotFound,
+			numTxns, txStr)
 		if sp.addBanScore(0, 10*numTxns, reason) {
 			return
 		}
@@ -1364,6 +1575,44 @@ func (s *server) relayTransactions(txns []*mempool.TxDesc) {
 	}
 }
 
+// relayLocalTransactions relays the passed transactions, submitted through the
+// RPC server, to all connected peers after a random delay, so that peers can't
+// tell they originate from this node by it being the first to announce them.
+func (s *server) relayLocalTransactions(txns []*mempool.TxDesc) {
+	s.unrelayedLocalTxsMtx.Lock()
+	for _, txD := range txns {
+		s.unrelayedLocalTxs[*txD.Tx.Hash()] = struct{}{}
+	}
+	s.unrelayedLocalTxsMtx.Unlock()
+
+	delay := time.Duration(mathrand.Int63n(int64(maxLocalTxRelayDelay)))
+	time.AfterFunc(delay, func() {
+		s.unrelayedLocalTxsMtx.Lock()
+		for _, txD := range txns {
+			delete(s.unrelayedLocalTxs, *txD.Tx.Hash())
+		}
+		s.unrelayedLocalTxsMtx.Unlock()
+
+		for _, txD := range txns {
+			iv := wire.NewInvVect(wire.InvTypeTx, txD.Tx.Hash())
+			select {
+			case s.relayInv <- relayMsg{invVect: iv, data: txD}:
+			case <-s.quit:
+				return
+			}
+		}
+	})
+}
+
+// isUnrelayedLocalTx returns whether the transaction with the passed hash was
+// submitted through the RPC server and is still waiting to be announced.
+func (s *server) isUnrelayedLocalTx(hash *chainhash.Hash) bool {
+	s.unrelayedLocalTxsMtx.Lock()
+	defer s.unrelayedLocalTxsMtx.Unlock()
+	_, ok := s.unrelayedLocalTxs[*hash]
+	return ok
+}
+
 // AnnounceNewTransactions generates and relays inventory vectors and notifies
 // both websocket and getblocktemplate long poll clients of the passed
 // transactions.  This function should be called whenever new transactions
@@ -1393,13 +1642,20 @@ func (s *server) TransactionConfirmed(tx *btcutil.Tx) {
 }
 
 // pushTxMsg sends a tx message for the provided transaction hash to the
-// connected peer.  An error is returned if the transaction hash is not known.
+// connected peer.  An error is returned if the transaction hash is not known,
+// or if it is a locally submitted transaction which has not been announced
+// yet.
 func (s *server) pushTxMsg(sp *serverPeer, hash *chainhash.Hash, doneChan chan<- struct{},
 	waitChan <-chan struct{}, encoding wire.MessageEncoding) er.R {
 	// Attempt to fetch the requested transaction from the pool.  A
 	// call could be made to check for existence first, but simply trying
 	// to fetch a missing transaction results in the same behavior.
+	// Transactions submitted locally are not revealed before they have
+	// been announced.
 	tx, err := s.txMemPool.FetchTransaction(hash)
+	if err == nil && s.isUnrelayedLocalTx(hash) {
+		err = er.Errorf("transaction %v has not been announced yet", hash)
+	}
 	if err != nil {
 		peerLog.Tracef("Unable to fetch tx %v from transaction "+
 			"pool: %v", hash, err)
@@ -1600,16 +1856,13 @@ func (s *server) handleAddPeerMsg(state *peerState, sp *serverPeer) bool {
 		sp.Disconnect()
 		return false
 	}
-	if banEnd, ok := state.banned[host]; ok {
-		if time.Now().Before(banEnd) {
-			srvrLog.Debugf("Peer %s is banned for another %v - disconnecting",
-				host, time.Until(banEnd))
+	if ip := net.ParseIP(host); ip != nil {
+		if ban := state.banned.lookup(ip); ban != nil {
+			srvrLog.Debugf("Peer %s is banned (%s) for another %v - "+
+				"disconnecting", host, ban.ipNet, time.Until(ban.until))
 			sp.Disconnect()
 			return false
 		}
-
-		srvrLog.Infof("Ban for peer %s has expired", host)
-		delete(state.banned, host)
 	}
 
 	// TODO: Check for max peers from a single IP.
@@ -1649,10 +1902,12 @@ func (s *server) handleAddPeerMsg(state *peerState, sp *serverPeer) bool {
 	// specified peers and actively avoids advertising and connecting to
 	// discovered peers.
 	if !cfg.SimNet && !sp.Inbound() {
+		relayAddrs := sp.connClass() == connmgr.ConnFullRelay
+
 		// Advertise the local address when the server accepts incoming
 		// connections and it believes itself to be close to the best
 		// known tip.
-		if !cfg.DisableListen && s.syncManager.IsCurrent() {
+		if relayAddrs && !cfg.DisableListen && s.syncManager.IsCurrent() {
 			// Get address that best matches.
 			lna := s.addrManager.GetBestLocalAddress(sp.NA())
 			if addrmgr.IsRoutable(lna) {
@@ -1666,7 +1921,7 @@ func (s *server) handleAddPeerMsg(state *peerState, sp *serverPeer) bool {
 		// more and the peer has a protocol version new enough to
 		// include a timestamp with addresses.
 		hasTimestamp := sp.ProtocolVersion() >= protocol.NetAddressTimeVersion
-		if s.addrManager.NeedMoreAddresses() && hasTimestamp {
+		if relayAddrs && s.addrManager.NeedMoreAddresses() && hasTimestamp {
 			sp.QueueMessage(wire.NewMsgGetAddr(), nil)
 		}
 
@@ -1697,7 +1952,9 @@ func (s *server) handleDonePeerMsg(state *peerState, sp *serverPeer) {
 			s.connManager.Disconnect(sp.connReq.ID())
 		} else {
 			s.connManager.Remove(sp.connReq.ID())
-			go s.connManager.NewConnReq()
+			if sp.connClass() != connmgr.ConnFeeler {
+				go s.connManager.NewConnReq()
+			}
 		}
 	}
 	if _, ok := list[sp.ID()]; ok {
@@ -1712,16 +1969,42 @@ func (s *server) handleDonePeerMsg(state *peerState, sp *serverPeer) {
 
 // handleBanPeerMsg deals with banning peers.  It is invoked from the
 // peerHandler goroutine.
-func (s *server) handleBanPeerMsg(state *peerState, sp *serverPeer) {
+func (s *server) handleBanPeerMsg(state *peerState, msg banPeerMsg) {
+	sp := msg.sp
 	host, _, err := net.SplitHostPort(sp.Addr())
 	if err != nil {
 		srvrLog.Debugf("can't split ban peer %s %v", sp.Addr(), err)
 		return
 	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		srvrLog.Debugf("can't ban peer %s which has no IP address", host)
+		return
+	}
 	direction := directionString(sp.Inbound())
 	srvrLog.Infof("Banned peer %s (%s) for %v", host, direction,
 		cfg.BanDuration)
-	state.banned[host] = time.Now().Add(cfg.BanDuration)
+	reason := "ban score exceeded: " + msg.reason
+	errr := state.banned.ban(singleIPNet(ip), time.Now().Add(cfg.BanDuration),
+		reason)
+	if errr != nil {
+		srvrLog.Errorf("Unable to store the ban of peer %s: %v", host, errr)
+	}
+}
+
+// disconnectSubnet disconnects all peers within the provided subnet.  It is
+// invoked from the peerHandler goroutine.
+func (s *server) disconnectSubnet(state *peerState, ipNet *net.IPNet) {
+	state.forAllPeers(func(sp *serverPeer) {
+		host, _, err := net.SplitHostPort(sp.Addr())
+		if err != nil {
+			return
+		}
+		if ip := net.ParseIP(host); ip != nil && ipNet.Contains(ip) {
+			srvrLog.Infof("Disconnecting banned peer %s", sp)
+			sp.Disconnect()
+		}
+	})
 }
 
 func (s *server) sendInvMsgToPeer(sp *serverPeer, msg relayMsg) bool {
@@ -1808,6 +2091,14 @@ func (s *server) handleBroadcastMsg(state *peerState, bmsg *broadcastMsg) {
 			}
 		}
 
+		if sp.Services()&bmsg.services != bmsg.services {
+			return
+		}
+
+		if sp.ProtocolVersion() < bmsg.protocolVersion {
+			return
+		}
+
 		sp.QueueMessage(bmsg.message, nil)
 	})
 }
@@ -1845,6 +2136,33 @@ type removeNodeMsg struct {
 	reply chan er.R
 }
 
+// banReply is the reply to a ban or unban query, changed is false when the
+// subnet was already banned, or respectively not banned.
+type banReply struct {
+	changed bool
+	err     er.R
+}
+
+type banSubnetMsg struct {
+	ipNet  *net.IPNet
+	until  time.Time
+	reason string
+	reply  chan banReply
+}
+
+type unbanSubnetMsg struct {
+	ipNet *net.IPNet
+	reply chan banReply
+}
+
+type listBannedMsg struct {
+	reply chan []*banEntry
+}
+
+type clearBannedMsg struct {
+	reply chan er.R
+}
+
 // handleQuery is the central handler for all queries and commands from other
 // goroutines related to peer state.
 func (s *server) handleQuery(state *peerState, querymsg interface{}) {
@@ -1954,6 +2272,25 @@ func (s *server) handleQuery(state *peerState, querymsg interface{}) {
 		}
 
 		msg.reply <- er.New("peer not found")
+	case banSubnetMsg:
+		if state.banned.isBanned(msg.ipNet) {
+			msg.reply <- banReply{}
+			return
+		}
+		if err := state.banned.ban(msg.ipNet, msg.until, msg.reason); err != nil {
+			msg.reply <- banReply{err: err}
+			return
+		}
+		srvrLog.Infof("Banned %s until %v", msg.ipNet, msg.until)
+		s.disconnectSubnet(state, msg.ipNet)
+		msg.reply <- banReply{changed: true}
+	case unbanSubnetMsg:
+		changed, err := state.banned.unban(msg.ipNet)
+		msg.reply <- banReply{changed: changed, err: err}
+	case listBannedMsg:
+		msg.reply <- state.banned.entries()
+	case clearBannedMsg:
+		msg.reply <- state.banned.clear()
 	}
 }
 
@@ -1999,6 +2336,9 @@ func newPeerConfig(sp *serverPeer) *peer.Config {
 			OnGetCFHeaders: sp.OnGetCFHeaders,
 			OnGetCFCheckpt: sp.OnGetCFCheckpt,
 			OnFeeFilter:    sp.OnFeeFilter,
+			OnPcAnnInv:     sp.OnPcAnnInv,
+			OnGetPcAnns:    sp.OnGetPcAnns,
+			OnPcAnn:        sp.OnPcAnn,
 			OnFilterAdd:    sp.OnFilterAdd,
 			OnFilterClear:  sp.OnFilterClear,
 			OnFilterLoad:   sp.OnFilterLoad,
@@ -2015,9 +2355,11 @@ func newPeerConfig(sp *serverPeer) *peer.Config {
 		UserAgentComments: cfg.UserAgentComments,
 		ChainParams:       sp.server.chainParams,
 		Services:          sp.server.services,
-		DisableRelayTx:    cfg.BlocksOnly,
+		DisableRelayTx:    cfg.BlocksOnly || sp.connClass() != connmgr.ConnFullRelay,
 		ProtocolVersion:   peer.MaxProtocolVersion,
 		TrickleInterval:   cfg.TrickleInterval,
+
+		InboundTrickleInterval: cfg.InboundTrickle,
 	}
 }
 
@@ -2040,6 +2382,7 @@ func (s *server) inboundPeerConnected(conn net.Conn) {
 // manager of the attempt.
 func (s *server) outboundPeerConnected(c *connmgr.ConnReq, conn net.Conn) {
 	sp := newServerPeer(s, c.Permanent)
+	sp.connReq = c
 	p, err := peer.NewOutboundPeer(newPeerConfig(sp), c.Addr.String())
 	if err != nil {
 		srvrLog.Debugf("Cannot create outbound peer %s: %v", c.Addr, err)
@@ -2047,12 +2390,13 @@ func (s *server) outboundPeerConnected(c *connmgr.ConnReq, conn net.Conn) {
 			s.connManager.Disconnect(c.ID())
 		} else {
 			s.connManager.Remove(c.ID())
-			go s.connManager.NewConnReq()
+			if c.Class != connmgr.ConnFeeler {
+				go s.connManager.NewConnReq()
+			}
 		}
 		return
 	}
 	sp.Peer = p
-	sp.connReq = c
 	sp.isWhitelisted = isWhitelisted(conn.RemoteAddr())
 	sp.AssociateConnection(conn)
 	go s.peerDoneHandler(sp)
@@ -2063,7 +2407,7 @@ func (s *server) outboundPeerConnected(c *connmgr.ConnReq, conn net.Conn) {
 func (s *server) peerDoneHandler(sp *serverPeer) {
 	sp.WaitForDisconnect()
 	s.donePeers <- sp
-	if sp.VerAckReceived() {
+	if sp.VerAckReceived() && sp.connClass() != connmgr.ConnFeeler {
 		s.syncManager.DonePeer(sp.Peer)
 		// Evict any remaining orphans that were sent by the peer.
 		numEvicted := s.txMemPool.RemoveOrphansByTag(mempool.Tag(sp.ID()))
@@ -2082,6 +2426,9 @@ func (s *server) sendRandomInv(state *peerState) {
 		return
 	}
 	winningTx := descs[mathrand.Intn(len(descs))]
+	if s.isUnrelayedLocalTx(winningTx.Tx.Hash()) {
+		return
+	}
 	candidates := make([]*serverPeer, 0, state.Count())
 	state.forAllPeers(func(sp *serverPeer) {
 		if !sp.Connected() {
@@ -2127,7 +2474,7 @@ func (s *server) peerHandler() {
 		inboundPeers:    make(map[int32]*serverPeer),
 		persistentPeers: make(map[int32]*serverPeer),
 		outboundPeers:   make(map[int32]*serverPeer),
-		banned:          make(map[string]time.Time),
+		banned:          s.banList,
 		outboundGroups:  make(map[string]int),
 	}
 
@@ -2161,8 +2508,8 @@ out:
 			s.handleUpdatePeerHeights(state, umsg)
 
 		// Peer to ban.
-		case p := <-s.banPeers:
-			s.handleBanPeerMsg(state, p)
+		case msg := <-s.banPeers:
+			s.handleBanPeerMsg(state, msg)
 
 		// New inventory to potentially be relayed to other peers.
 		case invMsg := <-s.relayInv:
@@ -2177,6 +2524,10 @@ out:
 			s.handleQuery(state, qmsg)
 
 		case <-s.quit:
+			// Save the block relay only peers to reconnect to them
+			// after a restart.
+			s.saveAnchors(state)
+
 			// Disconnect all peers on server shutdown.
 			state.forAllPeers(func(sp *serverPeer) {
 				srvrLog.Tracef("Shutdown peer %s", sp)
@@ -2218,8 +2569,9 @@ func (s *server) AddPeer(sp *serverPeer) {
 }
 
 // BanPeer bans a peer that has already been connected to the server by ip.
-func (s *server) BanPeer(sp *serverPeer) {
-	s.banPeers <- sp
+// The reason is recorded along with the ban.
+func (s *server) BanPeer(sp *serverPeer, reason string) {
+	s.banPeers <- banPeerMsg{sp: sp, reason: reason}
 }
 
 // RelayInventory relays the passed inventory vector to all connected peers
@@ -2235,6 +2587,28 @@ func (s *server) BroadcastMessage(msg wire.Message, exclPeers ...*serverPeer) {
 	s.broadcast <- bmsg
 }
 
+// RelayPcAnns announces the PacketCrypt announcements with the passed hashes
+// to all connected peers which relay announcements, except the peer they were
+// received from.
+func (s *server) RelayPcAnns(hashes []*chainhash.Hash, source *serverPeer) {
+	for len(hashes) > 0 {
+		n := len(hashes)
+		if n > wire.MaxPcAnnsPerMsg {
+			n = wire.MaxPcAnnsPerMsg
+		}
+		inv := wire.NewMsgPcAnnInv()
+		for _, hash := range hashes[:n] {
+			inv.AddAnnHash(hash)
+		}
+		hashes = hashes[n:]
+		bmsg := broadcastMsg{message: inv, services: protocol.SFNodePcAnn}
+		if source != nil {
+			bmsg.excludePeers = []*serverPeer{source}
+		}
+		s.broadcast <- bmsg
+	}
+}
+
 // ConnectedCount returns the number of currently connected peers.
 func (s *server) ConnectedCount() int32 {
 	replyChan := make(chan int32)
@@ -2339,6 +2713,46 @@ cleanup:
 	s.wg.Done()
 }
 
+// feeFilterHandler advertises the minimum fee of the mempool to peers with
+// feefilter messages as it rises when transactions are evicted from the full
+// mempool and decays afterwards, so that peers don't announce transactions
+// which would be rejected.  It must be run as a goroutine.
+func (s *server) feeFilterHandler() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(feeFilterCheckInterval)
+	defer ticker.Stop()
+
+	var advertised btcutil.Amount
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.quit:
+			return
+		}
+
+		// Only advertise changes of more than a quarter of the fee
+		// advertised before, the fee decays slowly.
+		minFee := s.txMemPool.MinFee()
+		change := minFee - advertised
+		if change < 0 {
+			change = -change
+		}
+		if change == 0 || (minFee != 0 && change*4 < advertised) {
+			continue
+		}
+		advertised = minFee
+		select {
+		case s.broadcast <- broadcastMsg{
+			message:         wire.NewMsgFeeFilter(int64(minFee)),
+			protocolVersion: protocol.FeeFilterVersion,
+		}:
+		case <-s.quit:
+			return
+		}
+	}
+}
+
 // Start begins accepting connections from peers.
 func (s *server) Start() {
 	// Already started?
@@ -2368,6 +2782,17 @@ func (s *server) Start() {
 		s.rpcServer.Start()
 	}
 
+	s.wg.Add(1)
+	go s.feeFilterHandler()
+
+	// Load the saved mempool unless persistence is disabled.
+	if cfg.NoPersistMempool {
+		atomic.StoreInt32(&s.mempoolLoaded, 1)
+	} else {
+		s.wg.Add(1)
+		go s.loadMempool()
+	}
+
 	// Start the CPU miner if generation is enabled.
 	if cfg.Generate {
 		s.cpuMiner.Start()
@@ -2393,6 +2818,13 @@ func (s *server) Stop() er.R {
 		s.rpcServer.Stop()
 	}
 
+	// Save the mempool unless persistence is disabled.
+	if !cfg.NoPersistMempool {
+		if err := s.saveMempool(); err != nil {
+			srvrLog.Errorf("Unable to save mempool: %v", err)
+		}
+	}
+
 	// Save fee estimator state in the database.
 	s.db.Update(func(tx database.Tx) er.R {
 		metadata := tx.Metadata()
@@ -2578,6 +3010,9 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 	if cfg.NoCFilters {
 		services &^= protocol.SFNodeCF
 	}
+	if cfg.PcAnnRelay {
+		services |= protocol.SFNodePcAnn
+	}
 
 	amgr := addrmgr.New(cfg.DataDir, pktdLookup)
 
@@ -2607,7 +3042,7 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		addrManager:          amgr,
 		newPeers:             make(chan *serverPeer, cfg.MaxPeers),
 		donePeers:            make(chan *serverPeer, cfg.MaxPeers),
-		banPeers:             make(chan *serverPeer, cfg.MaxPeers),
+		banPeers:             make(chan banPeerMsg, cfg.MaxPeers),
 		query:                make(chan interface{}),
 		relayInv:             make(chan relayMsg, cfg.MaxPeers),
 		broadcast:            make(chan broadcastMsg, cfg.MaxPeers),
@@ -2620,10 +3055,22 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		services:             services,
 		sigCache:             txscript.NewSigCache(cfg.SigCacheMaxSize),
 		hashCache:            txscript.NewHashCache(cfg.SigCacheMaxSize),
+		annCache:             announce.NewCache(cfg.AnnCacheMaxSize),
 		cfCheckptCaches:      make(map[wire.FilterType][]cfHeaderKV),
 		agentBlacklist:       agentBlacklist,
 		agentWhitelist:       agentWhitelist,
+		unrelayedLocalTxs:    make(map[chainhash.Hash]struct{}),
+	}
+
+	// Load the persisted bans.
+	banList, err := loadBanList(db)
+	if err != nil {
+		return nil, err
 	}
+	s.banList = banList
+
+	// Load the block relay only peers of the previous run.
+	s.anchors = loadAnchors()
 
 	// Create the transaction and address indexes if needed.
 	//
@@ -2651,6 +3098,11 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 		s.addrIndex = indexers.NewAddrIndex(db, chainParams)
 		indexes = append(indexes, s.addrIndex)
 	}
+	if cfg.SpentIndex {
+		indxLog.Info("Spent output index is enabled")
+		s.spentIndex = indexers.NewSpentIndex(db)
+		indexes = append(indexes, s.spentIndex)
+	}
 	if !cfg.NoCFilters {
 		indxLog.Info("Committed filter index is enabled")
 		s.cfIndex = indexers.NewCfIndex(db, chainParams)
@@ -2670,21 +3122,27 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 	}
 
 	// Create a new block chain instance with the appropriate configuration.
-	var err er.R
 	s.chain, err = blockchain.New(&blockchain.Config{
-		DB:           s.db,
-		Interrupt:    interrupt,
-		ChainParams:  s.chainParams,
-		Checkpoints:  checkpoints,
-		TimeSource:   s.timeSource,
-		SigCache:     s.sigCache,
-		IndexManager: indexManager,
-		HashCache:    s.hashCache,
+		DB:             s.db,
+		Interrupt:      interrupt,
+		ChainParams:    s.chainParams,
+		Checkpoints:    checkpoints,
+		TimeSource:     s.timeSource,
+		SigCache:       s.sigCache,
+		IndexManager:   indexManager,
+		HashCache:      s.hashCache,
+		PcProofWorkers: cfg.PcProofWorkers,
+		AnnCache:       s.annCache,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// Create the announcement pool when relaying announcements.
+	if cfg.PcAnnRelay {
+		s.pcAnnPool = newPcAnnPool(s.chain, s.annCache, cfg.MaxPcAnns)
+	}
+
 	// Search for a FeeEstimator state in the database. If none can be found
 	// or if it cannot be loaded, create a new one.
 	db.Update(func(tx database.Tx) er.R {
@@ -2722,6 +3180,7 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 			FreeTxRelayLimit:     cfg.FreeTxRelayLimit,
 			MaxOrphanTxs:         cfg.MaxOrphanTxs,
 			MaxOrphanTxSize:      defaultMaxOrphanTxSize,
+			MaxPoolSize:          cfg.MaxMempool * 1000000,
 			MaxSigOpCostPerTx:    blockchain.MaxBlockSigOpsCost / 4,
 			MinRelayTxFee:        cfg.minRelayTxFee,
 			MaxTxVersion:         2,
@@ -2797,11 +3256,26 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 	// specified peers and actively avoid advertising and connecting to
 	// discovered peers in order to prevent it from becoming a public test
 	// network.
-	var newAddressFunc func() (net.Addr, er.R)
+	var newAddressFunc func(connmgr.ConnClass) (net.Addr, er.R)
 	if !cfg.SimNet && !cfg.RegressionTest && len(cfg.ConnectPeers) == 0 {
-		newAddressFunc = func() (net.Addr, er.R) {
+		newAddressFunc = func(class connmgr.ConnClass) (net.Addr, er.R) {
+			// Reconnect to the block relay only peers of the
+			// previous run first.
+			if class == connmgr.ConnBlockRelayOnly {
+				if anchor := s.popAnchor(); anchor != "" {
+					return addrStringToNetAddr(anchor)
+				}
+			}
+
+			// Feeler connections test addresses which were never
+			// connected to.
+			getAddress := s.addrManager.GetAddress
+			if class == connmgr.ConnFeeler {
+				getAddress = s.addrManager.GetNewTableAddress
+			}
+
 			for tries := 0; tries < 100; tries++ {
-				addr := s.addrManager.GetAddress()
+				addr := getAddress()
 				if addr == nil {
 					break
 				}
@@ -2846,14 +3320,20 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 	if cfg.MaxPeers < targetOutbound {
 		targetOutbound = cfg.MaxPeers
 	}
+	targetBlockRelayOnly := defaultTargetBlockRelayOnly
+	if cfg.MaxPeers-targetOutbound < targetBlockRelayOnly {
+		targetBlockRelayOnly = cfg.MaxPeers - targetOutbound
+	}
 	cmgr, err := connmgr.New(&connmgr.Config{
-		Listeners:      listeners,
-		OnAccept:       s.inboundPeerConnected,
-		RetryDuration:  connectionRetryInterval,
-		TargetOutbound: uint32(targetOutbound),
-		Dial:           pktdDial,
-		OnConnection:   s.outboundPeerConnected,
-		GetNewAddress:  newAddressFunc,
+		Listeners:            listeners,
+		OnAccept:             s.inboundPeerConnected,
+		RetryDuration:        connectionRetryInterval,
+		TargetOutbound:       uint32(targetOutbound),
+		TargetBlockRelayOnly: uint32(targetBlockRelayOnly),
+		FeelerInterval:       feelerInterval,
+		Dial:                 pktdDial,
+		OnConnection:         s.outboundPeerConnected,
+		GetNewAddress:        newAddressFunc,
 	})
 	if err != nil {
 		return nil, err
@@ -2902,8 +3382,12 @@ func newServer(listenAddrs, agentBlacklist, agentWhitelist []string,
 			CPUMiner:     s.cpuMiner,
 			TxIndexOrNil: s.txIndex,
 			AddrIndex:    s.addrIndex,
+			SpentIndex:   s.spentIndex,
 			CfIndex:      s.cfIndex,
 			FeeEstimator: s.feeEstimator,
+			AnnCache:     s.annCache,
+			PcAnnPool:    s.pcAnnPool,
+			SaveMempool:  s.saveMempool,
 			ServiceFlags: services,
 		})
 		if err != nil {
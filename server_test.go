@@ -0,0 +1,175 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkt-cash/pktd/blockchain"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/mempool"
+	"github.com/pkt-cash/pktd/peer"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/protocol"
+)
+
+// newTestTxPool returns a mempool holding a single transaction, which is also
+// returned.
+func newTestTxPool(t *testing.T, params *chaincfg.Params) (*mempool.TxPool, *btcutil.Tx) {
+	prevTx := wire.NewMsgTx(1)
+	prevTx.AddTxIn(&wire.TxIn{})
+	prevTx.AddTxOut(wire.NewTxOut(100000000, []byte{opcode.OP_TRUE}))
+	utxos := blockchain.NewUtxoViewpoint()
+	utxos.AddTxOuts(btcutil.NewTx(prevTx), 1)
+
+	prevHash := prevTx.TxHash()
+	msgTx := wire.NewMsgTx(1)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0), nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(99990000, []byte{opcode.OP_TRUE}))
+	tx := btcutil.NewTx(msgTx)
+
+	pool := mempool.New(&mempool.Config{
+		Policy: mempool.Policy{
+			MaxTxVersion:         1,
+			DisableRelayPriority: true,
+			AcceptNonStd:         true,
+			MaxSigOpCostPerTx:    blockchain.MaxBlockSigOpsCost / 4,
+		},
+		ChainParams: params,
+		FetchUtxoView: func(*btcutil.Tx) (*blockchain.UtxoViewpoint, er.R) {
+			view := blockchain.NewUtxoViewpoint()
+			for outpoint, entry := range utxos.Entries() {
+				view.Entries()[outpoint] = entry.Clone()
+			}
+			return view, nil
+		},
+		BestHeight:     func() int32 { return 100 },
+		MedianTimePast: time.Now,
+		CalcSequenceLock: func(*btcutil.Tx, *blockchain.UtxoViewpoint) (*blockchain.SequenceLock, er.R) {
+			return &blockchain.SequenceLock{Seconds: -1, BlockHeight: -1}, nil
+		},
+	})
+	if _, _, err := pool.MaybeAcceptTransaction(tx, true, false); err != nil {
+		t.Fatalf("MaybeAcceptTransaction: %v", err)
+	}
+	return pool, tx
+}
+
+// connectTestServerPeer connects the server peer to a remote end played by the
+// test, the messages received by the remote end are sent to the returned
+// channel.
+func connectTestServerPeer(t *testing.T, sp *serverPeer, params *chaincfg.Params) <-chan wire.Message {
+	local, remote := net.Pipe()
+	msgs := make(chan wire.Message, 10)
+	verack := make(chan struct{})
+	go func() {
+		pver := protocol.ProtocolVersion
+		for {
+			msg, _, err := wire.ReadMessage(remote, pver, params.Net)
+			if err != nil {
+				return
+			}
+			switch msg := msg.(type) {
+			case *wire.MsgVersion:
+				me := wire.NewNetAddressIPPort(net.ParseIP("10.0.1.1"),
+					8333, 0)
+				version := wire.NewMsgVersion(me, &msg.AddrMe, 1, 0)
+				if wire.WriteMessage(remote, version, pver, params.Net) != nil ||
+					wire.WriteMessage(remote, wire.NewMsgVerAck(),
+						pver, params.Net) != nil {
+
+					return
+				}
+			case *wire.MsgVerAck:
+				close(verack)
+			default:
+				msgs <- msg
+			}
+		}
+	}()
+
+	var err er.R
+	sp.Peer, err = peer.NewOutboundPeer(&peer.Config{
+		ChainParams: params,
+		Services:    sp.server.services,
+	}, "10.0.0.1:8333")
+	if err != nil {
+		t.Fatalf("NewOutboundPeer: %v", err)
+	}
+	sp.Peer.AssociateConnection(local)
+	select {
+	case <-verack:
+	case <-time.After(time.Second):
+		t.Fatalf("verack timeout")
+	}
+	return msgs
+}
+
+// nextTestMessage returns the next message of the provided command received
+// by the remote end of a test peer, or nil if none is received in time.
+func nextTestMessage(msgs <-chan wire.Message, command string) wire.Message {
+	timeout := time.After(100 * time.Millisecond)
+	for {
+		select {
+		case msg := <-msgs:
+			if msg.Command() == command {
+				return msg
+			}
+		case <-timeout:
+			return nil
+		}
+	}
+}
+
+// TestUnrelayedLocalTx ensures that locally submitted transactions are not
+// revealed to peers, neither in mempool replies nor when asked for by hash,
+// before they have been announced.
+func TestUnrelayedLocalTx(t *testing.T) {
+	defer func(saved *config) { cfg = saved }(cfg)
+	cfg = &config{DisableBanning: true}
+	params := chaincfg.RegressionNetParams
+	pool, tx := newTestTxPool(t, &params)
+	s := &server{
+		txMemPool:         pool,
+		services:          protocol.SFNodeBloom,
+		unrelayedLocalTxs: map[chainhash.Hash]struct{}{*tx.Hash(): {}},
+	}
+	sp := newServerPeer(s, false)
+	msgs := connectTestServerPeer(t, sp, &params)
+	defer sp.Disconnect()
+
+	getData := wire.NewMsgGetData()
+	getData.AddInvVect(wire.NewInvVect(wire.InvTypeTx, tx.Hash()))
+
+	sp.OnMemPool(sp.Peer, wire.NewMsgMemPool())
+	if msg := nextTestMessage(msgs, wire.CmdInv); msg != nil {
+		t.Fatalf("mempool reply reveals the unannounced transaction")
+	}
+	sp.OnGetData(sp.Peer, getData)
+	if msg := nextTestMessage(msgs, wire.CmdNotFound); msg == nil {
+		t.Fatalf("unannounced transaction was not reported as not found")
+	}
+
+	// Once announced, the transaction is served.
+	delete(s.unrelayedLocalTxs, *tx.Hash())
+	sp.OnMemPool(sp.Peer, wire.NewMsgMemPool())
+	msg := nextTestMessage(msgs, wire.CmdInv)
+	if msg == nil || len(msg.(*wire.MsgInv).InvList) != 1 ||
+		msg.(*wire.MsgInv).InvList[0].Hash != *tx.Hash() {
+
+		t.Fatalf("mempool reply does not hold the announced transaction")
+	}
+	sp.OnGetData(sp.Peer, getData)
+	msg = nextTestMessage(msgs, wire.CmdTx)
+	if msg == nil || msg.(*wire.MsgTx).TxHash() != *tx.Hash() {
+		t.Fatalf("announced transaction was not served")
+	}
+}
@@ -100,6 +100,24 @@ const (
 	// operation whose public key isn't serialized in a compressed format
 	// non-standard.
 	ScriptVerifyWitnessPubKeyType
+
+	// ScriptVerifyTaproot defines whether or not to verify spends of
+	// witness version 1 programs according to taproot and tapscript.
+	// This is BIP0341 and BIP0342.
+	ScriptVerifyTaproot
+
+	// ScriptVerifyDiscourageUpgradableTaprootVersion makes a taproot
+	// script path spend with an unknown leaf version non-standard.
+	ScriptVerifyDiscourageUpgradableTaprootVersion
+
+	// ScriptVerifyDiscourageOpSuccess makes a tapscript which contains an
+	// OP_SUCCESSx opcode non-standard.
+	ScriptVerifyDiscourageOpSuccess
+
+	// ScriptVerifyDiscourageUpgradablePubKeyType makes a signature check
+	// in a tapscript against a public key which is not 32 bytes long
+	// non-standard.
+	ScriptVerifyDiscourageUpgradablePubKeyType
 )
 
 // halforder is used to tame ECDSA malleability (see BIP0062).
@@ -125,6 +143,7 @@ type Engine struct {
 	witnessVersion  int
 	witnessProgram  []byte
 	inputAmount     int64
+	taproot         *taprootExecCtx // set while executing a tapscript
 }
 
 // hasFlag returns whether the script engine instance has the passed flag set.
@@ -162,7 +181,8 @@ func (vm *Engine) executeOpcode(pop *parsescript.ParsedOpcode) er.R {
 	}
 
 	// Note that this includes OP_RESERVED which counts as a push operation.
-	if pop.Opcode.Value > opcode.OP_16 {
+	// Tapscript has no limit on the number of operations.
+	if pop.Opcode.Value > opcode.OP_16 && vm.taproot == nil {
 		vm.numOps++
 		if vm.numOps > params.MaxOpsPerScript {
 			str := fmt.Sprintf("exceeded max operation limit of %d",
@@ -240,7 +260,14 @@ func (vm *Engine) isWitnessVersionActive(version uint) bool {
 // verifyWitnessProgram validates the stored witness program using the passed
 // witness as input.
 func (vm *Engine) verifyWitnessProgram(witness [][]byte) er.R {
-	if vm.isWitnessVersionActive(0) {
+	if vm.isWitnessVersionActive(1) && vm.hasFlag(ScriptVerifyTaproot) &&
+		len(vm.witnessProgram) == params.PayToTaprootDataSize && !vm.bip16 {
+
+		// Taproot is only defined for native 32 byte version 1
+		// programs, other version 1 programs are treated as unknown
+		// versions below.
+		return vm.verifyTaprootProgram(witness)
+	} else if vm.isWitnessVersionActive(0) {
 		switch len(vm.witnessProgram) {
 		case params.PayToWitnessPubKeyHashDataSize: // P2WKH
 			// The witness stack should consist of exactly two
@@ -384,10 +411,12 @@ func (vm *Engine) CheckErrorCondition(finalScript bool) er.R {
 			"error check when script unfinished")
 	}
 
-	// If we're in version zero witness execution mode, and this was the
-	// final script, then the stack MUST be clean in order to maintain
-	// compatibility with BIP16.
-	if finalScript && vm.isWitnessVersionActive(0) && vm.dstack.Depth() != 1 {
+	// If we're in version zero or taproot witness execution mode, and this
+	// was the final script, then the stack MUST be clean in order to
+	// maintain compatibility with BIP16.
+	if finalScript && (vm.isWitnessVersionActive(0) || vm.isWitnessVersionActive(1)) &&
+		vm.dstack.Depth() != 1 {
+
 		return txscripterr.ScriptError(txscripterr.ErrEvalFalse, "witness program must "+
 			"have clean stack")
 	}
@@ -824,6 +853,20 @@ func (vm *Engine) SetStack(data [][]byte) {
 	setStack(&vm.dstack, data)
 }
 
+// GetAltStack returns the contents of the alternate stack as an array where the
+// last item in the array is the top of the stack.
+func (vm *Engine) GetAltStack() [][]byte {
+	return getStack(&vm.astack)
+}
+
+// CondStack returns a copy of the conditional execution stack, each entry is
+// one of OpCondTrue, OpCondFalse or OpCondSkip and the last entry is the
+// innermost branch.  Opcodes are executed only if the stack is empty or the
+// last entry is OpCondTrue.
+func (vm *Engine) CondStack() []int {
+	return append([]int(nil), vm.condStack...)
+}
+
 // NewEngine returns a new script engine for the provided public key script,
 // transaction, and input index.  The flags modify the behavior of the script
 // engine according to the description provided by each flag.
@@ -143,6 +143,73 @@ func TestCheckErrorCondition(t *testing.T) {
 	}
 }
 
+// TestStepState ensures that the alt stack and the conditional execution
+// stack can be observed while stepping through a script.
+func TestStepState(t *testing.T) {
+	tx := &wire.MsgTx{
+		Version: 1,
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: 0},
+			Sequence:         4294967295,
+		}},
+		TxOut: []*wire.TxOut{{Value: 1000000000}},
+	}
+	pkScript := mustParseShortForm("1 IF 0 IF 2 ENDIF 3 TOALTSTACK ENDIF TRUE")
+
+	vm, err := NewEngine(pkScript, tx, 0, 0, nil, nil, 0)
+	if err != nil {
+		t.Fatalf("failed to create script: %v", err)
+	}
+
+	// The state after each step.
+	tests := []struct {
+		cond     []int
+		altDepth int
+	}{
+		{nil, 0},                            // 1
+		{[]int{OpCondTrue}, 0},              // IF
+		{[]int{OpCondTrue}, 0},              // 0
+		{[]int{OpCondTrue, OpCondFalse}, 0}, // IF
+		{[]int{OpCondTrue, OpCondFalse}, 0}, // 2 (not executed)
+		{[]int{OpCondTrue}, 0},              // ENDIF
+		{[]int{OpCondTrue}, 0},              // 3
+		{[]int{OpCondTrue}, 1},              // TOALTSTACK
+		{nil, 1},                            // ENDIF
+	}
+	for i, test := range tests {
+		if _, err := vm.Step(); err != nil {
+			t.Fatalf("step %d failed: %v", i, err)
+		}
+		cond := vm.CondStack()
+		if len(cond) != len(test.cond) {
+			t.Fatalf("step %d: got cond stack %v, expected %v", i,
+				cond, test.cond)
+		}
+		for j := range cond {
+			if cond[j] != test.cond[j] {
+				t.Fatalf("step %d: got cond stack %v, expected %v", i,
+					cond, test.cond)
+			}
+		}
+		if alt := vm.GetAltStack(); len(alt) != test.altDepth {
+			t.Fatalf("step %d: got alt stack %v, expected depth %d", i,
+				alt, test.altDepth)
+		}
+	}
+	if stack := vm.GetStack(); len(stack) != 0 {
+		t.Fatalf("unexpected stack %v", stack)
+	}
+
+	// The alt stack does not persist once the script ends.
+	done, err := vm.Step()
+	if err != nil || !done {
+		t.Fatalf("final step failed: %v %v", done, err)
+	}
+	if alt := vm.GetAltStack(); len(alt) != 0 {
+		t.Fatalf("alt stack not cleared at end of script: %v", alt)
+	}
+}
+
 // TestInvalidFlagCombinations ensures the script engine returns the expected
 // error when disallowed flag combinations are specified.
 func TestInvalidFlagCombinations(t *testing.T) {
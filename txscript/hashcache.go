@@ -19,6 +19,27 @@ type TxSigHashes struct {
 	HashPrevOuts chainhash.Hash
 	HashSequence chainhash.Hash
 	HashOutputs  chainhash.Hash
+
+	// Taproot is the sighash midstate which is introduced within
+	// BIP0341, it is nil unless the outputs which are spent by the
+	// transaction are known.
+	Taproot *TaprootSigHashes
+}
+
+// TaprootSigHashes houses the partial set of sighashes introduced within
+// BIP0341.  Unlike the BIP0143 sighashes, these are single sha256 hashes and
+// they commit to the amounts and scripts of all of the outputs which are spent
+// by the transaction.
+type TaprootSigHashes struct {
+	HashPrevOuts      chainhash.Hash
+	HashAmounts       chainhash.Hash
+	HashScriptPubKeys chainhash.Hash
+	HashSequence      chainhash.Hash
+	HashOutputs       chainhash.Hash
+
+	// PrevOuts are the outputs which are spent by each input of the
+	// transaction, in order.
+	PrevOuts []*wire.TxOut
 }
 
 // NewTxSigHashes computes, and returns the cached sighashes of the given
@@ -31,6 +52,16 @@ func NewTxSigHashes(tx *wire.MsgTx) *TxSigHashes {
 	}
 }
 
+// NewTxSigHashesWithPrevOuts computes the cached sighashes of the given
+// transaction including the taproot sighashes, prevOuts must hold the output
+// which is spent by each input of the transaction.  If any of the outputs are
+// missing then the taproot sighashes are left out.
+func NewTxSigHashesWithPrevOuts(tx *wire.MsgTx, prevOuts []*wire.TxOut) *TxSigHashes {
+	sigHashes := NewTxSigHashes(tx)
+	sigHashes.Taproot = newTaprootSigHashes(tx, prevOuts)
+	return sigHashes
+}
+
 // HashCache houses a set of partial sighashes keyed by txid. The set of partial
 // sighashes are those introduced within BIP0143 by the new more efficient
 // sighash digest calculation algorithm. Using this threadsafe shared cache,
@@ -58,6 +89,30 @@ func (h *HashCache) AddSigHashes(tx *wire.MsgTx) {
 	h.Unlock()
 }
 
+// AddTaprootSigHashes computes, then adds the partial sighashes for the passed
+// transaction including the BIP0341 sighashes, prevOuts must hold the output
+// which is spent by each input of the transaction.  The BIP0143 sighashes are
+// reused if they were already added.  The entry is replaced rather than
+// updated because it may be in use by other goroutines.
+func (h *HashCache) AddTaprootSigHashes(tx *wire.MsgTx, prevOuts []*wire.TxOut) {
+	txid := tx.TxHash()
+	h.RLock()
+	cached, found := h.sigHashes[txid]
+	h.RUnlock()
+
+	sigHashes := new(TxSigHashes)
+	if found {
+		*sigHashes = *cached
+	} else {
+		sigHashes = NewTxSigHashes(tx)
+	}
+	sigHashes.Taproot = newTaprootSigHashes(tx, prevOuts)
+
+	h.Lock()
+	h.sigHashes[txid] = sigHashes
+	h.Unlock()
+}
+
 // ContainsHashes returns true if the partial sighashes for the passed
 // transaction currently exist within the HashCache, and false otherwise.
 func (h *HashCache) ContainsHashes(txid *chainhash.Hash) bool {
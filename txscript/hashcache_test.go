@@ -139,6 +139,52 @@ func TestHashCacheAddGet(t *testing.T) {
 	}
 }
 
+// TestHashCacheAddTaproot tests that the taproot sighashes are added to the
+// cached sighashes of a transaction without modifying the entry which may be
+// in use already.
+func TestHashCacheAddTaproot(t *testing.T) {
+	rand.Seed(time.Now().Unix())
+
+	cache := NewHashCache(10)
+
+	randTx, err := genTestTx()
+	if err != nil {
+		t.Fatalf("unable to generate tx: %v", err)
+	}
+	prevOuts := make([]*wire.TxOut, len(randTx.TxIn))
+	for i := range prevOuts {
+		prevOuts[i] = wire.NewTxOut(rand.Int63(), []byte{0x51})
+	}
+
+	cache.AddSigHashes(randTx)
+	txid := randTx.TxHash()
+	segwitHashes, _ := cache.GetSigHashes(&txid)
+
+	cache.AddTaprootSigHashes(randTx, prevOuts)
+	cacheHashes, ok := cache.GetSigHashes(&txid)
+	if !ok {
+		t.Fatalf("tx %v wasn't found in cache", txid)
+	}
+	if segwitHashes.Taproot != nil {
+		t.Fatalf("the previous entry was modified")
+	}
+	if cacheHashes.HashPrevOuts != segwitHashes.HashPrevOuts ||
+		cacheHashes.HashSequence != segwitHashes.HashSequence ||
+		cacheHashes.HashOutputs != segwitHashes.HashOutputs {
+
+		t.Fatalf("segwit sighashes don't match: expected %v, got %v",
+			spew.Sdump(segwitHashes), spew.Sdump(cacheHashes))
+	}
+	want := NewTxSigHashesWithPrevOuts(randTx, prevOuts)
+	if cacheHashes.Taproot == nil ||
+		cacheHashes.Taproot.HashAmounts != want.Taproot.HashAmounts ||
+		cacheHashes.Taproot.HashScriptPubKeys != want.Taproot.HashScriptPubKeys {
+
+		t.Fatalf("taproot sighashes don't match: expected %v, got %v",
+			spew.Sdump(want.Taproot), spew.Sdump(cacheHashes.Taproot))
+	}
+}
+
 // TestHashCachePurge tests that items are able to be properly removed from the
 // hash cache.
 func TestHashCachePurge(t *testing.T) {
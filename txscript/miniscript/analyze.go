@@ -0,0 +1,110 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package miniscript
+
+import (
+	"strconv"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/txscript/params"
+)
+
+const (
+	// maxStandardScriptSize is the largest P2WSH witness script which is
+	// relayed by bitcoin core, pktd uses the same limit for miniscript so
+	// that scripts remain portable.
+	maxStandardScriptSize = 3600
+
+	// maxStandardStackItems is the largest number of witness stack items,
+	// not counting the witness script, which is standard for P2WSH.
+	maxStandardStackItems = 100
+)
+
+// Analysis describes the properties of an expression which matter when
+// deciding whether it is safe to pay to.
+type Analysis struct {
+	// Type is the miniscript type of the expression.
+	Type Type
+
+	// NonMalleable is true if every satisfaction can be made without
+	// malleability.
+	NonMalleable bool
+
+	// NeedsSignature is true if every satisfaction needs a signature.
+	NeedsSignature bool
+
+	// TimelockMix is true if some spending path needs both a height
+	// and a time timelock of the same kind, which can never be satisfied.
+	TimelockMix bool
+
+	// ScriptSize is the size of the compiled witness script.
+	ScriptSize int
+
+	// OpCount is an upper bound on the number of non-push opcodes which
+	// are executed.
+	OpCount int
+
+	// MaxSatisfactionSize is the largest size of the satisfying witness
+	// stack elements, not including the witness script.
+	MaxSatisfactionSize int
+
+	// MaxStackItems is the largest number of satisfying witness stack
+	// elements, not including the witness script.
+	MaxStackItems int
+}
+
+// Analyze computes the properties of the expression.
+func (n *Node) Analyze() (*Analysis, er.R) {
+	script, err := n.Script()
+	if err != nil {
+		return nil, err
+	}
+	_, sat := n.costs()
+	return &Analysis{
+		Type:                n.typ,
+		NonMalleable:        n.typ.Has(TypeM),
+		NeedsSignature:      n.typ.Has(TypeS),
+		TimelockMix:         !n.typ.Has(TypeNoTimelockMix),
+		ScriptSize:          len(script),
+		OpCount:             n.opCount(),
+		MaxSatisfactionSize: sat.size,
+		MaxStackItems:       sat.elems,
+	}, nil
+}
+
+// Sane returns nil if the analysis shows a script which is safe to pay to,
+// otherwise it returns ErrInsane with the first reason why it is not.
+func (a *Analysis) Sane() er.R {
+	switch {
+	case !a.NonMalleable:
+		return ErrInsane.New("some satisfactions are malleable", nil)
+	case !a.NeedsSignature:
+		return ErrInsane.New("some satisfactions need no signature", nil)
+	case a.TimelockMix:
+		return ErrInsane.New("some spending paths mix height and time "+
+			"timelocks", nil)
+	case a.ScriptSize > maxStandardScriptSize:
+		return ErrInsane.New("script size "+strconv.Itoa(a.ScriptSize)+
+			" exceeds "+strconv.Itoa(maxStandardScriptSize), nil)
+	case a.OpCount > params.MaxOpsPerScript:
+		return ErrInsane.New("script may execute "+strconv.Itoa(a.OpCount)+
+			" opcodes, more than "+strconv.Itoa(params.MaxOpsPerScript), nil)
+	case a.MaxStackItems > maxStandardStackItems:
+		return ErrInsane.New("satisfaction may need "+
+			strconv.Itoa(a.MaxStackItems)+" stack items, more than "+
+			strconv.Itoa(maxStandardStackItems), nil)
+	}
+	return nil
+}
+
+// SanityCheck analyzes the expression and returns ErrInsane if it is not
+// safe to use as a script.
+func (n *Node) SanityCheck() er.R {
+	a, err := n.Analyze()
+	if err != nil {
+		return err
+	}
+	return a.Sane()
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package miniscript
+
+import (
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/txscript/scriptbuilder"
+)
+
+// instr is one instruction of a compiled script, either an opcode, a data
+// push or a number push.
+type instr struct {
+	op    byte
+	data  []byte
+	num   int64
+	isNum bool
+}
+
+func opInstr(op byte) instr       { return instr{op: op} }
+func dataInstr(data []byte) instr { return instr{data: data} }
+func numInstr(num int64) instr    { return instr{num: num, isNum: true} }
+
+// verifyForms maps the opcodes which have a VERIFY form to that form, a v:
+// wrapper replaces them instead of appending OP_VERIFY.
+var verifyForms = map[byte]byte{
+	opcode.OP_CHECKSIG:      opcode.OP_CHECKSIGVERIFY,
+	opcode.OP_CHECKMULTISIG: opcode.OP_CHECKMULTISIGVERIFY,
+	opcode.OP_EQUAL:         opcode.OP_EQUALVERIFY,
+	opcode.OP_NUMEQUAL:      opcode.OP_NUMEQUALVERIFY,
+}
+
+// hashOps are the opcodes which compute the digest of each hash fragment.
+var hashOps = map[Fragment]byte{
+	Sha256:    opcode.OP_SHA256,
+	Hash256:   opcode.OP_HASH256,
+	Ripemd160: opcode.OP_RIPEMD160,
+	Hash160:   opcode.OP_HASH160,
+}
+
+// instrs appends the instructions of the node to out.
+func (n *Node) instrs(out []instr) []instr {
+	switch n.Fragment {
+	case Just0:
+		return append(out, opInstr(opcode.OP_0))
+	case Just1:
+		return append(out, opInstr(opcode.OP_1))
+	case PkK:
+		return append(out, dataInstr(n.Keys[0]))
+	case PkH:
+		return append(out, opInstr(opcode.OP_DUP), opInstr(opcode.OP_HASH160),
+			dataInstr(btcutil.Hash160(n.Keys[0])),
+			opInstr(opcode.OP_EQUALVERIFY))
+	case Older:
+		return append(out, numInstr(int64(n.K)),
+			opInstr(opcode.OP_CHECKSEQUENCEVERIFY))
+	case After:
+		return append(out, numInstr(int64(n.K)),
+			opInstr(opcode.OP_CHECKLOCKTIMEVERIFY))
+	case Sha256, Hash256, Ripemd160, Hash160:
+		return append(out, opInstr(opcode.OP_SIZE), numInstr(32),
+			opInstr(opcode.OP_EQUALVERIFY), opInstr(hashOps[n.Fragment]),
+			dataInstr(n.Hash), opInstr(opcode.OP_EQUAL))
+	case AndOr:
+		out = n.Subs[0].instrs(out)
+		out = append(out, opInstr(opcode.OP_NOTIF))
+		out = n.Subs[2].instrs(out)
+		out = append(out, opInstr(opcode.OP_ELSE))
+		out = n.Subs[1].instrs(out)
+		return append(out, opInstr(opcode.OP_ENDIF))
+	case AndV:
+		return n.Subs[1].instrs(n.Subs[0].instrs(out))
+	case AndB:
+		out = n.Subs[1].instrs(n.Subs[0].instrs(out))
+		return append(out, opInstr(opcode.OP_BOOLAND))
+	case OrB:
+		out = n.Subs[1].instrs(n.Subs[0].instrs(out))
+		return append(out, opInstr(opcode.OP_BOOLOR))
+	case OrC:
+		out = append(n.Subs[0].instrs(out), opInstr(opcode.OP_NOTIF))
+		return append(n.Subs[1].instrs(out), opInstr(opcode.OP_ENDIF))
+	case OrD:
+		out = append(n.Subs[0].instrs(out), opInstr(opcode.OP_IFDUP),
+			opInstr(opcode.OP_NOTIF))
+		return append(n.Subs[1].instrs(out), opInstr(opcode.OP_ENDIF))
+	case OrI:
+		out = append(out, opInstr(opcode.OP_IF))
+		out = append(n.Subs[0].instrs(out), opInstr(opcode.OP_ELSE))
+		return append(n.Subs[1].instrs(out), opInstr(opcode.OP_ENDIF))
+	case Thresh:
+		for i, sub := range n.Subs {
+			out = sub.instrs(out)
+			if i > 0 {
+				out = append(out, opInstr(opcode.OP_ADD))
+			}
+		}
+		return append(out, numInstr(int64(n.K)), opInstr(opcode.OP_EQUAL))
+	case Multi:
+		out = append(out, numInstr(int64(n.K)))
+		for _, key := range n.Keys {
+			out = append(out, dataInstr(key))
+		}
+		return append(out, numInstr(int64(len(n.Keys))),
+			opInstr(opcode.OP_CHECKMULTISIG))
+	case WrapA:
+		out = append(out, opInstr(opcode.OP_TOALTSTACK))
+		return append(n.Subs[0].instrs(out), opInstr(opcode.OP_FROMALTSTACK))
+	case WrapS:
+		return n.Subs[0].instrs(append(out, opInstr(opcode.OP_SWAP)))
+	case WrapC:
+		return append(n.Subs[0].instrs(out), opInstr(opcode.OP_CHECKSIG))
+	case WrapD:
+		out = append(out, opInstr(opcode.OP_DUP), opInstr(opcode.OP_IF))
+		return append(n.Subs[0].instrs(out), opInstr(opcode.OP_ENDIF))
+	case WrapV:
+		start := len(out)
+		out = n.Subs[0].instrs(out)
+		if last := len(out) - 1; last >= start && out[last].data == nil &&
+			!out[last].isNum {
+			if op, ok := verifyForms[out[last].op]; ok {
+				out[last].op = op
+				return out
+			}
+		}
+		return append(out, opInstr(opcode.OP_VERIFY))
+	case WrapJ:
+		out = append(out, opInstr(opcode.OP_SIZE),
+			opInstr(opcode.OP_0NOTEQUAL), opInstr(opcode.OP_IF))
+		return append(n.Subs[0].instrs(out), opInstr(opcode.OP_ENDIF))
+	case WrapN:
+		return append(n.Subs[0].instrs(out), opInstr(opcode.OP_0NOTEQUAL))
+	}
+	return out
+}
+
+// Script compiles the expression to a witness script.
+func (n *Node) Script() ([]byte, er.R) {
+	b := scriptbuilder.NewScriptBuilder()
+	for _, in := range n.instrs(nil) {
+		switch {
+		case in.isNum:
+			b.AddInt64(in.num)
+		case in.data != nil:
+			b.AddData(in.data)
+		default:
+			b.AddOp(in.op)
+		}
+	}
+	return b.Script()
+}
+
+// opCount returns the number of non-push opcodes which may be executed by
+// the script, counting the keys of each OP_CHECKMULTISIG as consensus does.
+// Every opcode is counted, including those in branches which are not
+// taken, so this is an upper bound.
+func (n *Node) opCount() int {
+	count := 0
+	for _, in := range n.instrs(nil) {
+		if in.data != nil || in.isNum || in.op <= opcode.OP_16 {
+			continue
+		}
+		count++
+	}
+	var countKeys func(*Node)
+	countKeys = func(n *Node) {
+		if n.Fragment == Multi {
+			count += len(n.Keys)
+		}
+		for _, sub := range n.Subs {
+			countKeys(sub)
+		}
+	}
+	countKeys(n)
+	return count
+}
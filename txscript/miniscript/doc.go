@@ -0,0 +1,32 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package miniscript implements parsing, type checking, compilation and
+satisfaction of miniscript expressions for pay-to-witness-script-hash
+outputs.
+
+Miniscript is a structured subset of script which makes it possible to
+reason about the spending conditions of a script, for example:
+
+	or_d(multi(2,K1,K2,K3),and_v(v:pk(K4),older(25920)))
+
+is spendable with any 2 of the keys K1, K2 and K3, or with K4 alone once the
+output is 25920 blocks old.
+
+Every expression is type checked when it is parsed.  An expression which is
+not a valid top level expression (of type B) is rejected, but an expression
+may still be valid without being sane; Analyze reports whether the script
+can always be satisfied without malleability, whether every spending path
+needs a signature, whether heightlocks and timelocks are mixed and whether
+the script fits the standardness limits for P2WSH.  SanityCheck returns an
+error for the first of these which fails.
+
+Keys are hex encoded compressed public keys and hashes are hex encoded
+digests.  The pk, pkh, and_n and t:, l:, u: forms are accepted as shorthand
+and are produced again by String.
+
+More info: https://bitcoin.sipa.be/miniscript/
+*/
+package miniscript
@@ -0,0 +1,571 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package miniscript
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// Err is the error type for miniscript parsing, compilation and
+// satisfaction.
+var Err er.ErrorType = er.NewErrorType("miniscript.Err")
+
+var (
+	// ErrInvalidExpression is returned when an expression cannot be
+	// parsed.
+	ErrInvalidExpression = Err.CodeWithDetail("ErrInvalidExpression",
+		"invalid miniscript expression")
+
+	// ErrInvalidType is returned when an expression is well formed but
+	// one of its fragments does not type check.
+	ErrInvalidType = Err.CodeWithDetail("ErrInvalidType",
+		"miniscript expression does not type check")
+
+	// ErrInsane is returned by SanityCheck when a valid expression is not
+	// safe to use as a script.
+	ErrInsane = Err.CodeWithDetail("ErrInsane",
+		"miniscript expression is not sane")
+
+	// ErrNoSatisfaction is returned by Satisfy when no non-malleable
+	// satisfaction can be made with the available signatures, preimages
+	// and timelocks.
+	ErrNoSatisfaction = Err.CodeWithDetail("ErrNoSatisfaction",
+		"no non-malleable satisfaction available")
+)
+
+const (
+	// maxMultiKeys is the largest number of keys which is allowed by
+	// OP_CHECKMULTISIG.
+	maxMultiKeys = 20
+
+	// maxTimelock is the largest older() or after() value, larger values
+	// would be negative script numbers.
+	maxTimelock = 1<<31 - 1
+)
+
+// Fragment is the kind of a miniscript node.
+type Fragment int
+
+const (
+	// Just0 is 0, the expression which can never be satisfied.
+	Just0 Fragment = iota
+
+	// Just1 is 1, the expression which is always satisfied.
+	Just1
+
+	// PkK is pk_k(KEY), which puts the key on the stack.
+	PkK
+
+	// PkH is pk_h(KEY), which takes the key from the witness and checks
+	// its hash.
+	PkH
+
+	// Older is older(n), a relative timelock.
+	Older
+
+	// After is after(n), an absolute timelock.
+	After
+
+	// Sha256 is sha256(h), which requires a 32 byte SHA256 preimage.
+	Sha256
+
+	// Hash256 is hash256(h), which requires a 32 byte double SHA256
+	// preimage.
+	Hash256
+
+	// Ripemd160 is ripemd160(h), which requires a 32 byte RIPEMD160
+	// preimage.
+	Ripemd160
+
+	// Hash160 is hash160(h), which requires a 32 byte HASH160 preimage.
+	Hash160
+
+	// AndOr is andor(X,Y,Z), if X then Y else Z.
+	AndOr
+
+	// AndV is and_v(X,Y), X and Y where X is a verify expression.
+	AndV
+
+	// AndB is and_b(X,Y), X and Y combined with OP_BOOLAND.
+	AndB
+
+	// OrB is or_b(X,Z), X or Z combined with OP_BOOLOR.
+	OrB
+
+	// OrC is or_c(X,Z), X or else verify Z.
+	OrC
+
+	// OrD is or_d(X,Z), X or else Z.
+	OrD
+
+	// OrI is or_i(X,Z), X or Z selected by the witness.
+	OrI
+
+	// Thresh is thresh(k,X1,...,Xn), k of the n sub expressions.
+	Thresh
+
+	// Multi is multi(k,KEY1,...,KEYn), k of n keys by OP_CHECKMULTISIG.
+	Multi
+
+	// WrapA is a:X, X run on the alt stack.
+	WrapA
+
+	// WrapS is s:X, X run under the top stack element.
+	WrapS
+
+	// WrapC is c:X, X followed by OP_CHECKSIG.
+	WrapC
+
+	// WrapD is d:X, X run if the top stack element is true.
+	WrapD
+
+	// WrapV is v:X, X followed by a verify.
+	WrapV
+
+	// WrapJ is j:X, X run if the top stack element is not empty.
+	WrapJ
+
+	// WrapN is n:X, X followed by OP_0NOTEQUAL.
+	WrapN
+)
+
+// fragmentNames are the names of the fragments which take arguments.
+var fragmentNames = map[Fragment]string{
+	PkK:       "pk_k",
+	PkH:       "pk_h",
+	Older:     "older",
+	After:     "after",
+	Sha256:    "sha256",
+	Hash256:   "hash256",
+	Ripemd160: "ripemd160",
+	Hash160:   "hash160",
+	AndOr:     "andor",
+	AndV:      "and_v",
+	AndB:      "and_b",
+	OrB:       "or_b",
+	OrC:       "or_c",
+	OrD:       "or_d",
+	OrI:       "or_i",
+	Thresh:    "thresh",
+	Multi:     "multi",
+}
+
+// wrapperFragments maps wrapper letters to their fragment.
+var wrapperFragments = map[byte]Fragment{
+	'a': WrapA,
+	's': WrapS,
+	'c': WrapC,
+	'd': WrapD,
+	'v': WrapV,
+	'j': WrapJ,
+	'n': WrapN,
+}
+
+// Node is a parsed and type checked miniscript expression.
+type Node struct {
+	// Fragment is the kind of the node.
+	Fragment Fragment
+
+	// K is the threshold of thresh and multi and the value of older and
+	// after.
+	K uint32
+
+	// Keys holds the compressed public key of pk_k and pk_h and the keys
+	// of multi.
+	Keys [][]byte
+
+	// Hash is the digest of the hash fragments.
+	Hash []byte
+
+	// Subs are the sub expressions.
+	Subs []*Node
+
+	typ Type
+}
+
+// Type returns the type of the node.
+func (n *Node) Type() Type {
+	return n.typ
+}
+
+// hashSize returns the digest size of a hash fragment.
+func hashSize(f Fragment) int {
+	switch f {
+	case Sha256, Hash256:
+		return 32
+	default:
+		return 20
+	}
+}
+
+// newNode creates a node and computes its type, failing if it has none.
+func newNode(f Fragment, k uint32, keys [][]byte, hash []byte, subs ...*Node) (*Node, er.R) {
+	n := &Node{Fragment: f, K: k, Keys: keys, Hash: hash, Subs: subs}
+	n.typ = computeType(n)
+	if n.typ == 0 {
+		return nil, ErrInvalidType.New(n.String(), nil)
+	}
+	return n, nil
+}
+
+// Parse parses a miniscript expression and checks that it is a valid top
+// level expression, that is it has type B.  A valid expression is not
+// necessarily sane, see SanityCheck.
+func Parse(expr string) (*Node, er.R) {
+	p := parser{s: expr}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.s) {
+		return nil, ErrInvalidExpression.New("unexpected "+
+			strconv.Quote(p.s[p.pos:]), nil)
+	}
+	if !n.typ.Has(TypeB) {
+		return nil, ErrInvalidType.New("top level expression must be "+
+			"of type B, "+n.String()+" is of type "+n.typ.String(), nil)
+	}
+	return n, nil
+}
+
+type parser struct {
+	s   string
+	pos int
+}
+
+// token reads up to the next '(', ',' or ')'.
+func (p *parser) token() string {
+	start := p.pos
+	for p.pos < len(p.s) && !strings.ContainsRune("(),", rune(p.s[p.pos])) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *parser) expect(c byte) er.R {
+	if p.pos >= len(p.s) || p.s[p.pos] != c {
+		return ErrInvalidExpression.New("expected '"+string(c)+
+			"' at position "+strconv.Itoa(p.pos), nil)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) more() bool {
+	return p.pos < len(p.s) && p.s[p.pos] == ','
+}
+
+func (p *parser) key() ([]byte, er.R) {
+	tok := p.token()
+	key, err := hex.DecodeString(tok)
+	if err != nil || len(key) != 33 {
+		return nil, ErrInvalidExpression.New("key "+strconv.Quote(tok)+
+			" is not a hex encoded compressed public key", nil)
+	}
+	if _, err := btcec.ParsePubKey(key, btcec.S256()); err != nil {
+		return nil, ErrInvalidExpression.New("key "+strconv.Quote(tok), err)
+	}
+	return key, nil
+}
+
+func (p *parser) number(min, max uint64) (uint32, er.R) {
+	tok := p.token()
+	v, err := strconv.ParseUint(tok, 10, 32)
+	if err != nil || v < min || v > max || tok != strconv.FormatUint(v, 10) {
+		return 0, ErrInvalidExpression.New("number "+strconv.Quote(tok)+
+			" must be between "+strconv.FormatUint(min, 10)+" and "+
+			strconv.FormatUint(max, 10), nil)
+	}
+	return uint32(v), nil
+}
+
+// subs reads n comma separated sub expressions, the first one is expected
+// to follow the already consumed '('.
+func (p *parser) subs(n int) ([]*Node, er.R) {
+	out := make([]*Node, 0, n)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			if err := p.expect(','); err != nil {
+				return nil, err
+			}
+		}
+		sub, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, sub)
+	}
+	return out, nil
+}
+
+func (p *parser) expr() (*Node, er.R) {
+	start := p.pos
+	tok := p.token()
+	wrappers := ""
+	name := tok
+	if i := strings.IndexByte(tok, ':'); i >= 0 {
+		wrappers, name = tok[:i], tok[i+1:]
+		if wrappers == "" {
+			return nil, ErrInvalidExpression.New("empty wrapper in "+
+				strconv.Quote(tok), nil)
+		}
+	}
+
+	var n *Node
+	var err er.R
+	if name == "0" || name == "1" {
+		f := Just0
+		if name == "1" {
+			f = Just1
+		}
+		n, err = newNode(f, 0, nil, nil)
+	} else {
+		if err := p.expect('('); err != nil {
+			return nil, ErrInvalidExpression.New("unknown fragment "+
+				strconv.Quote(p.s[start:p.pos]), nil)
+		}
+		n, err = p.fragment(name)
+		if err == nil {
+			err = p.expect(')')
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrappers apply from the innermost (rightmost) one outward.
+	for i := len(wrappers) - 1; i >= 0; i-- {
+		switch w := wrappers[i]; w {
+		case 't':
+			one, _ := newNode(Just1, 0, nil, nil)
+			n, err = newNode(AndV, 0, nil, nil, n, one)
+		case 'l':
+			zero, _ := newNode(Just0, 0, nil, nil)
+			n, err = newNode(OrI, 0, nil, nil, zero, n)
+		case 'u':
+			zero, _ := newNode(Just0, 0, nil, nil)
+			n, err = newNode(OrI, 0, nil, nil, n, zero)
+		default:
+			f, ok := wrapperFragments[w]
+			if !ok {
+				return nil, ErrInvalidExpression.New("unknown wrapper '"+
+					string(w)+"'", nil)
+			}
+			n, err = newNode(f, 0, nil, nil, n)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// fragment parses the arguments of a named fragment, the opening '(' has
+// been consumed and the closing ')' is left for the caller.
+func (p *parser) fragment(name string) (*Node, er.R) {
+	switch name {
+	case "pk", "pkh", "pk_k", "pk_h":
+		key, err := p.key()
+		if err != nil {
+			return nil, err
+		}
+		f := PkK
+		if name == "pkh" || name == "pk_h" {
+			f = PkH
+		}
+		n, err := newNode(f, 0, [][]byte{key}, nil)
+		if err != nil || name == "pk_k" || name == "pk_h" {
+			return n, err
+		}
+		return newNode(WrapC, 0, nil, nil, n)
+
+	case "older", "after":
+		v, err := p.number(1, maxTimelock)
+		if err != nil {
+			return nil, err
+		}
+		f := Older
+		if name == "after" {
+			f = After
+		}
+		return newNode(f, v, nil, nil)
+
+	case "sha256", "hash256", "ripemd160", "hash160":
+		f := map[string]Fragment{"sha256": Sha256, "hash256": Hash256,
+			"ripemd160": Ripemd160, "hash160": Hash160}[name]
+		tok := p.token()
+		h, err := hex.DecodeString(tok)
+		if err != nil || len(h) != hashSize(f) {
+			return nil, ErrInvalidExpression.New(name+" requires a "+
+				strconv.Itoa(hashSize(f))+" byte hex encoded hash", nil)
+		}
+		return newNode(f, 0, nil, h)
+
+	case "andor":
+		subs, err := p.subs(3)
+		if err != nil {
+			return nil, err
+		}
+		return newNode(AndOr, 0, nil, nil, subs...)
+
+	case "and_n":
+		subs, err := p.subs(2)
+		if err != nil {
+			return nil, err
+		}
+		zero, _ := newNode(Just0, 0, nil, nil)
+		return newNode(AndOr, 0, nil, nil, subs[0], subs[1], zero)
+
+	case "and_v", "and_b", "or_b", "or_c", "or_d", "or_i":
+		subs, err := p.subs(2)
+		if err != nil {
+			return nil, err
+		}
+		f := map[string]Fragment{"and_v": AndV, "and_b": AndB,
+			"or_b": OrB, "or_c": OrC, "or_d": OrD, "or_i": OrI}[name]
+		return newNode(f, 0, nil, nil, subs...)
+
+	case "thresh":
+		k, err := p.number(1, maxTimelock)
+		if err != nil {
+			return nil, err
+		}
+		var subs []*Node
+		for p.more() {
+			p.pos++
+			sub, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			subs = append(subs, sub)
+		}
+		if int(k) > len(subs) {
+			return nil, ErrInvalidExpression.New("thresh requires at "+
+				"least "+strconv.Itoa(int(k))+" sub expressions", nil)
+		}
+		return newNode(Thresh, k, nil, nil, subs...)
+
+	case "multi":
+		k, err := p.number(1, maxMultiKeys)
+		if err != nil {
+			return nil, err
+		}
+		var keys [][]byte
+		for p.more() {
+			p.pos++
+			key, err := p.key()
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+		}
+		if int(k) > len(keys) || len(keys) > maxMultiKeys {
+			return nil, ErrInvalidExpression.New("multi requires between "+
+				strconv.Itoa(int(k))+" and "+strconv.Itoa(maxMultiKeys)+
+				" keys", nil)
+		}
+		return newNode(Multi, k, keys, nil)
+	}
+	return nil, ErrInvalidExpression.New("unknown fragment "+
+		strconv.Quote(name), nil)
+}
+
+// wrapper returns the wrapper letter which String uses for the node, and
+// the node it wraps, or 0 if the node is not printed as a wrapper.
+func (n *Node) wrapper() (byte, *Node) {
+	switch n.Fragment {
+	case WrapC:
+		if f := n.Subs[0].Fragment; f == PkK || f == PkH {
+			return 0, nil
+		}
+		return 'c', n.Subs[0]
+	case WrapA, WrapS, WrapD, WrapV, WrapJ, WrapN:
+		for w, f := range wrapperFragments {
+			if f == n.Fragment {
+				return w, n.Subs[0]
+			}
+		}
+	case AndV:
+		if n.Subs[1].Fragment == Just1 {
+			return 't', n.Subs[0]
+		}
+	case OrI:
+		if n.Subs[0].Fragment == Just0 {
+			return 'l', n.Subs[1]
+		}
+		if n.Subs[1].Fragment == Just0 {
+			return 'u', n.Subs[0]
+		}
+	}
+	return 0, nil
+}
+
+// String returns the expression, using the pk, pkh, and_n, t:, l: and u:
+// shorthands wherever they apply.
+func (n *Node) String() string {
+	var wrappers []byte
+	for {
+		w, sub := n.wrapper()
+		if w == 0 {
+			break
+		}
+		wrappers = append(wrappers, w)
+		n = sub
+	}
+	body := n.body()
+	if len(wrappers) == 0 {
+		return body
+	}
+	return string(wrappers) + ":" + body
+}
+
+func (n *Node) body() string {
+	switch n.Fragment {
+	case Just0:
+		return "0"
+	case Just1:
+		return "1"
+	case WrapC:
+		if n.Subs[0].Fragment == PkK {
+			return "pk(" + hex.EncodeToString(n.Subs[0].Keys[0]) + ")"
+		}
+		return "pkh(" + hex.EncodeToString(n.Subs[0].Keys[0]) + ")"
+	case PkK, PkH:
+		return fragmentNames[n.Fragment] + "(" +
+			hex.EncodeToString(n.Keys[0]) + ")"
+	case Older, After:
+		return fragmentNames[n.Fragment] + "(" +
+			strconv.FormatUint(uint64(n.K), 10) + ")"
+	case Sha256, Hash256, Ripemd160, Hash160:
+		return fragmentNames[n.Fragment] + "(" +
+			hex.EncodeToString(n.Hash) + ")"
+	case Multi:
+		args := []string{strconv.FormatUint(uint64(n.K), 10)}
+		for _, key := range n.Keys {
+			args = append(args, hex.EncodeToString(key))
+		}
+		return "multi(" + strings.Join(args, ",") + ")"
+	}
+
+	name := fragmentNames[n.Fragment]
+	subs := n.Subs
+	if n.Fragment == AndOr && n.Subs[2].Fragment == Just0 {
+		name = "and_n"
+		subs = n.Subs[:2]
+	}
+	var args []string
+	if n.Fragment == Thresh {
+		args = append(args, strconv.FormatUint(uint64(n.K), 10))
+	}
+	for _, sub := range subs {
+		args = append(args, sub.String())
+	}
+	return name + "(" + strings.Join(args, ",") + ")"
+}
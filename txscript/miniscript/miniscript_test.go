@@ -0,0 +1,251 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package miniscript_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/txscript"
+	"github.com/pkt-cash/pktd/txscript/miniscript"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/txscript/params"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// privKeys are the private keys 1 to 4, their public keys replace K1 to K4
+// in the test expressions.
+var privKeys = func() []*btcec.PrivateKey {
+	keys := make([]*btcec.PrivateKey, 4)
+	for i := range keys {
+		var b [32]byte
+		b[31] = byte(i + 1)
+		keys[i], _ = btcec.PrivKeyFromBytes(btcec.S256(), b[:])
+	}
+	return keys
+}()
+
+var preimage = bytes.Repeat([]byte{0x42}, 32)
+
+// expand replaces K1 to K4 with public keys and H with the sha256 of the
+// preimage.
+func expand(expr string) string {
+	for i, key := range privKeys {
+		expr = strings.Replace(expr, "K"+string(rune('1'+i)),
+			hex.EncodeToString(key.PubKey().SerializeCompressed()), -1)
+	}
+	h := sha256.Sum256(preimage)
+	return strings.Replace(expr, "H", hex.EncodeToString(h[:]), -1)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+		typ   string
+	}{
+		{"pk(K1)", true, "Bonduemsk"},
+		{"pkh(K1)", true, "Bnduemsk"},
+		{"and_v(v:pk(K1),pk(K2))", true, "Bnufmsk"},
+		{"or_d(multi(2,K1,K2,K3),and_v(v:pk(K4),older(25920)))", true, "Bfmsxhk"},
+		{"thresh(2,pk(K1),s:pk(K2),sln:older(12960))", true, "Bdumshk"},
+		{"andor(pk(K1),older(1),pk(K2))", true, "Bdemsxhk"},
+		{"or_b(sha256(H),s:pk(K1))", true, "Bduxk"},
+		{"pk_k(K1)", false, ""},
+		{"v:pk(K1)", false, ""},
+		{"and_v(pk(K1),pk(K2))", false, ""},
+		{"or_b(pk(K1),pk(K2))", false, ""},
+		{"thresh(2,pk(K1),pk(K2))", false, ""},
+		{"multi(3,K1,K2)", false, ""},
+		{"older(0)", false, ""},
+		{"pk(K1", false, ""},
+		{"pk(K1))", false, ""},
+		{"pk(00)", false, ""},
+		{"x:pk(K1)", false, ""},
+		{"sha256(00)", false, ""},
+	}
+	for _, test := range tests {
+		n, err := miniscript.Parse(expand(test.expr))
+		if !test.valid {
+			if err == nil {
+				t.Errorf("Parse(%s): expected failure", test.expr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%s): %v", test.expr, err)
+			continue
+		}
+		if got := n.Type().String(); got != test.typ {
+			t.Errorf("Parse(%s): type %s want %s", test.expr, got, test.typ)
+		}
+		if got := n.String(); got != expand(test.expr) {
+			t.Errorf("Parse(%s): String() = %s", test.expr, got)
+		}
+	}
+}
+
+func TestSanityCheck(t *testing.T) {
+	tests := []struct {
+		expr string
+		sane bool
+	}{
+		{"or_d(multi(2,K1,K2,K3),and_v(v:pk(K4),older(25920)))", true},
+		{"and_v(v:pk(K1),sha256(H))", true},
+		{"or_i(pk(K1),older(10))", false},
+		{"or_b(sha256(H),s:pk(K1))", false},
+		{"and_v(v:after(100),and_v(v:after(500000001),pk(K1)))", false},
+	}
+	for _, test := range tests {
+		n, err := miniscript.Parse(expand(test.expr))
+		if err != nil {
+			t.Fatalf("Parse(%s): %v", test.expr, err)
+		}
+		err = n.SanityCheck()
+		if test.sane && err != nil {
+			t.Errorf("SanityCheck(%s): %v", test.expr, err)
+		}
+		if !test.sane && !miniscript.ErrInsane.Is(err) {
+			t.Errorf("SanityCheck(%s): got %v want ErrInsane", test.expr, err)
+		}
+	}
+}
+
+func TestScript(t *testing.T) {
+	k1 := privKeys[0].PubKey().SerializeCompressed()
+	k2 := privKeys[1].PubKey().SerializeCompressed()
+	n, err := miniscript.Parse(expand("and_v(v:pk(K1),pk(K2))"))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	script, err := n.Script()
+	if err != nil {
+		t.Fatalf("Script: %v", err)
+	}
+	want := append([]byte{opcode.OP_DATA_33}, k1...)
+	want = append(want, opcode.OP_CHECKSIGVERIFY, opcode.OP_DATA_33)
+	want = append(want, k2...)
+	want = append(want, opcode.OP_CHECKSIG)
+	if !bytes.Equal(script, want) {
+		t.Fatalf("Script: got %x want %x", script, want)
+	}
+}
+
+// testSatisfier signs an input of a transaction with some of the test keys.
+type testSatisfier struct {
+	tx        *wire.MsgTx
+	script    []byte
+	amount    int64
+	keys      []*btcec.PrivateKey
+	preimages bool
+}
+
+func (s *testSatisfier) Sign(pubKey []byte) ([]byte, bool) {
+	for _, key := range s.keys {
+		if !bytes.Equal(key.PubKey().SerializeCompressed(), pubKey) {
+			continue
+		}
+		sig, err := txscript.RawTxInWitnessSignature(s.tx,
+			txscript.NewTxSigHashes(s.tx), 0, s.amount, s.script,
+			params.SigHashAll, key)
+		return sig, err == nil
+	}
+	return nil, false
+}
+
+func (s *testSatisfier) Preimage(f miniscript.Fragment, hash []byte) ([]byte, bool) {
+	return preimage, s.preimages && f == miniscript.Sha256
+}
+
+func (s *testSatisfier) CheckOlder(n uint32) bool {
+	return s.tx.Version >= 2 && s.tx.TxIn[0].Sequence >= n
+}
+
+func (s *testSatisfier) CheckAfter(n uint32) bool {
+	return s.tx.LockTime >= n
+}
+
+func TestSatisfy(t *testing.T) {
+	tests := []struct {
+		name      string
+		expr      string
+		keys      []int
+		sequence  uint32
+		preimages bool
+		ok        bool
+	}{
+		{"2 of 3", "or_d(multi(2,K1,K2,K3),and_v(v:pk(K4),older(10)))",
+			[]int{0, 2}, 0, false, true},
+		{"recovery", "or_d(multi(2,K1,K2,K3),and_v(v:pk(K4),older(10)))",
+			[]int{3}, 10, false, true},
+		{"recovery too early", "or_d(multi(2,K1,K2,K3),and_v(v:pk(K4),older(10)))",
+			[]int{3}, 9, false, false},
+		{"1 of 3", "or_d(multi(2,K1,K2,K3),and_v(v:pk(K4),older(10)))",
+			[]int{1}, 10, false, false},
+		{"thresh", "thresh(2,pk(K1),s:pk(K2),sln:older(10))",
+			[]int{1}, 10, false, true},
+		{"preimage", "and_v(v:sha256(H),pk(K1))",
+			[]int{0}, 0, true, true},
+		{"no preimage", "and_v(v:sha256(H),pk(K1))",
+			[]int{0}, 0, false, false},
+		{"signature without preimage", "or_b(sha256(H),s:pk(K1))",
+			[]int{0}, 0, false, false},
+	}
+	for _, test := range tests {
+		n, err := miniscript.Parse(expand(test.expr))
+		if err != nil {
+			t.Fatalf("%s: Parse: %v", test.name, err)
+		}
+		script, err := n.Script()
+		if err != nil {
+			t.Fatalf("%s: Script: %v", test.name, err)
+		}
+		h := sha256.Sum256(script)
+		pkScript := append([]byte{opcode.OP_0, opcode.OP_DATA_32}, h[:]...)
+
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{Sequence: test.sequence})
+		tx.AddTxOut(wire.NewTxOut(1000, pkScript))
+		s := &testSatisfier{tx: tx, script: script, amount: 5000,
+			preimages: test.preimages}
+		for _, i := range test.keys {
+			s.keys = append(s.keys, privKeys[i])
+		}
+
+		wit, err := n.Satisfy(s)
+		if !test.ok {
+			if !miniscript.ErrNoSatisfaction.Is(err) {
+				t.Errorf("%s: got %v want ErrNoSatisfaction", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Satisfy: %v", test.name, err)
+			continue
+		}
+		size := 0
+		for _, elem := range wit {
+			size += wire.VarIntSerializeSize(uint64(len(elem))) + len(elem)
+		}
+		if max := n.MaxSatisfactionSize(); size > max {
+			t.Errorf("%s: witness size %d larger than maximum %d",
+				test.name, size, max)
+		}
+
+		tx.TxIn[0].Witness = append(wit, script)
+		vm, err := txscript.NewEngine(pkScript, tx, 0,
+			txscript.StandardVerifyFlags, nil, nil, s.amount)
+		if err != nil {
+			t.Fatalf("%s: NewEngine: %v", test.name, err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Errorf("%s: Execute: %v", test.name, err)
+		}
+	}
+}
@@ -0,0 +1,393 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package miniscript
+
+import (
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// Satisfier provides the signatures, preimages and timelock information
+// which are needed to satisfy an expression.
+type Satisfier interface {
+	// Sign returns a signature, including the sighash type byte, for the
+	// compressed public key or false if the key cannot sign.
+	Sign(pubKey []byte) ([]byte, bool)
+
+	// Preimage returns the preimage of the digest of a hash fragment or
+	// false if the preimage is not known.
+	Preimage(f Fragment, hash []byte) ([]byte, bool)
+
+	// CheckOlder returns true if the input's sequence satisfies older(n).
+	CheckOlder(n uint32) bool
+
+	// CheckAfter returns true if the transaction's locktime satisfies
+	// after(n).
+	CheckAfter(n uint32) bool
+}
+
+// witness is one candidate satisfaction or dissatisfaction of a node.
+type witness struct {
+	// available is false if the witness cannot be made.
+	available bool
+
+	// hasSig is true if the witness contains a signature, which means a
+	// third party cannot create it.
+	hasSig bool
+
+	// malleable is true if a third party could change the witness into
+	// another valid witness.
+	malleable bool
+
+	stack [][]byte
+}
+
+var (
+	invalid   = witness{}
+	empty     = witness{available: true}
+	zero      = witness{available: true, stack: [][]byte{nil}}
+	one       = witness{available: true, stack: [][]byte{{1}}}
+	zeroBytes = witness{available: true, malleable: true,
+		stack: [][]byte{make([]byte, 32)}}
+)
+
+// size returns the serialized size of the witness stack elements.
+func (w *witness) size() int {
+	size := 0
+	for _, elem := range w.stack {
+		size += wire.VarIntSerializeSize(uint64(len(elem))) + len(elem)
+	}
+	return size
+}
+
+// then returns the witness which has a's elements below b's, it is made of
+// both witnesses and so is only available if both of them are.
+func (a witness) then(b witness) witness {
+	stack := make([][]byte, 0, len(a.stack)+len(b.stack))
+	stack = append(append(stack, a.stack...), b.stack...)
+	return witness{
+		available: a.available && b.available,
+		hasSig:    a.hasSig || b.hasSig,
+		malleable: a.malleable || b.malleable,
+		stack:     stack,
+	}
+}
+
+// mall returns the witness marked as malleable, this is used for witnesses
+// which can be swapped for another by a third party.
+func (a witness) mall() witness {
+	a.malleable = true
+	return a
+}
+
+// or returns the witness which should be used when either a or b would
+// satisfy, preferring one which does not need a signature because a third
+// party could always make that one instead.
+func (a witness) or(b witness) witness {
+	if !a.available {
+		return b
+	}
+	if !b.available {
+		return a
+	}
+	if !a.hasSig && b.hasSig {
+		return a
+	}
+	if !b.hasSig && a.hasSig {
+		return b
+	}
+	if !a.hasSig && !b.hasSig {
+		// A third party can swap between two witnesses which need no
+		// signature.
+		a.malleable = true
+		b.malleable = true
+	} else {
+		if b.malleable && !a.malleable {
+			return a
+		}
+		if a.malleable && !b.malleable {
+			return b
+		}
+	}
+	if a.size() <= b.size() {
+		return a
+	}
+	return b
+}
+
+// satisfactions returns the dissatisfaction and the satisfaction of the
+// node.
+func (n *Node) satisfactions(s Satisfier) (witness, witness) {
+	var x, y, z struct{ nsat, sat witness }
+	if len(n.Subs) > 0 && n.Fragment != Thresh {
+		x.nsat, x.sat = n.Subs[0].satisfactions(s)
+	}
+	if len(n.Subs) > 1 && n.Fragment != Thresh {
+		y.nsat, y.sat = n.Subs[1].satisfactions(s)
+	}
+	if len(n.Subs) > 2 && n.Fragment != Thresh {
+		z.nsat, z.sat = n.Subs[2].satisfactions(s)
+	}
+
+	switch n.Fragment {
+	case Just0:
+		return empty, invalid
+	case Just1:
+		return invalid, empty
+	case PkK:
+		return zero, signature(s, n.Keys[0])
+	case PkH:
+		key := witness{available: true, stack: [][]byte{n.Keys[0]}}
+		return zero.then(key), signature(s, n.Keys[0]).then(key)
+	case Older:
+		if s.CheckOlder(n.K) {
+			return invalid, empty
+		}
+		return invalid, invalid
+	case After:
+		if s.CheckAfter(n.K) {
+			return invalid, empty
+		}
+		return invalid, invalid
+	case Sha256, Hash256, Ripemd160, Hash160:
+		preimage, ok := s.Preimage(n.Fragment, n.Hash)
+		if !ok {
+			return zeroBytes, invalid
+		}
+		return zeroBytes, witness{available: true, stack: [][]byte{preimage}}
+	case AndV:
+		return y.nsat.then(x.sat).mall(), y.sat.then(x.sat)
+	case AndB:
+		return y.nsat.then(x.nsat).
+				or(y.sat.then(x.nsat).mall()).
+				or(y.nsat.then(x.sat).mall()),
+			y.sat.then(x.sat)
+	case OrB:
+		return y.nsat.then(x.nsat),
+			y.nsat.then(x.sat).
+				or(y.sat.then(x.nsat)).
+				or(y.sat.then(x.sat).mall())
+	case OrC:
+		return invalid, x.sat.or(y.sat.then(x.nsat))
+	case OrD:
+		return y.nsat.then(x.nsat), x.sat.or(y.sat.then(x.nsat))
+	case OrI:
+		return x.nsat.then(one).or(y.nsat.then(zero)),
+			x.sat.then(one).or(y.sat.then(zero))
+	case AndOr:
+		return y.nsat.then(x.sat).mall().or(z.nsat.then(x.nsat)),
+			y.sat.then(x.sat).or(z.sat.then(x.nsat))
+	case Thresh:
+		return n.threshSatisfactions(s)
+	case Multi:
+		return n.multiSatisfactions(s)
+	case WrapA, WrapS, WrapC, WrapN:
+		return x.nsat, x.sat
+	case WrapD:
+		return zero, x.sat.then(one)
+	case WrapV:
+		return invalid, x.sat
+	case WrapJ:
+		return zero, x.sat
+	}
+	return invalid, invalid
+}
+
+func signature(s Satisfier, key []byte) witness {
+	sig, ok := s.Sign(key)
+	if !ok {
+		return invalid
+	}
+	return witness{available: true, hasSig: true, stack: [][]byte{sig}}
+}
+
+// multiSatisfactions finds the best choice of k signatures for multi, the
+// signatures must be in the same order as the keys.
+func (n *Node) multiSatisfactions(s Satisfier) (witness, witness) {
+	// sats[j] is the best witness with j signatures from the keys so
+	// far, starting with the dummy element for OP_CHECKMULTISIG.
+	sats := []witness{zero}
+	for _, key := range n.Keys {
+		sig := signature(s, key)
+		next := []witness{sats[0]}
+		for j := 1; j < len(sats); j++ {
+			next = append(next, sats[j].or(sats[j-1].then(sig)))
+		}
+		next = append(next, sats[len(sats)-1].then(sig))
+		sats = next
+	}
+	nsat := zero
+	for i := uint32(0); i < n.K; i++ {
+		nsat = nsat.then(zero)
+	}
+	return nsat, sats[n.K]
+}
+
+// threshSatisfactions finds the best choice of k sub expressions to
+// satisfy for thresh, dissatisfying the others.
+func (n *Node) threshSatisfactions(s Satisfier) (witness, witness) {
+	// sats[j] is the best witness which satisfies j of the sub
+	// expressions so far, they are visited from last to first because
+	// the last one's witness is deepest in the stack.
+	sats := []witness{empty}
+	for i := len(n.Subs) - 1; i >= 0; i-- {
+		nsat, sat := n.Subs[i].satisfactions(s)
+		next := []witness{sats[0].then(nsat)}
+		for j := 1; j < len(sats); j++ {
+			next = append(next, sats[j].then(nsat).or(sats[j-1].then(sat)))
+		}
+		next = append(next, sats[len(sats)-1].then(sat))
+		sats = next
+	}
+	nsat := invalid
+	for i := range sats {
+		if i != 0 && i != int(n.K) {
+			sats[i] = sats[i].mall()
+		}
+		if i != int(n.K) {
+			nsat = nsat.or(sats[i])
+		}
+	}
+	return nsat, sats[n.K]
+}
+
+// Satisfy returns the witness stack elements, not including the witness
+// script itself, of the smallest non-malleable satisfaction of the
+// expression.  ErrNoSatisfaction is returned if there is none.
+func (n *Node) Satisfy(s Satisfier) ([][]byte, er.R) {
+	_, sat := n.satisfactions(s)
+	if !sat.available {
+		return nil, ErrNoSatisfaction.New("missing signatures, "+
+			"preimages or timelocks", nil)
+	}
+	if sat.malleable {
+		return nil, ErrNoSatisfaction.New("the only satisfaction "+
+			"is malleable", nil)
+	}
+	return sat.stack, nil
+}
+
+// cost is the worst case size of a satisfaction or dissatisfaction.
+type cost struct {
+	ok    bool
+	size  int
+	elems int
+}
+
+var noCost = cost{}
+
+func (a cost) plus(b cost) cost {
+	return cost{a.ok && b.ok, a.size + b.size, a.elems + b.elems}
+}
+
+// max returns the larger of a and b in each dimension.
+func (a cost) max(b cost) cost {
+	if !a.ok {
+		return b
+	}
+	if !b.ok {
+		return a
+	}
+	if b.size > a.size {
+		a.size = b.size
+	}
+	if b.elems > a.elems {
+		a.elems = b.elems
+	}
+	return a
+}
+
+var (
+	costEmpty = cost{ok: true}
+	costZero  = cost{ok: true, size: 1, elems: 1}
+	costOne   = cost{ok: true, size: 2, elems: 1}
+
+	// costSig is the size of a DER signature of up to 72 bytes with
+	// the sighash type byte.
+	costSig = cost{ok: true, size: 1 + 73, elems: 1}
+
+	costKey  = cost{ok: true, size: 1 + 33, elems: 1}
+	costHash = cost{ok: true, size: 1 + 32, elems: 1}
+)
+
+// costs returns the largest canonical dissatisfaction and satisfaction of
+// the node.
+func (n *Node) costs() (cost, cost) {
+	var x, y, z struct{ nsat, sat cost }
+	if len(n.Subs) > 0 && n.Fragment != Thresh {
+		x.nsat, x.sat = n.Subs[0].costs()
+	}
+	if len(n.Subs) > 1 && n.Fragment != Thresh {
+		y.nsat, y.sat = n.Subs[1].costs()
+	}
+	if len(n.Subs) > 2 && n.Fragment != Thresh {
+		z.nsat, z.sat = n.Subs[2].costs()
+	}
+
+	switch n.Fragment {
+	case Just0:
+		return costEmpty, noCost
+	case Just1, Older, After:
+		return noCost, costEmpty
+	case PkK:
+		return costZero, costSig
+	case PkH:
+		return costZero.plus(costKey), costSig.plus(costKey)
+	case Sha256, Hash256, Ripemd160, Hash160:
+		return costHash, costHash
+	case AndV:
+		return y.nsat.plus(x.sat), y.sat.plus(x.sat)
+	case AndB:
+		return y.nsat.plus(x.nsat), y.sat.plus(x.sat)
+	case OrB:
+		return y.nsat.plus(x.nsat),
+			y.nsat.plus(x.sat).max(y.sat.plus(x.nsat))
+	case OrC:
+		return noCost, x.sat.max(y.sat.plus(x.nsat))
+	case OrD:
+		return y.nsat.plus(x.nsat), x.sat.max(y.sat.plus(x.nsat))
+	case OrI:
+		return x.nsat.plus(costOne).max(y.nsat.plus(costZero)),
+			x.sat.plus(costOne).max(y.sat.plus(costZero))
+	case AndOr:
+		return z.nsat.plus(x.nsat),
+			y.sat.plus(x.sat).max(z.sat.plus(x.nsat))
+	case Thresh:
+		sats := []cost{costEmpty}
+		for i := len(n.Subs) - 1; i >= 0; i-- {
+			nsat, sat := n.Subs[i].costs()
+			next := []cost{sats[0].plus(nsat)}
+			for j := 1; j < len(sats); j++ {
+				next = append(next, sats[j].plus(nsat).max(sats[j-1].plus(sat)))
+			}
+			sats = append(next, sats[len(sats)-1].plus(sat))
+		}
+		return sats[0], sats[n.K]
+	case Multi:
+		nsat, sat := costZero, costZero
+		for i := uint32(0); i < n.K; i++ {
+			nsat = nsat.plus(costZero)
+			sat = sat.plus(costSig)
+		}
+		return nsat, sat
+	case WrapA, WrapS, WrapC, WrapN:
+		return x.nsat, x.sat
+	case WrapD:
+		return costZero, x.sat.plus(costOne)
+	case WrapV:
+		return noCost, x.sat
+	case WrapJ:
+		return costZero, x.sat
+	}
+	return noCost, noCost
+}
+
+// MaxSatisfactionSize returns the largest size of the witness stack
+// elements, not including the witness script, of any satisfaction of the
+// expression.  This is the size to use for fee estimation.
+func (n *Node) MaxSatisfactionSize() int {
+	_, sat := n.costs()
+	return sat.size
+}
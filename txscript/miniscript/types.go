@@ -0,0 +1,323 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package miniscript
+
+// Type is the set of type properties of a miniscript expression, see
+// https://bitcoin.sipa.be/miniscript/ for their meaning.
+type Type uint32
+
+const (
+	// TypeB is the base type, it pushes nonzero on satisfaction and an
+	// exact zero on dissatisfaction.
+	TypeB Type = 1 << iota
+
+	// TypeV is the verify type, it pushes nothing on satisfaction and
+	// cannot be dissatisfied.
+	TypeV
+
+	// TypeK is the key type, it pushes a public key for a signature to
+	// be checked against.
+	TypeK
+
+	// TypeW is the wrapped type, it takes its input from one below the
+	// top of the stack.
+	TypeW
+
+	// TypeZ is zero-arg, it consumes no stack elements.
+	TypeZ
+
+	// TypeO is one-arg, it consumes exactly one stack element.
+	TypeO
+
+	// TypeN is nonzero, its satisfaction never needs a zero top element.
+	TypeN
+
+	// TypeD is dissatisfiable, it has an unconditional dissatisfaction.
+	TypeD
+
+	// TypeU is unit, it pushes exactly 1 on satisfaction.
+	TypeU
+
+	// TypeE is expressive, it has a unique unconditional dissatisfaction
+	// which is non-malleable.
+	TypeE
+
+	// TypeF is forced, it has no dissatisfaction which does not need a
+	// signature.
+	TypeF
+
+	// TypeM is non-malleable, a non-malleable satisfaction exists for
+	// every combination of available signatures and preimages.
+	TypeM
+
+	// TypeS is safe, every satisfaction needs a signature.
+	TypeS
+
+	// TypeX is expensive verify, the last opcode is not one which has a
+	// VERIFY form.
+	TypeX
+
+	// TypeG contains a relative time timelock.
+	TypeG
+
+	// TypeH contains a relative height timelock.
+	TypeH
+
+	// TypeI contains an absolute time timelock.
+	TypeI
+
+	// TypeJ contains an absolute height timelock.
+	TypeJ
+
+	// TypeNoTimelockMix is set when no satisfaction needs both a height
+	// and a time timelock of the same kind.
+	TypeNoTimelockMix
+)
+
+// typeLetters are the letters of the type properties in bit order.
+const typeLetters = "BVKWzonduefmsxghijk"
+
+// timelockTypes are the properties which record the kinds of timelocks.
+const timelockTypes = TypeG | TypeH | TypeI | TypeJ
+
+// lockTimeThreshold is the value below which an absolute locktime is a
+// block height, it matches txscript.LockTimeThreshold.
+const lockTimeThreshold = 5e8
+
+// sequenceLockTimeIsSeconds is the bit of a relative locktime which
+// makes it a number of 512 second intervals rather than blocks.
+const sequenceLockTimeIsSeconds = 1 << 22
+
+// Has returns true if t has every property in other.
+func (t Type) Has(other Type) bool {
+	return t&other == other
+}
+
+// If returns t if cond is true and no properties otherwise.
+func (t Type) If(cond bool) Type {
+	if cond {
+		return t
+	}
+	return 0
+}
+
+// String returns the letters of the properties of the type, for example
+// "Bondu" for a B type which is one-arg, nonzero, dissatisfiable and unit.
+func (t Type) String() string {
+	out := make([]byte, 0, len(typeLetters))
+	for i := 0; i < len(typeLetters); i++ {
+		if t&(1<<uint(i)) != 0 {
+			out = append(out, typeLetters[i])
+		}
+	}
+	return string(out)
+}
+
+// noTimelockMix returns TypeNoTimelockMix if x and y both have it and if
+// combining them does not need both a height and a time lock of one kind.
+func noTimelockMix(x, y Type) Type {
+	mixed := (x.Has(TypeG) && y.Has(TypeH)) || (x.Has(TypeH) && y.Has(TypeG)) ||
+		(x.Has(TypeI) && y.Has(TypeJ)) || (x.Has(TypeJ) && y.Has(TypeI))
+	return TypeNoTimelockMix.If((x & y).Has(TypeNoTimelockMix) && !mixed)
+}
+
+// computeType returns the type of a node from the types of its sub
+// expressions, or 0 if the node does not type check.
+func computeType(n *Node) Type {
+	var x, y, z Type
+	if len(n.Subs) > 0 {
+		x = n.Subs[0].typ
+	}
+	if len(n.Subs) > 1 {
+		y = n.Subs[1].typ
+	}
+	if len(n.Subs) > 2 {
+		z = n.Subs[2].typ
+	}
+	var t Type
+	switch n.Fragment {
+	case Just0:
+		t = TypeB | TypeZ | TypeU | TypeD | TypeE | TypeM | TypeS | TypeX | TypeNoTimelockMix
+	case Just1:
+		t = TypeB | TypeZ | TypeU | TypeF | TypeM | TypeX | TypeNoTimelockMix
+	case PkK:
+		t = TypeK | TypeO | TypeN | TypeU | TypeD | TypeE | TypeM | TypeS | TypeX | TypeNoTimelockMix
+	case PkH:
+		t = TypeK | TypeN | TypeU | TypeD | TypeE | TypeM | TypeS | TypeX | TypeNoTimelockMix
+	case Older:
+		t = TypeB | TypeZ | TypeF | TypeM | TypeX | TypeNoTimelockMix |
+			TypeG.If(n.K&sequenceLockTimeIsSeconds != 0) |
+			TypeH.If(n.K&sequenceLockTimeIsSeconds == 0)
+	case After:
+		t = TypeB | TypeZ | TypeF | TypeM | TypeX | TypeNoTimelockMix |
+			TypeI.If(n.K >= lockTimeThreshold) |
+			TypeJ.If(n.K < lockTimeThreshold)
+	case Sha256, Hash256, Ripemd160, Hash160:
+		t = TypeB | TypeO | TypeN | TypeU | TypeD | TypeM | TypeNoTimelockMix
+	case Multi:
+		t = TypeB | TypeN | TypeU | TypeD | TypeE | TypeM | TypeS | TypeNoTimelockMix
+
+	case WrapA:
+		t = TypeW.If(x.Has(TypeB)) | x&(timelockTypes|TypeNoTimelockMix) |
+			x&(TypeU|TypeD|TypeF|TypeE|TypeM|TypeS) | TypeX
+	case WrapS:
+		t = TypeW.If(x.Has(TypeB|TypeO)) | x&(timelockTypes|TypeNoTimelockMix) |
+			x&(TypeU|TypeD|TypeF|TypeE|TypeM|TypeS|TypeX)
+	case WrapC:
+		t = TypeB.If(x.Has(TypeK)) | x&(timelockTypes|TypeNoTimelockMix) |
+			x&(TypeO|TypeN|TypeD|TypeF|TypeE|TypeM) | TypeU | TypeS
+	case WrapD:
+		// d: is only u in tapscript, where MINIMALIF is consensus, so it
+		// never is under P2WSH where it is only policy.
+		t = TypeB.If(x.Has(TypeV|TypeZ)) | TypeO.If(x.Has(TypeZ)) |
+			TypeE.If(x.Has(TypeF)) | x&(timelockTypes|TypeNoTimelockMix) |
+			x&(TypeM|TypeS) | TypeN | TypeD | TypeX
+	case WrapV:
+		t = TypeV.If(x.Has(TypeB)) | x&(timelockTypes|TypeNoTimelockMix) |
+			x&(TypeZ|TypeO|TypeN|TypeM|TypeS) | TypeF | TypeX
+	case WrapJ:
+		t = TypeB.If(x.Has(TypeB|TypeN)) | TypeE.If(x.Has(TypeF)) |
+			x&(timelockTypes|TypeNoTimelockMix) | x&(TypeO|TypeU|TypeM|TypeS) |
+			TypeN | TypeD | TypeX
+	case WrapN:
+		t = x&(timelockTypes|TypeNoTimelockMix) |
+			x&(TypeB|TypeZ|TypeO|TypeN|TypeD|TypeF|TypeE|TypeM|TypeS) |
+			TypeU | TypeX
+
+	case AndV:
+		t = (y & (TypeK | TypeV | TypeB)).If(x.Has(TypeV)) |
+			x&TypeN | (y & TypeN).If(x.Has(TypeZ)) |
+			((x | y) & TypeO).If((x | y).Has(TypeZ)) |
+			x&y&(TypeD|TypeM|TypeZ) | (x|y)&TypeS |
+			TypeF.If(y.Has(TypeF) || x.Has(TypeS)) |
+			y&(TypeU|TypeX) | (x|y)&timelockTypes | noTimelockMix(x, y)
+	case AndB:
+		t = (x & TypeB).If(y.Has(TypeW)) |
+			((x | y) & TypeO).If((x | y).Has(TypeZ)) |
+			x&TypeN | (y & TypeN).If(x.Has(TypeZ)) |
+			(x & y & TypeE).If((x & y).Has(TypeS)) |
+			x&y&(TypeD|TypeZ|TypeM) |
+			TypeF.If((x&y).Has(TypeF) || x.Has(TypeS|TypeF) || y.Has(TypeS|TypeF)) |
+			(x|y)&TypeS | TypeU | TypeX |
+			(x|y)&timelockTypes | noTimelockMix(x, y)
+	case OrB:
+		t = TypeB.If(x.Has(TypeB|TypeD) && y.Has(TypeW|TypeD)) |
+			((x | y) & TypeO).If((x | y).Has(TypeZ)) |
+			(x & y & TypeM).If((x|y).Has(TypeS) && (x&y).Has(TypeE)) |
+			x&y&(TypeZ|TypeS|TypeE) | TypeD | TypeU | TypeX |
+			(x|y)&timelockTypes | x&y&TypeNoTimelockMix
+	case OrD:
+		t = (y & TypeB).If(x.Has(TypeB|TypeD|TypeU)) |
+			(x & TypeO).If(y.Has(TypeZ)) |
+			(x & y & TypeM).If(x.Has(TypeE) && (x|y).Has(TypeS)) |
+			x&y&(TypeZ|TypeS) | y&(TypeU|TypeF|TypeD|TypeE) | TypeX |
+			(x|y)&timelockTypes | x&y&TypeNoTimelockMix
+	case OrC:
+		t = (y & TypeV).If(x.Has(TypeB|TypeD|TypeU)) |
+			(x & TypeO).If(y.Has(TypeZ)) |
+			(x & y & TypeM).If(x.Has(TypeE) && (x|y).Has(TypeS)) |
+			x&y&(TypeZ|TypeS) | TypeF | TypeX |
+			(x|y)&timelockTypes | x&y&TypeNoTimelockMix
+	case OrI:
+		t = x&y&(TypeV|TypeB|TypeK|TypeU|TypeF|TypeS) |
+			TypeO.If((x & y).Has(TypeZ)) |
+			((x | y) & TypeE).If((x | y).Has(TypeF)) |
+			(x & y & TypeM).If((x | y).Has(TypeS)) |
+			(x|y)&TypeD | TypeX |
+			(x|y)&timelockTypes | x&y&TypeNoTimelockMix
+	case AndOr:
+		t = (y & z & (TypeB | TypeK | TypeV)).If(x.Has(TypeB|TypeD|TypeU)) |
+			x&y&z&TypeZ |
+			((x | (y & z)) & TypeO).If((x | (y & z)).Has(TypeZ)) |
+			y&z&TypeU |
+			(z & TypeF).If(x.Has(TypeS) || y.Has(TypeF)) |
+			z&TypeD |
+			(z & TypeE).If(x.Has(TypeS) || y.Has(TypeF)) |
+			(x & y & z & TypeM).If(x.Has(TypeE) && (x|y|z).Has(TypeS)) |
+			z&(x|y)&TypeS | TypeX |
+			(x|y|z)&timelockTypes |
+			(noTimelockMix(x, y) & z)
+	case Thresh:
+		return sanitizeType(threshType(n))
+	}
+	return sanitizeType(t)
+}
+
+// threshType computes the type of thresh(k,X1,...,Xn), which requires the
+// first sub expression to be Bdu and the others to be Wdu.
+func threshType(n *Node) Type {
+	allE, allM := true, true
+	args, numS := 0, 0
+	acc := TypeNoTimelockMix
+	for i, sub := range n.Subs {
+		t := sub.typ
+		want := TypeW | TypeD | TypeU
+		if i == 0 {
+			want = TypeB | TypeD | TypeU
+		}
+		if !t.Has(want) {
+			return 0
+		}
+		if !t.Has(TypeE) {
+			allE = false
+		}
+		if !t.Has(TypeM) {
+			allM = false
+		}
+		if t.Has(TypeS) {
+			numS++
+		}
+		switch {
+		case t.Has(TypeZ):
+		case t.Has(TypeO):
+			args++
+		default:
+			args += 2
+		}
+		// A thresh of more than one needs no timelock mix between any
+		// two of its sub expressions.
+		k2 := (acc & t & TypeNoTimelockMix)
+		if n.K > 1 {
+			k2 = noTimelockMix(acc, t)
+		}
+		acc = (acc|t)&timelockTypes | k2
+	}
+	nsubs, k := len(n.Subs), int(n.K)
+	return TypeB | TypeD | TypeU |
+		TypeZ.If(args == 0) | TypeO.If(args == 1) |
+		TypeE.If(allE && numS == nsubs) |
+		TypeM.If(allE && allM && numS >= nsubs-k) |
+		TypeS.If(numS >= nsubs-k+1) | acc
+}
+
+// sanitizeType returns 0 if t has a conflicting set of properties, which
+// means the expression does not type check.
+func sanitizeType(t Type) Type {
+	basic := 0
+	for _, b := range []Type{TypeB, TypeV, TypeK, TypeW} {
+		if t.Has(b) {
+			basic++
+		}
+	}
+	switch {
+	case basic != 1:
+	case t.Has(TypeZ | TypeO):
+	case t.Has(TypeN | TypeZ):
+	case t.Has(TypeN | TypeW):
+	case t.Has(TypeV | TypeD):
+	case t.Has(TypeK) && !t.Has(TypeU):
+	case t.Has(TypeV | TypeU):
+	case t.Has(TypeE | TypeF):
+	case t.Has(TypeE) && !t.Has(TypeD):
+	case t.Has(TypeV | TypeE):
+	case t.Has(TypeD | TypeF):
+	case t.Has(TypeV) && !t.Has(TypeF):
+	case t.Has(TypeK) && !t.Has(TypeS):
+	case t.Has(TypeZ) && !t.Has(TypeM):
+	default:
+		return t
+	}
+	return 0
+}
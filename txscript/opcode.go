@@ -422,9 +422,11 @@ func executeOp(po *parsescript.ParsedOpcode, e *Engine) er.R {
 	case opcode.OP_NOP10:
 		return opcodeNop(po, e)
 
+	// Tapscript opcodes.
+	case opcode.OP_CHECKSIGADD:
+		return opcodeCheckSigAdd(po, e)
+
 	// Undefined opcodes.
-	case opcode.OP_UNKNOWN186:
-		return opcodeInvalid(po, e)
 	case opcode.OP_UNKNOWN187:
 		return opcodeInvalid(po, e)
 	case opcode.OP_UNKNOWN188:
@@ -905,14 +907,16 @@ func opcodeNop(op *parsescript.ParsedOpcode, vm *Engine) er.R {
 func popIfBool(vm *Engine) (bool, er.R) {
 	// When not in witness execution mode, not executing a v0 witness
 	// program, or the minimal if flag isn't set pop the top stack item as
-	// a normal bool.
-	if !vm.isWitnessVersionActive(0) || !vm.hasFlag(ScriptVerifyMinimalIf) {
+	// a normal bool.  In tapscript minimal if is a consensus rule.
+	if vm.taproot == nil &&
+		(!vm.isWitnessVersionActive(0) || !vm.hasFlag(ScriptVerifyMinimalIf)) {
+
 		return vm.dstack.PopBool()
 	}
 
-	// At this point, a v0 witness program is being executed and the minimal
-	// if flag is set, so enforce additional constraints on the top stack
-	// item.
+	// At this point, a v0 witness program or a tapscript is being executed
+	// and minimal if applies, so enforce additional constraints on the top
+	// stack item.
 	so, err := vm.dstack.PopByteArray()
 	if err != nil {
 		return false, err
@@ -1996,6 +2000,12 @@ func opcodeHash256(op *parsescript.ParsedOpcode, vm *Engine) er.R {
 // This opcode does not change the contents of the data stack.
 func opcodeCodeSeparator(op *parsescript.ParsedOpcode, vm *Engine) er.R {
 	vm.lastCodeSep = vm.scriptOff
+
+	// Tapscript signatures commit to the position of the opcode itself,
+	// the program counter has already moved past it.
+	if vm.taproot != nil {
+		vm.taproot.codeSepPos = uint32(vm.scriptOff - 1)
+	}
 	return nil
 }
 
@@ -2012,6 +2022,9 @@ func opcodeCodeSeparator(op *parsescript.ParsedOpcode, vm *Engine) er.R {
 // "script hash" is calculated, the signature is checked using standard
 // cryptographic methods against the provided public key.
 //
+// In tapscript the signature is a BIP0340 signature and the public key is 32
+// bytes, see checkTapscriptSig.
+//
 // Stack transformation: [... signature pubkey] -> [... bool]
 func opcodeCheckSig(op *parsescript.ParsedOpcode, vm *Engine) er.R {
 	pkBytes, err := vm.dstack.PopByteArray()
@@ -2024,6 +2037,15 @@ func opcodeCheckSig(op *parsescript.ParsedOpcode, vm *Engine) er.R {
 		return err
 	}
 
+	if vm.taproot != nil {
+		valid, err := vm.checkTapscriptSig(fullSigBytes, pkBytes)
+		if err != nil {
+			return err
+		}
+		vm.dstack.PushBool(valid)
+		return nil
+	}
+
 	// The signature actually needs needs to be longer than this, but at
 	// least 1 byte is needed for the hash type below.  The full length is
 	// checked depending on the script flags and upon parsing the signature.
@@ -2136,6 +2158,39 @@ func opcodeCheckSigVerify(op *parsescript.ParsedOpcode, vm *Engine) er.R {
 	return err
 }
 
+// opcodeCheckSigAdd is OP_CHECKSIGADD which is only defined in tapscript, it
+// treats the top 3 items on the stack as a signature, a number and a public key
+// and replaces them with the number incremented by one if the signature is
+// valid.  Outside of tapscript it is an invalid opcode.
+//
+// Stack transformation: [... signature n pubkey] -> [... n+success]
+func opcodeCheckSigAdd(op *parsescript.ParsedOpcode, vm *Engine) er.R {
+	if vm.taproot == nil {
+		return opcodeInvalid(op, vm)
+	}
+	pubKey, err := vm.dstack.PopByteArray()
+	if err != nil {
+		return err
+	}
+	n, err := vm.dstack.PopInt()
+	if err != nil {
+		return err
+	}
+	sig, err := vm.dstack.PopByteArray()
+	if err != nil {
+		return err
+	}
+	ok, err := vm.checkTapscriptSig(sig, pubKey)
+	if err != nil {
+		return err
+	}
+	if ok {
+		n++
+	}
+	vm.dstack.PushInt(n)
+	return nil
+}
+
 // parsedSigInfo houses a raw signature along with its parsed form and a flag
 // for whether or not it has already been parsed.  It is used to prevent parsing
 // the same signature multiple times when verifying a multisig.
@@ -2165,6 +2220,13 @@ type parsedSigInfo struct {
 // Stack transformation:
 // [... dummy [sig ...] numsigs [pubkey ...] numpubkeys] -> [... bool]
 func opcodeCheckMultiSig(op *parsescript.ParsedOpcode, vm *Engine) er.R {
+	// Tapscript replaces multisig with OP_CHECKSIGADD.
+	if vm.taproot != nil {
+		str := fmt.Sprintf("%s is disabled in tapscript",
+			opcode.OpcodeName(op.Opcode.Value))
+		return txscripterr.ScriptError(txscripterr.ErrTapscriptCheckMultiSig, str)
+	}
+
 	numKeys, err := vm.dstack.PopInt()
 	if err != nil {
 		return err
@@ -199,7 +199,7 @@ const (
 	OP_NOP8                = 0xb7 // 183
 	OP_NOP9                = 0xb8 // 184
 	OP_NOP10               = 0xb9 // 185
-	OP_UNKNOWN186          = 0xba // 186
+	OP_CHECKSIGADD         = 0xba // 186 - tapscript only, BIP0342
 	OP_UNKNOWN187          = 0xbb // 187
 	OP_UNKNOWN188          = 0xbc // 188
 	OP_UNKNOWN189          = 0xbd // 189
@@ -474,8 +474,10 @@ var opcodeNames = [256]string{
 	OP_NOP9:  "OP_NOP9",
 	OP_NOP10: "OP_NOP10",
 
+	// Tapscript opcodes.
+	OP_CHECKSIGADD: "OP_CHECKSIGADD",
+
 	// Undefined opcodes.
-	OP_UNKNOWN186: "OP_UNKNOWN186",
 	OP_UNKNOWN187: "OP_UNKNOWN187",
 	OP_UNKNOWN188: "OP_UNKNOWN188",
 	OP_UNKNOWN189: "OP_UNKNOWN189",
@@ -770,8 +772,10 @@ var opcodeLengths = [256]int{
 	OP_NOP9:  1,
 	OP_NOP10: 1,
 
+	// Tapscript opcodes.
+	OP_CHECKSIGADD: 1,
+
 	// Undefined opcodes.
-	OP_UNKNOWN186: 1,
 	OP_UNKNOWN187: 1,
 	OP_UNKNOWN188: 1,
 	OP_UNKNOWN189: 1,
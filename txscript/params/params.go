@@ -30,6 +30,47 @@ const (
 	// PayToWitnessScriptHashDataSize is the size of the witness program's
 	// data push for a pay-to-witness-script-hash output.
 	PayToWitnessScriptHashDataSize = 32
+
+	// PayToTaprootDataSize is the size of the witness program's data push
+	// for a pay-to-taproot output, it is the x coordinate of the output
+	// key.
+	PayToTaprootDataSize = 32
+)
+
+// These are the constants which are defined for taproot and tapscript in
+// BIP0341 and BIP0342.
+const (
+	// TaprootAnnexTag is the first byte of the last witness element of a
+	// taproot spend if that element is an annex.
+	TaprootAnnexTag = 0x50
+
+	// TaprootLeafMask is the mask which is applied to the first byte of a
+	// control block to get the leaf version.
+	TaprootLeafMask = 0xfe
+
+	// BaseLeafVersion is the leaf version of a tapscript.
+	BaseLeafVersion = 0xc0
+
+	// ControlBlockBaseSize is the size of a control block which has an
+	// empty merkle path, it is the leaf version and parity byte followed
+	// by the 32 byte internal key.
+	ControlBlockBaseSize = 33
+
+	// ControlBlockNodeSize is the size of each node of the merkle path in
+	// a control block.
+	ControlBlockNodeSize = 32
+
+	// ControlBlockMaxNodeCount is the maximum depth of the taproot script
+	// tree.
+	ControlBlockMaxNodeCount = 128
+
+	// ValidationWeightPerSigOp is the amount of the sigops budget of a
+	// tapscript which is used by each signature check.
+	ValidationWeightPerSigOp = 50
+
+	// ValidationWeightOffset is added to the size of the witness to get
+	// the sigops budget of a tapscript.
+	ValidationWeightOffset = 50
 )
 
 // Bip16Activation is the timestamp where BIP0016 is valid to use in the
@@ -43,6 +84,7 @@ type SigHashType uint32
 // Hash type bits from the end of a signature.
 const (
 	SigHashOld          SigHashType = 0x0
+	SigHashDefault      SigHashType = 0x0 // BIP0341, same as SigHashAll
 	SigHashAll          SigHashType = 0x1
 	SigHashNone         SigHashType = 0x2
 	SigHashSingle       SigHashType = 0x3
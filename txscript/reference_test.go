@@ -202,6 +202,8 @@ func parseScriptFlags(flagStr string) (ScriptFlags, er.R) {
 			flags |= ScriptVerifyMinimalIf
 		case "WITNESS_PUBKEYTYPE":
 			flags |= ScriptVerifyWitnessPubKeyType
+		case "TAPROOT":
+			flags |= ScriptVerifyTaproot
 		default:
 			return flags, er.Errorf("invalid flag: %s", flag)
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/pkt-cash/pktd/btcec"
 	"github.com/pkt-cash/pktd/btcutil"
 	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
 	"github.com/pkt-cash/pktd/wire"
 )
 
@@ -70,6 +71,53 @@ func WitnessSignature(tx *wire.MsgTx, sigHashes *TxSigHashes, idx int, amt int64
 	return wire.TxWitness{sig, pkData}, nil
 }
 
+// RawTxInTaprootSignature returns the BIP0340 schnorr signature for the input
+// idx of the given transaction, the hashType is appended to it unless it is
+// SigHashDefault.  The sigHashes must have been created with
+// NewTxSigHashesWithPrevOuts.  If tapLeafHash is nil then the signature is for
+// the key path, otherwise it is for a tapscript with that leaf hash.
+func RawTxInTaprootSignature(tx *wire.MsgTx, sigHashes *TxSigHashes, idx int,
+	hashType params.SigHashType, key *btcec.PrivateKey,
+	tapLeafHash *chainhash.Hash) ([]byte, er.R) {
+
+	hash, err := CalcTaprootSignatureHash(sigHashes, hashType, tx, idx,
+		tapLeafHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var aux [32]byte
+	signature, errr := key.SchnorrSign(hash, aux[:])
+	if errr != nil {
+		return nil, er.Errorf("cannot sign tx input: %s", errr)
+	}
+
+	if hashType == params.SigHashDefault {
+		return signature[:], nil
+	}
+	return append(signature[:], byte(hashType)), nil
+}
+
+// TaprootWitnessSignature creates an input witness stack for tx to spend a
+// pay-to-taproot output using the key path, where the output key is privKey
+// tweaked with no script tree.  The sigHashes must have been created with
+// NewTxSigHashesWithPrevOuts.  The signature observes the transaction digest
+// algorithm defined within BIP0341.
+func TaprootWitnessSignature(tx *wire.MsgTx, sigHashes *TxSigHashes, idx int,
+	hashType params.SigHashType, privKey *btcec.PrivateKey) (wire.TxWitness, er.R) {
+
+	tweaked, err := TweakTaprootPrivKey(privKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := RawTxInTaprootSignature(tx, sigHashes, idx, hashType,
+		tweaked, nil)
+	if err != nil {
+		return nil, err
+	}
+	return wire.TxWitness{sig}, nil
+}
+
 // RawTxInSignature returns the serialized ECDSA signature for the input idx of
 // the given transaction, with hashType appended to it.
 func RawTxInSignature(tx *wire.MsgTx, idx int, subScript []byte,
@@ -50,7 +50,10 @@ const (
 		ScriptVerifyWitness |
 		ScriptVerifyDiscourageUpgradeableWitnessProgram |
 		ScriptVerifyMinimalIf |
-		ScriptVerifyWitnessPubKeyType
+		ScriptVerifyWitnessPubKeyType |
+		ScriptVerifyDiscourageUpgradableTaprootVersion |
+		ScriptVerifyDiscourageOpSuccess |
+		ScriptVerifyDiscourageUpgradablePubKeyType
 )
 
 // ScriptClass is an enumeration for the list of standard types of script.
@@ -66,6 +69,8 @@ const (
 	WitnessV0ScriptHashTy                    // Pay to witness script hash.
 	MultiSigTy                               // Multi signature.
 	NullDataTy                               // Empty data-only (provably prunable).
+	WitnessV1TaprootTy                       // Pay to taproot.
+	WitnessUnknownTy                         // Pay to a future witness version.
 )
 
 // scriptClassToName houses the human-readable strings which describe each
@@ -79,6 +84,8 @@ var scriptClassToName = []string{
 	WitnessV0ScriptHashTy: "witness_v0_scripthash",
 	MultiSigTy:            "multisig",
 	NullDataTy:            "nulldata",
+	WitnessV1TaprootTy:    "witness_v1_taproot",
+	WitnessUnknownTy:      "witness_unknown",
 }
 
 // String implements the Stringer interface by returning the name of
@@ -93,7 +100,8 @@ func (t ScriptClass) String() string {
 
 // IsSegwit returns true if the script is a known segwit type.
 func (t ScriptClass) IsSegwit() bool {
-	return t == WitnessV0PubKeyHashTy || t == WitnessV0ScriptHashTy
+	return t == WitnessV0PubKeyHashTy || t == WitnessV0ScriptHashTy ||
+		t == WitnessV1TaprootTy
 }
 
 // isPubkey returns true if the script passed is a pay-to-pubkey transaction,
@@ -150,6 +158,23 @@ func isMultiSig(pops []parsescript.ParsedOpcode) bool {
 	return true
 }
 
+// isTaproot returns true if the passed script is a pay-to-taproot output,
+// false otherwise.
+func isTaproot(pops []parsescript.ParsedOpcode) bool {
+	return len(pops) == 2 &&
+		pops[0].Opcode.Value == opcode.OP_1 &&
+		pops[1].Opcode.Value == opcode.OP_DATA_32
+}
+
+// isWitnessUnknown returns true if the passed script is a witness program of
+// version 1 to 16 which is not a pay-to-taproot script, such programs are
+// reserved for future soft forks.
+func isWitnessUnknown(pops []parsescript.ParsedOpcode) bool {
+	return isWitnessProgram(pops) &&
+		pops[0].Opcode.Value != opcode.OP_0 &&
+		!isTaproot(pops)
+}
+
 // isNullData returns true if the passed script is a null data transaction,
 // false otherwise.
 func isNullData(pops []parsescript.ParsedOpcode) bool {
@@ -181,6 +206,10 @@ func typeOfScript(pops []parsescript.ParsedOpcode) ScriptClass {
 		return ScriptHashTy
 	} else if isWitnessScriptHash(pops) {
 		return WitnessV0ScriptHashTy
+	} else if isTaproot(pops) {
+		return WitnessV1TaprootTy
+	} else if isWitnessUnknown(pops) {
+		return WitnessUnknownTy
 	} else if isMultiSig(pops) {
 		return MultiSigTy
 	} else if isNullData(pops) {
@@ -225,6 +254,11 @@ func expectedInputs(pops []parsescript.ParsedOpcode, class ScriptClass) int {
 		// Not including script.  That is handled by the caller.
 		return 1
 
+	case WitnessV1TaprootTy:
+		// A key path spend, a script path spend is not known from the
+		// output.
+		return 1
+
 	case MultiSigTy:
 		// Standard multisig has a push a small number for the number
 		// of sigs and number of keys.  Check the first push instruction
@@ -437,6 +471,19 @@ func payToWitnessScriptHashScript(scriptHash []byte) ([]byte, er.R) {
 	return payToWitnessScriptHashScriptBuilder(scriptHash).Script()
 }
 
+// payToTaprootScript creates a new script to pay to a version 1 (taproot)
+// witness program.  The passed output key is expected to be valid.
+func payToTaprootScript(outputKey []byte) ([]byte, er.R) {
+	return scriptbuilder.NewScriptBuilder().AddOp(opcode.OP_1).AddData(outputKey).Script()
+}
+
+// payToWitnessScript creates a new script to pay to a witness program of the
+// given version.  The passed program is expected to be valid.
+func payToWitnessScript(witnessVersion byte, witnessProgram []byte) ([]byte, er.R) {
+	return scriptbuilder.NewScriptBuilder().AddInt64(int64(witnessVersion)).
+		AddData(witnessProgram).Script()
+}
+
 // payToPubKeyScriptBuilder creates a new script to pay a transaction output to a
 // public key. It is expected that the input is a valid pubkey.
 func payToPubKeyScriptBuilder(serializedPubKey []byte) *scriptbuilder.ScriptBuilder {
@@ -473,7 +520,7 @@ func payToNonStandardScriptBuilder(pkScript, voteFor, voteAgainst []byte) ([]byt
 
 	pops = stripVote(pops)
 	scriptClass := typeOfScript(pops)
-	if scriptClass.IsSegwit() {
+	if scriptClass.IsSegwit() || scriptClass == WitnessUnknownTy {
 		// It's not possible to append votes to a segwit script
 		return pkScript, nil
 	}
@@ -517,6 +564,12 @@ func PayToAddrScriptWithVote(addr btcutil.Address, voteFor, voteAgainst []byte)
 	case *btcutil.AddressWitnessScriptHash:
 		return payToWitnessScriptHashScript(addr.ScriptAddress())
 
+	case *btcutil.AddressTaproot:
+		return payToTaprootScript(addr.ScriptAddress())
+
+	case *btcutil.AddressSegWit:
+		return payToWitnessScript(addr.WitnessVersion(), addr.ScriptAddress())
+
 	case *btcutil.AddressNonStandard:
 		return payToNonStandardScriptBuilder(addr.ScriptAddress(), voteFor, voteAgainst)
 	}
@@ -690,6 +743,26 @@ func ExtractPkScriptAddrs(pkScript []byte, chainParams *chaincfg.Params) (Script
 			addrs = append(addrs, addr)
 		}
 
+	case WitnessV1TaprootTy:
+		// A pay-to-taproot script is of the form:
+		//  OP_1 <32-byte output key>
+		// Therefore, the output key is the second item on the stack.
+		requiredSigs = 1
+		addr, err := btcutil.NewAddressTaproot(pops[1].Data, chainParams)
+		if err == nil {
+			addrs = append(addrs, addr)
+		}
+
+	case WitnessUnknownTy:
+		// A witness program of a future version is of the form:
+		//  <version> <2 to 40 byte program>
+		// The number of required signatures is not known.
+		addr, err := btcutil.NewAddressSegWit(
+			byte(asSmallInt(pops[0].Opcode)), pops[1].Data, chainParams)
+		if err == nil {
+			addrs = append(addrs, addr)
+		}
+
 	case MultiSigTy:
 		// A multi-signature script is of the form:
 		//  <numsigs> <pubkey> <pubkey> <pubkey>... <numpubkeys> OP_CHECKMULTISIG
@@ -74,6 +74,33 @@ func newAddressScriptHash(scriptHash []byte) btcutil.Address {
 	return addr
 }
 
+// newAddressTaproot returns a new btcutil.AddressTaproot from the provided
+// output key.  It panics if an error occurs.  This is only used in the tests as
+// a helper since the only way it can fail is if there is an error in the test
+// source code.
+func newAddressTaproot(outputKey []byte) btcutil.Address {
+	addr, err := btcutil.NewAddressTaproot(outputKey, &chaincfg.MainNetParams)
+	if err != nil {
+		panic("invalid taproot output key in test source")
+	}
+
+	return addr
+}
+
+// newAddressSegWit returns a new btcutil.AddressSegWit from the provided
+// witness version and program.  It panics if an error occurs.  This is only
+// used in the tests as a helper since the only way it can fail is if there is
+// an error in the test source code.
+func newAddressSegWit(version byte, program []byte) btcutil.Address {
+	addr, err := btcutil.NewAddressSegWit(version, program,
+		&chaincfg.MainNetParams)
+	if err != nil {
+		panic("invalid witness program in test source")
+	}
+
+	return addr
+}
+
 // TestExtractPkScriptAddrs ensures that extracting the type, addresses, and
 // number of required signatures from PkScripts works as intended.
 func TestExtractPkScriptAddrs(t *testing.T) {
@@ -328,6 +355,34 @@ func TestExtractPkScriptAddrs(t *testing.T) {
 			reqSigs: 1,
 			class:   MultiSigTy,
 		},
+		{
+			name: "p2tr",
+			script: hexToBytes("512079be667ef9dcbbac55a06295ce870b07" +
+				"029bfcdb2dce28d959f2815b16f81798"),
+			addrs: []btcutil.Address{
+				newAddressTaproot(hexToBytes("79be667ef9dcbbac55a0" +
+					"6295ce870b07029bfcdb2dce28d959f2815b16f81798")),
+			},
+			reqSigs: 1,
+			class:   WitnessV1TaprootTy,
+		},
+		{
+			name:   "witness v2 program",
+			script: hexToBytes("5210751e76e8199196d454941c45d1b3a323"),
+			addrs: []btcutil.Address{
+				newAddressSegWit(2, hexToBytes("751e76e8199196d4" +
+					"54941c45d1b3a323")),
+			},
+			reqSigs: 0,
+			class:   WitnessUnknownTy,
+		},
+		{
+			name:    "witness v0 program of unknown length",
+			script:  hexToBytes("0010751e76e8199196d454941c45d1b3a323"),
+			addrs:   nil,
+			reqSigs: 0,
+			class:   NonStandardTy,
+		},
 		{
 			name:    "empty script",
 			script:  []byte{},
@@ -688,6 +743,21 @@ func TestPayToAddrScript(t *testing.T) {
 			nil,
 		},
 
+		// pay-to-taproot address on mainnet.
+		{
+			newAddressTaproot(hexToBytes("79be667ef9dcbbac55a06295ce" +
+				"870b07029bfcdb2dce28d959f2815b16f81798")),
+			"1 DATA_32 0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce" +
+				"28d959f2815b16f81798",
+			nil,
+		},
+		// pay-to-witness address of a future version on mainnet.
+		{
+			newAddressSegWit(16, hexToBytes("751e")),
+			"16 DATA_2 0x751e",
+			nil,
+		},
+
 		// Supported address types with nil pointers.
 		{(*btcutil.AddressPubKeyHash)(nil), "", errUnsupportedAddress},
 		{(*btcutil.AddressScriptHash)(nil), "", errUnsupportedAddress},
@@ -1045,6 +1115,18 @@ var scriptClassTests = []struct {
 		script: "0 DATA_32 0x9f96ade4b41d5433f4eda31e1738ec2b36f6e7d1420d94a6af99801a88f7f7ff",
 		class:  WitnessV0ScriptHashTy,
 	},
+	{
+		// A pay to taproot pk script.
+		name:   "Pay To Taproot",
+		script: "1 DATA_32 0x79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		class:  WitnessV1TaprootTy,
+	},
+	{
+		// A witness program of a future version.
+		name:   "Witness Unknown",
+		script: "2 DATA_16 0x751e76e8199196d454941c45d1b3a323",
+		class:  WitnessUnknownTy,
+	},
 }
 
 // TestScriptClass ensures all the scripts in scriptClassTests have the expected
@@ -1109,6 +1191,16 @@ func TestStringifyClass(t *testing.T) {
 			class:    NullDataTy,
 			stringed: "nulldata",
 		},
+		{
+			name:     "witnessv1taproot",
+			class:    WitnessV1TaprootTy,
+			stringed: "witness_v1_taproot",
+		},
+		{
+			name:     "witnessunknown",
+			class:    WitnessUnknownTy,
+			stringed: "witness_unknown",
+		},
 		{
 			name:     "broken",
 			class:    ScriptClass(255),
@@ -0,0 +1,561 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/txscript/params"
+	"github.com/pkt-cash/pktd/txscript/parsescript"
+	"github.com/pkt-cash/pktd/txscript/txscripterr"
+	"github.com/pkt-cash/pktd/wire"
+)
+
+// noCodeSepPos is the codesep_pos which is committed to by a tapscript
+// signature if no OP_CODESEPARATOR has been executed.
+const noCodeSepPos = 0xffffffff
+
+// Tags of the tagged hashes which are used by taproot, see BIP0340.
+var (
+	tagTapLeaf    = []byte("TapLeaf")
+	tagTapBranch  = []byte("TapBranch")
+	tagTapTweak   = []byte("TapTweak")
+	tagTapSighash = []byte("TapSighash")
+)
+
+// taggedHash returns sha256(sha256(tag) || sha256(tag) || msgs...) which is
+// the tagged hash that is defined in BIP0340.
+func taggedHash(tag []byte, msgs ...[]byte) chainhash.Hash {
+	tagHash := sha256.Sum256(tag)
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+	var out chainhash.Hash
+	copy(out[:], h.Sum(nil))
+	return out
+}
+
+// TapLeafHash returns the hash of a leaf of a taproot script tree.
+func TapLeafHash(leafVersion byte, script []byte) chainhash.Hash {
+	var b bytes.Buffer
+	b.WriteByte(leafVersion)
+	wire.WriteVarBytes(&b, 0, script)
+	return taggedHash(tagTapLeaf, b.Bytes())
+}
+
+// TapBranchHash returns the hash of a branch of a taproot script tree given
+// the hashes of its two children, the children are sorted so the order in
+// which they are given does not matter.
+func TapBranchHash(a, b []byte) chainhash.Hash {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return taggedHash(tagTapBranch, a, b)
+}
+
+// tapTweak returns the tweak which is added to the internal key to get the
+// output key.  If there is no script tree then merkleRoot is nil.
+func tapTweak(internalKey, merkleRoot []byte) (*big.Int, er.R) {
+	h := taggedHash(tagTapTweak, internalKey, merkleRoot)
+	t := new(big.Int).SetBytes(h[:])
+	if t.Cmp(btcec.S256().N) >= 0 {
+		return nil, er.New("taproot tweak is not less than the curve order")
+	}
+	return t, nil
+}
+
+// ComputeTaprootOutputKey returns the 32 byte x coordinate of the output key
+// of a taproot output which commits to the internal key and to the merkle root
+// of a script tree, merkleRoot is nil if there is no script tree.  The second
+// return value is true if the y coordinate of the output key is odd.
+func ComputeTaprootOutputKey(internalKey, merkleRoot []byte) ([]byte, bool, er.R) {
+	if len(internalKey) != 32 {
+		return nil, false, er.Errorf("taproot internal key must be 32 "+
+			"bytes, got %d", len(internalKey))
+	}
+	pub, err := btcec.ParsePubKey(append([]byte{0x02}, internalKey...), btcec.S256())
+	if err != nil {
+		return nil, false, err
+	}
+	t, err := tapTweak(internalKey, merkleRoot)
+	if err != nil {
+		return nil, false, err
+	}
+	curve := btcec.S256()
+	tx, ty := curve.ScalarBaseMult(t.Bytes())
+	qx, qy := curve.Add(pub.X, pub.Y, tx, ty)
+	if qx.Sign() == 0 && qy.Sign() == 0 {
+		return nil, false, er.New("taproot output key is infinity")
+	}
+	var outputKey [32]byte
+	qxBytes := qx.Bytes()
+	copy(outputKey[32-len(qxBytes):], qxBytes)
+	return outputKey[:], qy.Bit(0) == 1, nil
+}
+
+// TweakTaprootPrivKey returns the private key which signs for the output key
+// of a taproot output given the private key of the internal key, merkleRoot is
+// nil if there is no script tree.
+func TweakTaprootPrivKey(privKey *btcec.PrivateKey, merkleRoot []byte) (*btcec.PrivateKey, er.R) {
+	curve := btcec.S256()
+	d := new(big.Int).Set(privKey.D)
+	if privKey.PubKey().Y.Bit(0) == 1 {
+		d.Sub(curve.N, d)
+	}
+	t, err := tapTweak(SchnorrPubKeyBytes(privKey.PubKey()), merkleRoot)
+	if err != nil {
+		return nil, err
+	}
+	d.Add(d, t)
+	d.Mod(d, curve.N)
+	if d.Sign() == 0 {
+		return nil, er.New("tweaked taproot private key is zero")
+	}
+	tweaked, _ := btcec.PrivKeyFromBytes(curve, d.Bytes())
+	return tweaked, nil
+}
+
+// SchnorrPubKeyBytes returns the 32 byte x coordinate of a public key which is
+// how public keys are serialized for BIP0340 signatures.
+func SchnorrPubKeyBytes(pub *btcec.PublicKey) []byte {
+	return pub.SerializeCompressed()[1:]
+}
+
+// isOpSuccess returns true if the opcode is one of the OP_SUCCESSx opcodes
+// which are defined in BIP0342, a tapscript which contains any of them
+// succeeds unconditionally.
+func isOpSuccess(op byte) bool {
+	return op == 80 || op == 98 || (op >= 126 && op <= 129) ||
+		(op >= 131 && op <= 134) || (op >= 137 && op <= 138) ||
+		(op >= 141 && op <= 142) || (op >= 149 && op <= 153) ||
+		(op >= 187 && op <= 254)
+}
+
+// scanOpSuccess returns true if the script contains an OP_SUCCESSx opcode.
+// An OP_SUCCESSx which comes before a malformed push still makes the script
+// succeed, so a parse error is only returned if there is none.
+func scanOpSuccess(script []byte) (bool, er.R) {
+	pops, err := parsescript.ParseScript(script)
+	for _, pop := range pops {
+		if isOpSuccess(pop.Opcode.Value) {
+			return true, nil
+		}
+	}
+	return false, err
+}
+
+// taprootExecCtx is the state of a tapscript which is being executed.
+type taprootExecCtx struct {
+	annex       []byte
+	tapLeafHash chainhash.Hash
+
+	// codeSepPos is the opcode position of the last executed
+	// OP_CODESEPARATOR, or noCodeSepPos if none has been executed.
+	codeSepPos uint32
+
+	// sigOpsBudget is decreased by each signature check and the script
+	// fails if it becomes negative.
+	sigOpsBudget int64
+}
+
+// verifyTaprootProgram validates a spend of a taproot output, it is called
+// once the public key script has been executed.
+func (vm *Engine) verifyTaprootProgram(witness [][]byte) er.R {
+	if len(witness) == 0 {
+		return txscripterr.ScriptError(txscripterr.ErrWitnessProgramEmpty,
+			"witness program empty passed empty witness")
+	}
+
+	// If there are at least two elements and the last one starts with
+	// 0x50 then it is the annex, it is committed to by the signatures but
+	// otherwise ignored.
+	var annex []byte
+	if len(witness) >= 2 && len(witness[len(witness)-1]) > 0 &&
+		witness[len(witness)-1][0] == params.TaprootAnnexTag {
+
+		annex = witness[len(witness)-1]
+		witness = witness[:len(witness)-1]
+	}
+
+	// A single element is a key path spend, the element is a signature
+	// for the output key.
+	if len(witness) == 1 {
+		err := vm.verifySchnorrSig(witness[0], vm.witnessProgram, annex, nil)
+		if err != nil {
+			return err
+		}
+		vm.succeedTaproot()
+		return nil
+	}
+
+	// Otherwise it is a script path spend, the last two elements are the
+	// script and the control block.
+	controlBlock := witness[len(witness)-1]
+	script := witness[len(witness)-2]
+	stack := witness[:len(witness)-2]
+	if len(controlBlock) < params.ControlBlockBaseSize ||
+		len(controlBlock) > params.ControlBlockBaseSize+
+			params.ControlBlockMaxNodeCount*params.ControlBlockNodeSize ||
+		(len(controlBlock)-params.ControlBlockBaseSize)%
+			params.ControlBlockNodeSize != 0 {
+
+		str := fmt.Sprintf("invalid control block size %d",
+			len(controlBlock))
+		return txscripterr.ScriptError(txscripterr.ErrTaprootControlBlockSize, str)
+	}
+	leafVersion := controlBlock[0] & params.TaprootLeafMask
+	tapLeafHash := TapLeafHash(leafVersion, script)
+	if err := verifyTaprootCommitment(controlBlock, vm.witnessProgram,
+		tapLeafHash); err != nil {
+
+		return err
+	}
+
+	if leafVersion != params.BaseLeafVersion {
+		if vm.hasFlag(ScriptVerifyDiscourageUpgradableTaprootVersion) {
+			str := fmt.Sprintf("unknown taproot leaf version 0x%02x",
+				leafVersion)
+			return txscripterr.ScriptError(
+				txscripterr.ErrDiscourageUpgradableTaprootVersion, str)
+		}
+		vm.succeedTaproot()
+		return nil
+	}
+
+	// The script is a tapscript, if it contains any OP_SUCCESSx then it
+	// succeeds without being executed.
+	success, err := scanOpSuccess(script)
+	if err != nil {
+		return err
+	}
+	if success {
+		if vm.hasFlag(ScriptVerifyDiscourageOpSuccess) {
+			return txscripterr.ScriptError(txscripterr.ErrDiscourageOpSuccess,
+				"tapscript contains an OP_SUCCESSx opcode")
+		}
+		vm.succeedTaproot()
+		return nil
+	}
+
+	if len(stack) > params.MaxStackSize {
+		str := fmt.Sprintf("initial stack size %d > max allowed %d",
+			len(stack), params.MaxStackSize)
+		return txscripterr.ScriptError(txscripterr.ErrStackOverflow, str)
+	}
+	for _, elem := range stack {
+		if len(elem) > params.MaxScriptElementSize {
+			str := fmt.Sprintf("element size %d exceeds max allowed "+
+				"size %d", len(elem), params.MaxScriptElementSize)
+			return txscripterr.ScriptError(txscripterr.ErrElementTooBig, str)
+		}
+	}
+
+	pops, err := parsescript.ParseScript(script)
+	if err != nil {
+		return err
+	}
+	vm.taproot = &taprootExecCtx{
+		annex:        annex,
+		tapLeafHash:  tapLeafHash,
+		codeSepPos:   noCodeSepPos,
+		sigOpsBudget: int64(vm.tx.TxIn[vm.txIdx].Witness.SerializeSize()) + params.ValidationWeightOffset,
+	}
+	vm.scripts = append(vm.scripts, pops)
+	vm.SetStack(stack)
+	return nil
+}
+
+// succeedTaproot leaves a single true element on the stack so that a taproot
+// spend which does not execute a script passes CheckErrorCondition.
+func (vm *Engine) succeedTaproot() {
+	vm.SetStack(nil)
+	vm.dstack.PushBool(true)
+}
+
+// verifyTaprootCommitment checks that the internal key and the merkle path in
+// the control block, together with the leaf hash, commit to the output key.
+func verifyTaprootCommitment(controlBlock, outputKey []byte, tapLeafHash chainhash.Hash) er.R {
+	internalKey := controlBlock[1:params.ControlBlockBaseSize]
+	k := tapLeafHash[:]
+	for i := params.ControlBlockBaseSize; i < len(controlBlock); i += params.ControlBlockNodeSize {
+		branch := TapBranchHash(k, controlBlock[i:i+params.ControlBlockNodeSize])
+		k = branch[:]
+	}
+	key, oddY, err := ComputeTaprootOutputKey(internalKey, k)
+	if err != nil {
+		return txscripterr.ScriptError(txscripterr.ErrTaprootMerkleMismatch, err.Message())
+	}
+	if !bytes.Equal(key, outputKey) || oddY != (controlBlock[0]&1 == 1) {
+		return txscripterr.ScriptError(txscripterr.ErrTaprootMerkleMismatch,
+			"control block and script do not commit to the witness program")
+	}
+	return nil
+}
+
+// verifySchnorrSig checks a BIP0340 signature with an optional sighash type
+// byte against the BIP0341 signature hash of the input.  tapLeafHash is nil for
+// a key path spend.
+func (vm *Engine) verifySchnorrSig(sig, pubKey, annex []byte, tapLeafHash *chainhash.Hash) er.R {
+	hashType := params.SigHashDefault
+	switch len(sig) {
+	case 64:
+	case 65:
+		hashType = params.SigHashType(sig[64])
+		if hashType == params.SigHashDefault {
+			return txscripterr.ScriptError(txscripterr.ErrTaprootSigHashType,
+				"a 65 byte signature must not use the default sighash type")
+		}
+		sig = sig[:64]
+	default:
+		str := fmt.Sprintf("schnorr signature must be 64 or 65 bytes, "+
+			"got %d", len(sig))
+		return txscripterr.ScriptError(txscripterr.ErrTaprootSigSize, str)
+	}
+
+	sigHashes := vm.hashCache
+	if sigHashes == nil || sigHashes.Taproot == nil {
+		return txscripterr.ScriptError(txscripterr.ErrTaprootMissingPrevOuts,
+			"the outputs which are spent by the transaction are needed to "+
+				"validate a taproot spend")
+	}
+	codeSepPos := uint32(noCodeSepPos)
+	if vm.taproot != nil {
+		codeSepPos = vm.taproot.codeSepPos
+	}
+	hash, err := calcTaprootSignatureHash(sigHashes.Taproot, hashType,
+		&vm.tx, vm.txIdx, annex, tapLeafHash, codeSepPos)
+	if err != nil {
+		return err
+	}
+	if ok, _ := btcec.SchnorrVerify(hash[:], pubKey, sig); !ok {
+		return txscripterr.ScriptError(txscripterr.ErrTaprootSigInvalid,
+			"schnorr signature is invalid")
+	}
+	return nil
+}
+
+// checkTapscriptSig implements the signature check of OP_CHECKSIG,
+// OP_CHECKSIGVERIFY and OP_CHECKSIGADD in a tapscript, it returns true if the
+// signature is not empty and valid.  A non-empty signature which is invalid
+// makes the script fail.
+func (vm *Engine) checkTapscriptSig(sig, pubKey []byte) (bool, er.R) {
+	if len(pubKey) == 0 {
+		return false, txscripterr.ScriptError(txscripterr.ErrTapscriptEmptyPubKey,
+			"tapscript public key is empty")
+	}
+	if len(sig) == 0 {
+		return false, nil
+	}
+
+	vm.taproot.sigOpsBudget -= params.ValidationWeightPerSigOp
+	if vm.taproot.sigOpsBudget < 0 {
+		return false, txscripterr.ScriptError(txscripterr.ErrTapscriptValidationWeight,
+			"tapscript exceeds its signature check budget")
+	}
+
+	if len(pubKey) != 32 {
+		// Public keys of other sizes are reserved for future soft forks
+		// and any signature is valid for them.
+		if vm.hasFlag(ScriptVerifyDiscourageUpgradablePubKeyType) {
+			str := fmt.Sprintf("unknown tapscript public key type of "+
+				"%d bytes", len(pubKey))
+			return false, txscripterr.ScriptError(
+				txscripterr.ErrDiscourageUpgradablePubKeyType, str)
+		}
+		return true, nil
+	}
+
+	err := vm.verifySchnorrSig(sig, pubKey, vm.taproot.annex, &vm.taproot.tapLeafHash)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// newTaprootSigHashes computes the BIP0341 sighash midstate, it returns nil if
+// any of the spent outputs are missing.
+func newTaprootSigHashes(tx *wire.MsgTx, prevOuts []*wire.TxOut) *TaprootSigHashes {
+	if len(prevOuts) != len(tx.TxIn) {
+		return nil
+	}
+	var prevOutsBuf, amounts, scripts, sequences, outputs bytes.Buffer
+	var buf [8]byte
+	for i, in := range tx.TxIn {
+		if prevOuts[i] == nil {
+			return nil
+		}
+		prevOutsBuf.Write(in.PreviousOutPoint.Hash[:])
+		binary.LittleEndian.PutUint32(buf[:4], in.PreviousOutPoint.Index)
+		prevOutsBuf.Write(buf[:4])
+
+		binary.LittleEndian.PutUint64(buf[:], uint64(prevOuts[i].Value))
+		amounts.Write(buf[:])
+
+		wire.WriteVarBytes(&scripts, 0, prevOuts[i].PkScript)
+
+		binary.LittleEndian.PutUint32(buf[:4], in.Sequence)
+		sequences.Write(buf[:4])
+	}
+	for _, out := range tx.TxOut {
+		wire.WriteTxOut(&outputs, 0, 0, out)
+	}
+	return &TaprootSigHashes{
+		HashPrevOuts:      sha256.Sum256(prevOutsBuf.Bytes()),
+		HashAmounts:       sha256.Sum256(amounts.Bytes()),
+		HashScriptPubKeys: sha256.Sum256(scripts.Bytes()),
+		HashSequence:      sha256.Sum256(sequences.Bytes()),
+		HashOutputs:       sha256.Sum256(outputs.Bytes()),
+		PrevOuts:          prevOuts,
+	}
+}
+
+// isValidTaprootSigHashType returns true if the sighash type is defined for
+// taproot signatures.
+func isValidTaprootSigHashType(hashType params.SigHashType) bool {
+	switch hashType {
+	case params.SigHashDefault, params.SigHashAll, params.SigHashNone,
+		params.SigHashSingle, params.SigHashAll | params.SigHashAnyOneCanPay,
+		params.SigHashNone | params.SigHashAnyOneCanPay,
+		params.SigHashSingle | params.SigHashAnyOneCanPay:
+
+		return true
+	}
+	return false
+}
+
+// CalcTaprootSignatureHash returns the BIP0341 signature hash of an input
+// which spends a taproot output.  The sighashes must include the taproot
+// midstate, see NewTxSigHashesWithPrevOuts.  tapLeafHash is nil for a key path
+// spend and is the hash of the leaf script for a script path spend.
+func CalcTaprootSignatureHash(sigHashes *TxSigHashes, hashType params.SigHashType,
+	tx *wire.MsgTx, idx int, tapLeafHash *chainhash.Hash) ([]byte, er.R) {
+
+	if sigHashes == nil || sigHashes.Taproot == nil {
+		return nil, txscripterr.ScriptError(txscripterr.ErrTaprootMissingPrevOuts,
+			"the taproot sighashes require the spent outputs")
+	}
+	hash, err := calcTaprootSignatureHash(sigHashes.Taproot, hashType, tx,
+		idx, nil, tapLeafHash, noCodeSepPos)
+	if err != nil {
+		return nil, err
+	}
+	return hash[:], nil
+}
+
+// calcTaprootSignatureHash computes the signature hash which is defined in
+// BIP0341 and, if tapLeafHash is not nil, extended in BIP0342.
+func calcTaprootSignatureHash(sigHashes *TaprootSigHashes, hashType params.SigHashType,
+	tx *wire.MsgTx, idx int, annex []byte, tapLeafHash *chainhash.Hash,
+	codeSepPos uint32) (chainhash.Hash, er.R) {
+
+	msg, err := taprootSigMsg(sigHashes, hashType, tx, idx, annex,
+		tapLeafHash, codeSepPos)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	return taggedHash(tagTapSighash, msg), nil
+}
+
+// taprootSigMsg returns the message which is hashed by calcTaprootSignatureHash.
+func taprootSigMsg(sigHashes *TaprootSigHashes, hashType params.SigHashType,
+	tx *wire.MsgTx, idx int, annex []byte, tapLeafHash *chainhash.Hash,
+	codeSepPos uint32) ([]byte, er.R) {
+
+	if !isValidTaprootSigHashType(hashType) {
+		str := fmt.Sprintf("invalid taproot sighash type 0x%02x", hashType)
+		return nil, txscripterr.ScriptError(txscripterr.ErrTaprootSigHashType, str)
+	}
+	if idx < 0 || idx >= len(tx.TxIn) {
+		str := fmt.Sprintf("transaction input index %d is negative or "+
+			">= %d", idx, len(tx.TxIn))
+		return nil, txscripterr.ScriptError(txscripterr.ErrInvalidIndex, str)
+	}
+	outputType := hashType & params.SigHashSingle
+	anyoneCanPay := hashType&params.SigHashAnyOneCanPay != 0
+	if outputType == params.SigHashSingle && idx >= len(tx.TxOut) {
+		str := fmt.Sprintf("SigHashSingle input %d has no matching output", idx)
+		return nil, txscripterr.ScriptError(txscripterr.ErrTaprootSigHashType, str)
+	}
+
+	var msg bytes.Buffer
+	var buf [8]byte
+
+	// The sighash epoch, then the control data of the transaction.
+	msg.WriteByte(0x00)
+	msg.WriteByte(byte(hashType))
+	binary.LittleEndian.PutUint32(buf[:4], uint32(tx.Version))
+	msg.Write(buf[:4])
+	binary.LittleEndian.PutUint32(buf[:4], tx.LockTime)
+	msg.Write(buf[:4])
+
+	if !anyoneCanPay {
+		msg.Write(sigHashes.HashPrevOuts[:])
+		msg.Write(sigHashes.HashAmounts[:])
+		msg.Write(sigHashes.HashScriptPubKeys[:])
+		msg.Write(sigHashes.HashSequence[:])
+	}
+	if outputType != params.SigHashNone && outputType != params.SigHashSingle {
+		msg.Write(sigHashes.HashOutputs[:])
+	}
+
+	// Data about the input which is being signed.
+	spendType := byte(0)
+	if tapLeafHash != nil {
+		spendType |= 2
+	}
+	if annex != nil {
+		spendType |= 1
+	}
+	msg.WriteByte(spendType)
+	if anyoneCanPay {
+		txIn := tx.TxIn[idx]
+		msg.Write(txIn.PreviousOutPoint.Hash[:])
+		binary.LittleEndian.PutUint32(buf[:4], txIn.PreviousOutPoint.Index)
+		msg.Write(buf[:4])
+		prevOut := sigHashes.PrevOuts[idx]
+		binary.LittleEndian.PutUint64(buf[:], uint64(prevOut.Value))
+		msg.Write(buf[:])
+		wire.WriteVarBytes(&msg, 0, prevOut.PkScript)
+		binary.LittleEndian.PutUint32(buf[:4], txIn.Sequence)
+		msg.Write(buf[:4])
+	} else {
+		binary.LittleEndian.PutUint32(buf[:4], uint32(idx))
+		msg.Write(buf[:4])
+	}
+	if annex != nil {
+		var b bytes.Buffer
+		wire.WriteVarBytes(&b, 0, annex)
+		h := sha256.Sum256(b.Bytes())
+		msg.Write(h[:])
+	}
+
+	// Data about the output which is being signed.
+	if outputType == params.SigHashSingle {
+		var b bytes.Buffer
+		wire.WriteTxOut(&b, 0, 0, tx.TxOut[idx])
+		h := sha256.Sum256(b.Bytes())
+		msg.Write(h[:])
+	}
+
+	// The tapscript extension.
+	if tapLeafHash != nil {
+		msg.Write(tapLeafHash[:])
+		msg.WriteByte(0x00) // key_version
+		binary.LittleEndian.PutUint32(buf[:4], codeSepPos)
+		msg.Write(buf[:4])
+	}
+
+	return msg.Bytes(), nil
+}
@@ -0,0 +1,812 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package txscript
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pkt-cash/pktd/btcec"
+	"github.com/pkt-cash/pktd/btcutil"
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/txscript/opcode"
+	"github.com/pkt-cash/pktd/txscript/params"
+	"github.com/pkt-cash/pktd/txscript/scriptbuilder"
+	"github.com/pkt-cash/pktd/txscript/txscripterr"
+	"github.com/pkt-cash/pktd/wire"
+	"github.com/pkt-cash/pktd/wire/constants"
+)
+
+// taprootTestFlags are the script flags which enable taproot validation.
+const taprootTestFlags = ScriptBip16 | ScriptVerifyWitness | ScriptVerifyTaproot
+
+// taprootTestTree is a taproot script tree, it is a leaf when left and right
+// are nil.
+type taprootTestTree struct {
+	leafVersion byte
+	script      string
+	left, right *taprootTestTree
+}
+
+// taprootTestLeaf returns a leaf with the base leaf version.
+func taprootTestLeaf(script string) *taprootTestTree {
+	return &taprootTestTree{leafVersion: params.BaseLeafVersion, script: script}
+}
+
+// taprootTreeLeaves returns the merkle root of a script tree along with its
+// leaves, depth first, and the merkle path of each of them.
+func taprootTreeLeaves(tree *taprootTestTree) (chainhash.Hash, []*taprootTestTree, [][]byte) {
+	if tree.left == nil {
+		leafHash := TapLeafHash(tree.leafVersion, hexToBytes(tree.script))
+		return leafHash, []*taprootTestTree{tree}, [][]byte{nil}
+	}
+	left, leftLeaves, leftPaths := taprootTreeLeaves(tree.left)
+	right, rightLeaves, rightPaths := taprootTreeLeaves(tree.right)
+	for i := range leftPaths {
+		leftPaths[i] = append(leftPaths[i], right[:]...)
+	}
+	for i := range rightPaths {
+		rightPaths[i] = append(rightPaths[i], left[:]...)
+	}
+	return TapBranchHash(left[:], right[:]), append(leftLeaves, rightLeaves...),
+		append(leftPaths, rightPaths...)
+}
+
+// TestTaprootScriptPubKeyVectors ensures that the leaf hashes, merkle roots,
+// output keys, control blocks and addresses match the scriptPubKey test
+// vectors of BIP0341.
+func TestTaprootScriptPubKeyVectors(t *testing.T) {
+	tests := []struct {
+		name          string
+		internalKey   string
+		scriptTree    *taprootTestTree
+		leafHashes    []string
+		merkleRoot    string
+		outputKey     string
+		controlBlocks []string
+		address       string
+	}{
+		{
+			name:        "no script tree",
+			internalKey: "d6889cb081036e0faefa3a35157ad71086b123b2b144b649798b494c300a961d",
+			outputKey:   "53a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343",
+			address:     "bc1p2wsldez5mud2yam29q22wgfh9439spgduvct83k3pm50fcxa5dps59h4z5",
+		},
+		{
+			name:        "single leaf",
+			internalKey: "187791b6f712a8ea41c8ecdd0ee77fab3e85263b37e1ec18a3651926b3a6cf27",
+			scriptTree:  taprootTestLeaf("20d85a959b0290bf19bb89ed43c916be835475d013da4b362117393e25a48229b8ac"),
+			leafHashes:  []string{"5b75adecf53548f3ec6ad7d78383bf84cc57b55a3127c72b9a2481752dd88b21"},
+			merkleRoot:  "5b75adecf53548f3ec6ad7d78383bf84cc57b55a3127c72b9a2481752dd88b21",
+			outputKey:   "147c9c57132f6e7ecddba9800bb0c4449251c92a1e60371ee77557b6620f3ea3",
+			controlBlocks: []string{
+				"c1187791b6f712a8ea41c8ecdd0ee77fab3e85263b37e1ec18a3651926b3a6cf27",
+			},
+			address: "bc1pz37fc4cn9ah8anwm4xqqhvxygjf9rjf2resrw8h8w4tmvcs0863sa2e586",
+		},
+		{
+			name:        "single leaf, even output key",
+			internalKey: "93478e9488f956df2396be2ce6c5cced75f900dfa18e7dabd2428aae78451820",
+			scriptTree:  taprootTestLeaf("20b617298552a72ade070667e86ca63b8f5789a9fe8731ef91202a91c9f3459007ac"),
+			leafHashes:  []string{"c525714a7f49c28aedbbba78c005931a81c234b2f6c99a73e4d06082adc8bf2b"},
+			merkleRoot:  "c525714a7f49c28aedbbba78c005931a81c234b2f6c99a73e4d06082adc8bf2b",
+			outputKey:   "e4d810fd50586274face62b8a807eb9719cef49c04177cc6b76a9a4251d5450e",
+			controlBlocks: []string{
+				"c093478e9488f956df2396be2ce6c5cced75f900dfa18e7dabd2428aae78451820",
+			},
+			address: "bc1punvppl2stp38f7kwv2u2spltjuvuaayuqsthe34hd2dyy5w4g58qqfuag5",
+		},
+		{
+			name:        "two leaves, unknown leaf version",
+			internalKey: "ee4fe085983462a184015d1f782d6a5f8b9c2b60130aff050ce221ecf3786592",
+			scriptTree: &taprootTestTree{
+				left: taprootTestLeaf("20387671353e273264c495656e27e39ba899ea8fee3bb69fb2a680e22093447d48ac"),
+				right: &taprootTestTree{
+					leafVersion: 0xfa,
+					script:      "06424950333431",
+				},
+			},
+			leafHashes: []string{
+				"8ad69ec7cf41c2a4001fd1f738bf1e505ce2277acdcaa63fe4765192497f47a7",
+				"f224a923cd0021ab202ab139cc56802ddb92dcfc172b9212261a539df79a112a",
+			},
+			merkleRoot: "6c2dc106ab816b73f9d07e3cd1ef2c8c1256f519748e0813e4edd2405d277bef",
+			outputKey:  "712447206d7a5238acc7ff53fbe94a3b64539ad291c7cdbc490b7577e4b17df5",
+			address:    "bc1pwyjywgrd0ffr3tx8laflh6228dj98xkjj8rum0zfpd6h0e930h6saqxrrm",
+		},
+		{
+			name:        "two leaves",
+			internalKey: "f9f400803e683727b14f463836e1e78e1c64417638aa066919291a225f0e8dd8",
+			scriptTree: &taprootTestTree{
+				left:  taprootTestLeaf("2044b178d64c32c4a05cc4f4d1407268f764c940d20ce97abfd44db5c3592b72fdac"),
+				right: taprootTestLeaf("07546170726f6f74"),
+			},
+			leafHashes: []string{
+				"64512fecdb5afa04f98839b50e6f0cb7b1e539bf6f205f67934083cdcc3c8d89",
+				"2cb2b90daa543b544161530c925f285b06196940d6085ca9474d41dc3822c5cb",
+			},
+			merkleRoot: "ab179431c28d3b68fb798957faf5497d69c883c6fb1e1cd9f81483d87bac90cc",
+			outputKey:  "77e30a5522dd9f894c3f8b8bd4c4b2cf82ca7da8a3ea6a239655c39c050ab220",
+			address:    "bc1pwl3s54fzmk0cjnpl3w9af39je7pv5ldg504x5guk2hpecpg2kgsqaqstjq",
+		},
+		{
+			name:        "three leaves",
+			internalKey: "e0dfe2300b0dd746a3f8674dfd4525623639042569d829c7f0eed9602d263e6f",
+			scriptTree: &taprootTestTree{
+				left: taprootTestLeaf("2072ea6adcf1d371dea8fba1035a09f3d24ed5a059799bae114084130ee5898e69ac"),
+				right: &taprootTestTree{
+					left:  taprootTestLeaf("202352d137f2f3ab38d1eaa976758873377fa5ebb817372c71e2c542313d4abda8ac"),
+					right: taprootTestLeaf("207337c0dd4253cb86f2c43a2351aadd82cccb12a172cd120452b9bb8324f2186aac"),
+				},
+			},
+			leafHashes: []string{
+				"2645a02e0aac1fe69d69755733a9b7621b694bb5b5cde2bbfc94066ed62b9817",
+				"ba982a91d4fc552163cb1c0da03676102d5b7a014304c01f0c77b2b8e888de1c",
+				"9e31407bffa15fefbf5090b149d53959ecdf3f62b1246780238c24501d5ceaf6",
+			},
+			merkleRoot: "ccbd66c6f7e8fdab47b3a486f59d28262be857f30d4773f2d5ea47f7761ce0e2",
+			outputKey:  "91b64d5324723a985170e4dc5a0f84c041804f2cd12660fa5dec09fc21783605",
+			address:    "bc1pjxmy65eywgafs5tsunw95ruycpqcqnev6ynxp7jaasylcgtcxczs6n332e",
+		},
+		{
+			name:        "three leaves, odd output key",
+			internalKey: "55adf4e8967fbd2e29f20ac896e60c3b0f1d5b0efa9d34941b5958c7b0a0312d",
+			scriptTree: &taprootTestTree{
+				left: taprootTestLeaf("2071981521ad9fc9036687364118fb6ccd2035b96a423c59c5430e98310a11abe2ac"),
+				right: &taprootTestTree{
+					left:  taprootTestLeaf("20d5094d2dbe9b76e2c245a2b89b6006888952e2faa6a149ae318d69e520617748ac"),
+					right: taprootTestLeaf("20c440b462ad48c7a77f94cd4532d8f2119dcebbd7c9764557e62726419b08ad4cac"),
+				},
+			},
+			leafHashes: []string{
+				"f154e8e8e17c31d3462d7132589ed29353c6fafdb884c5a6e04ea938834f0d9d",
+				"737ed1fe30bc42b8022d717b44f0d93516617af64a64753b7a06bf16b26cd711",
+				"d7485025fceb78b9ed667db36ed8b8dc7b1f0b307ac167fa516fe4352b9f4ef7",
+			},
+			merkleRoot: "2f6b2c5397b6d68ca18e09a3f05161668ffe93a988582d55c6f07bd5b3329def",
+			outputKey:  "75169f4001aa68f15bbed28b218df1d0a62cbbcf1188c6665110c293c907b831",
+			address:    "bc1pw5tf7sqp4f50zka7629jrr036znzew70zxyvvej3zrpf8jg8hqcssyuewe",
+		},
+	}
+
+	for _, test := range tests {
+		internalKey := hexToBytes(test.internalKey)
+		var merkleRoot []byte
+		var leaves []*taprootTestTree
+		var paths [][]byte
+		if test.scriptTree != nil {
+			var root chainhash.Hash
+			root, leaves, paths = taprootTreeLeaves(test.scriptTree)
+			if hex.EncodeToString(root[:]) != test.merkleRoot {
+				t.Errorf("%s: merkle root %x, want %s", test.name,
+					root[:], test.merkleRoot)
+				continue
+			}
+			merkleRoot = root[:]
+		}
+
+		outputKey, oddY, err := ComputeTaprootOutputKey(internalKey, merkleRoot)
+		if err != nil {
+			t.Errorf("%s: ComputeTaprootOutputKey: %v", test.name, err)
+			continue
+		}
+		if hex.EncodeToString(outputKey) != test.outputKey {
+			t.Errorf("%s: output key %x, want %s", test.name,
+				outputKey, test.outputKey)
+			continue
+		}
+
+		for i, leaf := range leaves {
+			leafHash := TapLeafHash(leaf.leafVersion, hexToBytes(leaf.script))
+			if hex.EncodeToString(leafHash[:]) != test.leafHashes[i] {
+				t.Errorf("%s: leaf %d hash %x, want %s", test.name, i,
+					leafHash[:], test.leafHashes[i])
+				continue
+			}
+			controlBlock := append(taprootControlBlock(leaf.leafVersion,
+				internalKey, oddY), paths[i]...)
+			if test.controlBlocks != nil &&
+				hex.EncodeToString(controlBlock) != test.controlBlocks[i] {
+
+				t.Errorf("%s: leaf %d control block %x, want %s",
+					test.name, i, controlBlock, test.controlBlocks[i])
+			}
+			err := verifyTaprootCommitment(controlBlock, outputKey, leafHash)
+			if err != nil {
+				t.Errorf("%s: leaf %d: verifyTaprootCommitment: %v",
+					test.name, i, err)
+			}
+		}
+
+		addr, err := btcutil.NewAddressTaproot(outputKey,
+			&chaincfg.MainNetParams)
+		if err != nil {
+			t.Errorf("%s: NewAddressTaproot: %v", test.name, err)
+			continue
+		}
+		if addr.EncodeAddress() != test.address {
+			t.Errorf("%s: address %s, want %s", test.name,
+				addr.EncodeAddress(), test.address)
+		}
+		script, err := PayToAddrScript(addr)
+		if err != nil {
+			t.Errorf("%s: PayToAddrScript: %v", test.name, err)
+			continue
+		}
+		if want := append([]byte{opcode.OP_1, opcode.OP_DATA_32},
+			outputKey...); !bytes.Equal(script, want) {
+
+			t.Errorf("%s: script %x, want %x", test.name, script, want)
+		}
+	}
+}
+
+// TestTaprootKeyPathSpendingVectors ensures that the signature hashes and the
+// signatures match the keyPathSpending test vectors of BIP0341.
+func TestTaprootKeyPathSpendingVectors(t *testing.T) {
+	const rawUnsignedTx = "02000000097de20cbff686da83a54981d2b9bab3586f4ca7e48f57f5b55963115f3b334e9c010000000000000000d7b7cab57b1393ace2d064f4d4a2cb8af6def61273e127517d44759b6dafdd990000000000fffffffff8e1f583384333689228c5d28eac13366be082dc57441760d957275419a418420000000000fffffffff0689180aa63b30cb162a73c6d2a38b7eeda2a83ece74310fda0843ad604853b0100000000feffffffaa5202bdf6d8ccd2ee0f0202afbbb7461d9264a25e5bfd3c5a52ee1239e0ba6c0000000000feffffff956149bdc66faa968eb2be2d2faa29718acbfe3941215893a2a3446d32acd050000000000000000000e664b9773b88c09c32cb70a2a3e4da0ced63b7ba3b22f848531bbb1d5d5f4c94010000000000000000e9aa6b8e6c9de67619e6a3924ae25696bb7b694bb677a632a74ef7eadfd4eabf0000000000ffffffffa778eb6a263dc090464cd125c466b5a99667720b1c110468831d058aa1b82af10100000000ffffffff0200ca9a3b000000001976a91406afd46bcdfd22ef94ac122aa11f241244a37ecc88ac807840cb0000000020ac9a87f5594be208f8532db38cff670c450ed2fea8fcdefcc9a663f78bab962b0065cd1d"
+	utxosSpent := []struct {
+		scriptPubKey string
+		amount       int64
+	}{
+		{"512053a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343", 420000000},
+		{"5120147c9c57132f6e7ecddba9800bb0c4449251c92a1e60371ee77557b6620f3ea3", 462000000},
+		{"76a914751e76e8199196d454941c45d1b3a323f1433bd688ac", 294000000},
+		{"5120e4d810fd50586274face62b8a807eb9719cef49c04177cc6b76a9a4251d5450e", 504000000},
+		{"512091b64d5324723a985170e4dc5a0f84c041804f2cd12660fa5dec09fc21783605", 630000000},
+		{"00147dd65592d0ab2fe0d0257d571abf032cd9db93dc", 378000000},
+		{"512075169f4001aa68f15bbed28b218df1d0a62cbbcf1188c6665110c293c907b831", 672000000},
+		{"5120712447206d7a5238acc7ff53fbe94a3b64539ad291c7cdbc490b7577e4b17df5", 546000000},
+		{"512077e30a5522dd9f894c3f8b8bd4c4b2cf82ca7da8a3ea6a239655c39c050ab220", 588000000},
+	}
+
+	tx := wire.NewMsgTx(2)
+	if err := tx.Deserialize(bytes.NewReader(hexToBytes(rawUnsignedTx))); err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+	prevOuts := make([]*wire.TxOut, 0, len(utxosSpent))
+	for _, utxo := range utxosSpent {
+		prevOuts = append(prevOuts, wire.NewTxOut(utxo.amount,
+			hexToBytes(utxo.scriptPubKey)))
+	}
+	sigHashes := NewTxSigHashesWithPrevOuts(tx, prevOuts)
+
+	intermediary := []struct {
+		name string
+		hash chainhash.Hash
+		want string
+	}{
+		{"hashAmounts", sigHashes.Taproot.HashAmounts, "58a6964a4f5f8f0b642ded0a8a553be7622a719da71d1f5befcefcdee8e0fde6"},
+		{"hashOutputs", sigHashes.Taproot.HashOutputs, "a2e6dab7c1f0dcd297c8d61647fd17d821541ea69c3cc37dcbad7f90d4eb4bc5"},
+		{"hashPrevouts", sigHashes.Taproot.HashPrevOuts, "e3b33bb4ef3a52ad1fffb555c0d82828eb22737036eaeb02a235d82b909c4c3f"},
+		{"hashScriptPubkeys", sigHashes.Taproot.HashScriptPubKeys, "23ad0f61ad2bca5ba6a7693f50fce988e17c3780bf2b1e720cfbb38fbdd52e21"},
+		{"hashSequences", sigHashes.Taproot.HashSequence, "18959c7221ab5ce9e26c3cd67b22c24f8baa54bac281d8e6b05e400e6c3a957e"},
+	}
+	for _, test := range intermediary {
+		if hex.EncodeToString(test.hash[:]) != test.want {
+			t.Errorf("%s: %x, want %s", test.name, test.hash[:],
+				test.want)
+		}
+	}
+
+	tests := []struct {
+		txinIndex       int
+		internalPrivkey string
+		merkleRoot      string
+		hashType        params.SigHashType
+		tweakedPrivkey  string
+		sigMsg          string
+		sigHash         string
+		witness         string
+	}{
+		{
+			txinIndex:       0,
+			internalPrivkey: "6b973d88838f27366ed61c9ad6367663045cb456e28335c109e30717ae0c6baa",
+			hashType:        params.SigHashSingle,
+			tweakedPrivkey:  "2405b971772ad26915c8dcdf10f238753a9b837e5f8e6a86fd7c0cce5b7296d9",
+			sigMsg:          "0003020000000065cd1de3b33bb4ef3a52ad1fffb555c0d82828eb22737036eaeb02a235d82b909c4c3f58a6964a4f5f8f0b642ded0a8a553be7622a719da71d1f5befcefcdee8e0fde623ad0f61ad2bca5ba6a7693f50fce988e17c3780bf2b1e720cfbb38fbdd52e2118959c7221ab5ce9e26c3cd67b22c24f8baa54bac281d8e6b05e400e6c3a957e0000000000d0418f0e9a36245b9a50ec87f8bf5be5bcae434337b87139c3a5b1f56e33cba0",
+			sigHash:         "2514a6272f85cfa0f45eb907fcb0d121b808ed37c6ea160a5a9046ed5526d555",
+			witness:         "ed7c1647cb97379e76892be0cacff57ec4a7102aa24296ca39af7541246d8ff14d38958d4cc1e2e478e4d4a764bbfd835b16d4e314b72937b29833060b87276c03",
+		},
+		{
+			txinIndex:       1,
+			internalPrivkey: "1e4da49f6aaf4e5cd175fe08a32bb5cb4863d963921255f33d3bc31e1343907f",
+			merkleRoot:      "5b75adecf53548f3ec6ad7d78383bf84cc57b55a3127c72b9a2481752dd88b21",
+			hashType:        params.SigHashSingle | params.SigHashAnyOneCanPay,
+			tweakedPrivkey:  "ea260c3b10e60f6de018455cd0278f2f5b7e454be1999572789e6a9565d26080",
+			sigMsg:          "0083020000000065cd1d00d7b7cab57b1393ace2d064f4d4a2cb8af6def61273e127517d44759b6dafdd9900000000808f891b00000000225120147c9c57132f6e7ecddba9800bb0c4449251c92a1e60371ee77557b6620f3ea3ffffffffffcef8fb4ca7efc5433f591ecfc57391811ce1e186a3793024def5c884cba51d",
+			sigHash:         "325a644af47e8a5a2591cda0ab0723978537318f10e6a63d4eed783b96a71a4d",
+			witness:         "052aedffc554b41f52b521071793a6b88d6dbca9dba94cf34c83696de0c1ec35ca9c5ed4ab28059bd606a4f3a657eec0bb96661d42921b5f50a95ad33675b54f83",
+		},
+		{
+			txinIndex:       3,
+			internalPrivkey: "d3c7af07da2d54f7a7735d3d0fc4f0a73164db638b2f2f7c43f711f6d4aa7e64",
+			merkleRoot:      "c525714a7f49c28aedbbba78c005931a81c234b2f6c99a73e4d06082adc8bf2b",
+			hashType:        params.SigHashAll,
+			tweakedPrivkey:  "97323385e57015b75b0339a549c56a948eb961555973f0951f555ae6039ef00d",
+			sigMsg:          "0001020000000065cd1de3b33bb4ef3a52ad1fffb555c0d82828eb22737036eaeb02a235d82b909c4c3f58a6964a4f5f8f0b642ded0a8a553be7622a719da71d1f5befcefcdee8e0fde623ad0f61ad2bca5ba6a7693f50fce988e17c3780bf2b1e720cfbb38fbdd52e2118959c7221ab5ce9e26c3cd67b22c24f8baa54bac281d8e6b05e400e6c3a957ea2e6dab7c1f0dcd297c8d61647fd17d821541ea69c3cc37dcbad7f90d4eb4bc50003000000",
+			sigHash:         "bf013ea93474aa67815b1b6cc441d23b64fa310911d991e713cd34c7f5d46669",
+			witness:         "ff45f742a876139946a149ab4d9185574b98dc919d2eb6754f8abaa59d18b025637a3aa043b91817739554f4ed2026cf8022dbd83e351ce1fabc272841d2510a01",
+		},
+		{
+			txinIndex:       4,
+			internalPrivkey: "f36bb07a11e469ce941d16b63b11b9b9120a84d9d87cff2c84a8d4affb438f4e",
+			merkleRoot:      "ccbd66c6f7e8fdab47b3a486f59d28262be857f30d4773f2d5ea47f7761ce0e2",
+			hashType:        params.SigHashDefault,
+			tweakedPrivkey:  "a8e7aa924f0d58854185a490e6c41f6efb7b675c0f3331b7f14b549400b4d501",
+			sigMsg:          "0000020000000065cd1de3b33bb4ef3a52ad1fffb555c0d82828eb22737036eaeb02a235d82b909c4c3f58a6964a4f5f8f0b642ded0a8a553be7622a719da71d1f5befcefcdee8e0fde623ad0f61ad2bca5ba6a7693f50fce988e17c3780bf2b1e720cfbb38fbdd52e2118959c7221ab5ce9e26c3cd67b22c24f8baa54bac281d8e6b05e400e6c3a957ea2e6dab7c1f0dcd297c8d61647fd17d821541ea69c3cc37dcbad7f90d4eb4bc50004000000",
+			sigHash:         "4f900a0bae3f1446fd48490c2958b5a023228f01661cda3496a11da502a7f7ef",
+			witness:         "b4010dd48a617db09926f729e79c33ae0b4e94b79f04a1ae93ede6315eb3669de185a17d2b0ac9ee09fd4c64b678a0b61a0a86fa888a273c8511be83bfd6810f",
+		},
+		{
+			txinIndex:       6,
+			internalPrivkey: "415cfe9c15d9cea27d8104d5517c06e9de48e2f986b695e4f5ffebf230e725d8",
+			merkleRoot:      "2f6b2c5397b6d68ca18e09a3f05161668ffe93a988582d55c6f07bd5b3329def",
+			hashType:        params.SigHashNone,
+			tweakedPrivkey:  "241c14f2639d0d7139282aa6abde28dd8a067baa9d633e4e7230287ec2d02901",
+			sigMsg:          "0002020000000065cd1de3b33bb4ef3a52ad1fffb555c0d82828eb22737036eaeb02a235d82b909c4c3f58a6964a4f5f8f0b642ded0a8a553be7622a719da71d1f5befcefcdee8e0fde623ad0f61ad2bca5ba6a7693f50fce988e17c3780bf2b1e720cfbb38fbdd52e2118959c7221ab5ce9e26c3cd67b22c24f8baa54bac281d8e6b05e400e6c3a957e0006000000",
+			sigHash:         "15f25c298eb5cdc7eb1d638dd2d45c97c4c59dcaec6679cfc16ad84f30876b85",
+			witness:         "a3785919a2ce3c4ce26f298c3d51619bc474ae24014bcdd31328cd8cfbab2eff3395fa0a16fe5f486d12f22a9cedded5ae74feb4bbe5351346508c5405bcfee002",
+		},
+		{
+			txinIndex:       7,
+			internalPrivkey: "c7b0e81f0a9a0b0499e112279d718cca98e79a12e2f137c72ae5b213aad0d103",
+			merkleRoot:      "6c2dc106ab816b73f9d07e3cd1ef2c8c1256f519748e0813e4edd2405d277bef",
+			hashType:        params.SigHashNone | params.SigHashAnyOneCanPay,
+			tweakedPrivkey:  "65b6000cd2bfa6b7cf736767a8955760e62b6649058cbc970b7c0871d786346b",
+			sigMsg:          "0082020000000065cd1d00e9aa6b8e6c9de67619e6a3924ae25696bb7b694bb677a632a74ef7eadfd4eabf00000000804c8b2000000000225120712447206d7a5238acc7ff53fbe94a3b64539ad291c7cdbc490b7577e4b17df5ffffffff",
+			sigHash:         "cd292de50313804dabe4685e83f923d2969577191a3e1d2882220dca88cbeb10",
+			witness:         "ea0c6ba90763c2d3a296ad82ba45881abb4f426b3f87af162dd24d5109edc1cdd11915095ba47c3a9963dc1e6c432939872bc49212fe34c632cd3ab9fed429c482",
+		},
+		{
+			txinIndex:       8,
+			internalPrivkey: "77863416be0d0665e517e1c375fd6f75839544eca553675ef7fdf4949518ebaa",
+			merkleRoot:      "ab179431c28d3b68fb798957faf5497d69c883c6fb1e1cd9f81483d87bac90cc",
+			hashType:        params.SigHashAll | params.SigHashAnyOneCanPay,
+			tweakedPrivkey:  "ec18ce6af99f43815db543f47b8af5ff5df3b2cb7315c955aa4a86e8143d2bf5",
+			sigMsg:          "0081020000000065cd1da2e6dab7c1f0dcd297c8d61647fd17d821541ea69c3cc37dcbad7f90d4eb4bc500a778eb6a263dc090464cd125c466b5a99667720b1c110468831d058aa1b82af101000000002b0c230000000022512077e30a5522dd9f894c3f8b8bd4c4b2cf82ca7da8a3ea6a239655c39c050ab220ffffffff",
+			sigHash:         "cccb739eca6c13a8a89e6e5cd317ffe55669bbda23f2fd37b0f18755e008edd2",
+			witness:         "bbc9584a11074e83bc8c6759ec55401f0ae7b03ef290c3139814f545b58a9f8127258000874f44bc46db7646322107d4d86aec8e73b8719a61fff761d75b5dd981",
+		},
+	}
+
+	for _, test := range tests {
+		internalPrivkey, _ := btcec.PrivKeyFromBytes(btcec.S256(),
+			hexToBytes(test.internalPrivkey))
+		var merkleRoot []byte
+		if test.merkleRoot != "" {
+			merkleRoot = hexToBytes(test.merkleRoot)
+		}
+		tweakedPrivkey, err := TweakTaprootPrivKey(internalPrivkey, merkleRoot)
+		if err != nil {
+			t.Errorf("input %d: TweakTaprootPrivKey: %v", test.txinIndex,
+				err)
+			continue
+		}
+		if hex.EncodeToString(tweakedPrivkey.Serialize()) != test.tweakedPrivkey {
+			t.Errorf("input %d: tweaked private key %x, want %s",
+				test.txinIndex, tweakedPrivkey.Serialize(),
+				test.tweakedPrivkey)
+			continue
+		}
+
+		sigMsg, err := taprootSigMsg(sigHashes.Taproot, test.hashType, tx,
+			test.txinIndex, nil, nil, 0)
+		if err != nil {
+			t.Errorf("input %d: taprootSigMsg: %v", test.txinIndex, err)
+			continue
+		}
+		if hex.EncodeToString(sigMsg) != test.sigMsg {
+			t.Errorf("input %d: sigMsg %x, want %s", test.txinIndex,
+				sigMsg, test.sigMsg)
+		}
+		sigHash, err := CalcTaprootSignatureHash(sigHashes, test.hashType,
+			tx, test.txinIndex, nil)
+		if err != nil {
+			t.Errorf("input %d: CalcTaprootSignatureHash: %v",
+				test.txinIndex, err)
+			continue
+		}
+		if hex.EncodeToString(sigHash[:]) != test.sigHash {
+			t.Errorf("input %d: sigHash %x, want %s", test.txinIndex,
+				sigHash[:], test.sigHash)
+		}
+
+		sig, err := RawTxInTaprootSignature(tx, sigHashes, test.txinIndex,
+			test.hashType, tweakedPrivkey, nil)
+		if err != nil {
+			t.Errorf("input %d: RawTxInTaprootSignature: %v",
+				test.txinIndex, err)
+			continue
+		}
+		if hex.EncodeToString(sig) != test.witness {
+			t.Errorf("input %d: signature %x, want %s", test.txinIndex,
+				sig, test.witness)
+			continue
+		}
+
+		// The signed input is valid.
+		tx.TxIn[test.txinIndex].Witness = wire.TxWitness{sig}
+		vm, err := NewEngine(prevOuts[test.txinIndex].PkScript, tx,
+			test.txinIndex, taprootTestFlags, nil, sigHashes,
+			prevOuts[test.txinIndex].Value)
+		if err == nil {
+			err = vm.Execute()
+		}
+		if err != nil {
+			t.Errorf("input %d: signed input is not valid: %v",
+				test.txinIndex, err)
+		}
+	}
+}
+
+// taprootControlBlock returns the control block of a script tree with a
+// single leaf, the merkle path of a deeper leaf is appended to it.
+func taprootControlBlock(leafVersion byte, internalKey []byte, oddY bool) []byte {
+	first := leafVersion
+	if oddY {
+		first |= 1
+	}
+	return append([]byte{first}, internalKey...)
+}
+
+// taprootTestKey returns a private key which is derived from a single byte.
+func taprootTestKey(b byte) *btcec.PrivateKey {
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(),
+		bytes.Repeat([]byte{b}, 32))
+	return priv
+}
+
+// taprootSpendTx returns a transaction which spends a single output with the
+// given pkScript, along with the sighashes which commit to that output.
+func taprootSpendTx(pkScript []byte) (*wire.MsgTx, *TxSigHashes) {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash:  chainhash.Hash{0x01},
+			Index: 1,
+		},
+		Sequence: constants.MaxTxInSequenceNum,
+	})
+	tx.AddTxOut(wire.NewTxOut(90000000, []byte{opcode.OP_TRUE}))
+	prevOuts := []*wire.TxOut{wire.NewTxOut(100000000, pkScript)}
+	return tx, NewTxSigHashesWithPrevOuts(tx, prevOuts)
+}
+
+// executeTaproot runs the engine for the single input of the transaction.
+func executeTaproot(pkScript []byte, tx *wire.MsgTx, flags ScriptFlags,
+	sigHashes *TxSigHashes) er.R {
+
+	vm, err := NewEngine(pkScript, tx, 0, flags, nil, sigHashes, 100000000)
+	if err != nil {
+		return err
+	}
+	return vm.Execute()
+}
+
+// TestTaprootKeyPath ensures that key path spends of a taproot output are
+// validated.
+func TestTaprootKeyPath(t *testing.T) {
+	priv := taprootTestKey(0x11)
+	outputKey, _, err := ComputeTaprootOutputKey(
+		SchnorrPubKeyBytes(priv.PubKey()), nil)
+	if err != nil {
+		t.Fatalf("ComputeTaprootOutputKey: %v", err)
+	}
+	pkScript, err := payToTaprootScript(outputKey)
+	if err != nil {
+		t.Fatalf("payToTaprootScript: %v", err)
+	}
+	tx, sigHashes := taprootSpendTx(pkScript)
+	tweaked, err := TweakTaprootPrivKey(priv, nil)
+	if err != nil {
+		t.Fatalf("TweakTaprootPrivKey: %v", err)
+	}
+
+	sign := func(hashType params.SigHashType) []byte {
+		witness, err := TaprootWitnessSignature(tx, sigHashes, 0,
+			hashType, priv)
+		if err != nil {
+			t.Fatalf("TaprootWitnessSignature: %v", err)
+		}
+		return witness[0]
+	}
+
+	// A signature which commits to an annex.
+	annex := []byte{params.TaprootAnnexTag, 0x01, 0x02}
+	annexHash, err := calcTaprootSignatureHash(sigHashes.Taproot,
+		params.SigHashDefault, tx, 0, annex, nil, noCodeSepPos)
+	if err != nil {
+		t.Fatalf("calcTaprootSignatureHash: %v", err)
+	}
+	annexSig, errr := tweaked.SchnorrSign(annexHash[:], make([]byte, 32))
+	if errr != nil {
+		t.Fatalf("SchnorrSign: %v", errr)
+	}
+
+	badSig := sign(params.SigHashDefault)
+	badSig[10] ^= 0x01
+	zeroTypeSig := append(sign(params.SigHashDefault), 0x00)
+
+	tests := []struct {
+		name      string
+		witness   wire.TxWitness
+		flags     ScriptFlags
+		sigHashes *TxSigHashes
+		err       *er.ErrorCode
+	}{
+		{
+			name:    "default sighash",
+			witness: wire.TxWitness{sign(params.SigHashDefault)},
+		},
+		{
+			name:    "sighash all",
+			witness: wire.TxWitness{sign(params.SigHashAll)},
+		},
+		{
+			name:    "sighash single anyonecanpay",
+			witness: wire.TxWitness{sign(params.SigHashSingle | params.SigHashAnyOneCanPay)},
+		},
+		{
+			name:    "annex",
+			witness: wire.TxWitness{annexSig[:], annex},
+		},
+		{
+			name:    "annex which is not signed",
+			witness: wire.TxWitness{sign(params.SigHashDefault), annex},
+			err:     txscripterr.ErrTaprootSigInvalid,
+		},
+		{
+			name:    "bad signature",
+			witness: wire.TxWitness{badSig},
+			err:     txscripterr.ErrTaprootSigInvalid,
+		},
+		{
+			name:    "explicit default sighash type",
+			witness: wire.TxWitness{zeroTypeSig},
+			err:     txscripterr.ErrTaprootSigHashType,
+		},
+		{
+			name:    "short signature",
+			witness: wire.TxWitness{badSig[:63]},
+			err:     txscripterr.ErrTaprootSigSize,
+		},
+		{
+			name:      "missing prevouts",
+			witness:   wire.TxWitness{sign(params.SigHashDefault)},
+			sigHashes: NewTxSigHashes(tx),
+			err:       txscripterr.ErrTaprootMissingPrevOuts,
+		},
+		{
+			name:    "taproot is not active",
+			witness: wire.TxWitness{badSig},
+			flags:   ScriptBip16 | ScriptVerifyWitness,
+		},
+		{
+			name:    "taproot is not active, upgradable witness program discouraged",
+			witness: wire.TxWitness{badSig},
+			flags: ScriptBip16 | ScriptVerifyWitness |
+				ScriptVerifyDiscourageUpgradeableWitnessProgram,
+			err: txscripterr.ErrDiscourageUpgradableWitnessProgram,
+		},
+	}
+
+	for _, test := range tests {
+		flags := test.flags
+		if flags == 0 {
+			flags = taprootTestFlags
+		}
+		hashes := test.sigHashes
+		if hashes == nil {
+			hashes = sigHashes
+		}
+		tx.TxIn[0].Witness = test.witness
+		err := executeTaproot(pkScript, tx, flags, hashes)
+		if test.err == nil {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			}
+			continue
+		}
+		if !test.err.Is(err) {
+			t.Errorf("%s: expected %v, got %v", test.name,
+				test.err, err)
+		}
+	}
+}
+
+// TestTaprootScriptPath ensures that script path spends of a taproot output
+// are validated, including the tapscript changes of BIP0342.
+func TestTaprootScriptPath(t *testing.T) {
+	internal := taprootTestKey(0x21)
+	internalKey := SchnorrPubKeyBytes(internal.PubKey())
+	key1 := taprootTestKey(0x22)
+	key2 := taprootTestKey(0x23)
+	pk1 := SchnorrPubKeyBytes(key1.PubKey())
+	pk2 := SchnorrPubKeyBytes(key2.PubKey())
+
+	mustScript := func(b *scriptbuilder.ScriptBuilder) []byte {
+		script, err := b.Script()
+		if err != nil {
+			t.Fatalf("unable to build script: %v", err)
+		}
+		return script
+	}
+	checkSig := mustScript(scriptbuilder.NewScriptBuilder().
+		AddData(pk1).AddOp(opcode.OP_CHECKSIG))
+	checkSigAdd := mustScript(scriptbuilder.NewScriptBuilder().
+		AddData(pk1).AddOp(opcode.OP_CHECKSIG).
+		AddData(pk2).AddOp(opcode.OP_CHECKSIGADD).
+		AddInt64(2).AddOp(opcode.OP_NUMEQUAL))
+	checkMultiSig := mustScript(scriptbuilder.NewScriptBuilder().
+		AddInt64(1).AddData(pk1).AddInt64(1).
+		AddOp(opcode.OP_CHECKMULTISIG))
+	unknownKeyType := mustScript(scriptbuilder.NewScriptBuilder().
+		AddData(append([]byte{0x01}, pk1...)).AddOp(opcode.OP_CHECKSIG))
+	opSuccess := []byte{opcode.OP_FALSE, 0x50}
+
+	// spend returns the pkScript of an output whose script tree has the
+	// single leaf and the witness which spends it, sigs are created for
+	// the given keys.
+	spend := func(leafVersion byte, script []byte,
+		keys ...*btcec.PrivateKey) ([]byte, wire.TxWitness) {
+
+		leafHash := TapLeafHash(leafVersion, script)
+		outputKey, oddY, err := ComputeTaprootOutputKey(internalKey,
+			leafHash[:])
+		if err != nil {
+			t.Fatalf("ComputeTaprootOutputKey: %v", err)
+		}
+		pkScript, err := payToTaprootScript(outputKey)
+		if err != nil {
+			t.Fatalf("payToTaprootScript: %v", err)
+		}
+		tx, sigHashes := taprootSpendTx(pkScript)
+		var witness wire.TxWitness
+		for _, key := range keys {
+			sig, err := RawTxInTaprootSignature(tx, sigHashes, 0,
+				params.SigHashDefault, key, &leafHash)
+			if err != nil {
+				t.Fatalf("RawTxInTaprootSignature: %v", err)
+			}
+			witness = append(witness, sig)
+		}
+		witness = append(witness, script,
+			taprootControlBlock(leafVersion, internalKey, oddY))
+		return pkScript, witness
+	}
+
+	tests := []struct {
+		name        string
+		leafVersion byte
+		script      []byte
+		keys        []*btcec.PrivateKey
+		mutate      func(wire.TxWitness) wire.TxWitness
+		flags       ScriptFlags
+		err         *er.ErrorCode
+	}{
+		{
+			name:   "checksig",
+			script: checkSig,
+			keys:   []*btcec.PrivateKey{key1},
+		},
+		{
+			name:   "checksig with the wrong key",
+			script: checkSig,
+			keys:   []*btcec.PrivateKey{key2},
+			err:    txscripterr.ErrTaprootSigInvalid,
+		},
+		{
+			name:   "checksigadd 2 of 2",
+			script: checkSigAdd,
+			keys:   []*btcec.PrivateKey{key2, key1},
+		},
+		{
+			name:   "checksigadd with an empty signature",
+			script: checkSigAdd,
+			keys:   []*btcec.PrivateKey{key2, key1},
+			mutate: func(w wire.TxWitness) wire.TxWitness {
+				w[0] = nil
+				return w
+			},
+			err: txscripterr.ErrEvalFalse,
+		},
+		{
+			name:   "checkmultisig is disabled",
+			script: checkMultiSig,
+			keys:   []*btcec.PrivateKey{key1},
+			mutate: func(w wire.TxWitness) wire.TxWitness {
+				// CHECKMULTISIG pops an extra element.
+				return append(wire.TxWitness{nil}, w...)
+			},
+			err: txscripterr.ErrTapscriptCheckMultiSig,
+		},
+		{
+			name:   "unknown public key type",
+			script: unknownKeyType,
+			keys:   []*btcec.PrivateKey{key1},
+		},
+		{
+			name:   "unknown public key type discouraged",
+			script: unknownKeyType,
+			keys:   []*btcec.PrivateKey{key1},
+			flags:  taprootTestFlags | ScriptVerifyDiscourageUpgradablePubKeyType,
+			err:    txscripterr.ErrDiscourageUpgradablePubKeyType,
+		},
+		{
+			name:   "op_success",
+			script: opSuccess,
+		},
+		{
+			name:   "op_success discouraged",
+			script: opSuccess,
+			flags:  taprootTestFlags | ScriptVerifyDiscourageOpSuccess,
+			err:    txscripterr.ErrDiscourageOpSuccess,
+		},
+		{
+			name:        "unknown leaf version",
+			leafVersion: 0xc2,
+			script:      []byte{opcode.OP_FALSE},
+		},
+		{
+			name:        "unknown leaf version discouraged",
+			leafVersion: 0xc2,
+			script:      []byte{opcode.OP_FALSE},
+			flags:       taprootTestFlags | ScriptVerifyDiscourageUpgradableTaprootVersion,
+			err:         txscripterr.ErrDiscourageUpgradableTaprootVersion,
+		},
+		{
+			name:   "wrong parity in the control block",
+			script: checkSig,
+			keys:   []*btcec.PrivateKey{key1},
+			mutate: func(w wire.TxWitness) wire.TxWitness {
+				w[len(w)-1][0] ^= 0x01
+				return w
+			},
+			err: txscripterr.ErrTaprootMerkleMismatch,
+		},
+		{
+			name:   "bad control block size",
+			script: checkSig,
+			keys:   []*btcec.PrivateKey{key1},
+			mutate: func(w wire.TxWitness) wire.TxWitness {
+				w[len(w)-1] = append(w[len(w)-1], 0x00)
+				return w
+			},
+			err: txscripterr.ErrTaprootControlBlockSize,
+		},
+	}
+
+	for _, test := range tests {
+		leafVersion := test.leafVersion
+		if leafVersion == 0 {
+			leafVersion = params.BaseLeafVersion
+		}
+		flags := test.flags
+		if flags == 0 {
+			flags = taprootTestFlags
+		}
+		pkScript, witness := spend(leafVersion, test.script, test.keys...)
+		if test.mutate != nil {
+			witness = test.mutate(witness)
+		}
+		tx, sigHashes := taprootSpendTx(pkScript)
+		tx.TxIn[0].Witness = witness
+		err := executeTaproot(pkScript, tx, flags, sigHashes)
+		if test.err == nil {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			}
+			continue
+		}
+		if !test.err.Is(err) {
+			t.Errorf("%s: expected %v, got %v", test.name,
+				test.err, err)
+		}
+	}
+}
@@ -337,6 +337,66 @@ var (
 	// the public key used in either a check-sig or check-multi-sig isn't
 	// serialized in a compressed format.
 	ErrWitnessPubKeyType = Err.Code("ErrWitnessPubKeyType")
+
+	// ------------------------------------------
+	// Failures related to taproot and tapscript.
+	// ------------------------------------------
+
+	// ErrTaprootMissingPrevOuts is returned if ScriptVerifyTaproot is set
+	// and a taproot output is spent but the sighash midstate does not
+	// include all of the outputs which are spent by the transaction.
+	ErrTaprootMissingPrevOuts = Err.Code("ErrTaprootMissingPrevOuts")
+
+	// ErrTaprootSigInvalid is returned if a non-empty schnorr signature
+	// for a taproot key path spend, OP_CHECKSIG, OP_CHECKSIGVERIFY or
+	// OP_CHECKSIGADD does not verify.
+	ErrTaprootSigInvalid = Err.Code("ErrTaprootSigInvalid")
+
+	// ErrTaprootSigSize is returned if a schnorr signature is neither 64
+	// nor 65 bytes long.
+	ErrTaprootSigSize = Err.Code("ErrTaprootSigSize")
+
+	// ErrTaprootSigHashType is returned if a schnorr signature has an
+	// undefined sighash type or if the sighash type is SigHashSingle and
+	// there is no output with the same index as the input.
+	ErrTaprootSigHashType = Err.Code("ErrTaprootSigHashType")
+
+	// ErrTaprootControlBlockSize is returned if the control block of a
+	// taproot script path spend is not 33 + 32m bytes long where m is at
+	// most 128.
+	ErrTaprootControlBlockSize = Err.Code("ErrTaprootControlBlockSize")
+
+	// ErrTaprootMerkleMismatch is returned if the internal key, the leaf
+	// script and the merkle path of a taproot script path spend do not
+	// commit to the output key.
+	ErrTaprootMerkleMismatch = Err.Code("ErrTaprootMerkleMismatch")
+
+	// ErrTapscriptCheckMultiSig is returned if OP_CHECKMULTISIG or
+	// OP_CHECKMULTISIGVERIFY is executed in a tapscript.
+	ErrTapscriptCheckMultiSig = Err.Code("ErrTapscriptCheckMultiSig")
+
+	// ErrTapscriptEmptyPubKey is returned if a signature checking opcode
+	// is executed in a tapscript with an empty public key.
+	ErrTapscriptEmptyPubKey = Err.Code("ErrTapscriptEmptyPubKey")
+
+	// ErrTapscriptValidationWeight is returned if the signature checks of
+	// a tapscript exceed the budget which is given by the size of the
+	// witness.
+	ErrTapscriptValidationWeight = Err.Code("ErrTapscriptValidationWeight")
+
+	// ErrDiscourageUpgradableTaprootVersion is returned if
+	// ScriptVerifyDiscourageUpgradableTaprootVersion is set and a taproot
+	// script path spend uses an unknown leaf version.
+	ErrDiscourageUpgradableTaprootVersion = Err.Code("ErrDiscourageUpgradableTaprootVersion")
+
+	// ErrDiscourageOpSuccess is returned if ScriptVerifyDiscourageOpSuccess
+	// is set and a tapscript contains an OP_SUCCESSx opcode.
+	ErrDiscourageOpSuccess = Err.Code("ErrDiscourageOpSuccess")
+
+	// ErrDiscourageUpgradablePubKeyType is returned if
+	// ScriptVerifyDiscourageUpgradablePubKeyType is set and a tapscript
+	// checks a signature against a public key which is not 32 bytes long.
+	ErrDiscourageUpgradablePubKeyType = Err.Code("ErrDiscourageUpgradablePubKeyType")
 )
 
 // ScriptError creates an Error given a set of arguments.
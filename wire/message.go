@@ -60,6 +60,9 @@ const (
 	CmdCFHeaders    = "cfheaders"
 	CmdCFCheckpt    = "cfcheckpt"
 	CmdSendAddrV2   = "sendaddrv2"
+	CmdPcAnnInv     = "pcanninv"
+	CmdGetPcAnns    = "getpcanns"
+	CmdPcAnn        = "pcann"
 )
 
 // MessageEncoding represents the wire message encoding format to be used.
@@ -198,6 +201,15 @@ func makeEmptyMessage(command string) (Message, er.R) {
 	case CmdCFilter:
 		msg = &MsgCFilter{}
 
+	case CmdPcAnnInv:
+		msg = &MsgPcAnnInv{}
+
+	case CmdGetPcAnns:
+		msg = &MsgGetPcAnns{}
+
+	case CmdPcAnn:
+		msg = &MsgPcAnn{}
+
 	case CmdCFHeaders:
 		msg = &MsgCFHeaders{}
 
@@ -78,6 +78,9 @@ func TestMessage(t *testing.T) {
 		[]byte("payload"))
 	msgCFHeaders := NewMsgCFHeaders()
 	msgCFCheckpt := NewMsgCFCheckpt(GCSFilterRegular, &chainhash.Hash{}, 0)
+	msgPcAnnInv := NewMsgPcAnnInv()
+	msgGetPcAnns := NewMsgGetPcAnns()
+	msgPcAnn := NewMsgPcAnn(&PacketCryptAnn{})
 
 	tests := []struct {
 		in     Message             // Value to encode
@@ -112,6 +115,9 @@ func TestMessage(t *testing.T) {
 		{msgCFilter, msgCFilter, pver, protocol.MainNet, 65},
 		{msgCFHeaders, msgCFHeaders, pver, protocol.MainNet, 90},
 		{msgCFCheckpt, msgCFCheckpt, pver, protocol.MainNet, 58},
+		{msgPcAnnInv, msgPcAnnInv, pver, protocol.MainNet, 25},
+		{msgGetPcAnns, msgGetPcAnns, pver, protocol.MainNet, 25},
+		{msgPcAnn, msgPcAnn, pver, protocol.MainNet, 1048},
 	}
 
 	t.Logf("Running %d tests", len(tests))
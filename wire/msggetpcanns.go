@@ -0,0 +1,69 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+)
+
+// MsgGetPcAnns implements the Message interface and represents a getpcanns
+// message.  It is used to request PacketCrypt announcements by hash, usually
+// in response to a pcanninv message.  Each requested announcement which is
+// still available is sent back in a pcann message.  Each message is limited
+// to a maximum number of hashes, which is currently 1000.
+type MsgGetPcAnns struct {
+	AnnHashes []*chainhash.Hash
+}
+
+// AddAnnHash adds an announcement hash to the message.
+func (msg *MsgGetPcAnns) AddAnnHash(hash *chainhash.Hash) er.R {
+	if len(msg.AnnHashes)+1 > MaxPcAnnsPerMsg {
+		str := fmt.Sprintf("too many announcement hashes in message "+
+			"[max %v]", MaxPcAnnsPerMsg)
+		return messageError("MsgGetPcAnns.AddAnnHash", str)
+	}
+	msg.AnnHashes = append(msg.AnnHashes, hash)
+	return nil
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgGetPcAnns) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) er.R {
+	annHashes, err := readPcAnnHashes(r, pver, "MsgGetPcAnns")
+	if err != nil {
+		return err
+	}
+	msg.AnnHashes = annHashes
+	return nil
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgGetPcAnns) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) er.R {
+	return writePcAnnHashes(w, pver, "MsgGetPcAnns", msg.AnnHashes)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgGetPcAnns) Command() string {
+	return CmdGetPcAnns
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgGetPcAnns) MaxPayloadLength(pver uint32) uint32 {
+	// Num hashes (varInt) + max allowed hashes.
+	return MaxVarIntPayload + (MaxPcAnnsPerMsg * chainhash.HashSize)
+}
+
+// NewMsgGetPcAnns returns a new getpcanns message that conforms to the
+// Message interface.  See MsgGetPcAnns for details.
+func NewMsgGetPcAnns() *MsgGetPcAnns {
+	return &MsgGetPcAnns{AnnHashes: make([]*chainhash.Hash, 0)}
+}
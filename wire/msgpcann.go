@@ -0,0 +1,48 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"io"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+)
+
+// MsgPcAnn implements the Message interface and represents a pcann message.
+// It is used to deliver a PacketCrypt announcement in response to a getpcanns
+// message.
+type MsgPcAnn struct {
+	Ann PacketCryptAnn
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgPcAnn) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) er.R {
+	return msg.Ann.BtcDecode(r, pver, enc)
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgPcAnn) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) er.R {
+	return msg.Ann.BtcEncode(w, pver, enc)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgPcAnn) Command() string {
+	return CmdPcAnn
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgPcAnn) MaxPayloadLength(pver uint32) uint32 {
+	return PcAnnSerializeSize
+}
+
+// NewMsgPcAnn returns a new pcann message carrying the provided announcement
+// that conforms to the Message interface.  See MsgPcAnn for details.
+func NewMsgPcAnn(ann *PacketCryptAnn) *MsgPcAnn {
+	return &MsgPcAnn{Ann: *ann}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkt-cash/pktd/btcutil/er"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+)
+
+// MaxPcAnnsPerMsg is the maximum number of PacketCrypt announcement hashes
+// that can be in a single pcanninv or getpcanns message.
+const MaxPcAnnsPerMsg = 1000
+
+// readPcAnnHashes reads a list of announcement hashes for the named message.
+func readPcAnnHashes(r io.Reader, pver uint32, name string) ([]*chainhash.Hash, er.R) {
+	count, err := ReadVarInt(r, pver)
+	if err != nil {
+		return nil, err
+	}
+	if count > MaxPcAnnsPerMsg {
+		str := fmt.Sprintf("too many announcement hashes in message [%v]",
+			count)
+		return nil, messageError(name+".BtcDecode", str)
+	}
+
+	// Create a contiguous slice of hashes to deserialize into in order to
+	// reduce the number of allocations.
+	hashes := make([]chainhash.Hash, count)
+	annHashes := make([]*chainhash.Hash, count)
+	for i := range hashes {
+		if err := readElement(r, &hashes[i]); err != nil {
+			return nil, err
+		}
+		annHashes[i] = &hashes[i]
+	}
+	return annHashes, nil
+}
+
+// writePcAnnHashes writes a list of announcement hashes for the named message.
+func writePcAnnHashes(w io.Writer, pver uint32, name string, annHashes []*chainhash.Hash) er.R {
+	count := len(annHashes)
+	if count > MaxPcAnnsPerMsg {
+		str := fmt.Sprintf("too many announcement hashes in message [%v]",
+			count)
+		return messageError(name+".BtcEncode", str)
+	}
+	if err := WriteVarInt(w, pver, uint64(count)); err != nil {
+		return err
+	}
+	for _, hash := range annHashes {
+		if err := writeElement(w, hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MsgPcAnnInv implements the Message interface and represents a pcanninv
+// message.  It is used by peers relaying PacketCrypt announcements, see
+// protocol.SFNodePcAnn, to advertise the announcements they have by hash.
+// Each message is limited to a maximum number of hashes, which is currently
+// 1000.
+type MsgPcAnnInv struct {
+	AnnHashes []*chainhash.Hash
+}
+
+// AddAnnHash adds an announcement hash to the message.
+func (msg *MsgPcAnnInv) AddAnnHash(hash *chainhash.Hash) er.R {
+	if len(msg.AnnHashes)+1 > MaxPcAnnsPerMsg {
+		str := fmt.Sprintf("too many announcement hashes in message "+
+			"[max %v]", MaxPcAnnsPerMsg)
+		return messageError("MsgPcAnnInv.AddAnnHash", str)
+	}
+	msg.AnnHashes = append(msg.AnnHashes, hash)
+	return nil
+}
+
+// BtcDecode decodes r using the bitcoin protocol encoding into the receiver.
+// This is part of the Message interface implementation.
+func (msg *MsgPcAnnInv) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) er.R {
+	annHashes, err := readPcAnnHashes(r, pver, "MsgPcAnnInv")
+	if err != nil {
+		return err
+	}
+	msg.AnnHashes = annHashes
+	return nil
+}
+
+// BtcEncode encodes the receiver to w using the bitcoin protocol encoding.
+// This is part of the Message interface implementation.
+func (msg *MsgPcAnnInv) BtcEncode(w io.Writer, pver uint32, enc MessageEncoding) er.R {
+	return writePcAnnHashes(w, pver, "MsgPcAnnInv", msg.AnnHashes)
+}
+
+// Command returns the protocol command string for the message.  This is part
+// of the Message interface implementation.
+func (msg *MsgPcAnnInv) Command() string {
+	return CmdPcAnnInv
+}
+
+// MaxPayloadLength returns the maximum length the payload can be for the
+// receiver.  This is part of the Message interface implementation.
+func (msg *MsgPcAnnInv) MaxPayloadLength(pver uint32) uint32 {
+	// Num hashes (varInt) + max allowed hashes.
+	return MaxVarIntPayload + (MaxPcAnnsPerMsg * chainhash.HashSize)
+}
+
+// NewMsgPcAnnInv returns a new pcanninv message that conforms to the Message
+// interface.  See MsgPcAnnInv for details.
+func NewMsgPcAnnInv() *MsgPcAnnInv {
+	return &MsgPcAnnInv{AnnHashes: make([]*chainhash.Hash, 0)}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2020 Anode LLC
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
+	"github.com/pkt-cash/pktd/wire/protocol"
+)
+
+// TestPcAnnMessages tests encoding and decoding the PacketCrypt announcement
+// relay messages.
+func TestPcAnnMessages(t *testing.T) {
+	pver := protocol.ProtocolVersion
+	var ann PacketCryptAnn
+	ann.Header[0] = 1
+	ann.Header[1023] = 0xff
+	hash := ann.Hash()
+	other := chainhash.Hash{0x01}
+
+	inv := NewMsgPcAnnInv()
+	getAnns := NewMsgGetPcAnns()
+	for _, h := range []*chainhash.Hash{&hash, &other} {
+		if err := inv.AddAnnHash(h); err != nil {
+			t.Fatalf("MsgPcAnnInv.AddAnnHash: %v", err)
+		}
+		if err := getAnns.AddAnnHash(h); err != nil {
+			t.Fatalf("MsgGetPcAnns.AddAnnHash: %v", err)
+		}
+	}
+	tests := []struct {
+		in   Message
+		out  Message
+		size int
+	}{
+		{inv, NewMsgPcAnnInv(), 1 + 2*chainhash.HashSize},
+		{getAnns, NewMsgGetPcAnns(), 1 + 2*chainhash.HashSize},
+		{NewMsgPcAnn(&ann), &MsgPcAnn{}, PcAnnSerializeSize},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := test.in.BtcEncode(&buf, pver, BaseEncoding); err != nil {
+			t.Errorf("%s: encode failed: %v", test.in.Command(), err)
+			continue
+		}
+		if buf.Len() != test.size {
+			t.Errorf("%s: got %d bytes want %d", test.in.Command(),
+				buf.Len(), test.size)
+		}
+		if uint32(buf.Len()) > test.in.MaxPayloadLength(pver) {
+			t.Errorf("%s: payload exceeds the maximum", test.in.Command())
+		}
+		if err := test.out.BtcDecode(&buf, pver, BaseEncoding); err != nil {
+			t.Errorf("%s: decode failed: %v", test.in.Command(), err)
+			continue
+		}
+		if !reflect.DeepEqual(test.in, test.out) {
+			t.Errorf("%s: got %v want %v", test.in.Command(), test.out,
+				test.in)
+		}
+	}
+}
+
+// TestPcAnnInvTooMany ensures that messages with too many announcement hashes
+// are rejected.
+func TestPcAnnInvTooMany(t *testing.T) {
+	pver := protocol.ProtocolVersion
+	inv := NewMsgPcAnnInv()
+	for i := 0; i < MaxPcAnnsPerMsg; i++ {
+		if err := inv.AddAnnHash(&chainhash.Hash{}); err != nil {
+			t.Fatalf("AddAnnHash: %v", err)
+		}
+	}
+	if err := inv.AddAnnHash(&chainhash.Hash{}); err == nil {
+		t.Errorf("AddAnnHash: accepted too many hashes")
+	}
+
+	var buf bytes.Buffer
+	WriteVarInt(&buf, pver, MaxPcAnnsPerMsg+1)
+	if err := NewMsgGetPcAnns().BtcDecode(&buf, pver, BaseEncoding); err == nil {
+		t.Errorf("BtcDecode: accepted too many hashes")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pkt-cash/pktd/btcutil/er"
 
 	"github.com/pkt-cash/pktd/blockchain/packetcrypt/pcutil"
+	"github.com/pkt-cash/pktd/chaincfg/chainhash"
 )
 
 // PacketCryptAnn is the in-memory structure of a PacketCrypt announcement
@@ -96,6 +97,12 @@ func (p *PacketCryptAnn) HasSigningKey() bool {
 	return !pcutil.IsZero(p.GetSigningKey())
 }
 
+// Hash returns the hash which identifies the announcement, the double sha256
+// of its serialization.
+func (p *PacketCryptAnn) Hash() chainhash.Hash {
+	return chainhash.DoubleHashH(p.Header[:])
+}
+
 // BtcDecode decodes an announcement from a reader
 func (p *PacketCryptAnn) BtcDecode(r io.Reader, pver uint32, enc MessageEncoding) er.R {
 	_, err := io.ReadFull(r, p.Header[:])
@@ -87,6 +87,11 @@ const (
 	// SFNode2X is a flag used to indicate a peer is running the Segwit2X
 	// software.
 	SFNode2X
+
+	// SFNodePcAnn is a flag used to indicate a peer relays PacketCrypt
+	// announcements with the pcanninv, getpcanns and pcann messages.  It
+	// uses one of the bits reserved for experimental services.
+	SFNodePcAnn ServiceFlag = 1 << 24
 )
 
 // Map of service flags back to their constant names for pretty printing.
@@ -99,6 +104,7 @@ var sfStrings = map[ServiceFlag]string{
 	SFNodeBit5:    "SFNodeBit5",
 	SFNodeCF:      "SFNodeCF",
 	SFNode2X:      "SFNode2X",
+	SFNodePcAnn:   "SFNodePcAnn",
 }
 
 // orderedSFStrings is an ordered list of service flags from highest to
@@ -112,6 +118,7 @@ var orderedSFStrings = []ServiceFlag{
 	SFNodeBit5,
 	SFNodeCF,
 	SFNode2X,
+	SFNodePcAnn,
 }
 
 // String returns the ServiceFlag in human-readable form.